@@ -1,10 +1,19 @@
 // binary gen generates converters from the resource definition. The code is
 // intended to go directly in the convert package.
+//
+// The definitions come from the output of `terraform providers schema -json`,
+// or, built with -tags provider, from the provider itself:
+//
+//	terraform providers schema -json > schema.json
+//	gen -schema-json schema.json -provider-version 2.38.1 -output-dir convert/gen
 package main
 
 import (
 	"bytes"
+	"cmp"
+	"crypto/sha256"
 	_ "embed"
+	"encoding/hex"
 	"flag"
 	"fmt"
 	"go/format"
@@ -12,23 +21,27 @@ import (
 	"iter"
 	"log"
 	"maps"
+	"math/big"
 	"os"
 	"path/filepath"
 	"slices"
+	"strconv"
 	"strings"
 	"text/template"
 
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/pfcm/it"
+	"github.com/pfcm/ktf/convert/gen"
 	"github.com/pfcm/ktf/resource"
-	"github.com/pfcm/terraform-provider-kubernetes/v2/kubernetes"
+	"github.com/zclconf/go-cty/cty"
 )
 
 var (
-	outputDirFlag   = flag.String("output-dir", "", "`directory` to hold results")
-	resourcesFlag   = flag.String("resources", "", "`Comma separated list` of resources to generate (in the terraform_provider_kubernetes underscore format). If empty, will generate everything exported by the provide (except kubernetes_manifest)")
-	skipAliasesFlag = flag.Bool("skip-aliases", false, "if true, only generates versioned resources. Only applicable if -resources=\"\"")
-	packageNameFlag = flag.String("go-package", "gen", "go package name for the generated code")
+	outputDirFlag       = flag.String("output-dir", "", "`directory` to hold results")
+	resourcesFlag       = flag.String("resources", "", "`Comma separated list` of resources to generate (in the terraform_provider_kubernetes underscore format). If empty, will generate everything exported by the provide (except kubernetes_manifest)")
+	skipAliasesFlag     = flag.Bool("skip-aliases", false, "if true, only generates versioned resources. Only applicable if -resources=\"\"")
+	packageNameFlag     = flag.String("go-package", "gen", "go package name for the generated code")
+	schemaJSONFlag      = flag.String("schema-json", "", "`path` of the output of `terraform providers schema -json` to generate from. Required unless gen was built with -tags provider, in which case it defaults to the provider gen was built with")
+	providerVersionFlag = flag.String("provider-version", "", "the `version` of the provider -schema-json came from")
 )
 
 func main() {
@@ -37,28 +50,54 @@ func main() {
 		os.MkdirAll(*outputDirFlag, 077)
 	}
 
-	allResources := kubernetes.Provider().ResourcesMap
+	var (
+		allResources map[string]resourceSchema
+		source       providerSource
+		err          error
+	)
+	switch {
+	case *schemaJSONFlag != "":
+		if *providerVersionFlag == "" {
+			log.Fatal("-provider-version is required with -schema-json")
+		}
+		allResources, err = readSchemaJSON(*schemaJSONFlag)
+		source = providerSource{
+			Module:  "hashicorp/kubernetes " + *providerVersionFlag + " (terraform providers schema -json)",
+			Version: strings.TrimPrefix(*providerVersionFlag, "v"),
+		}
+	case compiledSchemas != nil:
+		allResources, source, err = compiledSchemas()
+	default:
+		log.Fatal("-schema-json is required (or build with -tags provider to use the provider's own schema)")
+	}
+	if err != nil {
+		log.Fatal(err)
+	}
 
 	var names iter.Seq[string]
 	if *resourcesFlag == "" {
-		names = maps.Keys(allResources)
+		names = it.Filter(maps.Keys(allResources), func(name string) bool {
+			return name != "kubernetes_manifest"
+		})
 		if *skipAliasesFlag {
-			names = it.Filter(names, func(name string) bool {
-				// TODO: some resources only exist without versions
-				pieces := strings.Split(name, "_")
-				return strings.HasPrefix(pieces[len(pieces)-1], "v")
-			})
+			// TODO: some resources only exist without versions
+			names = it.Filter(names, versioned)
 		}
 	} else {
 		names = slices.Values(strings.Split(*resourcesFlag, ","))
 	}
+	resources := make(map[string]resourceSchema)
 	for name := range names {
 		schema, ok := allResources[name]
 		if !ok {
 			log.Fatalf("unknown resource %q", name)
 		}
+		resources[name] = schema
+	}
+	blocks := shareBlocks(resources)
+	for name, schema := range resources {
 		var b bytes.Buffer
-		if err := generate(&b, *packageNameFlag, name, schema); err != nil {
+		if err := generate(&b, *packageNameFlag, name, schema, blocks[name]); err != nil {
 			log.Fatalf("generating %q: %v", name, err)
 		}
 		out, err := format.Source(b.Bytes())
@@ -72,20 +111,67 @@ func main() {
 			log.Fatal(err)
 		}
 	}
+
+	var b bytes.Buffer
+	source.Package = *packageNameFlag
+	if err := providerTmpl.Execute(&b, source); err != nil {
+		log.Fatalf("generating provider version: %v", err)
+	}
+	out, err := format.Source(b.Bytes())
+	if err != nil {
+		log.Fatalf("formatting generated code: %v\ncode:\n%s", err, b.String())
+	}
+	if err := os.WriteFile(filepath.Join(*outputDirFlag, "provider.gen.go"), out, 0777); err != nil {
+		log.Fatal(err)
+	}
+}
+
+// providerSource describes where the schemas came from, for provider.gen.go.
+type providerSource struct {
+	Package, Module, Version string
 }
 
-func generate(w io.Writer, packageName, name string, resource *schema.Resource) error {
+// compiledSchemas, if set, returns the schemas of the provider gen was built
+// with. It's only set when building with -tags provider, see provider.go.
+var compiledSchemas func() (map[string]resourceSchema, providerSource, error)
+
+// resourceSchema is the part of a resource's schema needed to generate its
+// spec, whichever source it came from.
+type resourceSchema struct {
+	version    int
+	block      schemaBlock
+	deprecated string
+}
+
+type schemaBlock struct {
+	attributes map[string]valueType
+	blocks     map[string]schemaBlock
+	// schema holds the metadata of the attributes and blocks.
+	schema map[string]gen.FieldSchema
+}
+
+// versioned reports whether name is a versioned resource, like
+// kubernetes_deployment_v1, rather than an alias.
+func versioned(name string) bool {
+	pieces := strings.Split(name, "_")
+	return strings.HasPrefix(pieces[len(pieces)-1], "v")
+}
+
+// generate writes the file for a resource, holding blocks, the first of which
+// is the resource's own spec.
+func generate(w io.Writer, packageName, name string, resource resourceSchema, blocks []blockSpec) error {
 	data := struct {
 		Package       string
 		Name          string
 		SchemaVersion int
-		Schema        map[string]*schema.Schema
+		Deprecated    string
 		Blocks        []blockSpec
 	}{
 		Package:       packageName,
 		Name:          name,
-		SchemaVersion: resource.SchemaVersion,
-		Blocks:        collectBlockSpecs(name, resource.Schema),
+		SchemaVersion: resource.version,
+		Deprecated:    resource.deprecated,
+		Blocks:        blocks,
 	}
 
 	return specTmpl.Execute(w, data)
@@ -95,96 +181,178 @@ type blockSpec struct {
 	Name string // unique reference for the block
 
 	Attributes map[string]valueType
+	// Names of attributes holding maps of resource quantities.
+	Quantities []string
 
-	// Names of sub-blocks.
+	// References of sub-blocks.
 	Blocks map[string]string
-	// TODO: things like description, optional etc? Probably not necessary,
-	// we're not trying to validate the config, just convert it.
+
+	// Metadata of the attributes and blocks.
+	Schema map[string]gen.FieldSchema
 }
 
 type valueType struct {
-	First  schema.ValueType
-	Second schema.ValueType // only set if first is list or set
+	Func string // name of the converter in the gen package
+	List bool   // whether Func converts each element of a list
 }
 
-func (vt valueType) IsList() bool {
-	// TODO: sets are probably special?
-	return vt.First == schema.TypeList || vt.First == schema.TypeSet
+// blockName is the name of the variable holding a nested block's spec, which
+// is also what gen.IsQuantityMap expects.
+func blockName(parent, name string) string {
+	return fmt.Sprintf("%s_%s", parent, resource.ToCamel(name))
 }
 
-func collectBlockSpecs(name string, r map[string]*schema.Schema) []blockSpec {
-	type todoBlock struct {
-		name     string
-		schema   map[string]*schema.Schema
-		maxItems int
+// shareBlocks returns the blockSpecs to write in each resource's file.
+// Nested blocks which are the same wherever they are, like the pod spec in
+// each of the workloads or every block of an aliased resource, are only
+// written once, in the file of the resource where they're least deeply
+// nested (preferring versioned resources), and everything else refers to
+// that. The resource's own spec comes first, then the rest by name.
+func shareBlocks(resources map[string]resourceSchema) map[string][]blockSpec {
+	type use struct {
+		resource, name string
+		depth          int
 	}
 	var (
-		blockSpecs []blockSpec
-		todo       = []todoBlock{{name: resource.ToCamel(name), schema: r}}
+		specs = make(map[string]blockSpec) // by key
+		uses  = make(map[string][]use)
 	)
-	for len(todo) > 0 {
-		i := len(todo) - 1
-		c := todo[i]
-		todo = todo[:i]
-
-		var (
-			attrs  = make(map[string]valueType)
-			blocks = make(map[string]string)
-		)
-		for name, s := range c.schema {
-			if s.Computed && !s.Optional {
-				// Read-only.
-				continue
+	var add func(resource, name string, depth int, b schemaBlock) string
+	add = func(resource, name string, depth int, b schemaBlock) string {
+		spec := blockSpec{
+			Attributes: b.attributes,
+			Blocks:     make(map[string]string),
+			Schema:     b.schema,
+		}
+		for name, vt := range b.attributes {
+			if vt.Func == "toQuantityMap" {
+				spec.Quantities = append(spec.Quantities, name)
 			}
-			switch t := s.Type; t {
-			case schema.TypeList, schema.TypeSet:
-				// Could be nested block, if the value type is not simple.
-				switch e := s.Elem.(type) {
-				case *schema.Schema:
-					// Simple type.
-					attrs[name] = valueType{First: t, Second: e.Type}
-				case *schema.Resource:
-					// Nested block.
-					childName := fmt.Sprintf("%s_%s", c.name, resource.ToCamel(name))
-					blocks[name] = childName
-					todo = append(todo, todoBlock{
-						name:   childName,
-						schema: e.Schema,
-					})
-				default:
-					panic(fmt.Errorf("impossible type %+v", e))
+		}
+		slices.Sort(spec.Quantities)
+		for child, cb := range b.blocks {
+			// Keys until the names are picked.
+			spec.Blocks[child] = add(resource, blockName(name, child), depth+1, cb)
+		}
+		key := "resource " + resource
+		if depth > 0 {
+			key = blockKey(spec)
+		}
+		specs[key] = spec
+		uses[key] = append(uses[key], use{resource: resource, name: name, depth: depth})
+		return key
+	}
+	for _, name := range slices.Sorted(maps.Keys(resources)) {
+		add(name, resource.ToCamel(name), 0, resources[name].block)
+	}
+
+	owners := make(map[string]use, len(uses))
+	for key, us := range uses {
+		owners[key] = slices.MinFunc(us, func(a, b use) int {
+			if c := cmp.Compare(a.depth, b.depth); c != 0 {
+				return c
+			}
+			if va, vb := versioned(a.resource), versioned(b.resource); va != vb {
+				if va {
+					return -1
 				}
-			default:
-				// Must be an attribute.
-				attrs[name] = valueType{First: t}
+				return 1
 			}
-		}
-		blockSpecs = append(blockSpecs, blockSpec{
-			Name:       c.name,
-			Attributes: attrs,
-			Blocks:     blocks,
-			// TODO: pass min and max down? Build this when we push maybe?
+			return cmp.Compare(a.name, b.name)
 		})
 	}
-	return blockSpecs
+	out := make(map[string][]blockSpec)
+	for _, key := range slices.Sorted(maps.Keys(specs)) {
+		spec, owner := specs[key], owners[key]
+		spec.Name = owner.name
+		for child, ck := range spec.Blocks {
+			spec.Blocks[child] = owners[ck].name
+		}
+		out[owner.resource] = append(out[owner.resource], spec)
+	}
+	for _, blocks := range out {
+		// The resource's own name is a prefix of the rest.
+		slices.SortFunc(blocks, func(a, b blockSpec) int { return cmp.Compare(a.Name, b.Name) })
+	}
+	return out
+}
+
+// blockKey identifies the contents of a block, whose Blocks hold the keys of
+// the sub-blocks.
+func blockKey(spec blockSpec) string {
+	h := sha256.New()
+	for _, name := range slices.Sorted(maps.Keys(spec.Attributes)) {
+		fmt.Fprintf(h, "attribute %q %+v\n", name, spec.Attributes[name])
+	}
+	for _, name := range slices.Sorted(maps.Keys(spec.Blocks)) {
+		fmt.Fprintf(h, "block %q %s\n", name, spec.Blocks[name])
+	}
+	for _, name := range slices.Sorted(maps.Keys(spec.Schema)) {
+		// fieldSchema only fails for defaults it can't write, which
+		// generate reports.
+		fs, _ := fieldSchema(spec.Schema[name])
+		fmt.Fprintf(h, "schema %q %s\n", name, fs)
+	}
+	return hex.EncodeToString(h.Sum(nil))
 }
 
 //go:embed spec.tmpl
 var rawSpecTmpl string
 
 var specTmpl = template.Must(template.New("spec").Funcs(template.FuncMap{
-	"valueFunc": func(in schema.ValueType) (string, error) {
-		f, ok := map[schema.ValueType]string{
-			schema.TypeBool:   "toBool",
-			schema.TypeInt:    "toInt",
-			schema.TypeFloat:  "toFloat",
-			schema.TypeString: "toString",
-			schema.TypeMap:    "toStringMap",
-		}[in]
-		if !ok {
-			return "", fmt.Errorf("unknown ValueType %v", in)
-		}
-		return f, nil
-	},
-	"first": func(bs []blockSpec) blockSpec { return bs[0] },
+	"first":       func(bs []blockSpec) blockSpec { return bs[0] },
+	"fieldSchema": fieldSchema,
 }).Parse(rawSpecTmpl))
+
+// fieldSchema writes fs as a composite literal, leaving out the zero fields.
+func fieldSchema(fs gen.FieldSchema) (string, error) {
+	var fields []string
+	for _, f := range []struct {
+		name string
+		set  bool
+		val  any
+	}{
+		{"Required", fs.Required, fs.Required},
+		{"Optional", fs.Optional, fs.Optional},
+		{"Sensitive", fs.Sensitive, fs.Sensitive},
+		{"ForceNew", fs.ForceNew, fs.ForceNew},
+		{"MinItems", fs.MinItems != 0, fs.MinItems},
+		{"MaxItems", fs.MaxItems != 0, fs.MaxItems},
+		{"Deprecated", fs.Deprecated != "", strconv.Quote(fs.Deprecated)},
+		{"ConflictsWith", len(fs.ConflictsWith) > 0, fmt.Sprintf("%#v", fs.ConflictsWith)},
+		{"Description", fs.Description != "", strconv.Quote(fs.Description)},
+	} {
+		if f.set {
+			fields = append(fields, fmt.Sprintf("%s: %v", f.name, f.val))
+		}
+	}
+	if fs.Default.Type() != cty.NilType {
+		d, err := ctyLiteral(fs.Default)
+		if err != nil {
+			return "", err
+		}
+		fields = append(fields, "Default: "+d)
+	}
+	return "{" + strings.Join(fields, ", ") + "}", nil
+}
+
+func ctyLiteral(v cty.Value) (string, error) {
+	switch v.Type() {
+	case cty.Bool:
+		return fmt.Sprintf("cty.BoolVal(%t)", v.True()), nil
+	case cty.String:
+		return fmt.Sprintf("cty.StringVal(%q)", v.AsString()), nil
+	case cty.Number:
+		f := v.AsBigFloat()
+		if i, acc := f.Int64(); acc == big.Exact {
+			return fmt.Sprintf("cty.NumberIntVal(%d)", i), nil
+		}
+		return fmt.Sprintf("cty.NumberFloatVal(%s)", f.Text('g', -1)), nil
+	}
+	return "", fmt.Errorf("unsupported default %#v", v)
+}
+
+//go:embed provider.tmpl
+var rawProviderTmpl string
+
+var providerTmpl = template.Must(template.New("provider").Parse(rawProviderTmpl))
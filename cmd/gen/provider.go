@@ -0,0 +1,155 @@
+//go:build provider
+
+package main
+
+import (
+	"fmt"
+	"reflect"
+	"runtime/debug"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/pfcm/terraform-provider-kubernetes/v2/kubernetes"
+	"github.com/zclconf/go-cty/cty"
+
+	"github.com/pfcm/ktf/convert/gen"
+	"github.com/pfcm/ktf/resource"
+)
+
+// providerModule is the module the schemas come from.
+const providerModule = "github.com/pfcm/terraform-provider-kubernetes/v2"
+
+func init() {
+	compiledSchemas = providerSchemas
+}
+
+// providerSchemas reads the schemas from the provider gen was built with,
+// along with its version so ktf can pin it.
+func providerSchemas() (map[string]resourceSchema, providerSource, error) {
+	info, ok := debug.ReadBuildInfo()
+	if !ok {
+		return nil, providerSource{}, fmt.Errorf("no build info")
+	}
+	var version string
+	for _, m := range info.Deps {
+		if m.Path == providerModule {
+			version = m.Version
+		}
+	}
+	if version == "" {
+		return nil, providerSource{}, fmt.Errorf("%s isn't a dependency", providerModule)
+	}
+
+	out := make(map[string]resourceSchema)
+	for name, r := range kubernetes.Provider().ResourcesMap {
+		b, err := fromSDK(resource.ToCamel(name), r.Schema)
+		if err != nil {
+			return nil, providerSource{}, fmt.Errorf("%s: %w", name, err)
+		}
+		out[name] = resourceSchema{version: r.SchemaVersion, block: b, deprecated: r.DeprecationMessage}
+	}
+	return out, providerSource{
+		Module:  providerModule + "@" + version,
+		Version: strings.TrimPrefix(version, "v"),
+	}, nil
+}
+
+func fromSDK(name string, s map[string]*schema.Schema) (schemaBlock, error) {
+	b := schemaBlock{
+		attributes: make(map[string]valueType),
+		blocks:     make(map[string]schemaBlock),
+		schema:     make(map[string]gen.FieldSchema),
+	}
+	for attr, s := range s {
+		if s.Computed && !s.Optional {
+			// Read-only.
+			continue
+		}
+		fs, err := sdkFieldSchema(s)
+		if err != nil {
+			return schemaBlock{}, fmt.Errorf("%s: %w", attr, err)
+		}
+		b.schema[attr] = fs
+		switch t := s.Type; t {
+		case schema.TypeList, schema.TypeSet:
+			// Could be nested block, if the value type is not simple.
+			switch e := s.Elem.(type) {
+			case *schema.Schema:
+				// Simple type.
+				f, err := sdkValueFunc(e.Type)
+				if err != nil {
+					return schemaBlock{}, err
+				}
+				b.attributes[attr] = valueType{Func: f, List: true}
+			case *schema.Resource:
+				// Nested block.
+				child, err := fromSDK(blockName(name, attr), e.Schema)
+				if err != nil {
+					return schemaBlock{}, err
+				}
+				b.blocks[attr] = child
+			default:
+				panic(fmt.Errorf("impossible type %+v", e))
+			}
+		case schema.TypeMap:
+			if gen.IsQuantityMap(name, attr) {
+				b.attributes[attr] = valueType{Func: "toQuantityMap"}
+				continue
+			}
+			fallthrough
+		default:
+			// Must be an attribute.
+			f, err := sdkValueFunc(t)
+			if err != nil {
+				return schemaBlock{}, err
+			}
+			b.attributes[attr] = valueType{Func: f}
+		}
+	}
+	return b, nil
+}
+
+func sdkFieldSchema(s *schema.Schema) (gen.FieldSchema, error) {
+	fs := gen.FieldSchema{
+		Required:      s.Required,
+		Optional:      s.Optional,
+		Sensitive:     s.Sensitive,
+		ForceNew:      s.ForceNew,
+		MinItems:      s.MinItems,
+		MaxItems:      s.MaxItems,
+		Deprecated:    s.Deprecated,
+		ConflictsWith: s.ConflictsWith,
+		Description:   s.Description,
+	}
+	if s.Default == nil {
+		return fs, nil
+	}
+	// Defaults are sometimes of named types, like v1.PreemptionPolicy.
+	switch d := reflect.ValueOf(s.Default); d.Kind() {
+	case reflect.Bool:
+		fs.Default = cty.BoolVal(d.Bool())
+	case reflect.Int, reflect.Int32, reflect.Int64:
+		fs.Default = cty.NumberIntVal(d.Int())
+	case reflect.Float64:
+		fs.Default = cty.NumberFloatVal(d.Float())
+	case reflect.String:
+		fs.Default = cty.StringVal(d.String())
+	default:
+		return gen.FieldSchema{}, fmt.Errorf("unsupported default %T (value %v)", s.Default, s.Default)
+	}
+	return fs, nil
+}
+
+func sdkValueFunc(in schema.ValueType) (string, error) {
+	f, ok := map[schema.ValueType]string{
+		schema.TypeBool:   "toBool",
+		schema.TypeInt:    "toInt",
+		schema.TypeFloat:  "toFloat",
+		schema.TypeString: "toString",
+		schema.TypeMap:    "toStringMap",
+	}[in]
+	if !ok {
+		return "", fmt.Errorf("unknown ValueType %v", in)
+	}
+	return f, nil
+}
@@ -0,0 +1,69 @@
+package main
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/pfcm/ktf/convert/gen"
+	"github.com/pfcm/ktf/resource"
+)
+
+// readSchemaJSON reads the schemas from the output of `terraform providers
+// schema -json`.
+func readSchemaJSON(path string) (map[string]resourceSchema, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	ps, err := gen.ReadProviderSchema(f)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+	out := make(map[string]resourceSchema, len(ps.ResourceSchemas))
+	for name, rs := range ps.ResourceSchemas {
+		b, err := fromJSON(resource.ToCamel(name), gen.ResourceBlock(rs.Block))
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", name, err)
+		}
+		out[name] = resourceSchema{version: rs.Version, block: b, deprecated: rs.DeprecationMessage()}
+	}
+	return out, nil
+}
+
+func fromJSON(name string, sb gen.SchemaBlock) (schemaBlock, error) {
+	b := schemaBlock{
+		attributes: make(map[string]valueType),
+		blocks:     make(map[string]schemaBlock),
+		schema:     make(map[string]gen.FieldSchema),
+	}
+	for attr, nb := range sb.BlockTypes {
+		child, err := fromJSON(blockName(name, attr), nb.Block)
+		if err != nil {
+			return schemaBlock{}, err
+		}
+		b.blocks[attr] = child
+		b.schema[attr] = nb.FieldSchema()
+	}
+	for attr, a := range sb.Attributes {
+		if a.Computed && !a.Optional {
+			// Read-only.
+			continue
+		}
+		b.schema[attr] = a.FieldSchema()
+		if obj, ok := gen.ObjectBlock(a.Type); ok {
+			child, err := fromJSON(blockName(name, attr), obj)
+			if err != nil {
+				return schemaBlock{}, err
+			}
+			b.blocks[attr] = child
+			continue
+		}
+		f, list, err := gen.ValueFunc(a.Type, gen.IsQuantityMap(name, attr))
+		if err != nil {
+			return schemaBlock{}, fmt.Errorf("%s: %w", attr, err)
+		}
+		b.attributes[attr] = valueType{Func: f, List: list}
+	}
+	return b, nil
+}
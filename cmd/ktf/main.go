@@ -1,32 +1,254 @@
 // binary ktf converts kubernetes yaml to terraform.
+//
+// Usage:
+//
+//	ktf [flags]
+//	ktf module -dir path [flags]
+//
+// The first form writes the resources to -out. The second writes a complete
+// module, with main.tf, variables.tf, outputs.tf and versions.tf, to -dir.
 package main
 
 import (
+	"bytes"
 	"flag"
 	"io"
 	"log"
 	"os"
+	"regexp"
+	"strings"
 
 	"github.com/pfcm/ktf"
+	"github.com/pfcm/ktf/convert"
+	"github.com/pfcm/ktf/convert/gen"
+	"github.com/pfcm/ktf/input"
 )
 
 var (
 	inputFileFlag  = flag.String("in", "-", "`path` of a kubernetes yaml manifest to convert, or \"-\" to read from stdin")
+	kustomizeFlag  = flag.String("kustomize", "", "if set, the `directory` of a kustomization to build and convert instead of -in. Bases and resources must all be local")
+	moduleDirFlag  = flag.String("dir", "", "`directory` to write the module to, for \"ktf module\"")
 	outputFileFlag = flag.String("out", "-", "`path` at which to write output, or \"-\" to write to stdout. If the file already exists, it will be overwritten")
+
+	canonicalQuantitiesFlag = flag.Bool("canonical-quantities", false, "if true, rewrite resource quantities (requests, limits, capacity etc.) into their canonical form, eg. \"1000m\" becomes \"1\"")
+	manifestRulesFlag       = flag.String("manifest-rules", "", "`path` of a yaml file holding a list of rules adding computed_fields, wait, field_manager or timeouts to the kubernetes_manifest resources for a group and kind")
+	fallbackFlag            = flag.String("fallback", "kubernetes_manifest", "the `resource` to write for objects without a typed resource in the kubernetes provider: kubernetes_manifest, or kubectl_manifest from the gavinbunney/kubectl provider, which doesn't need to reach the cluster at plan time. -manifest-rules can choose differently for some kinds")
+	kubectlYAMLEncodeFlag   = flag.Bool("kubectl-yamlencode", false, "if true, write the yaml_body of kubectl_manifest resources as yamlencode() of the equivalent HCL rather than a heredoc")
+	kubectlServerSideFlag   = flag.Bool("kubectl-server-side-apply", false, "if true, set server_side_apply on kubectl_manifest resources")
+	kubectlWaitFlag         = flag.Bool("kubectl-wait", false, "if true, set wait on kubectl_manifest resources, so destroying them waits for them to be deleted")
+	minimalFlag             = flag.Bool("minimal", false, "if true, leave out attributes of typed resources which are set to their default, according to the provider or the kubernetes API, such as restart_policy = \"Always\" or protocol = \"TCP\". The provider's defaults aren't known with -provider-schema")
+	encodeStructuredFlag    = flag.Bool("encode-structured", false, "if true, write strings holding JSON or multi-line YAML (eg. ConfigMap data or annotations) as jsonencode() or yamlencode() of the equivalent HCL. The resulting strings are equivalent but not byte-for-byte identical")
+	providerSchemaFlag      = flag.String("provider-schema", "", "`path` of the output of `terraform providers schema -json` to take the kubernetes provider's resources from, instead of the version ktf was built with")
+	providerVersionFlag     = flag.String("provider-version", "", "the `version` of the kubernetes provider to write resources for, eg. 2.38. Unless -provider-schema is the schema of that version, it has to match the version ktf was built with. \"ktf module\" needs it along with -provider-schema, to pin the provider")
+	upgradeAPIVersionsFlag  = flag.Bool("upgrade-api-versions", false, "if true, convert objects using API versions kubernetes no longer serves, such as extensions/v1beta1 Ingresses, as their replacement, moving any fields which changed where that can be done automatically. There is a warning about them either way")
+	fallbackUnknownFlag     = flag.Bool("fallback-unknown-fields", false, "if true, write objects with fields the provider's typed resource doesn't have with -fallback, instead of failing")
+	moduleRulesFlag         = flag.String("module-rules", "", "`path` of a yaml file holding a list of rules turning custom resources of a group and kind into calls to a terraform module, with inputs taken from paths in the resource")
+	parameterizeFlag        = flag.String("parameterize", "", "`path` of a yaml file holding a list of rules picking out fields, such as images or replica counts, to turn into variables")
+	forEachFlag             = flag.Bool("for-each", false, "if true, collapse resources of the same type which only differ in a few literal values (at most four, including the name) into a single resource with for_each over a map in locals")
+	hoistFlag               = flag.Bool("hoist-locals", false, "if true, move labels, annotations and images which are repeated across resources into locals")
+	helmFlag                = flag.String("helm", "", "if set, the `path` of a helm chart, as a directory or .tgz, to render and convert instead of -in. Dependencies must already be in the chart's charts directory")
+	helmValuesFlag          = flag.String("helm-values", "", "comma separated `paths` of values files for -helm, merged in order")
+	helmReleaseFlag         = flag.String("helm-release", "release", "the release `name` to render -helm with")
+	helmNamespaceFlag       = flag.String("helm-namespace", "default", "the `namespace` to render -helm in")
+	helmAsReleaseFlag       = flag.Bool("helm-as-release", false, "if true, write a helm_release resource installing the -helm chart with the -helm-values, instead of converting what it renders")
+	helmRepositoryFlag      = flag.String("helm-repository", "", "the `url` of the repository holding the -helm chart, for -helm-as-release. -helm is then the name of the chart rather than a path")
+	helmVersionFlag         = flag.String("helm-version", "", "the `version` of the -helm chart, for -helm-as-release")
+	helmSensitiveFlag       = flag.String("helm-sensitive", "", "a `regexp` matching the keys of any string values, besides passwords, secrets, tokens and keys, which -helm-as-release should take from sensitive variables")
+	helmHooksOutFlag        = flag.String("helm-hooks-out", "", "if set, the `path` at which to write the install and upgrade hooks of -helm separately from everything else. Otherwise they are included, with depends_on running them before or after everything else")
+	kustomizeOriginsFlag    = flag.Bool("kustomize-origins", false, "if true, report on stderr where in the -kustomize overlay each resource came from")
+	crdOutFlag              = flag.String("crd-out", "", "if set, the `path` at which to write CustomResourceDefinitions separately from everything else, so they can be applied first. Otherwise, and always for \"ktf module\", custom resources get a depends_on for the CRDs that define them")
 )
 
 func main() {
-	flag.Parse()
-
-	var input io.ReadCloser
-	if *inputFileFlag == "-" {
-		input = os.Stdin
+	module := len(os.Args) > 1 && os.Args[1] == "module"
+	if module {
+		flag.CommandLine.Parse(os.Args[2:])
+		if *moduleDirFlag == "" {
+			log.Fatal("ktf module: -dir is required")
+		}
+		if *providerSchemaFlag != "" && *providerVersionFlag == "" {
+			log.Fatal("ktf module: -provider-schema needs -provider-version, the version of the provider the schema is from, to pin it")
+		}
+		if *crdOutFlag != "" {
+			// Anything in the module is applied along with everything
+			// else, so the CRDs go in main.tf with depends_on instead.
+			log.Fatal("ktf module: -crd-out can't be used with modules")
+		}
 	} else {
+		flag.Parse()
+	}
+
+	if *helmAsReleaseFlag {
+		if *helmFlag == "" {
+			log.Fatal("-helm-as-release needs -helm")
+		}
+		writeHelmRelease()
+		return
+	}
+
+	var (
+		in    io.Reader
+		hooks []byte
+	)
+	switch {
+	case *helmFlag != "":
+		helmOpts := input.HelmOptions{
+			ReleaseName: *helmReleaseFlag,
+			Namespace:   *helmNamespaceFlag,
+		}
+		if *helmValuesFlag != "" {
+			helmOpts.ValuesFiles = strings.Split(*helmValuesFlag, ",")
+		}
+		rendered, err := input.Helm(*helmFlag, helmOpts)
+		if err != nil {
+			log.Fatalf("helm %s: %v", *helmFlag, err)
+		}
+		for _, s := range rendered.Skipped {
+			log.Printf("skipping helm hook %s", s)
+		}
+		if *helmHooksOutFlag != "" {
+			hooks = rendered.Hooks
+			in = bytes.NewReader(rendered.Manifests)
+		} else {
+			in = io.MultiReader(bytes.NewReader(rendered.Hooks), bytes.NewReader(rendered.Manifests))
+		}
+	case *kustomizeFlag != "":
+		var kustomizeOpts input.KustomizeOptions
+		if *kustomizeOriginsFlag {
+			kustomizeOpts.Origins = os.Stderr
+		}
+		built, err := input.Kustomize(*kustomizeFlag, kustomizeOpts)
+		if err != nil {
+			log.Fatalf("kustomize %s: %v", *kustomizeFlag, err)
+		}
+		in = bytes.NewReader(built)
+	case *inputFileFlag == "-":
+		in = os.Stdin
+	default:
 		i, err := os.Open(*inputFileFlag)
 		if err != nil {
 			log.Fatal(err)
 		}
-		input = i
+		in = i
+	}
+
+	opts := ktf.Options{
+		Convert: convert.Options{
+			CanonicalQuantities:   *canonicalQuantitiesFlag,
+			EncodeStructured:      *encodeStructuredFlag,
+			Fallback:              convert.Fallback(*fallbackFlag),
+			FallbackUnknownFields: *fallbackUnknownFlag,
+			Minimal:               *minimalFlag,
+			ProviderVersion:       strings.TrimPrefix(*providerVersionFlag, "v"),
+			UpgradeAPIVersions:    *upgradeAPIVersionsFlag,
+			Kubectl: convert.KubectlSettings{
+				YAMLEncode:      *kubectlYAMLEncodeFlag,
+				ServerSideApply: *kubectlServerSideFlag,
+				Wait:            *kubectlWaitFlag,
+			},
+		},
+		ForEach:  *forEachFlag,
+		Hoist:    *hoistFlag,
+		Warnings: os.Stderr,
+	}
+	if f := opts.Convert.Fallback; f != convert.ManifestFallback && f != convert.KubectlFallback {
+		log.Fatalf("-fallback: unknown resource %q", f)
+	}
+	if *manifestRulesFlag != "" {
+		f, err := os.Open(*manifestRulesFlag)
+		if err != nil {
+			log.Fatal(err)
+		}
+		rules, err := convert.ReadManifestRules(f)
+		f.Close()
+		if err != nil {
+			log.Fatalf("reading %s: %v", *manifestRulesFlag, err)
+		}
+		opts.Convert.ManifestRules = rules
+	}
+	switch {
+	case *providerSchemaFlag != "":
+		f, err := os.Open(*providerSchemaFlag)
+		if err != nil {
+			log.Fatal(err)
+		}
+		ps, err := gen.ReadProviderSchema(f)
+		f.Close()
+		if err != nil {
+			log.Fatalf("reading %s: %v", *providerSchemaFlag, err)
+		}
+		specs, err := ps.Specs()
+		if err != nil {
+			log.Fatalf("reading %s: %v", *providerSchemaFlag, err)
+		}
+		opts.Convert.Specs = specs
+		if *minimalFlag {
+			log.Printf("warning: -provider-schema has no defaults, so -minimal only leaves out the kubernetes API's")
+		}
+	case *providerVersionFlag != "":
+		specs, err := gen.SpecsFor(*providerVersionFlag)
+		if err != nil {
+			log.Fatalf("-provider-version: %v; use -provider-schema with the output of `terraform providers schema -json` for %s", err, *providerVersionFlag)
+		}
+		opts.Convert.Specs = specs
+	}
+	if *moduleRulesFlag != "" {
+		f, err := os.Open(*moduleRulesFlag)
+		if err != nil {
+			log.Fatal(err)
+		}
+		rules, err := convert.ReadModuleRules(f)
+		f.Close()
+		if err != nil {
+			log.Fatalf("reading %s: %v", *moduleRulesFlag, err)
+		}
+		reg := convert.NewRegistry()
+		reg.RegisterModules(rules)
+		opts.Convert.Registry = reg
+	}
+	if *parameterizeFlag != "" {
+		f, err := os.Open(*parameterizeFlag)
+		if err != nil {
+			log.Fatal(err)
+		}
+		params, err := convert.ReadParameters(f)
+		f.Close()
+		if err != nil {
+			log.Fatalf("reading %s: %v", *parameterizeFlag, err)
+		}
+		opts.Convert.Parameters = params
+	}
+	if *crdOutFlag != "" {
+		f, err := os.Create(*crdOutFlag)
+		if err != nil {
+			log.Fatal(err)
+		}
+		defer f.Close()
+		opts.CRDOut = f
+	}
+	if len(hooks) > 0 {
+		f, err := os.Create(*helmHooksOutFlag)
+		if err != nil {
+			log.Fatal(err)
+		}
+		hookOpts := opts
+		hookOpts.CRDOut = nil
+		if params := opts.Convert.Parameters; params != nil {
+			// The hooks are applied separately, so they need their
+			// own variables.
+			hookOpts.Convert.Parameters = &convert.Parameters{Rules: params.Rules}
+		}
+		err = ktf.ConvertWithOptions(bytes.NewReader(hooks), f, hookOpts)
+		f.Close()
+		if err != nil {
+			log.Fatalf("converting helm hooks: %v", err)
+		}
+	}
+	if module {
+		if err := ktf.ConvertModule(in, *moduleDirFlag, opts); err != nil {
+			log.Fatal(err)
+		}
+		return
 	}
 
 	var output io.WriteCloser
@@ -39,8 +261,57 @@ func main() {
 		}
 		output = o
 	}
+	if err := ktf.ConvertWithOptions(in, output, opts); err != nil {
+		log.Fatal(err)
+	}
+}
 
-	if err := ktf.Convert(input, output); err != nil {
+// writeHelmRelease writes a helm_release for the -helm chart to -out.
+func writeHelmRelease() {
+	rel := convert.HelmRelease{
+		Name:       *helmReleaseFlag,
+		Namespace:  *helmNamespaceFlag,
+		Chart:      *helmFlag,
+		Repository: *helmRepositoryFlag,
+		Version:    *helmVersionFlag,
+	}
+	if *helmValuesFlag != "" {
+		for _, path := range strings.Split(*helmValuesFlag, ",") {
+			f, err := os.Open(path)
+			if err != nil {
+				log.Fatal(err)
+			}
+			values, err := convert.ReadValues(f)
+			f.Close()
+			if err != nil {
+				log.Fatalf("reading %s: %v", path, err)
+			}
+			rel.Values = append(rel.Values, values)
+		}
+	}
+	var opts ktf.Options
+	if *helmSensitiveFlag != "" {
+		re, err := regexp.Compile(*helmSensitiveFlag)
+		if err != nil {
+			log.Fatalf("-helm-sensitive: %v", err)
+		}
+		opts.Convert.SensitiveValues = []*regexp.Regexp{re}
+	}
+
+	var output io.WriteCloser
+	if *outputFileFlag == "-" {
+		output = os.Stdout
+	} else {
+		o, err := os.Create(*outputFileFlag)
+		if err != nil {
+			log.Fatal(err)
+		}
+		output = o
+	}
+	if err := ktf.ConvertHelmRelease(rel, output, opts); err != nil {
+		log.Fatal(err)
+	}
+	if err := output.Close(); err != nil {
 		log.Fatal(err)
 	}
 }
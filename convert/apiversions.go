@@ -0,0 +1,146 @@
+package convert
+
+import (
+	"fmt"
+	"maps"
+
+	"github.com/hashicorp/hcl/v2"
+
+	"github.com/pfcm/ktf/resource"
+)
+
+// removedAPI is an API version of a kind which kubernetes no longer serves.
+type removedAPI struct {
+	removedIn string // the kubernetes version
+	// replacement is the API version to use instead, if there is one.
+	replacement string
+	// upgrade rewrites the object's fields for the replacement, or is nil if
+	// that can't be done automatically. An error means it can't be done for
+	// this object.
+	upgrade func(raw map[string]any) error
+}
+
+// sameFields is the upgrade for API versions whose fields didn't change.
+func sameFields(map[string]any) error { return nil }
+
+// removedAPIs are the API versions kubernetes has stopped serving, from
+// https://kubernetes.io/docs/reference/using-api/deprecation-guide/.
+var removedAPIs = map[resource.TypeKey]removedAPI{
+	{APIVersion: "extensions/v1beta1", Kind: "DaemonSet"}:         {removedIn: "1.16", replacement: "apps/v1", upgrade: upgradeWorkload},
+	{APIVersion: "extensions/v1beta1", Kind: "Deployment"}:        {removedIn: "1.16", replacement: "apps/v1", upgrade: upgradeWorkload},
+	{APIVersion: "extensions/v1beta1", Kind: "ReplicaSet"}:        {removedIn: "1.16", replacement: "apps/v1", upgrade: upgradeWorkload},
+	{APIVersion: "extensions/v1beta1", Kind: "NetworkPolicy"}:     {removedIn: "1.16", replacement: "networking.k8s.io/v1", upgrade: sameFields},
+	{APIVersion: "extensions/v1beta1", Kind: "PodSecurityPolicy"}: {removedIn: "1.16"},
+	{APIVersion: "extensions/v1beta1", Kind: "Ingress"}:           {removedIn: "1.22", replacement: "networking.k8s.io/v1", upgrade: upgradeIngress},
+	{APIVersion: "apps/v1beta1", Kind: "Deployment"}:              {removedIn: "1.16", replacement: "apps/v1", upgrade: upgradeWorkload},
+	{APIVersion: "apps/v1beta1", Kind: "StatefulSet"}:             {removedIn: "1.16", replacement: "apps/v1", upgrade: upgradeWorkload},
+	{APIVersion: "apps/v1beta2", Kind: "DaemonSet"}:               {removedIn: "1.16", replacement: "apps/v1", upgrade: sameFields},
+	{APIVersion: "apps/v1beta2", Kind: "Deployment"}:              {removedIn: "1.16", replacement: "apps/v1", upgrade: sameFields},
+	{APIVersion: "apps/v1beta2", Kind: "ReplicaSet"}:              {removedIn: "1.16", replacement: "apps/v1", upgrade: sameFields},
+	{APIVersion: "apps/v1beta2", Kind: "StatefulSet"}:             {removedIn: "1.16", replacement: "apps/v1", upgrade: sameFields},
+
+	{APIVersion: "admissionregistration.k8s.io/v1beta1", Kind: "MutatingWebhookConfiguration"}:   {removedIn: "1.22", replacement: "admissionregistration.k8s.io/v1", upgrade: upgradeWebhooks},
+	{APIVersion: "admissionregistration.k8s.io/v1beta1", Kind: "ValidatingWebhookConfiguration"}: {removedIn: "1.22", replacement: "admissionregistration.k8s.io/v1", upgrade: upgradeWebhooks},
+	{APIVersion: "apiextensions.k8s.io/v1beta1", Kind: "CustomResourceDefinition"}:               {removedIn: "1.22", replacement: "apiextensions.k8s.io/v1"},
+	{APIVersion: "apiregistration.k8s.io/v1beta1", Kind: "APIService"}:                           {removedIn: "1.22", replacement: "apiregistration.k8s.io/v1", upgrade: sameFields},
+	{APIVersion: "certificates.k8s.io/v1beta1", Kind: "CertificateSigningRequest"}:               {removedIn: "1.22", replacement: "certificates.k8s.io/v1"},
+	{APIVersion: "networking.k8s.io/v1beta1", Kind: "Ingress"}:                                   {removedIn: "1.22", replacement: "networking.k8s.io/v1", upgrade: upgradeIngress},
+	{APIVersion: "networking.k8s.io/v1beta1", Kind: "IngressClass"}:                              {removedIn: "1.22", replacement: "networking.k8s.io/v1", upgrade: sameFields},
+	{APIVersion: "rbac.authorization.k8s.io/v1beta1", Kind: "ClusterRole"}:                       {removedIn: "1.22", replacement: "rbac.authorization.k8s.io/v1", upgrade: sameFields},
+	{APIVersion: "rbac.authorization.k8s.io/v1beta1", Kind: "ClusterRoleBinding"}:                {removedIn: "1.22", replacement: "rbac.authorization.k8s.io/v1", upgrade: sameFields},
+	{APIVersion: "rbac.authorization.k8s.io/v1beta1", Kind: "Role"}:                              {removedIn: "1.22", replacement: "rbac.authorization.k8s.io/v1", upgrade: sameFields},
+	{APIVersion: "rbac.authorization.k8s.io/v1beta1", Kind: "RoleBinding"}:                       {removedIn: "1.22", replacement: "rbac.authorization.k8s.io/v1", upgrade: sameFields},
+	{APIVersion: "scheduling.k8s.io/v1beta1", Kind: "PriorityClass"}:                             {removedIn: "1.22", replacement: "scheduling.k8s.io/v1", upgrade: sameFields},
+	{APIVersion: "storage.k8s.io/v1beta1", Kind: "CSIDriver"}:                                    {removedIn: "1.22", replacement: "storage.k8s.io/v1", upgrade: sameFields},
+	{APIVersion: "storage.k8s.io/v1beta1", Kind: "CSINode"}:                                      {removedIn: "1.22", replacement: "storage.k8s.io/v1", upgrade: sameFields},
+	{APIVersion: "storage.k8s.io/v1beta1", Kind: "StorageClass"}:                                 {removedIn: "1.22", replacement: "storage.k8s.io/v1", upgrade: sameFields},
+	{APIVersion: "storage.k8s.io/v1beta1", Kind: "VolumeAttachment"}:                             {removedIn: "1.22", replacement: "storage.k8s.io/v1", upgrade: sameFields},
+
+	{APIVersion: "batch/v1beta1", Kind: "CronJob"}:                           {removedIn: "1.25", replacement: "batch/v1", upgrade: sameFields},
+	{APIVersion: "discovery.k8s.io/v1beta1", Kind: "EndpointSlice"}:          {removedIn: "1.25", replacement: "discovery.k8s.io/v1", upgrade: upgradeEndpointSlice},
+	{APIVersion: "events.k8s.io/v1beta1", Kind: "Event"}:                     {removedIn: "1.25", replacement: "events.k8s.io/v1", upgrade: sameFields},
+	{APIVersion: "autoscaling/v2beta1", Kind: "HorizontalPodAutoscaler"}:     {removedIn: "1.25", replacement: "autoscaling/v2", upgrade: upgradeHorizontalPodAutoscaler},
+	{APIVersion: "policy/v1beta1", Kind: "PodDisruptionBudget"}:              {removedIn: "1.25", replacement: "policy/v1", upgrade: upgradePodDisruptionBudget},
+	{APIVersion: "policy/v1beta1", Kind: "PodSecurityPolicy"}:                {removedIn: "1.25"},
+	{APIVersion: "node.k8s.io/v1beta1", Kind: "RuntimeClass"}:                {removedIn: "1.25", replacement: "node.k8s.io/v1", upgrade: sameFields},
+	{APIVersion: "autoscaling/v2beta2", Kind: "HorizontalPodAutoscaler"}:     {removedIn: "1.26", replacement: "autoscaling/v2", upgrade: sameFields},
+	{APIVersion: "storage.k8s.io/v1beta1", Kind: "CSIStorageCapacity"}:       {removedIn: "1.27", replacement: "storage.k8s.io/v1", upgrade: sameFields},
+	{APIVersion: "flowcontrol.apiserver.k8s.io/v1beta1", Kind: "FlowSchema"}: {removedIn: "1.26", replacement: "flowcontrol.apiserver.k8s.io/v1"},
+	{APIVersion: "flowcontrol.apiserver.k8s.io/v1beta2", Kind: "FlowSchema"}: {removedIn: "1.29", replacement: "flowcontrol.apiserver.k8s.io/v1"},
+	{APIVersion: "flowcontrol.apiserver.k8s.io/v1beta3", Kind: "FlowSchema"}: {removedIn: "1.32", replacement: "flowcontrol.apiserver.k8s.io/v1", upgrade: sameFields},
+}
+
+// UpgradeAPIVersion rewrites r for the replacement of its API version, if
+// kubernetes has stopped serving it and that can be done automatically.
+// Otherwise r is returned as it is. Either way there's a warning if the API
+// version has been removed.
+func UpgradeAPIVersion(r resource.Resource, opts Options) (resource.Resource, hcl.Diagnostics) {
+	return checkAPIVersion(r, opts, true)
+}
+
+// checkAPIVersion warns about r if its API version has been removed,
+// upgrading it first if upgrade is set.
+func checkAPIVersion(r resource.Resource, opts Options, upgrade bool) (resource.Resource, hcl.Diagnostics) {
+	removed, ok := removedAPIs[r.TypeKey]
+	if !ok {
+		return r, nil
+	}
+	what := fmt.Sprintf("%s %s was removed in kubernetes %s", r.APIVersion, r.Kind, removed.removedIn)
+	if removed.replacement == "" {
+		return r, hcl.Diagnostics{{
+			Severity: hcl.DiagWarning,
+			Summary:  "Removed API version",
+			Detail:   what + ", and has no replacement.",
+		}}
+	}
+	var upgradeErr error
+	if upgrade && removed.upgrade != nil {
+		raw := deepCopy(r.Raw).(map[string]any)
+		if upgradeErr = removed.upgrade(raw); upgradeErr == nil {
+			raw["apiVersion"] = removed.replacement
+			r.Raw = raw
+			r.APIVersion = removed.replacement
+			return r, hcl.Diagnostics{{
+				Severity: hcl.DiagWarning,
+				Summary:  "Upgraded API version",
+				Detail:   fmt.Sprintf("%s, so it has been converted as %s %s.", what, r.APIVersion, r.Kind),
+			}}
+		}
+	}
+	detail := fmt.Sprintf("%s; use %s instead", what, removed.replacement)
+	if spec, ok := opts.findSpec(resource.TypeKey{APIVersion: removed.replacement, Kind: r.Kind}); ok {
+		detail += fmt.Sprintf(", which converts to %s", spec.ResourceName)
+	}
+	switch {
+	case upgradeErr != nil:
+		detail += fmt.Sprintf(". It can't be upgraded automatically: %v", upgradeErr)
+	case upgrade:
+		detail += ". It can't be upgraded automatically"
+	}
+	return r, hcl.Diagnostics{{
+		Severity: hcl.DiagWarning,
+		Summary:  "Removed API version",
+		Detail:   detail + ".",
+	}}
+}
+
+// deepCopy copies the maps and slices in v, so an upgrade can change them
+// without affecting the original.
+func deepCopy(v any) any {
+	switch v := v.(type) {
+	case map[string]any:
+		out := maps.Clone(v)
+		for k, e := range out {
+			out[k] = deepCopy(e)
+		}
+		return out
+	case []any:
+		out := make([]any, len(v))
+		for i, e := range v {
+			out[i] = deepCopy(e)
+		}
+		return out
+	default:
+		return v
+	}
+}
@@ -0,0 +1,105 @@
+package convert
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/hcl/v2"
+)
+
+const betaCronJob = `
+apiVersion: batch/v1beta1
+kind: CronJob
+metadata:
+  name: nightly
+spec:
+  schedule: "0 3 * * *"
+  jobTemplate:
+    spec:
+      template:
+        spec:
+          restartPolicy: OnFailure
+          containers:
+          - name: job
+            image: busybox
+`
+
+func TestRemovedAPIVersions(t *testing.T) {
+	for _, c := range []struct {
+		name     string
+		in       string
+		upgrade  bool
+		resource string
+		summary  string
+		detail   string
+	}{{
+		name:     "cron job",
+		in:       betaCronJob,
+		resource: "kubernetes_cron_job",
+		summary:  "Removed API version",
+		detail:   "batch/v1beta1 CronJob was removed in kubernetes 1.25; use batch/v1 instead, which converts to kubernetes_cron_job_v1.",
+	}, {
+		name:     "cron job upgraded",
+		in:       betaCronJob,
+		upgrade:  true,
+		resource: "kubernetes_cron_job_v1",
+		summary:  "Upgraded API version",
+		detail:   "batch/v1beta1 CronJob was removed in kubernetes 1.25, so it has been converted as batch/v1 CronJob.",
+	}, {
+		name:     "pod disruption budget",
+		in:       "apiVersion: policy/v1beta1\nkind: PodDisruptionBudget\nmetadata: {name: web}\nspec:\n  minAvailable: 1\n  selector: {}\n",
+		upgrade:  true,
+		resource: "kubernetes_pod_disruption_budget",
+		summary:  "Removed API version",
+		detail:   "policy/v1beta1 PodDisruptionBudget was removed in kubernetes 1.25; use policy/v1 instead, which converts to kubernetes_pod_disruption_budget_v1. It can't be upgraded automatically: its empty selector matches no pods in policy/v1beta1, but every pod in the namespace in policy/v1.",
+	}, {
+		name:     "custom resource definition",
+		in:       "apiVersion: apiextensions.k8s.io/v1beta1\nkind: CustomResourceDefinition\nmetadata: {name: widgets.example.com}\nspec:\n  group: example.com\n",
+		upgrade:  true,
+		resource: "kubernetes_manifest",
+		summary:  "Removed API version",
+		detail:   "apiextensions.k8s.io/v1beta1 CustomResourceDefinition was removed in kubernetes 1.22; use apiextensions.k8s.io/v1 instead. It can't be upgraded automatically.",
+	}, {
+		name:     "pod security policy",
+		in:       "apiVersion: policy/v1beta1\nkind: PodSecurityPolicy\nmetadata: {name: restricted}\nspec:\n  privileged: false\n",
+		upgrade:  true,
+		resource: "kubernetes_pod_security_policy_v1beta1",
+		summary:  "Removed API version",
+		detail:   "policy/v1beta1 PodSecurityPolicy was removed in kubernetes 1.25, and has no replacement.",
+	}} {
+		t.Run(c.name, func(t *testing.T) {
+			blocks, diags := ConvertBlocks(decode(t, c.in), Options{UpgradeAPIVersions: c.upgrade})
+			if err := DiagsError(diags); err != nil {
+				t.Fatal(err)
+			}
+			if got := blocks[0].Labels()[0]; got != c.resource {
+				t.Errorf("got %s, want %s", got, c.resource)
+			}
+			if len(diags) == 0 || diags[0].Severity != hcl.DiagWarning || diags[0].Summary != c.summary || diags[0].Detail != c.detail {
+				t.Errorf("got diagnostics %v\nwant the first to be %q: %q", diags, c.summary, c.detail)
+			}
+		})
+	}
+}
+
+func TestDeprecatedResource(t *testing.T) {
+	_, diags := ConvertBlocks(decode(t, "apiVersion: policy/v1beta1\nkind: PodSecurityPolicy\nmetadata: {name: restricted}\nspec:\n  privileged: false\n"), Options{})
+	var found bool
+	for _, d := range diags {
+		found = found || d.Summary == "Deprecated resource" && strings.HasPrefix(d.Detail, "kubernetes_pod_security_policy_v1beta1: ")
+	}
+	if !found {
+		t.Errorf("no deprecation warning in %v", diags)
+	}
+}
+
+func TestUpgradeAPIVersionCopies(t *testing.T) {
+	r := decode(t, betaCronJob)
+	up, _ := UpgradeAPIVersion(r, Options{})
+	if up.APIVersion != "batch/v1" || up.Raw["apiVersion"] != "batch/v1" {
+		t.Errorf("upgraded to %s (%v), want batch/v1", up.APIVersion, up.Raw["apiVersion"])
+	}
+	if r.APIVersion != "batch/v1beta1" || r.Raw["apiVersion"] != "batch/v1beta1" {
+		t.Errorf("original changed to %s (%v)", r.APIVersion, r.Raw["apiVersion"])
+	}
+}
@@ -0,0 +1,240 @@
+package convert
+
+import (
+	"cmp"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"sync"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclwrite"
+
+	"github.com/pfcm/ktf/convert/gen"
+	"github.com/pfcm/ktf/resource"
+)
+
+// Backend turns a resource into terraform. The blocks can be resource, data
+// or module blocks, and the first one is the one which other resources depend
+// on. A Backend that returns no blocks and no errors declines the resource,
+// which is then offered to the next one.
+type Backend interface {
+	Convert(r resource.Resource, ctx Context) ([]*hclwrite.Block, hcl.Diagnostics)
+}
+
+// BackendFunc adapts a function to a Backend.
+type BackendFunc func(r resource.Resource, ctx Context) ([]*hclwrite.Block, hcl.Diagnostics)
+
+func (f BackendFunc) Convert(r resource.Resource, ctx Context) ([]*hclwrite.Block, hcl.Diagnostics) {
+	return f(r, ctx)
+}
+
+// Context is everything a Backend gets to know besides the resource itself.
+type Context struct {
+	Options Options
+}
+
+// The priorities of the built in backends. Anything registered with a higher
+// priority than SpecPriority is tried before the kubernetes provider's typed
+// resources, and anything between the two only when there isn't one.
+const (
+	SpecPriority     = 0
+	FallbackPriority = -100
+)
+
+// Registry holds the backends to try for each type of resource. The zero
+// value is an empty registry, without the built in backends.
+type Registry struct {
+	mu       sync.RWMutex
+	backends []registration
+}
+
+type registration struct {
+	group, version, kind string
+	priority             int
+	backend              Backend
+}
+
+func (reg registration) matches(tk resource.TypeKey) bool {
+	group, version := tk.GroupVersion()
+	return (reg.group == "*" || reg.group == group) &&
+		(reg.version == "" || reg.version == version) &&
+		(reg.kind == "" || reg.kind == tk.Kind)
+}
+
+// NewRegistry returns a registry holding the built in backends: the typed
+// resources from the kubernetes provider, at SpecPriority, and
+// kubernetes_manifest or kubectl_manifest for everything else, at
+// FallbackPriority.
+func NewRegistry() *Registry {
+	reg := &Registry{}
+	reg.Register("*", "", "", SpecPriority, BackendFunc(specBackend))
+	reg.Register("*", "", "", FallbackPriority, BackendFunc(fallbackBackend))
+	return reg
+}
+
+// DefaultRegistry is used by Convert unless Options.Registry is set.
+var DefaultRegistry = NewRegistry()
+
+// Register adds a backend for the given group ("" for the core API, or "*"
+// for any group), version and kind (empty for any). Backends are tried from
+// the highest priority down, and in the order they were registered when the
+// priorities are the same.
+func (reg *Registry) Register(group, version, kind string, priority int, b Backend) {
+	reg.mu.Lock()
+	defer reg.mu.Unlock()
+	i, _ := slices.BinarySearchFunc(reg.backends, priority, func(r registration, p int) int {
+		// Descending, and after anything with the same priority.
+		if r.priority >= p {
+			return -1
+		}
+		return 1
+	})
+	reg.backends = slices.Insert(reg.backends, i, registration{
+		group:    group,
+		version:  version,
+		kind:     kind,
+		priority: priority,
+		backend:  b,
+	})
+}
+
+// Convert converts r with the first backend that accepts it.
+func (reg *Registry) Convert(r resource.Resource, ctx Context) ([]*hclwrite.Block, hcl.Diagnostics) {
+	reg.mu.RLock()
+	backends := slices.Clone(reg.backends)
+	reg.mu.RUnlock()
+
+	var diags hcl.Diagnostics
+	for _, b := range backends {
+		if !b.matches(r.TypeKey) {
+			continue
+		}
+		blocks, ds := b.backend.Convert(r, ctx)
+		diags = append(diags, ds...)
+		if len(blocks) > 0 || diags.HasErrors() {
+			return blocks, append(diags, checkBlocks(blocks)...)
+		}
+	}
+	return nil, append(diags, &hcl.Diagnostic{
+		Severity: hcl.DiagError,
+		Summary:  "No backend",
+		Detail:   fmt.Sprintf("Nothing could convert %s %s.", cmp.Or(r.APIVersion, "(no apiVersion)"), r.Kind),
+	})
+}
+
+// checkBlocks makes sure a backend only returned things that can be depended
+// on.
+func checkBlocks(blocks []*hclwrite.Block) hcl.Diagnostics {
+	var diags hcl.Diagnostics
+	for _, b := range blocks {
+		want := 2
+		switch b.Type() {
+		case "resource", "data":
+		case "module":
+			want = 1
+		default:
+			diags = append(diags, &hcl.Diagnostic{
+				Severity: hcl.DiagError,
+				Summary:  "Unsupported block type",
+				Detail:   fmt.Sprintf("Backends can only return resource, data and module blocks, not %q.", b.Type()),
+			})
+			continue
+		}
+		if len(b.Labels()) != want {
+			diags = append(diags, &hcl.Diagnostic{
+				Severity: hcl.DiagError,
+				Summary:  "Wrong number of labels",
+				Detail:   fmt.Sprintf("A %s block needs %d labels, not %v.", b.Type(), want, b.Labels()),
+			})
+		}
+	}
+	return diags
+}
+
+// specBackend writes the kubernetes provider's typed resource, if it has one.
+func specBackend(r resource.Resource, ctx Context) ([]*hclwrite.Block, hcl.Diagnostics) {
+	spec, ok := ctx.Options.findSpec(r.TypeKey)
+	if !ok {
+		if generated, ok := gen.FindSpec(r.TypeKey); ok {
+			return nil, hcl.Diagnostics{{
+				Severity: hcl.DiagWarning,
+				Summary:  "Resource not in provider",
+				Detail:   fmt.Sprintf("%s isn't in version %s of the kubernetes provider.", generated.ResourceName, providerVersion(ctx.Options)),
+			}}
+		}
+		return nil, nil
+	}
+	b, err := convertFromSpec(spec, spec.ResourceName, r, ctx.Options)
+	var unknown *unknownFieldsError
+	if errors.As(err, &unknown) && ctx.Options.FallbackUnknownFields {
+		return nil, hcl.Diagnostics{{
+			Severity: hcl.DiagWarning,
+			Summary:  "Fields not in typed resource",
+			Detail: fmt.Sprintf("%s isn't in %s in version %s of the kubernetes provider.",
+				strings.Join(unknown.paths, ", "), spec.ResourceName, providerVersion(ctx.Options)),
+		}}
+	}
+	if err != nil {
+		return nil, errorDiags(err)
+	}
+	if spec.Deprecated != "" {
+		return []*hclwrite.Block{b}, hcl.Diagnostics{{
+			Severity: hcl.DiagWarning,
+			Summary:  "Deprecated resource",
+			Detail:   fmt.Sprintf("%s: %s", spec.ResourceName, spec.Deprecated),
+		}}
+	}
+	return []*hclwrite.Block{b}, nil
+}
+
+// findSpec finds the spec for tk in Specs, or the generated specs if that
+// isn't set.
+func (opts Options) findSpec(tk resource.TypeKey) (gen.ConverterSpec, bool) {
+	if opts.Specs != nil {
+		return opts.Specs.Find(tk)
+	}
+	return gen.FindSpec(tk)
+}
+
+func providerVersion(opts Options) string {
+	return cmp.Or(opts.ProviderVersion, gen.ProviderVersion)
+}
+
+// fallbackBackend writes a kubernetes_manifest or kubectl_manifest.
+func fallbackBackend(r resource.Resource, ctx Context) ([]*hclwrite.Block, hcl.Diagnostics) {
+	b, err := convertToManifest(r, ctx.Options)
+	if err != nil {
+		return nil, errorDiags(err)
+	}
+	return []*hclwrite.Block{b}, nil
+}
+
+// errorDiags wraps err in a diagnostic, keeping it in Extra so DiagsError can
+// return it as it was.
+func errorDiags(err error) hcl.Diagnostics {
+	return hcl.Diagnostics{{
+		Severity: hcl.DiagError,
+		Summary:  "Conversion failed",
+		Detail:   err.Error(),
+		Extra:    err,
+	}}
+}
+
+// DiagsError returns an error made from the errors in diags, or nil if there
+// aren't any. Warnings are ignored.
+func DiagsError(diags hcl.Diagnostics) error {
+	var errs []error
+	for _, d := range diags {
+		if d.Severity != hcl.DiagError {
+			continue
+		}
+		if err, ok := d.Extra.(error); ok {
+			errs = append(errs, err)
+		} else {
+			errs = append(errs, fmt.Errorf("%s: %s", d.Summary, d.Detail))
+		}
+	}
+	return errors.Join(errs...)
+}
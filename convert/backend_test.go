@@ -0,0 +1,145 @@
+package convert
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclwrite"
+	"github.com/zclconf/go-cty/cty"
+
+	"github.com/pfcm/ktf/convert/gen"
+	"github.com/pfcm/ktf/resource"
+)
+
+func TestRegistry(t *testing.T) {
+	database := BackendFunc(func(r resource.Resource, ctx Context) ([]*hclwrite.Block, hcl.Diagnostics) {
+		b := hclwrite.NewBlock("module", []string{resource.ToSnake(r.Metadata.Name)})
+		b.Body().SetAttributeValue("source", cty.StringVal("./modules/database"))
+		return []*hclwrite.Block{b}, hcl.Diagnostics{{Severity: hcl.DiagWarning, Summary: "Check the size"}}
+	})
+	declines := BackendFunc(func(resource.Resource, Context) ([]*hclwrite.Block, hcl.Diagnostics) {
+		return nil, nil
+	})
+	locals := BackendFunc(func(resource.Resource, Context) ([]*hclwrite.Block, hcl.Diagnostics) {
+		return []*hclwrite.Block{hclwrite.NewBlock("locals", nil)}, nil
+	})
+
+	reg := NewRegistry()
+	reg.Register("example.com", "", "Database", 10, database)
+	reg.Register("*", "", "Database", 20, declines)
+	reg.Register("example.com", "v2", "Database", 15, locals)
+	reg.Register("", "v1", "ConfigMap", FallbackPriority+1, database)
+
+	for _, c := range []struct {
+		name, in string
+		want     []string
+		warnings int
+		err      string
+	}{{
+		name:     "custom",
+		in:       "apiVersion: example.com/v1\nkind: Database\nmetadata:\n  name: orders-db\n",
+		want:     []string{"module.orders_db"},
+		warnings: 1,
+	}, {
+		name: "invalid block",
+		in:   "apiVersion: example.com/v2\nkind: Database\nmetadata:\n  name: orders-db\n",
+		err:  "Unsupported block type",
+	}, {
+		name: "typed resource first",
+		in:   "apiVersion: v1\nkind: ConfigMap\nmetadata:\n  name: settings\n",
+		want: []string{"resource.kubernetes_config_map_v1.settings"},
+	}, {
+		name: "fallback",
+		in:   "apiVersion: other.example.com/v1\nkind: Database\nmetadata:\n  name: orders-db\n",
+		want: []string{"resource.kubernetes_manifest.database__orders_db"},
+	}} {
+		t.Run(c.name, func(t *testing.T) {
+			blocks, diags := ConvertBlocks(decode(t, c.in), Options{Registry: reg})
+			if c.err != "" {
+				if err := DiagsError(diags); err == nil || !strings.Contains(err.Error(), c.err) {
+					t.Fatalf("got error %v, want %q", err, c.err)
+				}
+				return
+			}
+			if err := DiagsError(diags); err != nil {
+				t.Fatal(err)
+			}
+			if len(diags) != c.warnings {
+				t.Errorf("got %d warnings, want %d: %v", len(diags), c.warnings, diags)
+			}
+			var got []string
+			for _, b := range blocks {
+				got = append(got, strings.Join(append([]string{b.Type()}, b.Labels()...), "."))
+			}
+			if diff := cmp.Diff(c.want, got); diff != "" {
+				t.Errorf("unexpected blocks (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestSpecsOption(t *testing.T) {
+	ps, err := gen.ReadProviderSchema(strings.NewReader(`{"provider_schemas": {"registry.terraform.io/hashicorp/kubernetes": {"resource_schemas": {
+  "kubernetes_widget_v1": {"block": {
+    "attributes": {"id": {"type": "string", "optional": true, "computed": true}, "size": {"type": "number", "optional": true}},
+    "block_types": {"metadata": {"nesting_mode": "list", "block": {"attributes": {"name": {"type": "string", "optional": true}}}}}
+  }}
+}}}}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	specs, err := ps.Specs()
+	if err != nil {
+		t.Fatal(err)
+	}
+	opts := Options{Specs: specs}
+
+	b, err := Convert(decode(t, "apiVersion: v1\nkind: Widget\nmetadata:\n  name: test\nsize: 3\n"), opts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	f := hclwrite.NewEmptyFile()
+	f.Body().AppendBlock(b)
+	want := `resource "kubernetes_widget_v1" "test" {
+  size = 3
+  metadata {
+    name = "test"
+  }
+}
+`
+	if diff := cmp.Diff(want, string(hclwrite.Format(f.Bytes()))); diff != "" {
+		t.Errorf("unexpected output (-want +got):\n%s", diff)
+	}
+
+	// Only the resources in the schema are typed.
+	blocks, diags := ConvertBlocks(decode(t, "apiVersion: v1\nkind: ConfigMap\nmetadata:\n  name: settings\n"), opts)
+	if err := DiagsError(diags); err != nil {
+		t.Fatal(err)
+	}
+	if got := blocks[0].Labels()[0]; got != "kubernetes_manifest" {
+		t.Errorf("ConfigMap converted to %s, want kubernetes_manifest", got)
+	}
+	if len(diags) != 1 || diags[0].Summary != "Resource not in provider" {
+		t.Errorf("unexpected warnings for ConfigMap: %v", diags)
+	}
+
+	// As are the fields.
+	const colourful = "apiVersion: v1\nkind: Widget\nmetadata:\n  name: test\ncolour: red\n"
+	if _, err := Convert(decode(t, colourful), opts); err == nil || !strings.Contains(err.Error(), "colour") {
+		t.Errorf("got error %v, want one about colour", err)
+	}
+	opts.FallbackUnknownFields = true
+	opts.ProviderVersion = "2.20"
+	blocks, diags = ConvertBlocks(decode(t, colourful), opts)
+	if err := DiagsError(diags); err != nil {
+		t.Fatal(err)
+	}
+	if got := blocks[0].Labels()[0]; got != "kubernetes_manifest" {
+		t.Errorf("Widget with unknown fields converted to %s, want kubernetes_manifest", got)
+	}
+	if len(diags) != 1 || diags[0].Detail != "colour isn't in kubernetes_widget_v1 in version 2.20 of the kubernetes provider." {
+		t.Errorf("unexpected warnings for Widget: %v", diags)
+	}
+}
@@ -5,33 +5,108 @@ package convert
 import (
 	"fmt"
 	"maps"
+	"regexp"
 	"slices"
 	"strings"
 
-	"github.com/hashicorp/hcl/v2/hclsyntax"
+	"github.com/hashicorp/hcl/v2"
 	"github.com/hashicorp/hcl/v2/hclwrite"
 
 	"github.com/pfcm/ktf/convert/gen"
 	"github.com/pfcm/ktf/resource"
 )
 
-func Convert(r resource.Resource) (*hclwrite.Block, error) {
-	spec, ok := gen.FindSpec(r.TypeKey)
-	if !ok {
-		return convertToManifest(r)
+// Options control the details of how resources are converted. The zero value
+// is the default behaviour.
+type Options struct {
+	// CanonicalQuantities rewrites resource quantities (requests, limits,
+	// capacities etc.) into their canonical form, eg. "1000m" to "1".
+	CanonicalQuantities bool
+	// EncodeStructured writes string values which hold a JSON object or
+	// array as jsonencode({...}), and multi-line strings holding a YAML
+	// mapping or sequence as yamlencode({...}), so they can be read (and
+	// diffed) as HCL. The string terraform produces will be equivalent
+	// but not identical: formatting, key order and YAML comments are lost.
+	EncodeStructured bool
+	// ManifestRules add settings like wait and computed_fields to
+	// kubernetes_manifest resources. They are checked in order, before
+	// DefaultManifestRules, and the first match wins.
+	ManifestRules []ManifestRule
+	// Fallback is the resource written for anything without a typed
+	// resource, unless a ManifestRule says otherwise. It defaults to
+	// ManifestFallback.
+	Fallback Fallback
+	// Kubectl holds the settings for kubectl_manifest resources, for
+	// ManifestRules which don't have their own.
+	Kubectl KubectlSettings
+	// Parameters, if set, replaces the fields matched by its rules with
+	// references to variables, which are added to Parameters.Variables.
+	Parameters *Parameters
+	// Registry holds the backends used to convert each resource. It
+	// defaults to DefaultRegistry.
+	Registry *Registry
+	// Specs, if set, replace the generated specs for the typed resources,
+	// eg. with ones read from a schema by gen.ReadProviderSchema.
+	Specs gen.Specs
+	// ProviderVersion is the version of the kubernetes provider Specs are
+	// for, which is pinned in modules. It defaults to gen.ProviderVersion,
+	// unless Specs are set, which could be for any version.
+	ProviderVersion string
+	// Minimal leaves out attributes of typed resources which are set to
+	// their default, in the provider's schema or in the kubernetes API,
+	// along with any blocks that leaves empty. Only the generated specs
+	// know the provider's defaults: `terraform providers schema -json`
+	// doesn't include them, so with Specs read from it only the kubernetes
+	// API's defaults are left out.
+	Minimal bool
+	// FallbackUnknownFields writes resources with fields the typed resource
+	// doesn't have, which can happen with older versions of the provider,
+	// with the Fallback (and a warning) rather than failing.
+	FallbackUnknownFields bool
+	// UpgradeAPIVersions converts resources using API versions kubernetes
+	// no longer serves as their replacement, moving any fields which
+	// changed, where that can be done automatically. Either way, there's a
+	// warning about them.
+	UpgradeAPIVersions bool
+	// SensitiveValues match the keys of helm string values which should
+	// be set from sensitive variables with set_sensitive by
+	// ConvertHelmRelease, in addition to DefaultSensitiveValues.
+	SensitiveValues []*regexp.Regexp
+}
+
+// Convert converts r with the first backend in opts.Registry that accepts it,
+// returning only the main block.
+func Convert(r resource.Resource, opts Options) (*hclwrite.Block, error) {
+	blocks, diags := ConvertBlocks(r, opts)
+	if err := DiagsError(diags); err != nil {
+		return nil, err
 	}
-	return convertFromSpec(spec, spec.ResourceName, r)
+	return blocks[0], nil
 }
 
-func convertFromSpec(spec gen.ConverterSpec, resourceName string, r resource.Resource) (*hclwrite.Block, error) {
+// ConvertBlocks is like Convert, but returns all of the blocks and any
+// warnings.
+func ConvertBlocks(r resource.Resource, opts Options) ([]*hclwrite.Block, hcl.Diagnostics) {
+	reg := opts.Registry
+	if reg == nil {
+		reg = DefaultRegistry
+	}
+	r, diags := checkAPIVersion(r, opts, opts.UpgradeAPIVersions)
+	blocks, ds := reg.Convert(r, Context{Options: opts})
+	return blocks, append(diags, ds...)
+}
+
+func convertFromSpec(spec gen.ConverterSpec, resourceName string, r resource.Resource, opts Options) (*hclwrite.Block, error) {
 	b := hclwrite.NewBlock("resource", []string{resourceName, resource.ToSnake(r.Metadata.Name)})
-	if err := writeFromSpec(spec, b, r.Raw); err != nil {
+	p := parameterizer{params: opts.Parameters, r: r}
+	if err := writeFromSpec(spec, b, r.Raw, opts, p, nil); err != nil {
 		return nil, err
 	}
 	return b, nil
 }
 
-func writeFromSpec(spec gen.ConverterSpec, b *hclwrite.Block, data map[string]any) error {
+// writeFromSpec writes data, found at path in the resource, into b.
+func writeFromSpec(spec gen.ConverterSpec, b *hclwrite.Block, data map[string]any, opts Options, p parameterizer, path []any) error {
 	var (
 		leftovers = keySet(data)
 		body      = b.Body()
@@ -48,9 +123,21 @@ func writeFromSpec(spec gen.ConverterSpec, b *hclwrite.Block, data map[string]an
 
 		val, err := toVal(v)
 		if err != nil {
-			return err
+			return fmt.Errorf("converting %q: %w", name, err)
 		}
-		body.SetAttributeValue(name, val)
+		if opts.CanonicalQuantities && spec.Quantities[name] {
+			val, err = gen.CanonicalQuantities(val)
+			if err != nil {
+				return fmt.Errorf("converting %q: %w", name, err)
+			}
+		}
+		attrPath := append(slices.Clip(path), camelName)
+		if _, param := p.lookup(attrPath); opts.Minimal && !param && isDefault(spec, name, val, attrPath) {
+			continue
+		}
+		w := tokenWriter{opts: opts, params: p, path: attrPath}
+		w.emitCty(val)
+		body.SetAttributeRaw(name, w.tokens)
 	}
 	for name, subSpec := range spec.IterBlocks() {
 		camelName := resource.ToCamel(name)
@@ -66,173 +153,99 @@ func writeFromSpec(spec gen.ConverterSpec, b *hclwrite.Block, data map[string]an
 		}
 		delete(leftovers, camelName)
 
-		var subData []map[string]any
+		var (
+			subData  []map[string]any
+			subPaths [][]any
+		)
 		switch t := v.(type) {
 		case map[string]any:
 			subData = []map[string]any{t}
+			subPaths = [][]any{append(slices.Clip(path), camelName)}
 		case []any:
-			for _, a := range t {
+			for i, a := range t {
 				sd, ok := a.(map[string]any)
 				if !ok {
 					return fmt.Errorf("unexpected type in list for %q: %T (value %v)", name, a, a)
 				}
 				subData = append(subData, sd)
+				subPaths = append(subPaths, append(slices.Clip(path), camelName, i))
 			}
 		default:
 			return fmt.Errorf("unexpected type for %q: %T (value %v)", name, v, v)
 		}
-		for _, sd := range subData {
+		for i, sd := range subData {
 			subBlock := body.AppendNewBlock(name, nil)
-			if err := writeFromSpec(subSpec, subBlock, sd); err != nil {
+			if err := writeFromSpec(subSpec, subBlock, sd, opts, p, subPaths[i]); err != nil {
 				return fmt.Errorf("writing %q: %w", name, err)
 			}
+			// Blocks which were empty to begin with, like empty_dir,
+			// can still mean something.
+			sub := subBlock.Body()
+			if opts.Minimal && len(sd) > 0 && len(sub.Attributes()) == 0 && len(sub.Blocks()) == 0 && !spec.Schema[name].Required {
+				body.RemoveBlock(subBlock)
+			}
 		}
 	}
 	for _, k := range []string{"apiVersion", "kind"} {
 		delete(leftovers, k)
 	}
 	if len(leftovers) != 0 {
-		return fmt.Errorf("leftover keys: %v", slices.Collect(maps.Keys(leftovers)))
+		err := &unknownFieldsError{keys: slices.Sorted(maps.Keys(leftovers))}
+		for _, k := range err.keys {
+			err.paths = append(err.paths, pathString(append(slices.Clip(path), k)))
+		}
+		return err
 	}
 	return nil
 }
 
-func convertToManifest(r resource.Resource) (*hclwrite.Block, error) {
-	name := resource.ToSnake(strings.Join([]string{r.Kind, r.Metadata.Name}, "__"))
-	name = strings.ReplaceAll(name, ".", "_")
-	b := hclwrite.NewBlock("resource", []string{"kubernetes_manifest", name})
-
-	tokens, err := manifestDataTokens(r)
-	if err != nil {
-		return nil, err
-	}
-	b.Body().SetAttributeRaw("manifest", tokens)
+// unknownFieldsError is returned for fields which aren't in the spec.
+type unknownFieldsError struct {
+	keys  []string
+	paths []string // from the top of the resource
+}
 
-	return b, nil
+func (e *unknownFieldsError) Error() string {
+	return fmt.Sprintf("leftover keys: %v", e.keys)
 }
 
-func manifestDataTokens(r resource.Resource) (hclwrite.Tokens, error) {
-	var tokens hclwrite.Tokens
-
-	emitSingle := func(b byte, spacesBefore int) error {
-		var t hclsyntax.TokenType
-		switch b {
-		case '{':
-			t = hclsyntax.TokenOBrace
-		case '}':
-			t = hclsyntax.TokenCBrace
-		case '[':
-			t = hclsyntax.TokenOBrack
-		case ']':
-			t = hclsyntax.TokenCBrack
-		case '=':
-			t = hclsyntax.TokenEqual
-		case ',':
-			t = hclsyntax.TokenComma
-		case '\n':
-			t = hclsyntax.TokenNewline
-		default:
-			return fmt.Errorf("unknown single char token: %q", b)
-		}
-		tokens = append(tokens, &hclwrite.Token{
-			Type:         t,
-			Bytes:        []byte{b},
-			SpacesBefore: spacesBefore,
-		})
-		return nil
-	}
-	emitString := func(s string) {
-		tokens = append(tokens, &hclwrite.Token{
-			Type:  hclsyntax.TokenQuotedLit,
-			Bytes: fmt.Appendf(nil, "%q", s),
-		})
+func convertToManifest(r resource.Resource, opts Options) (*hclwrite.Block, error) {
+	rule, ok := findManifestRule(r, opts)
+	fallback := opts.Fallback
+	if rule.Fallback != "" {
+		fallback = rule.Fallback
 	}
-	emitFloat64 := func(f float64) {
-		tokens = append(tokens, &hclwrite.Token{
-			Type:  hclsyntax.TokenNumberLit,
-			Bytes: fmt.Append(nil, f),
-		})
-	}
-	emitBool := func(b bool) {
-		tokens = append(tokens, &hclwrite.Token{
-			Type:  hclsyntax.TokenStringLit, // or Ident?
-			Bytes: fmt.Append(nil, b),
-		})
+	if fallback == KubectlFallback {
+		return convertToKubectl(r, rule, opts)
 	}
+	b := hclwrite.NewBlock("resource", []string{string(ManifestFallback), manifestName(r)})
 
-	// forward declarations so these closures can mutually recurse.
-	var (
-		emitValue func(any) error
-		emitMap   func(map[string]any) error
-		emitList  func([]any) error
-		emitAttr  func(string, any) error
-	)
-	emitValue = func(a any) error {
-		switch v := a.(type) {
-		case string:
-			emitString(v)
-		case float64:
-			emitFloat64(v)
-		case bool:
-			emitBool(v)
-		case map[string]any:
-			return emitMap(v)
-		case []any:
-			return emitList(v)
-		default:
-			return fmt.Errorf("unhandled type in manifest: %T (value: %v)", v, v)
-		}
-		return nil
+	tokens, err := manifestDataTokens(r, opts)
+	if err != nil {
+		return nil, err
 	}
-	emitMap = func(m map[string]any) error {
-		if err := emitSingle('{', 1); err != nil {
-			return err
-		}
-		if err := emitSingle('\n', 0); err != nil {
-			return err
-		}
-
-		names := slices.Collect(maps.Keys(m))
-		slices.Sort(names)
-		for _, name := range names {
-			if err := emitAttr(name, m[name]); err != nil {
-				return err
-			}
-		}
-
-		return emitSingle('}', 1)
+	b.Body().SetAttributeRaw("manifest", tokens)
+	if ok {
+		writeManifestRule(b.Body(), rule)
 	}
-	emitList = func(l []any) error {
-		if err := emitSingle('[', 1); err != nil {
-			return err
-		}
-
-		for i, v := range l {
-			if err := emitValue(v); err != nil {
-				return err
-			}
-			if i != len(l)-1 {
-				emitSingle(',', 0)
-			}
-		}
 
-		return emitSingle(']', 1)
-	}
-	emitAttr = func(name string, value any) error {
-		// "name" = <value>
-		emitString(name)
-		emitSingle('=', 1)
+	return b, nil
+}
 
-		if err := emitValue(value); err != nil {
-			return err
-		}
-		return emitSingle('\n', 0)
-	}
+// manifestName is the name of the resource for an object without a typed
+// resource, made from its kind and name as they're the only things sure to
+// distinguish it.
+func manifestName(r resource.Resource) string {
+	name := resource.ToSnake(strings.Join([]string{r.Kind, r.Metadata.Name}, "__"))
+	return strings.ReplaceAll(name, ".", "_")
+}
 
-	if err := emitValue(r.Raw); err != nil {
+func manifestDataTokens(r resource.Resource, opts Options) (hclwrite.Tokens, error) {
+	w := tokenWriter{opts: opts, params: parameterizer{params: opts.Parameters, r: r}}
+	if err := w.emitValue(r.Raw); err != nil {
 		return nil, err
 	}
-	return tokens, nil
+	return w.tokens, nil
 }
 
 func keySet[K comparable, V any](m map[K]V) map[K]bool {
@@ -1 +1,135 @@
 package convert
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/hcl/v2/hclsyntax"
+	"github.com/hashicorp/hcl/v2/hclwrite"
+	"github.com/zclconf/go-cty/cty"
+	"k8s.io/apimachinery/pkg/util/yaml"
+
+	"github.com/pfcm/ktf/resource"
+)
+
+// decode decodes a single resource the same way as ktf.Convert.
+func decode(t *testing.T, in string) resource.Resource {
+	t.Helper()
+	r := resource.New()
+	if err := yaml.NewYAMLOrJSONDecoder(strings.NewReader(in), 4*1024).Decode(&r); err != nil {
+		t.Fatalf("decoding %q: %v", in, err)
+	}
+	return r
+}
+
+func TestManifestTypes(t *testing.T) {
+	for _, c := range []struct {
+		yaml string
+		want cty.Value
+	}{
+		{"true", cty.True},
+		{"false", cty.False},
+		{`"true"`, cty.StringVal("true")},
+		{"0", cty.NumberIntVal(0)},
+		{"-12", cty.NumberIntVal(-12)},
+		{"1000000", cty.NumberIntVal(1000000)},
+		{"1e6", cty.NumberIntVal(1000000)},
+		{"12345678901234567890", cty.MustParseNumberVal("12345678901234567890")},
+		{"18446744073709551615", cty.MustParseNumberVal("18446744073709551615")},
+		{"1.5", cty.MustParseNumberVal("1.5")},
+		{"0.1", cty.MustParseNumberVal("0.1")},
+		{"null", cty.NullVal(cty.DynamicPseudoType)},
+		{"", cty.NullVal(cty.DynamicPseudoType)},
+		{"~", cty.NullVal(cty.DynamicPseudoType)},
+		{"{}", cty.EmptyObjectVal},
+		{"[]", cty.EmptyTupleVal},
+		{"[1, true, null, x]", cty.TupleVal([]cty.Value{cty.NumberIntVal(1), cty.True, cty.NullVal(cty.DynamicPseudoType), cty.StringVal("x")})},
+		{"{a: {b: null}}", cty.ObjectVal(map[string]cty.Value{"a": cty.ObjectVal(map[string]cty.Value{"b": cty.NullVal(cty.DynamicPseudoType)})})},
+	} {
+		t.Run(c.yaml, func(t *testing.T) {
+			r := decode(t, "apiVersion: example.com/v1\nkind: Test\nmetadata:\n  name: test\nvalue: "+c.yaml+"\n")
+			tokens, err := manifestDataTokens(r, Options{})
+			if err != nil {
+				t.Fatal(err)
+			}
+			if bytes.ContainsAny(numberLits(tokens), "eE") {
+				t.Errorf("number written with an exponent: %s", tokens.Bytes())
+			}
+			b := hclwrite.NewBlock("test", nil)
+			b.Body().SetAttributeRaw("manifest", tokens)
+			got := evalAttr(t, b, "manifest").GetAttr("value")
+
+			if !got.Type().Equals(c.want.Type()) {
+				t.Fatalf("got type %s, want %s (value %#v)", got.Type().FriendlyName(), c.want.Type().FriendlyName(), got)
+			}
+			if !got.RawEquals(c.want) {
+				t.Errorf("got %#v, want %#v", got, c.want)
+			}
+		})
+	}
+}
+
+// TestManifestBigNumbers checks numbers that are too big even for the yaml
+// parser are fine if the input is json.
+func TestManifestBigNumbers(t *testing.T) {
+	const big = "-98765432109876543210987654321"
+	r := decode(t, `{"apiVersion": "example.com/v1", "kind": "Test", "metadata": {"name": "test"}, "value": `+big+`}`)
+	tokens, err := manifestDataTokens(r, Options{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	b := hclwrite.NewBlock("test", nil)
+	b.Body().SetAttributeRaw("manifest", tokens)
+	got := evalAttr(t, b, "manifest").GetAttr("value")
+	if want := cty.MustParseNumberVal(big); !got.RawEquals(want) {
+		t.Errorf("got %#v, want %#v", got, want)
+	}
+}
+
+func numberLits(tokens hclwrite.Tokens) []byte {
+	var out []byte
+	for _, t := range tokens {
+		if t.Type == hclsyntax.TokenNumberLit {
+			out = append(out, t.Bytes...)
+		}
+	}
+	return out
+}
+
+func BenchmarkConvert(b *testing.B) {
+	files, err := filepath.Glob("testdata/*.yaml")
+	if err != nil {
+		b.Fatal(err)
+	}
+	var rs []resource.Resource
+	for _, f := range files {
+		raw, err := os.ReadFile(f)
+		if err != nil {
+			b.Fatal(err)
+		}
+		d := yaml.NewYAMLOrJSONDecoder(bytes.NewReader(raw), 4*1024)
+		for {
+			r := resource.New()
+			if err := d.Decode(&r); errors.Is(err, io.EOF) {
+				break
+			} else if err != nil {
+				b.Fatalf("%s: %v", f, err)
+			}
+			if !r.IsEmpty() {
+				rs = append(rs, r)
+			}
+		}
+	}
+	for b.Loop() {
+		for _, r := range rs {
+			if _, err := Convert(r, Options{}); err != nil {
+				b.Fatalf("%s %s: %v", r.Kind, r.Metadata.Name, err)
+			}
+		}
+	}
+}
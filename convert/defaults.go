@@ -0,0 +1,51 @@
+package convert
+
+import (
+	"strings"
+
+	"github.com/zclconf/go-cty/cty"
+
+	"github.com/pfcm/ktf/convert/gen"
+)
+
+// apiDefaults are the values the kubernetes API fills in for fields which the
+// provider has no default for, but reads back, so leaving them out doesn't
+// cause a diff. They're keyed by the end of the path to the field, without
+// list indexes.
+var apiDefaults = map[string]cty.Value{
+	// Pods, and the templates of everything that makes them.
+	"spec.schedulerName":                      cty.StringVal("default-scheduler"),
+	"spec.serviceAccountName":                 cty.StringVal("default"),
+	"containers.terminationMessagePolicy":     cty.StringVal("File"),
+	"initContainers.terminationMessagePolicy": cty.StringVal("File"),
+	"spec.revisionHistoryLimit":               cty.NumberIntVal(10),
+	"spec.podManagementPolicy":                cty.StringVal("OrderedReady"),
+	"spec.externalTrafficPolicy":              cty.StringVal("Cluster"),
+	"spec.internalTrafficPolicy":              cty.StringVal("Cluster"),
+	"spec.ipFamilyPolicy":                     cty.StringVal("SingleStack"),
+}
+
+// isDefault reports whether val is the default for the attribute name of spec,
+// found at path, according to either the provider's schema or apiDefaults.
+func isDefault(spec gen.ConverterSpec, name string, val cty.Value, path []any) bool {
+	if def := spec.Schema[name].Default; def.Type() != cty.NilType && equal(val, def) {
+		return true
+	}
+	var keys []string
+	for _, p := range path {
+		if k, ok := p.(string); ok {
+			keys = append(keys, k)
+		}
+	}
+	field := strings.Join(keys, ".")
+	for suffix, def := range apiDefaults {
+		if (field == suffix || strings.HasSuffix(field, "."+suffix)) && equal(val, def) {
+			return true
+		}
+	}
+	return false
+}
+
+func equal(a, b cty.Value) bool {
+	return a.Type().Equals(b.Type()) && a.IsKnown() && !a.IsNull() && a.Equals(b).True()
+}
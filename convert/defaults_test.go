@@ -0,0 +1,146 @@
+package convert
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/hcl/v2/hclwrite"
+
+	"github.com/pfcm/ktf/convert/gen"
+)
+
+func TestMinimal(t *testing.T) {
+	const in = `
+apiVersion: apps/v1
+kind: StatefulSet
+metadata:
+  name: db
+spec:
+  replicas: 1
+  podManagementPolicy: OrderedReady
+  revisionHistoryLimit: 5
+  serviceName: db
+  updateStrategy:
+    type: RollingUpdate
+    rollingUpdate: {partition: 0}
+  selector:
+    matchLabels: {app: db}
+  template:
+    metadata:
+      labels: {app: db}
+    spec:
+      restartPolicy: Always
+      schedulerName: default-scheduler
+      terminationGracePeriodSeconds: 60
+      volumes:
+      - name: tmp
+        emptyDir: {}
+      containers:
+      - name: db
+        image: postgres:16
+        terminationMessagePolicy: File
+        ports: [{containerPort: 5432, protocol: TCP}]
+`
+	params, err := ReadParameters(strings.NewReader("- kind: StatefulSet\n  path: spec.template.spec.restartPolicy\n"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	b, err := Convert(decode(t, in), Options{Minimal: true, Parameters: params})
+	if err != nil {
+		t.Fatal(err)
+	}
+	f := hclwrite.NewEmptyFile()
+	f.Body().AppendBlock(b)
+	want := `resource "kubernetes_stateful_set_v1" "db" {
+  metadata {
+    name = "db"
+  }
+  spec {
+    replicas               = "1"
+    revision_history_limit = 5
+    service_name           = "db"
+    selector {
+      match_labels = {
+        app = "db"
+      }
+    }
+    template {
+      metadata {
+        labels = {
+          app = "db"
+        }
+      }
+      spec {
+        restart_policy                   = var.db_restart_policy
+        termination_grace_period_seconds = 60
+        container {
+          image = "postgres:16"
+          name  = "db"
+          port {
+            container_port = 5432
+          }
+        }
+        volume {
+          name = "tmp"
+          empty_dir {
+          }
+        }
+      }
+    }
+  }
+}
+`
+	if diff := cmp.Diff(want, string(hclwrite.Format(f.Bytes()))); diff != "" {
+		t.Errorf("unexpected output (-want +got):\n%s", diff)
+	}
+}
+
+// TestMinimalSchema checks that with specs from a provider schema, which has
+// no defaults, only the kubernetes API's defaults are left out.
+func TestMinimalSchema(t *testing.T) {
+	ps, err := gen.ReadProviderSchema(strings.NewReader(`{"provider_schemas": {"registry.terraform.io/hashicorp/kubernetes": {"resource_schemas": {
+  "kubernetes_pod_v1": {"block": {"block_types": {
+    "metadata": {"nesting_mode": "list", "max_items": 1, "block": {"attributes": {"name": {"type": "string", "optional": true}}}},
+    "spec": {"nesting_mode": "list", "max_items": 1, "block": {"attributes": {
+      "restart_policy": {"type": "string", "optional": true},
+      "scheduler_name": {"type": "string", "optional": true, "computed": true}
+    }}}
+  }}}
+}}}}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	specs, err := ps.Specs()
+	if err != nil {
+		t.Fatal(err)
+	}
+	const in = `
+apiVersion: v1
+kind: Pod
+metadata:
+  name: web
+spec:
+  restartPolicy: Always
+  schedulerName: default-scheduler
+`
+	b, err := Convert(decode(t, in), Options{Minimal: true, Specs: specs})
+	if err != nil {
+		t.Fatal(err)
+	}
+	f := hclwrite.NewEmptyFile()
+	f.Body().AppendBlock(b)
+	// The built in specs would leave out restart_policy too.
+	want := `resource "kubernetes_pod_v1" "web" {
+  metadata {
+    name = "web"
+  }
+  spec {
+    restart_policy = "Always"
+  }
+}
+`
+	if diff := cmp.Diff(want, string(hclwrite.Format(f.Bytes()))); diff != "" {
+		t.Errorf("unexpected output (-want +got):\n%s", diff)
+	}
+}
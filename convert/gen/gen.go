@@ -2,14 +2,18 @@
 package gen
 
 import (
+	"encoding/json"
 	"fmt"
+	"strconv"
+	"strings"
 
 	"github.com/zclconf/go-cty/cty"
+	"k8s.io/apimachinery/pkg/api/resource"
 )
 
 // specs is the registry of all of the generated ConverterSpecs. It should only
 // be accessed via register or FindSpec.
-var specs map[string]ConverterSpec
+var specs Specs
 
 func register(name string, spec ConverterSpec) {
 	if specs == nil {
@@ -46,6 +50,12 @@ func toInt(in any) (cty.Value, error) {
 	case float64:
 		// TODO: check the conversion is clean?
 		return cty.NumberIntVal(int64(v)), nil
+	case json.Number:
+		n, err := cty.ParseNumberVal(v.String())
+		if err != nil || !n.AsBigFloat().IsInt() {
+			return cty.Value{}, fmt.Errorf("expected some kind of int, got %v", v)
+		}
+		return n, nil
 	default:
 		return cty.Value{}, fmt.Errorf("expected some kind of int, got %T (value %v)", v, v)
 	}
@@ -57,15 +67,35 @@ func toFloat(a any) (cty.Value, error) {
 		return cty.NumberFloatVal(float64(v)), nil
 	case float64:
 		return cty.NumberFloatVal(v), nil
+	case json.Number:
+		n, err := cty.ParseNumberVal(v.String())
+		if err != nil {
+			return cty.Value{}, fmt.Errorf("expected some kind of float, got %v: %w", v, err)
+		}
+		return n, nil
 	default:
 		return cty.Value{}, fmt.Errorf("expected some kind of float, got %T (value %v)", v, v)
 	}
 }
 
+// toNumber converts any kind of number, for schemas which don't say whether
+// they want an int or a float.
+func toNumber(a any) (cty.Value, error) {
+	switch a.(type) {
+	case float32, float64, json.Number:
+		return toFloat(a)
+	default:
+		return toInt(a)
+	}
+}
+
 func toString(a any) (cty.Value, error) {
 	switch v := a.(type) {
 	case string:
 		return cty.StringVal(v), nil
+	case json.Number:
+		// Unquoted numbers are kept exactly as they were written.
+		return cty.StringVal(v.String()), nil
 	case float64, bool:
 		// Sometimes people don't quote things that are expected to be
 		// strings.
@@ -91,3 +121,73 @@ func toStringMap(in any) (cty.Value, error) {
 	}
 	return cty.MapVal(out), nil
 }
+
+// toQuantityMap converts a map of kubernetes resource quantities, such as the
+// requests and limits of a container. The quantities are checked, but
+// otherwise left as they were written, see CanonicalQuantities.
+func toQuantityMap(in any) (cty.Value, error) {
+	m, ok := in.(map[string]any)
+	if !ok {
+		return cty.Value{}, fmt.Errorf("expected map[string]any, got %T (value %v)", in, in)
+	}
+	if len(m) == 0 {
+		return cty.MapValEmpty(cty.String), nil
+	}
+
+	out := make(map[string]cty.Value, len(m))
+	for k, v := range m {
+		s, _, err := parseQuantity(v)
+		if err != nil {
+			return cty.Value{}, fmt.Errorf("%q: %w", k, err)
+		}
+		out[k] = cty.StringVal(s)
+	}
+	return cty.MapVal(out), nil
+}
+
+// parseQuantity parses a single quantity, returning the trimmed string it
+// was parsed from as well as the result. Quantities are often written
+// unquoted (`cpu: 1`), so as well as strings this accepts numbers.
+func parseQuantity(in any) (string, resource.Quantity, error) {
+	var s string
+	switch v := in.(type) {
+	case string:
+		s = strings.TrimSpace(v)
+	case json.Number:
+		s = v.String()
+	case float64:
+		s = strconv.FormatFloat(v, 'f', -1, 64)
+	case int:
+		s = strconv.Itoa(v)
+	case int64:
+		s = strconv.FormatInt(v, 10)
+	default:
+		return "", resource.Quantity{}, fmt.Errorf("expected quantity, got %T (value %v)", in, in)
+	}
+	q, err := resource.ParseQuantity(s)
+	if err != nil {
+		return "", resource.Quantity{}, fmt.Errorf("invalid quantity %q (expected something like \"500m\" or \"1Gi\"): %w", s, err)
+	}
+	return s, q, nil
+}
+
+// CanonicalQuantities rewrites a map value produced for one of a
+// ConverterSpec's Quantities into canonical form, so "1000m" becomes "1" and
+// "1024Mi" becomes "1Gi".
+func CanonicalQuantities(v cty.Value) (cty.Value, error) {
+	if v.IsNull() || v.LengthInt() == 0 {
+		return v, nil
+	}
+	out := make(map[string]cty.Value, v.LengthInt())
+	for k, q := range v.AsValueMap() {
+		if q.Type() != cty.String {
+			return cty.Value{}, fmt.Errorf("%q: expected string quantity, got %s", k, q.Type().FriendlyName())
+		}
+		_, parsed, err := parseQuantity(q.AsString())
+		if err != nil {
+			return cty.Value{}, fmt.Errorf("%q: %w", k, err)
+		}
+		out[k] = cty.StringVal(parsed.String())
+	}
+	return cty.MapVal(out), nil
+}
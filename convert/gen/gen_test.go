@@ -0,0 +1,98 @@
+package gen
+
+import (
+	"encoding/json"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/zclconf/go-cty/cty"
+)
+
+func TestToQuantityMap(t *testing.T) {
+	for _, c := range []struct {
+		name      string
+		in        map[string]any
+		want      map[string]string // as written
+		canonical map[string]string
+		err       string
+	}{{
+		name:      "strings",
+		in:        map[string]any{"cpu": "500m", "memory": "1024Mi"},
+		want:      map[string]string{"cpu": "500m", "memory": "1024Mi"},
+		canonical: map[string]string{"cpu": "500m", "memory": "1Gi"},
+	}, {
+		name:      "unquoted numbers",
+		in:        map[string]any{"cpu": float64(1), "nvidia.com/gpu": float64(2), "fractional": 0.5},
+		want:      map[string]string{"cpu": "1", "nvidia.com/gpu": "2", "fractional": "0.5"},
+		canonical: map[string]string{"cpu": "1", "nvidia.com/gpu": "2", "fractional": "500m"},
+	}, {
+		name:      "json numbers",
+		in:        map[string]any{"cpu": json.Number("2"), "memory": json.Number("1e9")},
+		want:      map[string]string{"cpu": "2", "memory": "1e9"},
+		canonical: map[string]string{"cpu": "2", "memory": "1e9"},
+	}, {
+		name:      "canonicalised",
+		in:        map[string]any{"cpu": "1000m", "storage": " 10Gi "},
+		want:      map[string]string{"cpu": "1000m", "storage": "10Gi"},
+		canonical: map[string]string{"cpu": "1", "storage": "10Gi"},
+	}, {
+		name: "invalid suffix",
+		in:   map[string]any{"memory": "10Gb"},
+		err:  `"memory": invalid quantity "10Gb"`,
+	}, {
+		name: "not a quantity",
+		in:   map[string]any{"cpu": true},
+		err:  `"cpu": expected quantity, got bool`,
+	}} {
+		t.Run(c.name, func(t *testing.T) {
+			got, err := toQuantityMap(c.in)
+			if c.err != "" {
+				if err == nil || !strings.Contains(err.Error(), c.err) {
+					t.Fatalf("toQuantityMap(%v): got error %v, want error containing %q", c.in, err, c.err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("toQuantityMap(%v): %v", c.in, err)
+			}
+			checkStringMap(t, got, c.want)
+
+			canonical, err := CanonicalQuantities(got)
+			if err != nil {
+				t.Fatalf("CanonicalQuantities(%#v): %v", got, err)
+			}
+			checkStringMap(t, canonical, c.canonical)
+		})
+	}
+}
+
+func checkStringMap(t *testing.T, got cty.Value, want map[string]string) {
+	t.Helper()
+	w := make(map[string]cty.Value, len(want))
+	for k, v := range want {
+		w[k] = cty.StringVal(v)
+	}
+	if wantVal := cty.MapVal(w); !got.RawEquals(wantVal) {
+		t.Errorf("got: %#v\nwant: %#v", got, wantVal)
+	}
+}
+
+func TestSharedSpecs(t *testing.T) {
+	// The maps are only built once for blocks which are the same in
+	// several resources.
+	same := func(a, b ConverterSpec) bool {
+		return reflect.ValueOf(a.Schema).UnsafePointer() == reflect.ValueOf(b.Schema).UnsafePointer()
+	}
+	deployment := specs["kubernetes_deployment"].Blocks["spec"]
+	if !same(deployment, specs["kubernetes_deployment_v1"].Blocks["spec"]) {
+		t.Errorf("kubernetes_deployment and kubernetes_deployment_v1 have separate specs")
+	}
+	container := deployment.Blocks["template"].Blocks["spec"].Blocks["container"]
+	if !same(container, specs["kubernetes_daemon_set_v1"].Blocks["spec"].Blocks["template"].Blocks["spec"].Blocks["container"]) {
+		t.Errorf("kubernetes_deployment and kubernetes_daemon_set_v1 have separate container specs")
+	}
+	if container.ResourceName != "" {
+		t.Errorf("shared spec has ResourceName %q", container.ResourceName)
+	}
+}
@@ -5,15 +5,9 @@ package gen
 // DO NOT EDIT.
 
 import (
-	"fmt"
-
 	"github.com/zclconf/go-cty/cty"
 )
 
-// Make sure resources with no list attributes don't cause build errors because
-// of an unused import.
-var _ = fmt.Sprintf
-
 func init() {
 	register("kubernetes_annotations", kubernetesAnnotations)
 }
@@ -31,13 +25,24 @@ var kubernetesAnnotations = ConverterSpec{
 	Blocks: map[string]ConverterSpec{
 		"metadata": kubernetesAnnotations_metadata,
 	},
+	Schema: map[string]FieldSchema{
+		"annotations":          {Optional: true, Description: "A map of annotations to apply to the resource."},
+		"api_version":          {Required: true, ForceNew: true, Description: "The apiVersion of the resource to annotate."},
+		"field_manager":        {Optional: true, Description: "Set the name of the field manager for the specified labels.", Default: cty.StringVal("Terraform")},
+		"force":                {Optional: true, Description: "Force overwriting annotations that were created or edited outside of Terraform."},
+		"kind":                 {Required: true, ForceNew: true, Description: "The kind of the resource to annotate."},
+		"metadata":             {Required: true, MaxItems: 1},
+		"template_annotations": {Optional: true, Description: "A map of annotations to apply to the resource template."},
+	},
 }
 
 var kubernetesAnnotations_metadata = ConverterSpec{
-	ResourceName: "kubernetes_annotations",
 	Attributes: map[string]func(any) (cty.Value, error){
 		"name":      toString,
 		"namespace": toString,
 	},
-	Blocks: map[string]ConverterSpec{},
+	Schema: map[string]FieldSchema{
+		"name":      {Required: true, ForceNew: true, Description: "The name of the resource."},
+		"namespace": {Optional: true, ForceNew: true, Description: "The namespace of the resource."},
+	},
 }
@@ -5,15 +5,9 @@ package gen
 // DO NOT EDIT.
 
 import (
-	"fmt"
-
 	"github.com/zclconf/go-cty/cty"
 )
 
-// Make sure resources with no list attributes don't cause build errors because
-// of an unused import.
-var _ = fmt.Sprintf
-
 func init() {
 	register("kubernetes_api_service", kubernetesApiService)
 }
@@ -22,43 +16,11 @@ var kubernetesApiService = ConverterSpec{
 	ResourceName: "kubernetes_api_service",
 	Attributes:   map[string]func(any) (cty.Value, error){},
 	Blocks: map[string]ConverterSpec{
-		"metadata": kubernetesApiService_metadata,
-		"spec":     kubernetesApiService_spec,
-	},
-}
-
-var kubernetesApiService_spec = ConverterSpec{
-	ResourceName: "kubernetes_api_service",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"ca_bundle":                toString,
-		"group":                    toString,
-		"group_priority_minimum":   toInt,
-		"insecure_skip_tls_verify": toBool,
-		"version":                  toString,
-		"version_priority":         toInt,
-	},
-	Blocks: map[string]ConverterSpec{
-		"service": kubernetesApiService_spec_service,
+		"metadata": kubernetesApiServiceV1_metadata,
+		"spec":     kubernetesApiServiceV1_spec,
 	},
-}
-
-var kubernetesApiService_spec_service = ConverterSpec{
-	ResourceName: "kubernetes_api_service",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"name":      toString,
-		"namespace": toString,
-		"port":      toInt,
-	},
-	Blocks: map[string]ConverterSpec{},
-}
-
-var kubernetesApiService_metadata = ConverterSpec{
-	ResourceName: "kubernetes_api_service",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"annotations":   toStringMap,
-		"generate_name": toString,
-		"labels":        toStringMap,
-		"name":          toString,
+	Schema: map[string]FieldSchema{
+		"metadata": {Required: true, MaxItems: 1, Description: "Standard api_service's metadata. More info: https://github.com/kubernetes/community/blob/master/contributors/devel/sig-architecture/api-conventions.md#metadata"},
+		"spec":     {Required: true, MaxItems: 1, Description: "Spec contains information for locating and communicating with a server. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#spec-and-status"},
 	},
-	Blocks: map[string]ConverterSpec{},
 }
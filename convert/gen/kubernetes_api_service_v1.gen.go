@@ -5,15 +5,9 @@ package gen
 // DO NOT EDIT.
 
 import (
-	"fmt"
-
 	"github.com/zclconf/go-cty/cty"
 )
 
-// Make sure resources with no list attributes don't cause build errors because
-// of an unused import.
-var _ = fmt.Sprintf
-
 func init() {
 	register("kubernetes_api_service_v1", kubernetesApiServiceV1)
 }
@@ -25,10 +19,28 @@ var kubernetesApiServiceV1 = ConverterSpec{
 		"metadata": kubernetesApiServiceV1_metadata,
 		"spec":     kubernetesApiServiceV1_spec,
 	},
+	Schema: map[string]FieldSchema{
+		"metadata": {Required: true, MaxItems: 1, Description: "Standard api_service's metadata. More info: https://github.com/kubernetes/community/blob/master/contributors/devel/sig-architecture/api-conventions.md#metadata"},
+		"spec":     {Required: true, MaxItems: 1, Description: "Spec contains information for locating and communicating with a server. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#spec-and-status"},
+	},
+}
+
+var kubernetesApiServiceV1_metadata = ConverterSpec{
+	Attributes: map[string]func(any) (cty.Value, error){
+		"annotations":   toStringMap,
+		"generate_name": toString,
+		"labels":        toStringMap,
+		"name":          toString,
+	},
+	Schema: map[string]FieldSchema{
+		"annotations":   {Optional: true, Description: "An unstructured key value map stored with the api_service that may be used to store arbitrary metadata. More info: https://kubernetes.io/docs/concepts/overview/working-with-objects/annotations/"},
+		"generate_name": {Optional: true, ForceNew: true, ConflictsWith: []string{"metadata.0.name"}, Description: "Prefix, used by the server, to generate a unique name ONLY IF the `name` field has not been provided. This value will also be combined with a unique suffix. More info: https://github.com/kubernetes/community/blob/master/contributors/devel/sig-architecture/api-conventions.md#idempotency"},
+		"labels":        {Optional: true, Description: "Map of string keys and values that can be used to organize and categorize (scope and select) the api_service. May match selectors of replication controllers and services. More info: https://kubernetes.io/docs/concepts/overview/working-with-objects/labels/"},
+		"name":          {Optional: true, ForceNew: true, ConflictsWith: []string{"metadata.0.generate_name"}, Description: "Name of the api_service, must be unique. Cannot be updated. More info: https://kubernetes.io/docs/concepts/overview/working-with-objects/names/#names"},
+	},
 }
 
 var kubernetesApiServiceV1_spec = ConverterSpec{
-	ResourceName: "kubernetes_api_service_v1",
 	Attributes: map[string]func(any) (cty.Value, error){
 		"ca_bundle":                toString,
 		"group":                    toString,
@@ -40,25 +52,26 @@ var kubernetesApiServiceV1_spec = ConverterSpec{
 	Blocks: map[string]ConverterSpec{
 		"service": kubernetesApiServiceV1_spec_service,
 	},
+	Schema: map[string]FieldSchema{
+		"ca_bundle":                {Optional: true, Description: "CABundle is a PEM encoded CA bundle which will be used to validate an API server's serving certificate. If unspecified, system trust roots on the apiserver are used."},
+		"group":                    {Required: true, Description: "Group is the API group name this server hosts."},
+		"group_priority_minimum":   {Required: true, Description: "GroupPriorityMinimum is the priority this group should have at least. Higher priority means that the group is preferred by clients over lower priority ones. Note that other versions of this group might specify even higher GroupPriorityMininum values such that the whole group gets a higher priority. The primary sort is based on GroupPriorityMinimum, ordered highest number to lowest (20 before 10). The secondary sort is based on the alphabetical comparison of the name of the object. (v1.bar before v1.foo) We'd recommend something like: *.k8s.io (except extensions) at 18000 and PaaSes (OpenShift, Deis) are recommended to be in the 2000s."},
+		"insecure_skip_tls_verify": {Optional: true, Description: "InsecureSkipTLSVerify disables TLS certificate verification when communicating with this server. This is strongly discouraged. You should use the CABundle instead.", Default: cty.BoolVal(false)},
+		"service":                  {Optional: true, ForceNew: true, MaxItems: 1, Description: "Service is a reference to the service for this API server. It must communicate on port 443. If the Service is nil, that means the handling for the API groupversion is handled locally on this server. The call will simply delegate to the normal handler chain to be fulfilled."},
+		"version":                  {Required: true, Description: "Version is the API version this server hosts. For example, `v1`."},
+		"version_priority":         {Required: true, Description: "VersionPriority controls the ordering of this API version inside of its group. Must be greater than zero. The primary sort is based on VersionPriority, ordered highest to lowest (20 before 10). Since it's inside of a group, the number can be small, probably in the 10s. In case of equal version priorities, the version string will be used to compute the order inside a group. If the version string is `kube-like`, it will sort above non `kube-like` version strings, which are ordered lexicographically. `Kube-like` versions start with a `v`, then are followed by a number (the major version), then optionally the string `alpha` or `beta` and another number (the minor version). These are sorted first by GA > `beta` > `alpha` (where GA is a version with no suffix such as `beta` or `alpha`), and then by comparing major version, then minor version. An example sorted list of versions: `v10`, `v2`, `v1`, `v11beta2`, `v10beta3`, `v3beta1`, `v12alpha1`, `v11alpha2`, `foo1`, `foo10`."},
+	},
 }
 
 var kubernetesApiServiceV1_spec_service = ConverterSpec{
-	ResourceName: "kubernetes_api_service_v1",
 	Attributes: map[string]func(any) (cty.Value, error){
 		"name":      toString,
 		"namespace": toString,
 		"port":      toInt,
 	},
-	Blocks: map[string]ConverterSpec{},
-}
-
-var kubernetesApiServiceV1_metadata = ConverterSpec{
-	ResourceName: "kubernetes_api_service_v1",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"annotations":   toStringMap,
-		"generate_name": toString,
-		"labels":        toStringMap,
-		"name":          toString,
+	Schema: map[string]FieldSchema{
+		"name":      {Required: true, Description: "Name is the name of the service."},
+		"namespace": {Required: true, Description: "Namespace is the namespace of the service."},
+		"port":      {Optional: true, Description: "If specified, the port on the service that is hosting the service. Defaults to 443 for backward compatibility. Should be a valid port number (1-65535, inclusive).", Default: cty.NumberIntVal(443)},
 	},
-	Blocks: map[string]ConverterSpec{},
 }
@@ -5,15 +5,9 @@ package gen
 // DO NOT EDIT.
 
 import (
-	"fmt"
-
 	"github.com/zclconf/go-cty/cty"
 )
 
-// Make sure resources with no list attributes don't cause build errors because
-// of an unused import.
-var _ = fmt.Sprintf
-
 func init() {
 	register("kubernetes_certificate_signing_request", kubernetesCertificateSigningRequest)
 }
@@ -24,42 +18,25 @@ var kubernetesCertificateSigningRequest = ConverterSpec{
 		"auto_approve": toBool,
 	},
 	Blocks: map[string]ConverterSpec{
-		"metadata": kubernetesCertificateSigningRequest_metadata,
+		"metadata": kubernetesCertificateSigningRequestV1_metadata,
 		"spec":     kubernetesCertificateSigningRequest_spec,
 	},
-}
-
-var kubernetesCertificateSigningRequest_metadata = ConverterSpec{
-	ResourceName: "kubernetes_certificate_signing_request",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"annotations":   toStringMap,
-		"generate_name": toString,
-		"labels":        toStringMap,
-		"name":          toString,
+	Schema: map[string]FieldSchema{
+		"auto_approve": {Optional: true, ForceNew: true, Description: "Automatically approve the CertificateSigningRequest", Default: cty.BoolVal(true)},
+		"metadata":     {Required: true, ForceNew: true, MaxItems: 1, Description: "Standard certificate signing request's metadata. More info: https://github.com/kubernetes/community/blob/master/contributors/devel/sig-architecture/api-conventions.md#metadata"},
+		"spec":         {Required: true, ForceNew: true, MaxItems: 1, Description: "Describes a certificate signing request"},
 	},
-	Blocks: map[string]ConverterSpec{},
 }
 
 var kubernetesCertificateSigningRequest_spec = ConverterSpec{
-	ResourceName: "kubernetes_certificate_signing_request",
 	Attributes: map[string]func(any) (cty.Value, error){
 		"request":     toString,
 		"signer_name": toString,
-		"usages": func(a any) (cty.Value, error) {
-			l, ok := a.([]any)
-			if !ok {
-				return cty.Value{}, fmt.Errorf("expected slice, got: %T", a)
-			}
-			vl := make([]cty.Value, 0, len(l))
-			for _, val := range l {
-				v, err := toString(val)
-				if err != nil {
-					return cty.Value{}, err
-				}
-				vl = append(vl, v)
-			}
-			return cty.ListVal(vl), nil
-		},
+		"usages":      toList(toString),
+	},
+	Schema: map[string]FieldSchema{
+		"request":     {Required: true, ForceNew: true, Description: "Base64-encoded PKCS#10 CSR data"},
+		"signer_name": {Optional: true, ForceNew: true, Description: "Requested signer for the request. It is a qualified name in the form: `scope-hostname.io/name`.If empty, it will be defaulted: 1. If it's a kubelet client certificate, it is assigned `kubernetes.io/kube-apiserver-client-kubelet`.2. If it's a kubelet serving certificate, it is assigned `kubernetes.io/kubelet-serving`.3. Otherwise, it is assigned `kubernetes.io/legacy-unknown`. Distribution of trust for signers happens out of band.You can select on this field using `spec.signerName`."},
+		"usages":      {Optional: true, ForceNew: true, Description: "allowedUsages specifies a set of usage contexts the key will be valid for. See:\n\thttps://tools.ietf.org/html/rfc5280#section-4.2.1.3\n\thttps://tools.ietf.org/html/rfc5280#section-4.2.1.12\n\nValid values are:\n \"signing\",\n \"digital signature\",\n \"content commitment\",\n \"key encipherment\",\n \"key agreement\",\n \"data encipherment\",\n \"cert sign\",\n \"crl sign\",\n \"encipher only\",\n \"decipher only\",\n \"any\",\n \"server auth\",\n \"client auth\",\n \"code signing\",\n \"email protection\",\n \"s/mime\",\n \"ipsec end system\",\n \"ipsec tunnel\",\n \"ipsec user\",\n \"timestamping\",\n \"ocsp signing\",\n \"microsoft sgc\",\n \"netscape sgc\""},
 	},
-	Blocks: map[string]ConverterSpec{},
 }
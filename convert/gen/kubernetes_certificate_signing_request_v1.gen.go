@@ -5,15 +5,9 @@ package gen
 // DO NOT EDIT.
 
 import (
-	"fmt"
-
 	"github.com/zclconf/go-cty/cty"
 )
 
-// Make sure resources with no list attributes don't cause build errors because
-// of an unused import.
-var _ = fmt.Sprintf
-
 func init() {
 	register("kubernetes_certificate_signing_request_v1", kubernetesCertificateSigningRequestV1)
 }
@@ -27,40 +21,39 @@ var kubernetesCertificateSigningRequestV1 = ConverterSpec{
 		"metadata": kubernetesCertificateSigningRequestV1_metadata,
 		"spec":     kubernetesCertificateSigningRequestV1_spec,
 	},
-}
-
-var kubernetesCertificateSigningRequestV1_spec = ConverterSpec{
-	ResourceName: "kubernetes_certificate_signing_request_v1",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"expiration_seconds": toInt,
-		"request":            toString,
-		"signer_name":        toString,
-		"usages": func(a any) (cty.Value, error) {
-			l, ok := a.([]any)
-			if !ok {
-				return cty.Value{}, fmt.Errorf("expected slice, got: %T", a)
-			}
-			vl := make([]cty.Value, 0, len(l))
-			for _, val := range l {
-				v, err := toString(val)
-				if err != nil {
-					return cty.Value{}, err
-				}
-				vl = append(vl, v)
-			}
-			return cty.ListVal(vl), nil
-		},
+	Schema: map[string]FieldSchema{
+		"auto_approve": {Optional: true, ForceNew: true, Description: "Automatically approve the CertificateSigningRequest", Default: cty.BoolVal(true)},
+		"metadata":     {Required: true, ForceNew: true, MaxItems: 1, Description: "Standard certificate signing request's metadata. More info: https://github.com/kubernetes/community/blob/master/contributors/devel/sig-architecture/api-conventions.md#metadata"},
+		"spec":         {Required: true, ForceNew: true, MaxItems: 1, Description: "CertificateSigningRequest objects provide a mechanism to obtain x509 certificates by submitting a certificate signing request, and having it asynchronously approved and issued.\n\nKubelets use this API to obtain:\n 1. client certificates to authenticate to kube-apiserver (with the \"kubernetes.io/kube-apiserver-client-kubelet\" signerName).\n 2. serving certificates for TLS endpoints kube-apiserver can connect to securely (with the \"kubernetes.io/kubelet-serving\" signerName).\n\nThis API can be used to request client certificates to authenticate to kube-apiserver (with the \"kubernetes.io/kube-apiserver-client\" signerName), or to obtain certificates from custom non-Kubernetes signers."},
 	},
-	Blocks: map[string]ConverterSpec{},
 }
 
 var kubernetesCertificateSigningRequestV1_metadata = ConverterSpec{
-	ResourceName: "kubernetes_certificate_signing_request_v1",
 	Attributes: map[string]func(any) (cty.Value, error){
 		"annotations":   toStringMap,
 		"generate_name": toString,
 		"labels":        toStringMap,
 		"name":          toString,
 	},
-	Blocks: map[string]ConverterSpec{},
+	Schema: map[string]FieldSchema{
+		"annotations":   {Optional: true, Description: "An unstructured key value map stored with the certificate signing request that may be used to store arbitrary metadata. More info: https://kubernetes.io/docs/concepts/overview/working-with-objects/annotations/"},
+		"generate_name": {Optional: true, ForceNew: true, ConflictsWith: []string{"metadata.0.name"}, Description: "Prefix, used by the server, to generate a unique name ONLY IF the `name` field has not been provided. This value will also be combined with a unique suffix. More info: https://github.com/kubernetes/community/blob/master/contributors/devel/sig-architecture/api-conventions.md#idempotency"},
+		"labels":        {Optional: true, Description: "Map of string keys and values that can be used to organize and categorize (scope and select) the certificate signing request. May match selectors of replication controllers and services. More info: https://kubernetes.io/docs/concepts/overview/working-with-objects/labels/"},
+		"name":          {Optional: true, ForceNew: true, ConflictsWith: []string{"metadata.0.generate_name"}, Description: "Name of the certificate signing request, must be unique. Cannot be updated. More info: https://kubernetes.io/docs/concepts/overview/working-with-objects/names/#names"},
+	},
+}
+
+var kubernetesCertificateSigningRequestV1_spec = ConverterSpec{
+	Attributes: map[string]func(any) (cty.Value, error){
+		"expiration_seconds": toInt,
+		"request":            toString,
+		"signer_name":        toString,
+		"usages":             toList(toString),
+	},
+	Schema: map[string]FieldSchema{
+		"expiration_seconds": {Optional: true, ForceNew: true, Description: "expirationSeconds is the requested duration of validity of the issued certificate. The certificate signer may issue a certificate with a different validity duration so a client must check the delta between the notBefore and and notAfter fields in the issued certificate to determine the actual duration.\n\nThe v1.22+ in-tree implementations of the well-known Kubernetes signers will honor this field as long as the requested duration is not greater than the maximum duration they will honor per the --cluster-signing-duration CLI flag to the Kubernetes controller manager.\n\nCertificate signers may not honor this field for various reasons:\n\n  1. Old signer that is unaware of the field (such as the in-tree\n     implementations prior to v1.22)\n  2. Signer whose configured maximum is shorter than the requested duration\n  3. Signer whose configured minimum is longer than the requested duration\n\nThe minimum valid value for expirationSeconds is 600, i.e. 10 minutes."},
+		"request":            {Required: true, ForceNew: true, Description: "request contains an x509 certificate signing request encoded in a \"CERTIFICATE REQUEST\" PEM block. When serialized as JSON or YAML, the data is additionally base64-encoded."},
+		"signer_name":        {Required: true, ForceNew: true, Description: "signerName indicates the requested signer, and is a qualified name.\n\nList/watch requests for CertificateSigningRequests can filter on this field using a \"spec.signerName=NAME\" fieldSelector.\n\nWell-known Kubernetes signers are:\n 1. \"kubernetes.io/kube-apiserver-client\": issues client certificates that can be used to authenticate to kube-apiserver.\n  Requests for this signer are never auto-approved by kube-controller-manager, can be issued by the \"csrsigning\" controller in kube-controller-manager.\n 2. \"kubernetes.io/kube-apiserver-client-kubelet\": issues client certificates that kubelets use to authenticate to kube-apiserver.\n  Requests for this signer can be auto-approved by the \"csrapproving\" controller in kube-controller-manager, and can be issued by the \"csrsigning\" controller in kube-controller-manager.\n 3. \"kubernetes.io/kubelet-serving\" issues serving certificates that kubelets use to serve TLS endpoints, which kube-apiserver can connect to securely.\n  Requests for this signer are never auto-approved by kube-controller-manager, and can be issued by the \"csrsigning\" controller in kube-controller-manager.\n\nMore details are available at https://k8s.io/docs/reference/access-authn-authz/certificate-signing-requests/#kubernetes-signers\n\nCustom signerNames can also be specified. The signer defines:\n 1. Trust distribution: how trust (CA bundles) are distributed.\n 2. Permitted subjects: and behavior when a disallowed subject is requested.\n 3. Required, permitted, or forbidden x509 extensions in the request (including whether subjectAltNames are allowed, which types, restrictions on allowed values) and behavior when a disallowed extension is requested.\n 4. Required, permitted, or forbidden key usages / extended key usages.\n 5. Expiration/certificate lifetime: whether it is fixed by the signer, configurable by the admin.\n 6. Whether or not requests for CA certificates are allowed."},
+		"usages":             {Optional: true, ForceNew: true, Description: "usages specifies a set of key usages requested in the issued certificate.\n\nRequests for TLS client certificates typically request: \"digital signature\", \"key encipherment\", \"client auth\".\n\nRequests for TLS serving certificates typically request: \"key encipherment\", \"digital signature\", \"server auth\".\n\nValid values are:\n \"signing\", \"digital signature\", \"content commitment\",\n \"key encipherment\", \"key agreement\", \"data encipherment\",\n \"cert sign\", \"crl sign\", \"encipher only\", \"decipher only\", \"any\",\n \"server auth\", \"client auth\",\n \"code signing\", \"email protection\", \"s/mime\",\n \"ipsec end system\", \"ipsec tunnel\", \"ipsec user\",\n \"timestamping\", \"ocsp signing\", \"microsoft sgc\", \"netscape sgc\""},
+	},
 }
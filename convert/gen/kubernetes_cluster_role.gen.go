@@ -5,15 +5,9 @@ package gen
 // DO NOT EDIT.
 
 import (
-	"fmt"
-
 	"github.com/zclconf/go-cty/cty"
 )
 
-// Make sure resources with no list attributes don't cause build errors because
-// of an unused import.
-var _ = fmt.Sprintf
-
 func init() {
 	register("kubernetes_cluster_role", kubernetesClusterRole)
 }
@@ -22,143 +16,13 @@ var kubernetesClusterRole = ConverterSpec{
 	ResourceName: "kubernetes_cluster_role",
 	Attributes:   map[string]func(any) (cty.Value, error){},
 	Blocks: map[string]ConverterSpec{
-		"aggregation_rule": kubernetesClusterRole_aggregationRule,
-		"metadata":         kubernetesClusterRole_metadata,
-		"rule":             kubernetesClusterRole_rule,
+		"aggregation_rule": kubernetesClusterRoleV1_aggregationRule,
+		"metadata":         kubernetesClusterRoleV1_metadata,
+		"rule":             kubernetesClusterRoleV1_rule,
 	},
-}
-
-var kubernetesClusterRole_aggregationRule = ConverterSpec{
-	ResourceName: "kubernetes_cluster_role",
-	Attributes:   map[string]func(any) (cty.Value, error){},
-	Blocks: map[string]ConverterSpec{
-		"cluster_role_selectors": kubernetesClusterRole_aggregationRule_clusterRoleSelectors,
-	},
-}
-
-var kubernetesClusterRole_aggregationRule_clusterRoleSelectors = ConverterSpec{
-	ResourceName: "kubernetes_cluster_role",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"match_labels": toStringMap,
-	},
-	Blocks: map[string]ConverterSpec{
-		"match_expressions": kubernetesClusterRole_aggregationRule_clusterRoleSelectors_matchExpressions,
-	},
-}
-
-var kubernetesClusterRole_aggregationRule_clusterRoleSelectors_matchExpressions = ConverterSpec{
-	ResourceName: "kubernetes_cluster_role",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"key":      toString,
-		"operator": toString,
-		"values": func(a any) (cty.Value, error) {
-			l, ok := a.([]any)
-			if !ok {
-				return cty.Value{}, fmt.Errorf("expected slice, got: %T", a)
-			}
-			vl := make([]cty.Value, 0, len(l))
-			for _, val := range l {
-				v, err := toString(val)
-				if err != nil {
-					return cty.Value{}, err
-				}
-				vl = append(vl, v)
-			}
-			return cty.ListVal(vl), nil
-		},
-	},
-	Blocks: map[string]ConverterSpec{},
-}
-
-var kubernetesClusterRole_rule = ConverterSpec{
-	ResourceName: "kubernetes_cluster_role",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"api_groups": func(a any) (cty.Value, error) {
-			l, ok := a.([]any)
-			if !ok {
-				return cty.Value{}, fmt.Errorf("expected slice, got: %T", a)
-			}
-			vl := make([]cty.Value, 0, len(l))
-			for _, val := range l {
-				v, err := toString(val)
-				if err != nil {
-					return cty.Value{}, err
-				}
-				vl = append(vl, v)
-			}
-			return cty.ListVal(vl), nil
-		},
-		"non_resource_urls": func(a any) (cty.Value, error) {
-			l, ok := a.([]any)
-			if !ok {
-				return cty.Value{}, fmt.Errorf("expected slice, got: %T", a)
-			}
-			vl := make([]cty.Value, 0, len(l))
-			for _, val := range l {
-				v, err := toString(val)
-				if err != nil {
-					return cty.Value{}, err
-				}
-				vl = append(vl, v)
-			}
-			return cty.ListVal(vl), nil
-		},
-		"resource_names": func(a any) (cty.Value, error) {
-			l, ok := a.([]any)
-			if !ok {
-				return cty.Value{}, fmt.Errorf("expected slice, got: %T", a)
-			}
-			vl := make([]cty.Value, 0, len(l))
-			for _, val := range l {
-				v, err := toString(val)
-				if err != nil {
-					return cty.Value{}, err
-				}
-				vl = append(vl, v)
-			}
-			return cty.ListVal(vl), nil
-		},
-		"resources": func(a any) (cty.Value, error) {
-			l, ok := a.([]any)
-			if !ok {
-				return cty.Value{}, fmt.Errorf("expected slice, got: %T", a)
-			}
-			vl := make([]cty.Value, 0, len(l))
-			for _, val := range l {
-				v, err := toString(val)
-				if err != nil {
-					return cty.Value{}, err
-				}
-				vl = append(vl, v)
-			}
-			return cty.ListVal(vl), nil
-		},
-		"verbs": func(a any) (cty.Value, error) {
-			l, ok := a.([]any)
-			if !ok {
-				return cty.Value{}, fmt.Errorf("expected slice, got: %T", a)
-			}
-			vl := make([]cty.Value, 0, len(l))
-			for _, val := range l {
-				v, err := toString(val)
-				if err != nil {
-					return cty.Value{}, err
-				}
-				vl = append(vl, v)
-			}
-			return cty.ListVal(vl), nil
-		},
-	},
-	Blocks: map[string]ConverterSpec{},
-}
-
-var kubernetesClusterRole_metadata = ConverterSpec{
-	ResourceName: "kubernetes_cluster_role",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"annotations":   toStringMap,
-		"generate_name": toString,
-		"labels":        toStringMap,
-		"name":          toString,
+	Schema: map[string]FieldSchema{
+		"aggregation_rule": {Optional: true, MaxItems: 1, Description: "Describes how to build the Rules for this ClusterRole."},
+		"metadata":         {Required: true, MaxItems: 1, Description: "Standard clusterRole's metadata. More info: https://github.com/kubernetes/community/blob/master/contributors/devel/sig-architecture/api-conventions.md#metadata"},
+		"rule":             {Optional: true, MinItems: 1, Description: "List of PolicyRules for this ClusterRole"},
 	},
-	Blocks: map[string]ConverterSpec{},
 }
@@ -5,15 +5,9 @@ package gen
 // DO NOT EDIT.
 
 import (
-	"fmt"
-
 	"github.com/zclconf/go-cty/cty"
 )
 
-// Make sure resources with no list attributes don't cause build errors because
-// of an unused import.
-var _ = fmt.Sprintf
-
 func init() {
 	register("kubernetes_cluster_role_binding", kubernetesClusterRoleBinding)
 }
@@ -22,40 +16,13 @@ var kubernetesClusterRoleBinding = ConverterSpec{
 	ResourceName: "kubernetes_cluster_role_binding",
 	Attributes:   map[string]func(any) (cty.Value, error){},
 	Blocks: map[string]ConverterSpec{
-		"metadata": kubernetesClusterRoleBinding_metadata,
-		"role_ref": kubernetesClusterRoleBinding_roleRef,
-		"subject":  kubernetesClusterRoleBinding_subject,
+		"metadata": kubernetesClusterRoleBindingV1_metadata,
+		"role_ref": kubernetesClusterRoleBindingV1_roleRef,
+		"subject":  kubernetesClusterRoleBindingV1_subject,
 	},
-}
-
-var kubernetesClusterRoleBinding_subject = ConverterSpec{
-	ResourceName: "kubernetes_cluster_role_binding",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"api_group": toString,
-		"kind":      toString,
-		"name":      toString,
-		"namespace": toString,
-	},
-	Blocks: map[string]ConverterSpec{},
-}
-
-var kubernetesClusterRoleBinding_roleRef = ConverterSpec{
-	ResourceName: "kubernetes_cluster_role_binding",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"api_group": toString,
-		"kind":      toString,
-		"name":      toString,
-	},
-	Blocks: map[string]ConverterSpec{},
-}
-
-var kubernetesClusterRoleBinding_metadata = ConverterSpec{
-	ResourceName: "kubernetes_cluster_role_binding",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"annotations":   toStringMap,
-		"generate_name": toString,
-		"labels":        toStringMap,
-		"name":          toString,
+	Schema: map[string]FieldSchema{
+		"metadata": {Required: true, MaxItems: 1, Description: "Standard clusterRoleBinding's metadata. More info: https://github.com/kubernetes/community/blob/master/contributors/devel/sig-architecture/api-conventions.md#metadata"},
+		"role_ref": {Required: true, ForceNew: true, MaxItems: 1, Description: "RoleRef references the Cluster Role for this binding"},
+		"subject":  {Required: true, MinItems: 1, Description: "Subjects defines the entities to bind a ClusterRole to."},
 	},
-	Blocks: map[string]ConverterSpec{},
 }
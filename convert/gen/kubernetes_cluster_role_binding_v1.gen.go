@@ -5,15 +5,9 @@ package gen
 // DO NOT EDIT.
 
 import (
-	"fmt"
-
 	"github.com/zclconf/go-cty/cty"
 )
 
-// Make sure resources with no list attributes don't cause build errors because
-// of an unused import.
-var _ = fmt.Sprintf
-
 func init() {
 	register("kubernetes_cluster_role_binding_v1", kubernetesClusterRoleBindingV1)
 }
@@ -26,36 +20,52 @@ var kubernetesClusterRoleBindingV1 = ConverterSpec{
 		"role_ref": kubernetesClusterRoleBindingV1_roleRef,
 		"subject":  kubernetesClusterRoleBindingV1_subject,
 	},
-}
-
-var kubernetesClusterRoleBindingV1_roleRef = ConverterSpec{
-	ResourceName: "kubernetes_cluster_role_binding_v1",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"api_group": toString,
-		"kind":      toString,
-		"name":      toString,
+	Schema: map[string]FieldSchema{
+		"metadata": {Required: true, MaxItems: 1, Description: "Standard clusterRoleBinding's metadata. More info: https://github.com/kubernetes/community/blob/master/contributors/devel/sig-architecture/api-conventions.md#metadata"},
+		"role_ref": {Required: true, ForceNew: true, MaxItems: 1, Description: "RoleRef references the Cluster Role for this binding"},
+		"subject":  {Required: true, MinItems: 1, Description: "Subjects defines the entities to bind a ClusterRole to."},
 	},
-	Blocks: map[string]ConverterSpec{},
 }
 
 var kubernetesClusterRoleBindingV1_metadata = ConverterSpec{
-	ResourceName: "kubernetes_cluster_role_binding_v1",
 	Attributes: map[string]func(any) (cty.Value, error){
 		"annotations":   toStringMap,
 		"generate_name": toString,
 		"labels":        toStringMap,
 		"name":          toString,
 	},
-	Blocks: map[string]ConverterSpec{},
+	Schema: map[string]FieldSchema{
+		"annotations":   {Optional: true, Description: "An unstructured key value map stored with the clusterRoleBinding that may be used to store arbitrary metadata. More info: https://kubernetes.io/docs/concepts/overview/working-with-objects/annotations/"},
+		"generate_name": {Optional: true, ForceNew: true, ConflictsWith: []string{"metadata.0.name"}, Description: "Prefix, used by the server, to generate a unique name ONLY IF the `name` field has not been provided. This value will also be combined with a unique suffix. More info: https://github.com/kubernetes/community/blob/master/contributors/devel/sig-architecture/api-conventions.md#idempotency"},
+		"labels":        {Optional: true, Description: "Map of string keys and values that can be used to organize and categorize (scope and select) the clusterRoleBinding. May match selectors of replication controllers and services. More info: https://kubernetes.io/docs/concepts/overview/working-with-objects/labels/"},
+		"name":          {Optional: true, ForceNew: true, ConflictsWith: []string{"metadata.0.generate_name"}, Description: "Name of the clusterRoleBinding, must be unique. Cannot be updated. More info: https://kubernetes.io/docs/concepts/overview/working-with-objects/names/#names"},
+	},
+}
+
+var kubernetesClusterRoleBindingV1_roleRef = ConverterSpec{
+	Attributes: map[string]func(any) (cty.Value, error){
+		"api_group": toString,
+		"kind":      toString,
+		"name":      toString,
+	},
+	Schema: map[string]FieldSchema{
+		"api_group": {Required: true, ForceNew: true, Description: "The API group of the user. The only value possible at the moment is `rbac.authorization.k8s.io`."},
+		"kind":      {Required: true, ForceNew: true, Description: "The kind of resource."},
+		"name":      {Required: true, ForceNew: true, Description: "The name of the User to bind to."},
+	},
 }
 
 var kubernetesClusterRoleBindingV1_subject = ConverterSpec{
-	ResourceName: "kubernetes_cluster_role_binding_v1",
 	Attributes: map[string]func(any) (cty.Value, error){
 		"api_group": toString,
 		"kind":      toString,
 		"name":      toString,
 		"namespace": toString,
 	},
-	Blocks: map[string]ConverterSpec{},
+	Schema: map[string]FieldSchema{
+		"api_group": {Optional: true, Description: "The API group of the subject resource."},
+		"kind":      {Required: true, Description: "The kind of resource."},
+		"name":      {Required: true, Description: "The name of the resource to bind to."},
+		"namespace": {Optional: true, Description: "The Namespace of the subject resource.", Default: cty.StringVal("default")},
+	},
 }
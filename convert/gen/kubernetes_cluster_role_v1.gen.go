@@ -5,15 +5,9 @@ package gen
 // DO NOT EDIT.
 
 import (
-	"fmt"
-
 	"github.com/zclconf/go-cty/cty"
 )
 
-// Make sure resources with no list attributes don't cause build errors because
-// of an unused import.
-var _ = fmt.Sprintf
-
 func init() {
 	register("kubernetes_cluster_role_v1", kubernetesClusterRoleV1)
 }
@@ -26,139 +20,77 @@ var kubernetesClusterRoleV1 = ConverterSpec{
 		"metadata":         kubernetesClusterRoleV1_metadata,
 		"rule":             kubernetesClusterRoleV1_rule,
 	},
+	Schema: map[string]FieldSchema{
+		"aggregation_rule": {Optional: true, MaxItems: 1, Description: "Describes how to build the Rules for this ClusterRole."},
+		"metadata":         {Required: true, MaxItems: 1, Description: "Standard clusterRole's metadata. More info: https://github.com/kubernetes/community/blob/master/contributors/devel/sig-architecture/api-conventions.md#metadata"},
+		"rule":             {Optional: true, MinItems: 1, Description: "List of PolicyRules for this ClusterRole"},
+	},
 }
 
 var kubernetesClusterRoleV1_aggregationRule = ConverterSpec{
-	ResourceName: "kubernetes_cluster_role_v1",
-	Attributes:   map[string]func(any) (cty.Value, error){},
+	Attributes: map[string]func(any) (cty.Value, error){},
 	Blocks: map[string]ConverterSpec{
 		"cluster_role_selectors": kubernetesClusterRoleV1_aggregationRule_clusterRoleSelectors,
 	},
+	Schema: map[string]FieldSchema{
+		"cluster_role_selectors": {Optional: true, Description: "A list of selectors which will be used to find ClusterRoles and create the rules."},
+	},
 }
 
 var kubernetesClusterRoleV1_aggregationRule_clusterRoleSelectors = ConverterSpec{
-	ResourceName: "kubernetes_cluster_role_v1",
 	Attributes: map[string]func(any) (cty.Value, error){
 		"match_labels": toStringMap,
 	},
 	Blocks: map[string]ConverterSpec{
 		"match_expressions": kubernetesClusterRoleV1_aggregationRule_clusterRoleSelectors_matchExpressions,
 	},
+	Schema: map[string]FieldSchema{
+		"match_expressions": {Optional: true, Description: "A list of label selector requirements. The requirements are ANDed."},
+		"match_labels":      {Optional: true, Description: "A map of {key,value} pairs. A single {key,value} in the matchLabels map is equivalent to an element of `match_expressions`, whose key field is \"key\", the operator is \"In\", and the values array contains only \"value\". The requirements are ANDed."},
+	},
 }
 
 var kubernetesClusterRoleV1_aggregationRule_clusterRoleSelectors_matchExpressions = ConverterSpec{
-	ResourceName: "kubernetes_cluster_role_v1",
 	Attributes: map[string]func(any) (cty.Value, error){
 		"key":      toString,
 		"operator": toString,
-		"values": func(a any) (cty.Value, error) {
-			l, ok := a.([]any)
-			if !ok {
-				return cty.Value{}, fmt.Errorf("expected slice, got: %T", a)
-			}
-			vl := make([]cty.Value, 0, len(l))
-			for _, val := range l {
-				v, err := toString(val)
-				if err != nil {
-					return cty.Value{}, err
-				}
-				vl = append(vl, v)
-			}
-			return cty.ListVal(vl), nil
-		},
+		"values":   toList(toString),
 	},
-	Blocks: map[string]ConverterSpec{},
-}
-
-var kubernetesClusterRoleV1_rule = ConverterSpec{
-	ResourceName: "kubernetes_cluster_role_v1",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"api_groups": func(a any) (cty.Value, error) {
-			l, ok := a.([]any)
-			if !ok {
-				return cty.Value{}, fmt.Errorf("expected slice, got: %T", a)
-			}
-			vl := make([]cty.Value, 0, len(l))
-			for _, val := range l {
-				v, err := toString(val)
-				if err != nil {
-					return cty.Value{}, err
-				}
-				vl = append(vl, v)
-			}
-			return cty.ListVal(vl), nil
-		},
-		"non_resource_urls": func(a any) (cty.Value, error) {
-			l, ok := a.([]any)
-			if !ok {
-				return cty.Value{}, fmt.Errorf("expected slice, got: %T", a)
-			}
-			vl := make([]cty.Value, 0, len(l))
-			for _, val := range l {
-				v, err := toString(val)
-				if err != nil {
-					return cty.Value{}, err
-				}
-				vl = append(vl, v)
-			}
-			return cty.ListVal(vl), nil
-		},
-		"resource_names": func(a any) (cty.Value, error) {
-			l, ok := a.([]any)
-			if !ok {
-				return cty.Value{}, fmt.Errorf("expected slice, got: %T", a)
-			}
-			vl := make([]cty.Value, 0, len(l))
-			for _, val := range l {
-				v, err := toString(val)
-				if err != nil {
-					return cty.Value{}, err
-				}
-				vl = append(vl, v)
-			}
-			return cty.ListVal(vl), nil
-		},
-		"resources": func(a any) (cty.Value, error) {
-			l, ok := a.([]any)
-			if !ok {
-				return cty.Value{}, fmt.Errorf("expected slice, got: %T", a)
-			}
-			vl := make([]cty.Value, 0, len(l))
-			for _, val := range l {
-				v, err := toString(val)
-				if err != nil {
-					return cty.Value{}, err
-				}
-				vl = append(vl, v)
-			}
-			return cty.ListVal(vl), nil
-		},
-		"verbs": func(a any) (cty.Value, error) {
-			l, ok := a.([]any)
-			if !ok {
-				return cty.Value{}, fmt.Errorf("expected slice, got: %T", a)
-			}
-			vl := make([]cty.Value, 0, len(l))
-			for _, val := range l {
-				v, err := toString(val)
-				if err != nil {
-					return cty.Value{}, err
-				}
-				vl = append(vl, v)
-			}
-			return cty.ListVal(vl), nil
-		},
+	Schema: map[string]FieldSchema{
+		"key":      {Optional: true, Description: "The label key that the selector applies to."},
+		"operator": {Optional: true, Description: "A key's relationship to a set of values. Valid operators ard `In`, `NotIn`, `Exists` and `DoesNotExist`."},
+		"values":   {Optional: true, Description: "An array of string values. If the operator is `In` or `NotIn`, the values array must be non-empty. If the operator is `Exists` or `DoesNotExist`, the values array must be empty. This array is replaced during a strategic merge patch."},
 	},
-	Blocks: map[string]ConverterSpec{},
 }
 
 var kubernetesClusterRoleV1_metadata = ConverterSpec{
-	ResourceName: "kubernetes_cluster_role_v1",
 	Attributes: map[string]func(any) (cty.Value, error){
 		"annotations":   toStringMap,
 		"generate_name": toString,
 		"labels":        toStringMap,
 		"name":          toString,
 	},
-	Blocks: map[string]ConverterSpec{},
+	Schema: map[string]FieldSchema{
+		"annotations":   {Optional: true, Description: "An unstructured key value map stored with the clusterRole that may be used to store arbitrary metadata. More info: https://kubernetes.io/docs/concepts/overview/working-with-objects/annotations/"},
+		"generate_name": {Optional: true, ForceNew: true, ConflictsWith: []string{"metadata.0.name"}, Description: "Prefix, used by the server, to generate a unique name ONLY IF the `name` field has not been provided. This value will also be combined with a unique suffix. More info: https://github.com/kubernetes/community/blob/master/contributors/devel/sig-architecture/api-conventions.md#idempotency"},
+		"labels":        {Optional: true, Description: "Map of string keys and values that can be used to organize and categorize (scope and select) the clusterRole. May match selectors of replication controllers and services. More info: https://kubernetes.io/docs/concepts/overview/working-with-objects/labels/"},
+		"name":          {Optional: true, ForceNew: true, ConflictsWith: []string{"metadata.0.generate_name"}, Description: "Name of the clusterRole, must be unique. Cannot be updated. More info: https://kubernetes.io/docs/concepts/overview/working-with-objects/names/#names"},
+	},
+}
+
+var kubernetesClusterRoleV1_rule = ConverterSpec{
+	Attributes: map[string]func(any) (cty.Value, error){
+		"api_groups":        toList(toString),
+		"non_resource_urls": toList(toString),
+		"resource_names":    toList(toString),
+		"resources":         toList(toString),
+		"verbs":             toList(toString),
+	},
+	Schema: map[string]FieldSchema{
+		"api_groups":        {Optional: true, MinItems: 1, Description: "APIGroups is the name of the APIGroup that contains the resources. If multiple API groups are specified, any action requested against one of the enumerated resources in any API group will be allowed."},
+		"non_resource_urls": {Optional: true, Description: "NonResourceURLs is a set of partial urls that a user should have access to. *s are allowed, but only as the full, final step in the path Since non-resource URLs are not namespaced, this field is only applicable for ClusterRoles referenced from a ClusterRoleBinding. Rules can either apply to API resources (such as \"pods\" or \"secrets\") or non-resource URL paths (such as \"/api\"), but not both."},
+		"resource_names":    {Optional: true, Description: "ResourceNames is an optional white list of names that the rule applies to. An empty set means that everything is allowed."},
+		"resources":         {Optional: true, Description: "Resources is a list of resources this rule applies to. ResourceAll represents all resources."},
+		"verbs":             {Required: true, Description: "Verbs is a list of Verbs that apply to ALL the ResourceKinds and AttributeRestrictions contained in this rule. VerbAll represents all kinds."},
+	},
 }
@@ -5,15 +5,9 @@ package gen
 // DO NOT EDIT.
 
 import (
-	"fmt"
-
 	"github.com/zclconf/go-cty/cty"
 )
 
-// Make sure resources with no list attributes don't cause build errors because
-// of an unused import.
-var _ = fmt.Sprintf
-
 func init() {
 	register("kubernetes_config_map", kubernetesConfigMap)
 }
@@ -26,18 +20,12 @@ var kubernetesConfigMap = ConverterSpec{
 		"immutable":   toBool,
 	},
 	Blocks: map[string]ConverterSpec{
-		"metadata": kubernetesConfigMap_metadata,
+		"metadata": kubernetesConfigMapV1_metadata,
 	},
-}
-
-var kubernetesConfigMap_metadata = ConverterSpec{
-	ResourceName: "kubernetes_config_map",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"annotations":   toStringMap,
-		"generate_name": toString,
-		"labels":        toStringMap,
-		"name":          toString,
-		"namespace":     toString,
+	Schema: map[string]FieldSchema{
+		"binary_data": {Optional: true, Description: "BinaryData contains the binary data. Each key must consist of alphanumeric characters, '-', '_' or '.'. BinaryData can contain byte sequences that are not in the UTF-8 range. The keys stored in BinaryData must not overlap with the ones in the Data field, this is enforced during validation process. Using this field will require 1.10+ apiserver and kubelet. This field only accepts base64-encoded payloads that will be decoded/encoded before being sent/received to/from the apiserver."},
+		"data":        {Optional: true, Description: "Data contains the configuration data. Each key must consist of alphanumeric characters, '-', '_' or '.'. Values with non-UTF-8 byte sequences must use the BinaryData field. The keys stored in Data must not overlap with the keys in the BinaryData field, this is enforced during validation process."},
+		"immutable":   {Optional: true, Description: "Immutable, if set to true, ensures that data stored in the ConfigMap cannot be updated (only object metadata can be modified). If not set to true, the field can be modified at any time. Defaulted to nil."},
+		"metadata":    {Required: true, MaxItems: 1, Description: "Standard config map's metadata. More info: https://github.com/kubernetes/community/blob/master/contributors/devel/sig-architecture/api-conventions.md#metadata"},
 	},
-	Blocks: map[string]ConverterSpec{},
 }
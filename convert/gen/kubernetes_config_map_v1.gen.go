@@ -5,15 +5,9 @@ package gen
 // DO NOT EDIT.
 
 import (
-	"fmt"
-
 	"github.com/zclconf/go-cty/cty"
 )
 
-// Make sure resources with no list attributes don't cause build errors because
-// of an unused import.
-var _ = fmt.Sprintf
-
 func init() {
 	register("kubernetes_config_map_v1", kubernetesConfigMapV1)
 }
@@ -28,10 +22,15 @@ var kubernetesConfigMapV1 = ConverterSpec{
 	Blocks: map[string]ConverterSpec{
 		"metadata": kubernetesConfigMapV1_metadata,
 	},
+	Schema: map[string]FieldSchema{
+		"binary_data": {Optional: true, Description: "BinaryData contains the binary data. Each key must consist of alphanumeric characters, '-', '_' or '.'. BinaryData can contain byte sequences that are not in the UTF-8 range. The keys stored in BinaryData must not overlap with the ones in the Data field, this is enforced during validation process. Using this field will require 1.10+ apiserver and kubelet. This field only accepts base64-encoded payloads that will be decoded/encoded before being sent/received to/from the apiserver."},
+		"data":        {Optional: true, Description: "Data contains the configuration data. Each key must consist of alphanumeric characters, '-', '_' or '.'. Values with non-UTF-8 byte sequences must use the BinaryData field. The keys stored in Data must not overlap with the keys in the BinaryData field, this is enforced during validation process."},
+		"immutable":   {Optional: true, Description: "Immutable, if set to true, ensures that data stored in the ConfigMap cannot be updated (only object metadata can be modified). If not set to true, the field can be modified at any time. Defaulted to nil."},
+		"metadata":    {Required: true, MaxItems: 1, Description: "Standard config map's metadata. More info: https://github.com/kubernetes/community/blob/master/contributors/devel/sig-architecture/api-conventions.md#metadata"},
+	},
 }
 
 var kubernetesConfigMapV1_metadata = ConverterSpec{
-	ResourceName: "kubernetes_config_map_v1",
 	Attributes: map[string]func(any) (cty.Value, error){
 		"annotations":   toStringMap,
 		"generate_name": toString,
@@ -39,5 +38,11 @@ var kubernetesConfigMapV1_metadata = ConverterSpec{
 		"name":          toString,
 		"namespace":     toString,
 	},
-	Blocks: map[string]ConverterSpec{},
+	Schema: map[string]FieldSchema{
+		"annotations":   {Optional: true, Description: "An unstructured key value map stored with the config map that may be used to store arbitrary metadata. More info: https://kubernetes.io/docs/concepts/overview/working-with-objects/annotations/"},
+		"generate_name": {Optional: true, ForceNew: true, ConflictsWith: []string{"metadata.name"}, Description: "Prefix, used by the server, to generate a unique name ONLY IF the `name` field has not been provided. This value will also be combined with a unique suffix. More info: https://github.com/kubernetes/community/blob/master/contributors/devel/sig-architecture/api-conventions.md#idempotency"},
+		"labels":        {Optional: true, Description: "Map of string keys and values that can be used to organize and categorize (scope and select) the config map. May match selectors of replication controllers and services. More info: https://kubernetes.io/docs/concepts/overview/working-with-objects/labels/"},
+		"name":          {Optional: true, ForceNew: true, ConflictsWith: []string{"metadata.generate_name"}, Description: "Name of the config map, must be unique. Cannot be updated. More info: https://kubernetes.io/docs/concepts/overview/working-with-objects/names/#names"},
+		"namespace":     {Optional: true, ForceNew: true, Description: "Namespace defines the space within which name of the config map must be unique.", Default: cty.StringVal("default")},
+	},
 }
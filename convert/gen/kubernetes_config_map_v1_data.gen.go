@@ -5,15 +5,9 @@ package gen
 // DO NOT EDIT.
 
 import (
-	"fmt"
-
 	"github.com/zclconf/go-cty/cty"
 )
 
-// Make sure resources with no list attributes don't cause build errors because
-// of an unused import.
-var _ = fmt.Sprintf
-
 func init() {
 	register("kubernetes_config_map_v1_data", kubernetesConfigMapV1Data)
 }
@@ -28,13 +22,21 @@ var kubernetesConfigMapV1Data = ConverterSpec{
 	Blocks: map[string]ConverterSpec{
 		"metadata": kubernetesConfigMapV1Data_metadata,
 	},
+	Schema: map[string]FieldSchema{
+		"data":          {Required: true, Description: "The data we want to add to the ConfigMap."},
+		"field_manager": {Optional: true, Description: "Set the name of the field manager for the specified labels.", Default: cty.StringVal("Terraform")},
+		"force":         {Optional: true, Description: "Force overwriting data that is managed outside of Terraform."},
+		"metadata":      {Required: true, MaxItems: 1},
+	},
 }
 
 var kubernetesConfigMapV1Data_metadata = ConverterSpec{
-	ResourceName: "kubernetes_config_map_v1_data",
 	Attributes: map[string]func(any) (cty.Value, error){
 		"name":      toString,
 		"namespace": toString,
 	},
-	Blocks: map[string]ConverterSpec{},
+	Schema: map[string]FieldSchema{
+		"name":      {Required: true, ForceNew: true, Description: "The name of the ConfigMap."},
+		"namespace": {Optional: true, ForceNew: true, Description: "The namespace of the ConfigMap.", Default: cty.StringVal("default")},
+	},
 }
@@ -5,15 +5,9 @@ package gen
 // DO NOT EDIT.
 
 import (
-	"fmt"
-
 	"github.com/zclconf/go-cty/cty"
 )
 
-// Make sure resources with no list attributes don't cause build errors because
-// of an unused import.
-var _ = fmt.Sprintf
-
 func init() {
 	register("kubernetes_csi_driver", kubernetesCsiDriver)
 }
@@ -22,42 +16,11 @@ var kubernetesCsiDriver = ConverterSpec{
 	ResourceName: "kubernetes_csi_driver",
 	Attributes:   map[string]func(any) (cty.Value, error){},
 	Blocks: map[string]ConverterSpec{
-		"metadata": kubernetesCsiDriver_metadata,
-		"spec":     kubernetesCsiDriver_spec,
+		"metadata": kubernetesCsiDriverV1_metadata,
+		"spec":     kubernetesCsiDriverV1_spec,
 	},
-}
-
-var kubernetesCsiDriver_metadata = ConverterSpec{
-	ResourceName: "kubernetes_csi_driver",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"annotations":   toStringMap,
-		"generate_name": toString,
-		"labels":        toStringMap,
-		"name":          toString,
-	},
-	Blocks: map[string]ConverterSpec{},
-}
-
-var kubernetesCsiDriver_spec = ConverterSpec{
-	ResourceName: "kubernetes_csi_driver",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"attach_required":   toBool,
-		"pod_info_on_mount": toBool,
-		"volume_lifecycle_modes": func(a any) (cty.Value, error) {
-			l, ok := a.([]any)
-			if !ok {
-				return cty.Value{}, fmt.Errorf("expected slice, got: %T", a)
-			}
-			vl := make([]cty.Value, 0, len(l))
-			for _, val := range l {
-				v, err := toString(val)
-				if err != nil {
-					return cty.Value{}, err
-				}
-				vl = append(vl, v)
-			}
-			return cty.ListVal(vl), nil
-		},
+	Schema: map[string]FieldSchema{
+		"metadata": {Required: true, MaxItems: 1, Description: "Standard csi driver's metadata. More info: https://github.com/kubernetes/community/blob/master/contributors/devel/sig-architecture/api-conventions.md#metadata"},
+		"spec":     {Optional: true, MaxItems: 1, Description: "Spec of the CSIDriver"},
 	},
-	Blocks: map[string]ConverterSpec{},
 }
@@ -5,15 +5,9 @@ package gen
 // DO NOT EDIT.
 
 import (
-	"fmt"
-
 	"github.com/zclconf/go-cty/cty"
 )
 
-// Make sure resources with no list attributes don't cause build errors because
-// of an unused import.
-var _ = fmt.Sprintf
-
 func init() {
 	register("kubernetes_csi_driver_v1", kubernetesCsiDriverV1)
 }
@@ -25,39 +19,36 @@ var kubernetesCsiDriverV1 = ConverterSpec{
 		"metadata": kubernetesCsiDriverV1_metadata,
 		"spec":     kubernetesCsiDriverV1_spec,
 	},
-}
-
-var kubernetesCsiDriverV1_spec = ConverterSpec{
-	ResourceName: "kubernetes_csi_driver_v1",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"attach_required":   toBool,
-		"pod_info_on_mount": toBool,
-		"volume_lifecycle_modes": func(a any) (cty.Value, error) {
-			l, ok := a.([]any)
-			if !ok {
-				return cty.Value{}, fmt.Errorf("expected slice, got: %T", a)
-			}
-			vl := make([]cty.Value, 0, len(l))
-			for _, val := range l {
-				v, err := toString(val)
-				if err != nil {
-					return cty.Value{}, err
-				}
-				vl = append(vl, v)
-			}
-			return cty.ListVal(vl), nil
-		},
+	Schema: map[string]FieldSchema{
+		"metadata": {Required: true, MaxItems: 1, Description: "Standard csi driver's metadata. More info: https://github.com/kubernetes/community/blob/master/contributors/devel/sig-architecture/api-conventions.md#metadata"},
+		"spec":     {Optional: true, MaxItems: 1, Description: "Spec of the CSIDriver"},
 	},
-	Blocks: map[string]ConverterSpec{},
 }
 
 var kubernetesCsiDriverV1_metadata = ConverterSpec{
-	ResourceName: "kubernetes_csi_driver_v1",
 	Attributes: map[string]func(any) (cty.Value, error){
 		"annotations":   toStringMap,
 		"generate_name": toString,
 		"labels":        toStringMap,
 		"name":          toString,
 	},
-	Blocks: map[string]ConverterSpec{},
+	Schema: map[string]FieldSchema{
+		"annotations":   {Optional: true, Description: "An unstructured key value map stored with the csi driver that may be used to store arbitrary metadata. More info: https://kubernetes.io/docs/concepts/overview/working-with-objects/annotations/"},
+		"generate_name": {Optional: true, ForceNew: true, ConflictsWith: []string{"metadata.0.name"}, Description: "Prefix, used by the server, to generate a unique name ONLY IF the `name` field has not been provided. This value will also be combined with a unique suffix. More info: https://github.com/kubernetes/community/blob/master/contributors/devel/sig-architecture/api-conventions.md#idempotency"},
+		"labels":        {Optional: true, Description: "Map of string keys and values that can be used to organize and categorize (scope and select) the csi driver. May match selectors of replication controllers and services. More info: https://kubernetes.io/docs/concepts/overview/working-with-objects/labels/"},
+		"name":          {Optional: true, ForceNew: true, ConflictsWith: []string{"metadata.0.generate_name"}, Description: "Name of the csi driver, must be unique. Cannot be updated. More info: https://kubernetes.io/docs/concepts/overview/working-with-objects/names/#names"},
+	},
+}
+
+var kubernetesCsiDriverV1_spec = ConverterSpec{
+	Attributes: map[string]func(any) (cty.Value, error){
+		"attach_required":        toBool,
+		"pod_info_on_mount":      toBool,
+		"volume_lifecycle_modes": toList(toString),
+	},
+	Schema: map[string]FieldSchema{
+		"attach_required":        {Required: true, ForceNew: true, Description: "Indicates if the CSI volume driver requires an attach operation"},
+		"pod_info_on_mount":      {Optional: true, Description: "Indicates that the CSI volume driver requires additional pod information (like podName, podUID, etc.) during mount operations"},
+		"volume_lifecycle_modes": {Optional: true, Description: "Defines what kind of volumes this CSI volume driver supports"},
+	},
 }
@@ -5,15 +5,9 @@ package gen
 // DO NOT EDIT.
 
 import (
-	"fmt"
-
 	"github.com/zclconf/go-cty/cty"
 )
 
-// Make sure resources with no list attributes don't cause build errors because
-// of an unused import.
-var _ = fmt.Sprintf
-
 func init() {
 	register("kubernetes_daemon_set_v1", kubernetesDaemonSetV1)
 }
@@ -27,66 +21,86 @@ var kubernetesDaemonSetV1 = ConverterSpec{
 		"metadata": kubernetesDaemonSetV1_metadata,
 		"spec":     kubernetesDaemonSetV1_spec,
 	},
+	Schema: map[string]FieldSchema{
+		"metadata":         {Required: true, MaxItems: 1, Description: "Standard daemonset's metadata. More info: https://github.com/kubernetes/community/blob/master/contributors/devel/sig-architecture/api-conventions.md#metadata"},
+		"spec":             {Required: true, MaxItems: 1, Description: "Spec defines the specification of the desired behavior of the daemonset. More info: https://v1-9.docs.kubernetes.io/docs/reference/generated/kubernetes-api/v1.9/#daemonset-v1-apps"},
+		"wait_for_rollout": {Optional: true, Description: "Wait for the rollout of the deployment to complete. Defaults to true.", Default: cty.BoolVal(true)},
+	},
+}
+
+var kubernetesDaemonSetV1_metadata = ConverterSpec{
+	Attributes: map[string]func(any) (cty.Value, error){
+		"annotations":   toStringMap,
+		"generate_name": toString,
+		"labels":        toStringMap,
+		"name":          toString,
+		"namespace":     toString,
+	},
+	Schema: map[string]FieldSchema{
+		"annotations":   {Optional: true, Description: "An unstructured key value map stored with the daemonset that may be used to store arbitrary metadata. More info: https://kubernetes.io/docs/concepts/overview/working-with-objects/annotations/"},
+		"generate_name": {Optional: true, ForceNew: true, ConflictsWith: []string{"metadata.name"}, Description: "Prefix, used by the server, to generate a unique name ONLY IF the `name` field has not been provided. This value will also be combined with a unique suffix. More info: https://github.com/kubernetes/community/blob/master/contributors/devel/sig-architecture/api-conventions.md#idempotency"},
+		"labels":        {Optional: true, Description: "Map of string keys and values that can be used to organize and categorize (scope and select) the daemonset. May match selectors of replication controllers and services. More info: https://kubernetes.io/docs/concepts/overview/working-with-objects/labels/"},
+		"name":          {Optional: true, ForceNew: true, ConflictsWith: []string{"metadata.generate_name"}, Description: "Name of the daemonset, must be unique. Cannot be updated. More info: https://kubernetes.io/docs/concepts/overview/working-with-objects/names/#names"},
+		"namespace":     {Optional: true, ForceNew: true, Description: "Namespace defines the space within which name of the daemonset must be unique.", Default: cty.StringVal("default")},
+	},
 }
 
 var kubernetesDaemonSetV1_spec = ConverterSpec{
-	ResourceName: "kubernetes_daemon_set_v1",
 	Attributes: map[string]func(any) (cty.Value, error){
 		"min_ready_seconds":      toInt,
 		"revision_history_limit": toInt,
 	},
 	Blocks: map[string]ConverterSpec{
-		"selector": kubernetesDaemonSetV1_spec_selector,
+		"selector": kubernetesClusterRoleV1_aggregationRule_clusterRoleSelectors,
 		"strategy": kubernetesDaemonSetV1_spec_strategy,
 		"template": kubernetesDaemonSetV1_spec_template,
 	},
+	Schema: map[string]FieldSchema{
+		"min_ready_seconds":      {Optional: true, Description: "Minimum number of seconds for which a newly created pod should be ready without any of its container crashing, for it to be considered available. Defaults to 0 (pod will be considered available as soon as it is ready)", Default: cty.NumberIntVal(0)},
+		"revision_history_limit": {Optional: true, Description: "The number of old ReplicaSets to retain to allow rollback. This is a pointer to distinguish between explicit zero and not specified. Defaults to 10.", Default: cty.NumberIntVal(10)},
+		"selector":               {Optional: true, ForceNew: true, MaxItems: 1, Description: "A label query over pods that are managed by the DaemonSet."},
+		"strategy":               {Optional: true, MaxItems: 1, Description: "The deployment strategy used to replace existing pods with new ones."},
+		"template":               {Required: true, MaxItems: 1, Description: "An object that describes the pod that will be created. The DaemonSet will create exactly one copy of this pod on every node that matches the template's node selector (or on every node if no node selector is specified). More info: https://kubernetes.io/docs/concepts/workloads/controllers/daemonset/#pod-template"},
+	},
 }
 
-var kubernetesDaemonSetV1_spec_selector = ConverterSpec{
-	ResourceName: "kubernetes_daemon_set_v1",
+var kubernetesDaemonSetV1_spec_strategy = ConverterSpec{
 	Attributes: map[string]func(any) (cty.Value, error){
-		"match_labels": toStringMap,
+		"type": toString,
 	},
 	Blocks: map[string]ConverterSpec{
-		"match_expressions": kubernetesDaemonSetV1_spec_selector_matchExpressions,
+		"rolling_update": kubernetesDaemonSetV1_spec_strategy_rollingUpdate,
+	},
+	Schema: map[string]FieldSchema{
+		"rolling_update": {Optional: true, MaxItems: 1, Description: "Rolling update config params. Present only if type = 'RollingUpdate'."},
+		"type":           {Optional: true, Description: "Type of deployment. Can be 'RollingUpdate' or 'OnDelete'. Default is RollingUpdate.", Default: cty.StringVal("RollingUpdate")},
 	},
 }
 
-var kubernetesDaemonSetV1_spec_selector_matchExpressions = ConverterSpec{
-	ResourceName: "kubernetes_daemon_set_v1",
+var kubernetesDaemonSetV1_spec_strategy_rollingUpdate = ConverterSpec{
 	Attributes: map[string]func(any) (cty.Value, error){
-		"key":      toString,
-		"operator": toString,
-		"values": func(a any) (cty.Value, error) {
-			l, ok := a.([]any)
-			if !ok {
-				return cty.Value{}, fmt.Errorf("expected slice, got: %T", a)
-			}
-			vl := make([]cty.Value, 0, len(l))
-			for _, val := range l {
-				v, err := toString(val)
-				if err != nil {
-					return cty.Value{}, err
-				}
-				vl = append(vl, v)
-			}
-			return cty.ListVal(vl), nil
-		},
-	},
-	Blocks: map[string]ConverterSpec{},
+		"max_surge":       toString,
+		"max_unavailable": toString,
+	},
+	Schema: map[string]FieldSchema{
+		"max_surge":       {Optional: true, Description: "The maximum number of nodes with an existing available DaemonSet pod that can have an updated DaemonSet pod during during an update. Value can be an absolute number (ex: 5) or a percentage of desired pods (ex: 10%). This can not be 0 if MaxUnavailable is 0. Absolute number is calculated from percentage by rounding up to a minimum of 1. Default value is 0. Example: when this is set to 30%, at most 30% of the total number of nodes that should be running the daemon pod (i.e. status.desiredNumberScheduled) can have their a new pod created before the old pod is marked as deleted. The update starts by launching new pods on 30% of nodes. Once an updated pod is available (Ready for at least minReadySeconds) the old DaemonSet pod on that node is marked deleted. If the old pod becomes unavailable for any reason Ready transitions to false, is evicted, or is drained) an updated pod is immediatedly created on that node without considering surge limits. Allowing surge implies the possibility that the resources consumed by the daemonset on any given node can double if the readiness check fails, and so resource intensive daemonsets should take into account that they may cause evictionsduring disruption.", Default: cty.NumberIntVal(0)},
+		"max_unavailable": {Optional: true, Description: "The maximum number of DaemonSet pods that can be unavailable during the update. Value can be an absolute number (ex: 5) or a percentage of total number of DaemonSet pods at the start of the update (ex: 10%). Absolute number is calculated from percentage by rounding up. This cannot be 0 if MaxSurge is 0 Default value is 1. Example: when this is set to 30%, at most 30% of the total number of nodes that should be running the daemon pod (i.e. status.desiredNumberScheduled) can have their pods stopped for an update at any given time. The update starts by stopping at most 30% of those DaemonSet pods and then brings up new DaemonSet pods in their place. Once the new pods are available, it then proceeds onto other DaemonSet pods, thus ensuring that at least 70% of original number of DaemonSet pods are available at all times during the update.", Default: cty.NumberIntVal(1)},
+	},
 }
 
 var kubernetesDaemonSetV1_spec_template = ConverterSpec{
-	ResourceName: "kubernetes_daemon_set_v1",
-	Attributes:   map[string]func(any) (cty.Value, error){},
+	Attributes: map[string]func(any) (cty.Value, error){},
 	Blocks: map[string]ConverterSpec{
 		"metadata": kubernetesDaemonSetV1_spec_template_metadata,
 		"spec":     kubernetesDaemonSetV1_spec_template_spec,
 	},
+	Schema: map[string]FieldSchema{
+		"metadata": {Required: true, MaxItems: 1, Description: "Standard daemon set's metadata. More info: https://github.com/kubernetes/community/blob/master/contributors/devel/sig-architecture/api-conventions.md#metadata"},
+		"spec":     {Optional: true, MaxItems: 1, Description: "Spec of the pods owned by the daemon set"},
+	},
 }
 
 var kubernetesDaemonSetV1_spec_template_metadata = ConverterSpec{
-	ResourceName: "kubernetes_daemon_set_v1",
 	Attributes: map[string]func(any) (cty.Value, error){
 		"annotations":   toStringMap,
 		"generate_name": toString,
@@ -94,11 +108,16 @@ var kubernetesDaemonSetV1_spec_template_metadata = ConverterSpec{
 		"name":          toString,
 		"namespace":     toString,
 	},
-	Blocks: map[string]ConverterSpec{},
+	Schema: map[string]FieldSchema{
+		"annotations":   {Optional: true, Description: "An unstructured key value map stored with the daemon set that may be used to store arbitrary metadata. More info: https://kubernetes.io/docs/concepts/overview/working-with-objects/annotations/"},
+		"generate_name": {Optional: true, ForceNew: true, ConflictsWith: []string{"metadata.name"}, Description: "Prefix, used by the server, to generate a unique name ONLY IF the `name` field has not been provided. This value will also be combined with a unique suffix. More info: https://github.com/kubernetes/community/blob/master/contributors/devel/sig-architecture/api-conventions.md#idempotency"},
+		"labels":        {Optional: true, Description: "Map of string keys and values that can be used to organize and categorize (scope and select) the daemon set. May match selectors of replication controllers and services. More info: https://kubernetes.io/docs/concepts/overview/working-with-objects/labels/"},
+		"name":          {Optional: true, ForceNew: true, ConflictsWith: []string{"metadata.generate_name"}, Description: "Name of the daemon set, must be unique. Cannot be updated. More info: https://kubernetes.io/docs/concepts/overview/working-with-objects/names/#names"},
+		"namespace":     {Optional: true, ForceNew: true, Description: "Namespace defines the space within which name of the daemon set must be unique."},
+	},
 }
 
 var kubernetesDaemonSetV1_spec_template_spec = ConverterSpec{
-	ResourceName: "kubernetes_daemon_set_v1",
 	Attributes: map[string]func(any) (cty.Value, error){
 		"active_deadline_seconds":          toInt,
 		"automount_service_account_token":  toBool,
@@ -120,2767 +139,460 @@ var kubernetesDaemonSetV1_spec_template_spec = ConverterSpec{
 		"termination_grace_period_seconds": toInt,
 	},
 	Blocks: map[string]ConverterSpec{
-		"affinity":                   kubernetesDaemonSetV1_spec_template_spec_affinity,
+		"affinity":                   kubernetesPodV1_spec_affinity,
 		"container":                  kubernetesDaemonSetV1_spec_template_spec_container,
 		"dns_config":                 kubernetesDaemonSetV1_spec_template_spec_dnsConfig,
 		"host_aliases":               kubernetesDaemonSetV1_spec_template_spec_hostAliases,
 		"image_pull_secrets":         kubernetesDaemonSetV1_spec_template_spec_imagePullSecrets,
-		"init_container":             kubernetesDaemonSetV1_spec_template_spec_initContainer,
-		"os":                         kubernetesDaemonSetV1_spec_template_spec_os,
+		"init_container":             kubernetesDaemonSetV1_spec_template_spec_container,
+		"os":                         kubernetesPodV1_spec_os,
 		"readiness_gate":             kubernetesDaemonSetV1_spec_template_spec_readinessGate,
 		"security_context":           kubernetesDaemonSetV1_spec_template_spec_securityContext,
 		"toleration":                 kubernetesDaemonSetV1_spec_template_spec_toleration,
 		"topology_spread_constraint": kubernetesDaemonSetV1_spec_template_spec_topologySpreadConstraint,
 		"volume":                     kubernetesDaemonSetV1_spec_template_spec_volume,
 	},
-}
-
-var kubernetesDaemonSetV1_spec_template_spec_volume = ConverterSpec{
-	ResourceName: "kubernetes_daemon_set_v1",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"name": toString,
-	},
-	Blocks: map[string]ConverterSpec{
-		"aws_elastic_block_store": kubernetesDaemonSetV1_spec_template_spec_volume_awsElasticBlockStore,
-		"azure_disk":              kubernetesDaemonSetV1_spec_template_spec_volume_azureDisk,
-		"azure_file":              kubernetesDaemonSetV1_spec_template_spec_volume_azureFile,
-		"ceph_fs":                 kubernetesDaemonSetV1_spec_template_spec_volume_cephFs,
-		"cinder":                  kubernetesDaemonSetV1_spec_template_spec_volume_cinder,
-		"config_map":              kubernetesDaemonSetV1_spec_template_spec_volume_configMap,
-		"csi":                     kubernetesDaemonSetV1_spec_template_spec_volume_csi,
-		"downward_api":            kubernetesDaemonSetV1_spec_template_spec_volume_downwardApi,
-		"empty_dir":               kubernetesDaemonSetV1_spec_template_spec_volume_emptyDir,
-		"ephemeral":               kubernetesDaemonSetV1_spec_template_spec_volume_ephemeral,
-		"fc":                      kubernetesDaemonSetV1_spec_template_spec_volume_fc,
-		"flex_volume":             kubernetesDaemonSetV1_spec_template_spec_volume_flexVolume,
-		"flocker":                 kubernetesDaemonSetV1_spec_template_spec_volume_flocker,
-		"gce_persistent_disk":     kubernetesDaemonSetV1_spec_template_spec_volume_gcePersistentDisk,
-		"git_repo":                kubernetesDaemonSetV1_spec_template_spec_volume_gitRepo,
-		"glusterfs":               kubernetesDaemonSetV1_spec_template_spec_volume_glusterfs,
-		"host_path":               kubernetesDaemonSetV1_spec_template_spec_volume_hostPath,
-		"iscsi":                   kubernetesDaemonSetV1_spec_template_spec_volume_iscsi,
-		"local":                   kubernetesDaemonSetV1_spec_template_spec_volume_local,
-		"nfs":                     kubernetesDaemonSetV1_spec_template_spec_volume_nfs,
-		"persistent_volume_claim": kubernetesDaemonSetV1_spec_template_spec_volume_persistentVolumeClaim,
-		"photon_persistent_disk":  kubernetesDaemonSetV1_spec_template_spec_volume_photonPersistentDisk,
-		"projected":               kubernetesDaemonSetV1_spec_template_spec_volume_projected,
-		"quobyte":                 kubernetesDaemonSetV1_spec_template_spec_volume_quobyte,
-		"rbd":                     kubernetesDaemonSetV1_spec_template_spec_volume_rbd,
-		"secret":                  kubernetesDaemonSetV1_spec_template_spec_volume_secret,
-		"vsphere_volume":          kubernetesDaemonSetV1_spec_template_spec_volume_vsphereVolume,
+	Schema: map[string]FieldSchema{
+		"active_deadline_seconds":          {Optional: true, Description: "Optional duration in seconds the pod may be active on the node relative to StartTime before the system will actively try to mark it failed and kill associated containers. Value must be a positive integer."},
+		"affinity":                         {Optional: true, MaxItems: 1, Description: "Optional pod scheduling constraints."},
+		"automount_service_account_token":  {Optional: true, Description: "AutomountServiceAccountToken indicates whether a service account token should be automatically mounted.", Default: cty.BoolVal(true)},
+		"container":                        {Optional: true, Description: "List of containers belonging to the pod. Containers cannot currently be added or removed. There must be at least one container in a Pod. Cannot be updated. More info: https://kubernetes.io/docs/concepts/containers/"},
+		"dns_config":                       {Optional: true, MaxItems: 1, Description: "Specifies the DNS parameters of a pod. Parameters specified here will be merged to the generated DNS configuration based on DNSPolicy. Optional: Defaults to empty"},
+		"dns_policy":                       {Optional: true, Description: "Set DNS policy for containers within the pod. Valid values are 'ClusterFirstWithHostNet', 'ClusterFirst', 'Default' or 'None'. DNS parameters given in DNSConfig will be merged with the policy selected with DNSPolicy. To have DNS options set along with hostNetwork, you have to specify DNS policy explicitly to 'ClusterFirstWithHostNet'. Defaults to 'ClusterFirst'. More info: https://kubernetes.io/docs/concepts/services-networking/dns-pod-service/#pod-s-dns-policy", Default: cty.StringVal("ClusterFirst")},
+		"enable_service_links":             {Optional: true, Description: "Enables generating environment variables for service discovery. Defaults to true.", Default: cty.BoolVal(true)},
+		"host_aliases":                     {Optional: true, Description: "List of hosts and IPs that will be injected into the pod's hosts file if specified. Optional: Defaults to empty."},
+		"host_ipc":                         {Optional: true, Description: "Use the host's ipc namespace. Optional: Defaults to false.", Default: cty.BoolVal(false)},
+		"host_network":                     {Optional: true, Description: "Host networking requested for this pod. Use the host's network namespace. If this option is set, the ports that will be used must be specified.", Default: cty.BoolVal(false)},
+		"host_pid":                         {Optional: true, Description: "Use the host's pid namespace.", Default: cty.BoolVal(false)},
+		"hostname":                         {Optional: true, Description: "Specifies the hostname of the Pod If not specified, the pod's hostname will be set to a system-defined value."},
+		"image_pull_secrets":               {Optional: true, Description: "ImagePullSecrets is an optional list of references to secrets in the same namespace to use for pulling any of the images used by this PodSpec. If specified, these secrets will be passed to individual puller implementations for them to use. For example, in the case of docker, only DockerConfig type secrets are honored. More info: https://kubernetes.io/docs/concepts/containers/images/#specifying-imagepullsecrets-on-a-pod"},
+		"init_container":                   {Optional: true, Description: "List of init containers belonging to the pod. Init containers always run to completion and each must complete successfully before the next is started. More info: https://kubernetes.io/docs/concepts/workloads/pods/init-containers/"},
+		"node_name":                        {Optional: true, Description: "NodeName is a request to schedule this pod onto a specific node. If it is non-empty, the scheduler simply schedules this pod onto that node, assuming that it fits resource requirements."},
+		"node_selector":                    {Optional: true, Description: "NodeSelector is a selector which must be true for the pod to fit on a node. Selector which must match a node's labels for the pod to be scheduled on that node. More info: https://kubernetes.io/docs/concepts/configuration/assign-pod-node/."},
+		"os":                               {Optional: true, MaxItems: 1, Description: "Specifies the OS of the containers in the pod."},
+		"priority_class_name":              {Optional: true, Description: "If specified, indicates the pod's priority. \"system-node-critical\" and \"system-cluster-critical\" are two special keywords which indicate the highest priorities with the former being the highest priority. Any other name must be defined by creating a PriorityClass object with that name. If not specified, the pod priority will be default or zero if there is no default."},
+		"readiness_gate":                   {Optional: true, Description: "If specified, all readiness gates will be evaluated for pod readiness. A pod is ready when all its containers are ready AND all conditions specified in the readiness gates have status equal to \"True\" More info: https://git.k8s.io/enhancements/keps/sig-network/0007-pod-ready%2B%2B.md"},
+		"restart_policy":                   {Optional: true, Description: "Restart policy for all containers within the pod. One of Always, OnFailure, Never. More info: https://kubernetes.io/docs/concepts/workloads/pods/pod-lifecycle/#restart-policy.", Default: cty.StringVal("Always")},
+		"runtime_class_name":               {Optional: true, Description: "RuntimeClassName is a feature for selecting the container runtime configuration. The container runtime configuration is used to run a Pod's containers. More info: https://kubernetes.io/docs/concepts/containers/runtime-class"},
+		"scheduler_name":                   {Optional: true, Description: "If specified, the pod will be dispatched by specified scheduler. If not specified, the pod will be dispatched by default scheduler."},
+		"security_context":                 {Optional: true, MaxItems: 1, Description: "SecurityContext holds pod-level security attributes and common container settings. Optional: Defaults to empty"},
+		"service_account_name":             {Optional: true, Description: "ServiceAccountName is the name of the ServiceAccount to use to run this pod. More info: http://releases.k8s.io/HEAD/docs/design/service_accounts.md."},
+		"share_process_namespace":          {Optional: true, Description: "Share a single process namespace between all of the containers in a pod. When this is set containers will be able to view and signal processes from other containers in the same pod, and the first process in each container will not be assigned PID 1. HostPID and ShareProcessNamespace cannot both be set. Optional: Defaults to false.", Default: cty.BoolVal(false)},
+		"subdomain":                        {Optional: true, Description: "If specified, the fully qualified Pod hostname will be \"...svc.\". If not specified, the pod will not have a domainname at all.."},
+		"termination_grace_period_seconds": {Optional: true, Description: "Optional duration in seconds the pod needs to terminate gracefully. May be decreased in delete request. Value must be non-negative integer. The value zero indicates delete immediately. If this value is nil, the default grace period will be used instead. The grace period is the duration in seconds after the processes running in the pod are sent a termination signal and the time when the processes are forcibly halted with a kill signal. Set this value longer than the expected cleanup time for your process.", Default: cty.NumberIntVal(30)},
+		"toleration":                       {Optional: true, Description: "If specified, the pod's toleration. Optional: Defaults to empty"},
+		"topology_spread_constraint":       {Optional: true, Description: "describes how a group of pods ought to spread across topology domains. Scheduler will schedule pods in a way which abides by the constraints."},
+		"volume":                           {Optional: true, Description: "List of volumes that can be mounted by containers belonging to the pod. More info: https://kubernetes.io/docs/concepts/storage/volumes"},
 	},
 }
 
-var kubernetesDaemonSetV1_spec_template_spec_volume_projected = ConverterSpec{
-	ResourceName: "kubernetes_daemon_set_v1",
+var kubernetesDaemonSetV1_spec_template_spec_container = ConverterSpec{
 	Attributes: map[string]func(any) (cty.Value, error){
-		"default_mode": toString,
-	},
-	Blocks: map[string]ConverterSpec{
-		"sources": kubernetesDaemonSetV1_spec_template_spec_volume_projected_sources,
-	},
-}
-
-var kubernetesDaemonSetV1_spec_template_spec_volume_projected_sources = ConverterSpec{
-	ResourceName: "kubernetes_daemon_set_v1",
-	Attributes:   map[string]func(any) (cty.Value, error){},
-	Blocks: map[string]ConverterSpec{
-		"config_map":            kubernetesDaemonSetV1_spec_template_spec_volume_projected_sources_configMap,
-		"downward_api":          kubernetesDaemonSetV1_spec_template_spec_volume_projected_sources_downwardApi,
-		"secret":                kubernetesDaemonSetV1_spec_template_spec_volume_projected_sources_secret,
-		"service_account_token": kubernetesDaemonSetV1_spec_template_spec_volume_projected_sources_serviceAccountToken,
+		"args":                       toList(toString),
+		"command":                    toList(toString),
+		"image":                      toString,
+		"image_pull_policy":          toString,
+		"name":                       toString,
+		"stdin":                      toBool,
+		"stdin_once":                 toBool,
+		"termination_message_path":   toString,
+		"termination_message_policy": toString,
+		"tty":                        toBool,
+		"working_dir":                toString,
 	},
-}
-
-var kubernetesDaemonSetV1_spec_template_spec_volume_projected_sources_downwardApi = ConverterSpec{
-	ResourceName: "kubernetes_daemon_set_v1",
-	Attributes:   map[string]func(any) (cty.Value, error){},
 	Blocks: map[string]ConverterSpec{
-		"items": kubernetesDaemonSetV1_spec_template_spec_volume_projected_sources_downwardApi_items,
+		"env":              kubernetesEnv_env,
+		"env_from":         kubernetesDaemonSetV1_spec_template_spec_container_envFrom,
+		"lifecycle":        kubernetesDaemonSetV1_spec_template_spec_container_lifecycle,
+		"liveness_probe":   kubernetesPodV1_spec_container_livenessProbe,
+		"port":             kubernetesDaemonSetV1_spec_template_spec_container_port,
+		"readiness_probe":  kubernetesPodV1_spec_container_livenessProbe,
+		"resources":        kubernetesDaemonSetV1_spec_template_spec_container_resources,
+		"security_context": kubernetesDaemonSetV1_spec_template_spec_container_securityContext,
+		"startup_probe":    kubernetesPodV1_spec_container_livenessProbe,
+		"volume_device":    kubernetesPodV1_spec_container_volumeDevice,
+		"volume_mount":     kubernetesPodV1_spec_container_volumeMount,
+	},
+	Schema: map[string]FieldSchema{
+		"args":                       {Optional: true, Description: "Arguments to the entrypoint. The docker image's CMD is used if this is not provided. Variable references $(VAR_NAME) are expanded using the container's environment. If a variable cannot be resolved, the reference in the input string will be unchanged. The $(VAR_NAME) syntax can be escaped with a double $$, ie: $$(VAR_NAME). Escaped references will never be expanded, regardless of whether the variable exists or not. Cannot be updated. More info: https://kubernetes.io/docs/tasks/inject-data-application/define-command-argument-container/#running-a-command-in-a-shell"},
+		"command":                    {Optional: true, Description: "Entrypoint array. Not executed within a shell. The docker image's ENTRYPOINT is used if this is not provided. Variable references $(VAR_NAME) are expanded using the container's environment. If a variable cannot be resolved, the reference in the input string will be unchanged. The $(VAR_NAME) syntax can be escaped with a double $$, ie: $$(VAR_NAME). Escaped references will never be expanded, regardless of whether the variable exists or not. Cannot be updated. More info: https://kubernetes.io/docs/tasks/inject-data-application/define-command-argument-container/#running-a-command-in-a-shell"},
+		"env":                        {Optional: true, Description: "List of environment variables to set in the container. Cannot be updated."},
+		"env_from":                   {Optional: true, Description: "List of sources to populate environment variables in the container. The keys defined within a source must be a C_IDENTIFIER. All invalid keys will be reported as an event when the container is starting. When a key exists in multiple sources, the value associated with the last source will take precedence. Values defined by an Env with a duplicate key will take precedence. Cannot be updated."},
+		"image":                      {Optional: true, Description: "Docker image name. More info: https://kubernetes.io/docs/concepts/containers/images/"},
+		"image_pull_policy":          {Optional: true, Description: "Image pull policy. One of Always, Never, IfNotPresent. Defaults to Always if :latest tag is specified, or IfNotPresent otherwise. Cannot be updated. More info: https://kubernetes.io/docs/concepts/containers/images/#updating-images"},
+		"lifecycle":                  {Optional: true, MaxItems: 1, Description: "Actions that the management system should take in response to container lifecycle events"},
+		"liveness_probe":             {Optional: true, MaxItems: 1, Description: "Periodic probe of container liveness. Container will be restarted if the probe fails. Cannot be updated. More info: https://kubernetes.io/docs/concepts/workloads/pods/pod-lifecycle/#container-probes"},
+		"name":                       {Required: true, Description: "Name of the container specified as a DNS_LABEL. Each container in a pod must have a unique name (DNS_LABEL). Cannot be updated."},
+		"port":                       {Optional: true, Description: "List of ports to expose from the container. Exposing a port here gives the system additional information about the network connections a container uses, but is primarily informational. Not specifying a port here DOES NOT prevent that port from being exposed. Any port which is listening on the default \"0.0.0.0\" address inside a container will be accessible from the network. Cannot be updated."},
+		"readiness_probe":            {Optional: true, MaxItems: 1, Description: "Periodic probe of container service readiness. Container will be removed from service endpoints if the probe fails. Cannot be updated. More info: https://kubernetes.io/docs/concepts/workloads/pods/pod-lifecycle/#container-probes"},
+		"resources":                  {Optional: true, MaxItems: 1, Description: "Compute Resources required by this container. Cannot be updated. More info: https://kubernetes.io/docs/concepts/storage/persistent-volumes#resources"},
+		"security_context":           {Optional: true, MaxItems: 1, Description: "Security options the pod should run with. More info: https://kubernetes.io/docs/tasks/configure-pod-container/security-context/"},
+		"startup_probe":              {Optional: true, MaxItems: 1, Description: "StartupProbe indicates that the Pod has successfully initialized. If specified, no other probes are executed until this completes successfully. If this probe fails, the Pod will be restarted, just as if the livenessProbe failed. This can be used to provide different probe parameters at the beginning of a Pod's lifecycle, when it might take a long time to load data or warm a cache, than during steady-state operation. This cannot be updated. This is an alpha feature enabled by the StartupProbe feature flag. More info: https://kubernetes.io/docs/concepts/workloads/pods/pod-lifecycle#container-probes"},
+		"stdin":                      {Optional: true, Description: "Whether this container should allocate a buffer for stdin in the container runtime. If this is not set, reads from stdin in the container will always result in EOF. ", Default: cty.BoolVal(false)},
+		"stdin_once":                 {Optional: true, Description: "Whether the container runtime should close the stdin channel after it has been opened by a single attach. When stdin is true the stdin stream will remain open across multiple attach sessions. If stdinOnce is set to true, stdin is opened on container start, is empty until the first client attaches to stdin, and then remains open and accepts data until the client disconnects, at which time stdin is closed and remains closed until the container is restarted. If this flag is false, a container processes that reads from stdin will never receive an EOF.", Default: cty.BoolVal(false)},
+		"termination_message_path":   {Optional: true, Description: "Optional: Path at which the file to which the container's termination message will be written is mounted into the container's filesystem. Message written is intended to be brief final status, such as an assertion failure message. Defaults to /dev/termination-log. Cannot be updated.", Default: cty.StringVal("/dev/termination-log")},
+		"termination_message_policy": {Optional: true, Description: "Optional: Indicate how the termination message should be populated. File will use the contents of terminationMessagePath to populate the container status message on both success and failure. FallbackToLogsOnError will use the last chunk of container log output if the termination message file is empty and the container exited with an error. The log output is limited to 2048 bytes or 80 lines, whichever is smaller. Defaults to File. Cannot be updated."},
+		"tty":                        {Optional: true, Description: "Whether this container should allocate a TTY for itself", Default: cty.BoolVal(false)},
+		"volume_device":              {Optional: true, Description: "Raw volume devices to attach into the container's filesystem as raw block devices. Cannot be updated."},
+		"volume_mount":               {Optional: true, Description: "Pod volumes to mount into the container's filesystem. Cannot be updated."},
+		"working_dir":                {Optional: true, Description: "Container's working directory. If not specified, the container runtime's default will be used, which might be configured in the container image. Cannot be updated."},
 	},
 }
 
-var kubernetesDaemonSetV1_spec_template_spec_volume_projected_sources_downwardApi_items = ConverterSpec{
-	ResourceName: "kubernetes_daemon_set_v1",
+var kubernetesDaemonSetV1_spec_template_spec_container_envFrom = ConverterSpec{
 	Attributes: map[string]func(any) (cty.Value, error){
-		"mode": toString,
-		"path": toString,
+		"prefix": toString,
 	},
 	Blocks: map[string]ConverterSpec{
-		"field_ref":          kubernetesDaemonSetV1_spec_template_spec_volume_projected_sources_downwardApi_items_fieldRef,
-		"resource_field_ref": kubernetesDaemonSetV1_spec_template_spec_volume_projected_sources_downwardApi_items_resourceFieldRef,
-	},
-}
-
-var kubernetesDaemonSetV1_spec_template_spec_volume_projected_sources_downwardApi_items_resourceFieldRef = ConverterSpec{
-	ResourceName: "kubernetes_daemon_set_v1",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"container_name": toString,
-		"divisor":        toString,
-		"resource":       toString,
+		"config_map_ref": kubernetesDaemonSetV1_spec_template_spec_container_envFrom_configMapRef,
+		"secret_ref":     kubernetesDaemonSetV1_spec_template_spec_container_envFrom_secretRef,
 	},
-	Blocks: map[string]ConverterSpec{},
-}
-
-var kubernetesDaemonSetV1_spec_template_spec_volume_projected_sources_downwardApi_items_fieldRef = ConverterSpec{
-	ResourceName: "kubernetes_daemon_set_v1",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"api_version": toString,
-		"field_path":  toString,
+	Schema: map[string]FieldSchema{
+		"config_map_ref": {Optional: true, MaxItems: 1, Description: "The ConfigMap to select from"},
+		"prefix":         {Optional: true, Description: "An optional identifer to prepend to each key in the ConfigMap. Must be a C_IDENTIFIER."},
+		"secret_ref":     {Optional: true, MaxItems: 1, Description: "The Secret to select from"},
 	},
-	Blocks: map[string]ConverterSpec{},
 }
 
-var kubernetesDaemonSetV1_spec_template_spec_volume_projected_sources_configMap = ConverterSpec{
-	ResourceName: "kubernetes_daemon_set_v1",
+var kubernetesDaemonSetV1_spec_template_spec_container_envFrom_configMapRef = ConverterSpec{
 	Attributes: map[string]func(any) (cty.Value, error){
 		"name":     toString,
 		"optional": toBool,
 	},
-	Blocks: map[string]ConverterSpec{
-		"items": kubernetesDaemonSetV1_spec_template_spec_volume_projected_sources_configMap_items,
-	},
-}
-
-var kubernetesDaemonSetV1_spec_template_spec_volume_projected_sources_configMap_items = ConverterSpec{
-	ResourceName: "kubernetes_daemon_set_v1",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"key":  toString,
-		"mode": toString,
-		"path": toString,
+	Schema: map[string]FieldSchema{
+		"name":     {Required: true, Description: "Name of the referent. More info: https://kubernetes.io/docs/concepts/overview/working-with-objects/names/#names"},
+		"optional": {Optional: true, Description: "Specify whether the ConfigMap must be defined"},
 	},
-	Blocks: map[string]ConverterSpec{},
 }
 
-var kubernetesDaemonSetV1_spec_template_spec_volume_projected_sources_secret = ConverterSpec{
-	ResourceName: "kubernetes_daemon_set_v1",
+var kubernetesDaemonSetV1_spec_template_spec_container_envFrom_secretRef = ConverterSpec{
 	Attributes: map[string]func(any) (cty.Value, error){
 		"name":     toString,
 		"optional": toBool,
 	},
-	Blocks: map[string]ConverterSpec{
-		"items": kubernetesDaemonSetV1_spec_template_spec_volume_projected_sources_secret_items,
-	},
-}
-
-var kubernetesDaemonSetV1_spec_template_spec_volume_projected_sources_secret_items = ConverterSpec{
-	ResourceName: "kubernetes_daemon_set_v1",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"key":  toString,
-		"mode": toString,
-		"path": toString,
-	},
-	Blocks: map[string]ConverterSpec{},
-}
-
-var kubernetesDaemonSetV1_spec_template_spec_volume_projected_sources_serviceAccountToken = ConverterSpec{
-	ResourceName: "kubernetes_daemon_set_v1",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"audience":           toString,
-		"expiration_seconds": toInt,
-		"path":               toString,
+	Schema: map[string]FieldSchema{
+		"name":     {Required: true, Description: "Name of the referent. More info: https://kubernetes.io/docs/concepts/overview/working-with-objects/names/#names"},
+		"optional": {Optional: true, Description: "Specify whether the Secret must be defined"},
 	},
-	Blocks: map[string]ConverterSpec{},
 }
 
-var kubernetesDaemonSetV1_spec_template_spec_volume_flexVolume = ConverterSpec{
-	ResourceName: "kubernetes_daemon_set_v1",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"driver":    toString,
-		"fs_type":   toString,
-		"options":   toStringMap,
-		"read_only": toBool,
-	},
+var kubernetesDaemonSetV1_spec_template_spec_container_lifecycle = ConverterSpec{
+	Attributes: map[string]func(any) (cty.Value, error){},
 	Blocks: map[string]ConverterSpec{
-		"secret_ref": kubernetesDaemonSetV1_spec_template_spec_volume_flexVolume_secretRef,
+		"post_start": kubernetesPodV1_spec_container_lifecycle_postStart,
+		"pre_stop":   kubernetesPodV1_spec_container_lifecycle_postStart,
 	},
-}
-
-var kubernetesDaemonSetV1_spec_template_spec_volume_flexVolume_secretRef = ConverterSpec{
-	ResourceName: "kubernetes_daemon_set_v1",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"name":      toString,
-		"namespace": toString,
+	Schema: map[string]FieldSchema{
+		"post_start": {Optional: true, Description: "post_start is called immediately after a container is created. If the handler fails, the container is terminated and restarted according to its restart policy. Other management of the container blocks until the hook completes. More info: https://kubernetes.io/docs/concepts/containers/container-lifecycle-hooks/#container-hooks"},
+		"pre_stop":   {Optional: true, Description: "pre_stop is called immediately before a container is terminated. The container is terminated after the handler completes. The reason for termination is passed to the handler. Regardless of the outcome of the handler, the container is eventually terminated. Other management of the container blocks until the hook completes. More info: https://kubernetes.io/docs/concepts/containers/container-lifecycle-hooks/#container-hooks"},
 	},
-	Blocks: map[string]ConverterSpec{},
 }
 
-var kubernetesDaemonSetV1_spec_template_spec_volume_cinder = ConverterSpec{
-	ResourceName: "kubernetes_daemon_set_v1",
+var kubernetesDaemonSetV1_spec_template_spec_container_port = ConverterSpec{
 	Attributes: map[string]func(any) (cty.Value, error){
-		"fs_type":   toString,
-		"read_only": toBool,
-		"volume_id": toString,
+		"container_port": toInt,
+		"host_ip":        toString,
+		"host_port":      toInt,
+		"name":           toString,
+		"protocol":       toString,
 	},
-	Blocks: map[string]ConverterSpec{},
-}
-
-var kubernetesDaemonSetV1_spec_template_spec_volume_azureDisk = ConverterSpec{
-	ResourceName: "kubernetes_daemon_set_v1",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"caching_mode":  toString,
-		"data_disk_uri": toString,
-		"disk_name":     toString,
-		"fs_type":       toString,
-		"kind":          toString,
-		"read_only":     toBool,
-	},
-	Blocks: map[string]ConverterSpec{},
-}
-
-var kubernetesDaemonSetV1_spec_template_spec_volume_awsElasticBlockStore = ConverterSpec{
-	ResourceName: "kubernetes_daemon_set_v1",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"fs_type":   toString,
-		"partition": toInt,
-		"read_only": toBool,
-		"volume_id": toString,
+	Schema: map[string]FieldSchema{
+		"container_port": {Required: true, Description: "Number of port to expose on the pod's IP address. This must be a valid port number, 0 < x < 65536."},
+		"host_ip":        {Optional: true, Description: "What host IP to bind the external port to."},
+		"host_port":      {Optional: true, Description: "Number of port to expose on the host. If specified, this must be a valid port number, 0 < x < 65536. If HostNetwork is specified, this must match ContainerPort. Most containers do not need this."},
+		"name":           {Optional: true, Description: "If specified, this must be an IANA_SVC_NAME and unique within the pod. Each named port in a pod must have a unique name. Name for the port that can be referred to by services"},
+		"protocol":       {Optional: true, Description: "Protocol for port. Must be UDP or TCP. Defaults to \"TCP\".", Default: cty.StringVal("TCP")},
 	},
-	Blocks: map[string]ConverterSpec{},
 }
 
-var kubernetesDaemonSetV1_spec_template_spec_volume_local = ConverterSpec{
-	ResourceName: "kubernetes_daemon_set_v1",
+var kubernetesDaemonSetV1_spec_template_spec_container_resources = ConverterSpec{
 	Attributes: map[string]func(any) (cty.Value, error){
-		"path": toString,
+		"limits":   toQuantityMap,
+		"requests": toQuantityMap,
 	},
-	Blocks: map[string]ConverterSpec{},
-}
-
-var kubernetesDaemonSetV1_spec_template_spec_volume_csi = ConverterSpec{
-	ResourceName: "kubernetes_daemon_set_v1",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"driver":            toString,
-		"fs_type":           toString,
-		"read_only":         toBool,
-		"volume_attributes": toStringMap,
+	Quantities: map[string]bool{
+		"limits":   true,
+		"requests": true,
 	},
-	Blocks: map[string]ConverterSpec{
-		"node_publish_secret_ref": kubernetesDaemonSetV1_spec_template_spec_volume_csi_nodePublishSecretRef,
+	Schema: map[string]FieldSchema{
+		"limits":   {Optional: true, Description: "Describes the maximum amount of compute resources allowed. More info: https://kubernetes.io/docs/concepts/configuration/manage-resources-containers/"},
+		"requests": {Optional: true, Description: "Requests describes the minimum amount of compute resources required. If Requests is omitted for a container, it defaults to Limits if that is explicitly specified, otherwise to an implementation-defined value. More info: https://kubernetes.io/docs/concepts/configuration/manage-compute-resources-container/"},
 	},
 }
 
-var kubernetesDaemonSetV1_spec_template_spec_volume_csi_nodePublishSecretRef = ConverterSpec{
-	ResourceName: "kubernetes_daemon_set_v1",
+var kubernetesDaemonSetV1_spec_template_spec_container_securityContext = ConverterSpec{
 	Attributes: map[string]func(any) (cty.Value, error){
-		"name": toString,
+		"allow_privilege_escalation": toBool,
+		"privileged":                 toBool,
+		"read_only_root_filesystem":  toBool,
+		"run_as_group":               toString,
+		"run_as_non_root":            toBool,
+		"run_as_user":                toString,
 	},
-	Blocks: map[string]ConverterSpec{},
-}
-
-var kubernetesDaemonSetV1_spec_template_spec_volume_gitRepo = ConverterSpec{
-	ResourceName: "kubernetes_daemon_set_v1",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"directory":  toString,
-		"repository": toString,
-		"revision":   toString,
+	Blocks: map[string]ConverterSpec{
+		"capabilities":     kubernetesDaemonSetV1_spec_template_spec_container_securityContext_capabilities,
+		"se_linux_options": kubernetesDaemonSetV1_spec_template_spec_securityContext_seLinuxOptions,
+		"seccomp_profile":  kubernetesDaemonSetV1_spec_template_spec_securityContext_seccompProfile,
 	},
-	Blocks: map[string]ConverterSpec{},
-}
-
-var kubernetesDaemonSetV1_spec_template_spec_volume_quobyte = ConverterSpec{
-	ResourceName: "kubernetes_daemon_set_v1",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"group":     toString,
-		"read_only": toBool,
-		"registry":  toString,
-		"user":      toString,
-		"volume":    toString,
+	Schema: map[string]FieldSchema{
+		"allow_privilege_escalation": {Optional: true, Description: "AllowPrivilegeEscalation controls whether a process can gain more privileges than its parent process. This bool directly controls if the no_new_privs flag will be set on the container process. AllowPrivilegeEscalation is true always when the container is: 1) run as Privileged 2) has CAP_SYS_ADMIN", Default: cty.BoolVal(true)},
+		"capabilities":               {Optional: true, MaxItems: 1, Description: "The capabilities to add/drop when running containers. Defaults to the default set of capabilities granted by the container runtime."},
+		"privileged":                 {Optional: true, Description: "Run container in privileged mode. Processes in privileged containers are essentially equivalent to root on the host. Defaults to false.", Default: cty.BoolVal(false)},
+		"read_only_root_filesystem":  {Optional: true, Description: "Whether this container has a read-only root filesystem. Default is false.", Default: cty.BoolVal(false)},
+		"run_as_group":               {Optional: true, Description: "The GID to run the entrypoint of the container process. Uses runtime default if unset. May also be set in PodSecurityContext. If set in both SecurityContext and PodSecurityContext, the value specified in SecurityContext takes precedence."},
+		"run_as_non_root":            {Optional: true, Description: "Indicates that the container must run as a non-root user. If true, the Kubelet will validate the image at runtime to ensure that it does not run as UID 0 (root) and fail to start the container if it does. If unset or false, no such validation will be performed. May also be set in PodSecurityContext. If set in both SecurityContext and PodSecurityContext, the value specified in SecurityContext takes precedence."},
+		"run_as_user":                {Optional: true, Description: "The UID to run the entrypoint of the container process. Defaults to user specified in image metadata if unspecified. May also be set in PodSecurityContext. If set in both SecurityContext and PodSecurityContext, the value specified in SecurityContext takes precedence."},
+		"se_linux_options":           {Optional: true, MaxItems: 1, Description: "The SELinux context to be applied to the container. If unspecified, the container runtime will allocate a random SELinux context for each container. May also be set in PodSecurityContext. If set in both SecurityContext and PodSecurityContext, the value specified in SecurityContext takes precedence."},
+		"seccomp_profile":            {Optional: true, MaxItems: 1, Description: "The seccomp options to use by the containers in this pod. Note that this field cannot be set when spec.os.name is windows."},
 	},
-	Blocks: map[string]ConverterSpec{},
 }
 
-var kubernetesDaemonSetV1_spec_template_spec_volume_photonPersistentDisk = ConverterSpec{
-	ResourceName: "kubernetes_daemon_set_v1",
+var kubernetesDaemonSetV1_spec_template_spec_container_securityContext_capabilities = ConverterSpec{
 	Attributes: map[string]func(any) (cty.Value, error){
-		"fs_type": toString,
-		"pd_id":   toString,
+		"add":  toList(toString),
+		"drop": toList(toString),
 	},
-	Blocks: map[string]ConverterSpec{},
-}
-
-var kubernetesDaemonSetV1_spec_template_spec_volume_flocker = ConverterSpec{
-	ResourceName: "kubernetes_daemon_set_v1",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"dataset_name": toString,
-		"dataset_uuid": toString,
+	Schema: map[string]FieldSchema{
+		"add":  {Optional: true, Description: "Added capabilities"},
+		"drop": {Optional: true, Description: "Removed capabilities"},
 	},
-	Blocks: map[string]ConverterSpec{},
 }
 
-var kubernetesDaemonSetV1_spec_template_spec_volume_cephFs = ConverterSpec{
-	ResourceName: "kubernetes_daemon_set_v1",
+var kubernetesDaemonSetV1_spec_template_spec_dnsConfig = ConverterSpec{
 	Attributes: map[string]func(any) (cty.Value, error){
-		"monitors": func(a any) (cty.Value, error) {
-			l, ok := a.([]any)
-			if !ok {
-				return cty.Value{}, fmt.Errorf("expected slice, got: %T", a)
-			}
-			vl := make([]cty.Value, 0, len(l))
-			for _, val := range l {
-				v, err := toString(val)
-				if err != nil {
-					return cty.Value{}, err
-				}
-				vl = append(vl, v)
-			}
-			return cty.ListVal(vl), nil
-		},
-		"path":        toString,
-		"read_only":   toBool,
-		"secret_file": toString,
-		"user":        toString,
+		"nameservers": toList(toString),
+		"searches":    toList(toString),
 	},
 	Blocks: map[string]ConverterSpec{
-		"secret_ref": kubernetesDaemonSetV1_spec_template_spec_volume_cephFs_secretRef,
+		"option": kubernetesDaemonSetV1_spec_template_spec_dnsConfig_option,
 	},
-}
-
-var kubernetesDaemonSetV1_spec_template_spec_volume_cephFs_secretRef = ConverterSpec{
-	ResourceName: "kubernetes_daemon_set_v1",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"name":      toString,
-		"namespace": toString,
+	Schema: map[string]FieldSchema{
+		"nameservers": {Optional: true, Description: "A list of DNS name server IP addresses. This will be appended to the base nameservers generated from DNSPolicy. Duplicated nameservers will be removed."},
+		"option":      {Optional: true, Description: "A list of DNS resolver options. This will be merged with the base options generated from DNSPolicy. Duplicated entries will be removed. Resolution options given in Options will override those that appear in the base DNSPolicy."},
+		"searches":    {Optional: true, Description: "A list of DNS search domains for host-name lookup. This will be appended to the base search paths generated from DNSPolicy. Duplicated search paths will be removed."},
 	},
-	Blocks: map[string]ConverterSpec{},
 }
 
-var kubernetesDaemonSetV1_spec_template_spec_volume_secret = ConverterSpec{
-	ResourceName: "kubernetes_daemon_set_v1",
+var kubernetesDaemonSetV1_spec_template_spec_dnsConfig_option = ConverterSpec{
 	Attributes: map[string]func(any) (cty.Value, error){
-		"default_mode": toString,
-		"optional":     toBool,
-		"secret_name":  toString,
+		"name":  toString,
+		"value": toString,
 	},
-	Blocks: map[string]ConverterSpec{
-		"items": kubernetesDaemonSetV1_spec_template_spec_volume_secret_items,
+	Schema: map[string]FieldSchema{
+		"name":  {Required: true, Description: "Name of the option."},
+		"value": {Optional: true, Description: "Value of the option. Optional: Defaults to empty."},
 	},
 }
 
-var kubernetesDaemonSetV1_spec_template_spec_volume_secret_items = ConverterSpec{
-	ResourceName: "kubernetes_daemon_set_v1",
+var kubernetesDaemonSetV1_spec_template_spec_hostAliases = ConverterSpec{
 	Attributes: map[string]func(any) (cty.Value, error){
-		"key":  toString,
-		"mode": toString,
-		"path": toString,
+		"hostnames": toList(toString),
+		"ip":        toString,
 	},
-	Blocks: map[string]ConverterSpec{},
-}
-
-var kubernetesDaemonSetV1_spec_template_spec_volume_persistentVolumeClaim = ConverterSpec{
-	ResourceName: "kubernetes_daemon_set_v1",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"claim_name": toString,
-		"read_only":  toBool,
+	Schema: map[string]FieldSchema{
+		"hostnames": {Required: true, Description: "Hostnames for the IP address."},
+		"ip":        {Required: true, Description: "IP address of the host file entry."},
 	},
-	Blocks: map[string]ConverterSpec{},
 }
 
-var kubernetesDaemonSetV1_spec_template_spec_volume_emptyDir = ConverterSpec{
-	ResourceName: "kubernetes_daemon_set_v1",
+var kubernetesDaemonSetV1_spec_template_spec_imagePullSecrets = ConverterSpec{
 	Attributes: map[string]func(any) (cty.Value, error){
-		"medium":     toString,
-		"size_limit": toString,
+		"name": toString,
 	},
-	Blocks: map[string]ConverterSpec{},
-}
-
-var kubernetesDaemonSetV1_spec_template_spec_volume_glusterfs = ConverterSpec{
-	ResourceName: "kubernetes_daemon_set_v1",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"endpoints_name": toString,
-		"path":           toString,
-		"read_only":      toBool,
+	Schema: map[string]FieldSchema{
+		"name": {Required: true, Description: "Name of the referent. More info: https://kubernetes.io/docs/concepts/overview/working-with-objects/names/#names"},
 	},
-	Blocks: map[string]ConverterSpec{},
-}
-
-var kubernetesDaemonSetV1_spec_template_spec_volume_fc = ConverterSpec{
-	ResourceName: "kubernetes_daemon_set_v1",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"fs_type":   toString,
-		"lun":       toInt,
-		"read_only": toBool,
-		"target_ww_ns": func(a any) (cty.Value, error) {
-			l, ok := a.([]any)
-			if !ok {
-				return cty.Value{}, fmt.Errorf("expected slice, got: %T", a)
-			}
-			vl := make([]cty.Value, 0, len(l))
-			for _, val := range l {
-				v, err := toString(val)
-				if err != nil {
-					return cty.Value{}, err
-				}
-				vl = append(vl, v)
-			}
-			return cty.ListVal(vl), nil
-		},
-	},
-	Blocks: map[string]ConverterSpec{},
 }
 
-var kubernetesDaemonSetV1_spec_template_spec_volume_azureFile = ConverterSpec{
-	ResourceName: "kubernetes_daemon_set_v1",
+var kubernetesDaemonSetV1_spec_template_spec_readinessGate = ConverterSpec{
 	Attributes: map[string]func(any) (cty.Value, error){
-		"read_only":        toBool,
-		"secret_name":      toString,
-		"secret_namespace": toString,
-		"share_name":       toString,
+		"condition_type": toString,
+	},
+	Schema: map[string]FieldSchema{
+		"condition_type": {Required: true, Description: "refers to a condition in the pod's condition list with matching type."},
 	},
-	Blocks: map[string]ConverterSpec{},
 }
 
-var kubernetesDaemonSetV1_spec_template_spec_volume_hostPath = ConverterSpec{
-	ResourceName: "kubernetes_daemon_set_v1",
+var kubernetesDaemonSetV1_spec_template_spec_securityContext = ConverterSpec{
 	Attributes: map[string]func(any) (cty.Value, error){
-		"path": toString,
-		"type": toString,
+		"fs_group":               toString,
+		"fs_group_change_policy": toString,
+		"run_as_group":           toString,
+		"run_as_non_root":        toBool,
+		"run_as_user":            toString,
+		"supplemental_groups":    toList(toInt),
 	},
-	Blocks: map[string]ConverterSpec{},
-}
-
-var kubernetesDaemonSetV1_spec_template_spec_volume_ephemeral = ConverterSpec{
-	ResourceName: "kubernetes_daemon_set_v1",
-	Attributes:   map[string]func(any) (cty.Value, error){},
 	Blocks: map[string]ConverterSpec{
-		"volume_claim_template": kubernetesDaemonSetV1_spec_template_spec_volume_ephemeral_volumeClaimTemplate,
+		"se_linux_options": kubernetesDaemonSetV1_spec_template_spec_securityContext_seLinuxOptions,
+		"seccomp_profile":  kubernetesDaemonSetV1_spec_template_spec_securityContext_seccompProfile,
+		"sysctl":           kubernetesDaemonSetV1_spec_template_spec_securityContext_sysctl,
+		"windows_options":  kubernetesPodV1_spec_securityContext_windowsOptions,
 	},
-}
-
-var kubernetesDaemonSetV1_spec_template_spec_volume_ephemeral_volumeClaimTemplate = ConverterSpec{
-	ResourceName: "kubernetes_daemon_set_v1",
-	Attributes:   map[string]func(any) (cty.Value, error){},
-	Blocks: map[string]ConverterSpec{
-		"metadata": kubernetesDaemonSetV1_spec_template_spec_volume_ephemeral_volumeClaimTemplate_metadata,
-		"spec":     kubernetesDaemonSetV1_spec_template_spec_volume_ephemeral_volumeClaimTemplate_spec,
+	Schema: map[string]FieldSchema{
+		"fs_group":               {Optional: true, Description: "A special supplemental group that applies to all containers in a pod. Some volume types allow the Kubelet to change the ownership of that volume to be owned by the pod: 1. The owning GID will be the FSGroup 2. The setgid bit is set (new files created in the volume will be owned by FSGroup) 3. The permission bits are OR'd with rw-rw---- If unset, the Kubelet will not modify the ownership and permissions of any volume."},
+		"fs_group_change_policy": {Optional: true, Description: "fsGroupChangePolicy defines behavior of changing ownership and permission of the volume before being exposed inside Pod. This field will only apply to volume types which support fsGroup based ownership(and permissions). It will have no effect on ephemeral volume types such as: secret, configmaps and emptydir."},
+		"run_as_group":           {Optional: true, Description: "The GID to run the entrypoint of the container process. Uses runtime default if unset. May also be set in SecurityContext. If set in both SecurityContext and PodSecurityContext, the value specified in SecurityContext takes precedence for that container."},
+		"run_as_non_root":        {Optional: true, Description: "Indicates that the container must run as a non-root user. If true, the Kubelet will validate the image at runtime to ensure that it does not run as UID 0 (root) and fail to start the container if it does. If unset or false, no such validation will be performed. May also be set in SecurityContext. If set in both SecurityContext and PodSecurityContext, the value specified in SecurityContext takes precedence."},
+		"run_as_user":            {Optional: true, Description: "The UID to run the entrypoint of the container process. Defaults to user specified in image metadata if unspecified. May also be set in SecurityContext. If set in both SecurityContext and PodSecurityContext, the value specified in SecurityContext takes precedence for that container."},
+		"se_linux_options":       {Optional: true, MaxItems: 1, Description: "The SELinux context to be applied to all containers. If unspecified, the container runtime will allocate a random SELinux context for each container. May also be set in SecurityContext. If set in both SecurityContext and PodSecurityContext, the value specified in SecurityContext takes precedence for that container."},
+		"seccomp_profile":        {Optional: true, MaxItems: 1, Description: "The seccomp options to use by the containers in this pod. Note that this field cannot be set when spec.os.name is windows."},
+		"supplemental_groups":    {Optional: true, Description: "A list of groups applied to the first process run in each container, in addition to the container's primary GID. If unspecified, no groups will be added to any container."},
+		"sysctl":                 {Optional: true, Description: "holds a list of namespaced sysctls used for the pod."},
+		"windows_options":        {Optional: true, MaxItems: 1, Description: "The Windows specific settings applied to all containers. If unspecified, the options within a container's SecurityContext will be used. If set in both SecurityContext and PodSecurityContext, the value specified in SecurityContext takes precedence. Note that this field cannot be set when spec.os.name is linux."},
 	},
 }
 
-var kubernetesDaemonSetV1_spec_template_spec_volume_ephemeral_volumeClaimTemplate_spec = ConverterSpec{
-	ResourceName: "kubernetes_daemon_set_v1",
+var kubernetesDaemonSetV1_spec_template_spec_securityContext_seLinuxOptions = ConverterSpec{
 	Attributes: map[string]func(any) (cty.Value, error){
-		"access_modes": func(a any) (cty.Value, error) {
-			l, ok := a.([]any)
-			if !ok {
-				return cty.Value{}, fmt.Errorf("expected slice, got: %T", a)
-			}
-			vl := make([]cty.Value, 0, len(l))
-			for _, val := range l {
-				v, err := toString(val)
-				if err != nil {
-					return cty.Value{}, err
-				}
-				vl = append(vl, v)
-			}
-			return cty.ListVal(vl), nil
-		},
-		"storage_class_name": toString,
-		"volume_mode":        toString,
-		"volume_name":        toString,
+		"level": toString,
+		"role":  toString,
+		"type":  toString,
+		"user":  toString,
 	},
-	Blocks: map[string]ConverterSpec{
-		"resources": kubernetesDaemonSetV1_spec_template_spec_volume_ephemeral_volumeClaimTemplate_spec_resources,
-		"selector":  kubernetesDaemonSetV1_spec_template_spec_volume_ephemeral_volumeClaimTemplate_spec_selector,
+	Schema: map[string]FieldSchema{
+		"level": {Optional: true, Description: "Level is SELinux level label that applies to the container."},
+		"role":  {Optional: true, Description: "Role is a SELinux role label that applies to the container."},
+		"type":  {Optional: true, Description: "Type is a SELinux type label that applies to the container."},
+		"user":  {Optional: true, Description: "User is a SELinux user label that applies to the container."},
 	},
 }
 
-var kubernetesDaemonSetV1_spec_template_spec_volume_ephemeral_volumeClaimTemplate_spec_selector = ConverterSpec{
-	ResourceName: "kubernetes_daemon_set_v1",
+var kubernetesDaemonSetV1_spec_template_spec_securityContext_seccompProfile = ConverterSpec{
 	Attributes: map[string]func(any) (cty.Value, error){
-		"match_labels": toStringMap,
+		"localhost_profile": toString,
+		"type":              toString,
 	},
-	Blocks: map[string]ConverterSpec{
-		"match_expressions": kubernetesDaemonSetV1_spec_template_spec_volume_ephemeral_volumeClaimTemplate_spec_selector_matchExpressions,
+	Schema: map[string]FieldSchema{
+		"localhost_profile": {Optional: true, Description: "Localhost Profile indicates a profile defined in a file on the node should be used. The profile must be preconfigured on the node to work.", Default: cty.StringVal("")},
+		"type":              {Optional: true, Description: "Type indicates which kind of seccomp profile will be applied. Valid options are: Localhost, RuntimeDefault, Unconfined.", Default: cty.StringVal("Unconfined")},
 	},
 }
 
-var kubernetesDaemonSetV1_spec_template_spec_volume_ephemeral_volumeClaimTemplate_spec_selector_matchExpressions = ConverterSpec{
-	ResourceName: "kubernetes_daemon_set_v1",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"key":      toString,
-		"operator": toString,
-		"values": func(a any) (cty.Value, error) {
-			l, ok := a.([]any)
-			if !ok {
-				return cty.Value{}, fmt.Errorf("expected slice, got: %T", a)
-			}
-			vl := make([]cty.Value, 0, len(l))
-			for _, val := range l {
-				v, err := toString(val)
-				if err != nil {
-					return cty.Value{}, err
-				}
-				vl = append(vl, v)
-			}
-			return cty.ListVal(vl), nil
-		},
-	},
-	Blocks: map[string]ConverterSpec{},
-}
-
-var kubernetesDaemonSetV1_spec_template_spec_volume_ephemeral_volumeClaimTemplate_spec_resources = ConverterSpec{
-	ResourceName: "kubernetes_daemon_set_v1",
+var kubernetesDaemonSetV1_spec_template_spec_securityContext_sysctl = ConverterSpec{
 	Attributes: map[string]func(any) (cty.Value, error){
-		"limits":   toStringMap,
-		"requests": toStringMap,
+		"name":  toString,
+		"value": toString,
+	},
+	Schema: map[string]FieldSchema{
+		"name":  {Required: true, Description: "Name of a property to set."},
+		"value": {Required: true, Description: "Value of a property to set."},
 	},
-	Blocks: map[string]ConverterSpec{},
 }
 
-var kubernetesDaemonSetV1_spec_template_spec_volume_ephemeral_volumeClaimTemplate_metadata = ConverterSpec{
-	ResourceName: "kubernetes_daemon_set_v1",
+var kubernetesDaemonSetV1_spec_template_spec_toleration = ConverterSpec{
 	Attributes: map[string]func(any) (cty.Value, error){
-		"annotations": toStringMap,
-		"labels":      toStringMap,
+		"effect":             toString,
+		"key":                toString,
+		"operator":           toString,
+		"toleration_seconds": toString,
+		"value":              toString,
+	},
+	Schema: map[string]FieldSchema{
+		"effect":             {Optional: true, Description: "Effect indicates the taint effect to match. Empty means match all taint effects. When specified, allowed values are NoSchedule, PreferNoSchedule and NoExecute."},
+		"key":                {Optional: true, Description: "Key is the taint key that the toleration applies to. Empty means match all taint keys. If the key is empty, operator must be Exists; this combination means to match all values and all keys."},
+		"operator":           {Optional: true, Description: "Operator represents a key's relationship to the value. Valid operators are Exists and Equal. Defaults to Equal. Exists is equivalent to wildcard for value, so that a pod can tolerate all taints of a particular category.", Default: cty.StringVal("Equal")},
+		"toleration_seconds": {Optional: true, Description: "TolerationSeconds represents the period of time the toleration (which must be of effect NoExecute, otherwise this field is ignored) tolerates the taint. By default, it is not set, which means tolerate the taint forever (do not evict). Zero and negative values will be treated as 0 (evict immediately) by the system."},
+		"value":              {Optional: true, Description: "Value is the taint value the toleration matches to. If the operator is Exists, the value should be empty, otherwise just a regular string."},
 	},
-	Blocks: map[string]ConverterSpec{},
 }
 
-var kubernetesDaemonSetV1_spec_template_spec_volume_downwardApi = ConverterSpec{
-	ResourceName: "kubernetes_daemon_set_v1",
+var kubernetesDaemonSetV1_spec_template_spec_topologySpreadConstraint = ConverterSpec{
 	Attributes: map[string]func(any) (cty.Value, error){
-		"default_mode": toString,
+		"match_label_keys":     toList(toString),
+		"max_skew":             toInt,
+		"min_domains":          toInt,
+		"node_affinity_policy": toString,
+		"node_taints_policy":   toString,
+		"topology_key":         toString,
+		"when_unsatisfiable":   toString,
 	},
 	Blocks: map[string]ConverterSpec{
-		"items": kubernetesDaemonSetV1_spec_template_spec_volume_downwardApi_items,
+		"label_selector": kubernetesClusterRoleV1_aggregationRule_clusterRoleSelectors,
+	},
+	Schema: map[string]FieldSchema{
+		"label_selector":       {Optional: true, Description: "A label query over a set of resources, in this case pods."},
+		"match_label_keys":     {Optional: true, Description: "is a set of pod label keys to select the pods over which spreading will be calculated."},
+		"max_skew":             {Optional: true, Description: "describes the degree to which pods may be unevenly distributed.", Default: cty.NumberIntVal(1)},
+		"min_domains":          {Optional: true, Description: "indicates a minimum number of eligible domains."},
+		"node_affinity_policy": {Optional: true, Description: "indicates how we will treat Pod's nodeAffinity/nodeSelector when calculating pod topology spread skew."},
+		"node_taints_policy":   {Optional: true, Description: "indicates how we will treat node taints when calculating pod topology spread skew."},
+		"topology_key":         {Optional: true, Description: "the key of node labels. Nodes that have a label with this key and identical values are considered to be in the same topology."},
+		"when_unsatisfiable":   {Optional: true, Description: "indicates how to deal with a pod if it doesn't satisfy the spread constraint.", Default: cty.StringVal("DoNotSchedule")},
 	},
 }
 
-var kubernetesDaemonSetV1_spec_template_spec_volume_downwardApi_items = ConverterSpec{
-	ResourceName: "kubernetes_daemon_set_v1",
+var kubernetesDaemonSetV1_spec_template_spec_volume = ConverterSpec{
 	Attributes: map[string]func(any) (cty.Value, error){
-		"mode": toString,
-		"path": toString,
+		"name": toString,
 	},
 	Blocks: map[string]ConverterSpec{
-		"field_ref":          kubernetesDaemonSetV1_spec_template_spec_volume_downwardApi_items_fieldRef,
-		"resource_field_ref": kubernetesDaemonSetV1_spec_template_spec_volume_downwardApi_items_resourceFieldRef,
+		"aws_elastic_block_store": kubernetesPersistentVolumeV1_spec_persistentVolumeSource_awsElasticBlockStore,
+		"azure_disk":              kubernetesPersistentVolumeV1_spec_persistentVolumeSource_azureDisk,
+		"azure_file":              kubernetesPersistentVolumeV1_spec_persistentVolumeSource_azureFile,
+		"ceph_fs":                 kubernetesPersistentVolumeV1_spec_persistentVolumeSource_cephFs,
+		"cinder":                  kubernetesPersistentVolumeV1_spec_persistentVolumeSource_cinder,
+		"config_map":              kubernetesPodV1_spec_volume_configMap,
+		"csi":                     kubernetesPodV1_spec_volume_csi,
+		"downward_api":            kubernetesPodV1_spec_volume_downwardApi,
+		"empty_dir":               kubernetesDaemonSetV1_spec_template_spec_volume_emptyDir,
+		"ephemeral":               kubernetesPodV1_spec_volume_ephemeral,
+		"fc":                      kubernetesPersistentVolumeV1_spec_persistentVolumeSource_fc,
+		"flex_volume":             kubernetesPersistentVolumeV1_spec_persistentVolumeSource_flexVolume,
+		"flocker":                 kubernetesPersistentVolumeV1_spec_persistentVolumeSource_flocker,
+		"gce_persistent_disk":     kubernetesPersistentVolumeV1_spec_persistentVolumeSource_gcePersistentDisk,
+		"git_repo":                kubernetesPodV1_spec_volume_gitRepo,
+		"glusterfs":               kubernetesPersistentVolumeV1_spec_persistentVolumeSource_glusterfs,
+		"host_path":               kubernetesPersistentVolumeV1_spec_persistentVolumeSource_hostPath,
+		"iscsi":                   kubernetesPersistentVolumeV1_spec_persistentVolumeSource_iscsi,
+		"local":                   kubernetesPersistentVolumeV1_spec_persistentVolumeSource_local,
+		"nfs":                     kubernetesPersistentVolumeV1_spec_persistentVolumeSource_nfs,
+		"persistent_volume_claim": kubernetesPodV1_spec_volume_persistentVolumeClaim,
+		"photon_persistent_disk":  kubernetesPersistentVolumeV1_spec_persistentVolumeSource_photonPersistentDisk,
+		"projected":               kubernetesPodV1_spec_volume_projected,
+		"quobyte":                 kubernetesPersistentVolumeV1_spec_persistentVolumeSource_quobyte,
+		"rbd":                     kubernetesPersistentVolumeV1_spec_persistentVolumeSource_rbd,
+		"secret":                  kubernetesPodV1_spec_volume_secret,
+		"vsphere_volume":          kubernetesPersistentVolumeV1_spec_persistentVolumeSource_vsphereVolume,
+	},
+	Schema: map[string]FieldSchema{
+		"aws_elastic_block_store": {Optional: true, MaxItems: 1, Description: "Represents an AWS Disk resource that is attached to a kubelet's host machine and then exposed to the pod. More info: https://kubernetes.io/docs/concepts/storage/volumes#awselasticblockstore"},
+		"azure_disk":              {Optional: true, MaxItems: 1, Description: "Represents an Azure Data Disk mount on the host and bind mount to the pod."},
+		"azure_file":              {Optional: true, MaxItems: 1, Description: "Represents an Azure File Service mount on the host and bind mount to the pod."},
+		"ceph_fs":                 {Optional: true, MaxItems: 1, Description: "Represents a Ceph FS mount on the host that shares a pod's lifetime"},
+		"cinder":                  {Optional: true, MaxItems: 1, Description: "Represents a cinder volume attached and mounted on kubelets host machine. More info: https://examples.k8s.io/mysql-cinder-pd/README.md"},
+		"config_map":              {Optional: true, MaxItems: 1, Description: "ConfigMap represents a configMap that should populate this volume"},
+		"csi":                     {Optional: true, MaxItems: 1, Description: "Represents a CSI Volume. More info: https://kubernetes.io/docs/concepts/storage/volumes#csi"},
+		"downward_api":            {Optional: true, MaxItems: 1, Description: "DownwardAPI represents downward API about the pod that should populate this volume"},
+		"empty_dir":               {Optional: true, MaxItems: 1, Description: "EmptyDir represents a temporary directory that shares a pod's lifetime. More info: https://kubernetes.io/docs/concepts/storage/volumes#emptydir"},
+		"ephemeral":               {Optional: true, MaxItems: 1, Description: "Represents an ephemeral volume that is handled by a normal storage driver. More info: https://kubernetes.io/docs/concepts/storage/ephemeral-volumes/#generic-ephemeral-volumes"},
+		"fc":                      {Optional: true, MaxItems: 1, Description: "Represents a Fibre Channel resource that is attached to a kubelet's host machine and then exposed to the pod."},
+		"flex_volume":             {Optional: true, MaxItems: 1, Description: "Represents a generic volume resource that is provisioned/attached using an exec based plugin. This is an alpha feature and may change in future."},
+		"flocker":                 {Optional: true, MaxItems: 1, Description: "Represents a Flocker volume attached to a kubelet's host machine and exposed to the pod for its usage. This depends on the Flocker control service being running"},
+		"gce_persistent_disk":     {Optional: true, MaxItems: 1, Description: "Represents a GCE Disk resource that is attached to a kubelet's host machine and then exposed to the pod. Provisioned by an admin. More info: https://kubernetes.io/docs/concepts/storage/volumes#gcepersistentdisk"},
+		"git_repo":                {Optional: true, MaxItems: 1, Description: "GitRepo represents a git repository at a particular revision."},
+		"glusterfs":               {Optional: true, MaxItems: 1, Description: "Represents a Glusterfs volume that is attached to a host and exposed to the pod. Provisioned by an admin. More info: https://examples.k8s.io/volumes/glusterfs/README.md"},
+		"host_path":               {Optional: true, MaxItems: 1, Description: "Represents a directory on the host. Provisioned by a developer or tester. This is useful for single-node development and testing only! On-host storage is not supported in any way and WILL NOT WORK in a multi-node cluster. More info: https://kubernetes.io/docs/concepts/storage/volumes#hostpath"},
+		"iscsi":                   {Optional: true, MaxItems: 1, Description: "Represents an ISCSI Disk resource that is attached to a kubelet's host machine and then exposed to the pod. Provisioned by an admin."},
+		"local":                   {Optional: true, MaxItems: 1, Description: "Represents a mounted local storage device such as a disk, partition or directory. Local volumes can only be used as a statically created PersistentVolume. Dynamic provisioning is not supported yet. More info: https://kubernetes.io/docs/concepts/storage/volumes#local"},
+		"name":                    {Optional: true, Description: "Volume's name. Must be a DNS_LABEL and unique within the pod. More info: https://kubernetes.io/docs/concepts/overview/working-with-objects/names/#names"},
+		"nfs":                     {Optional: true, MaxItems: 1, Description: "Represents an NFS mount on the host. Provisioned by an admin. More info: https://kubernetes.io/docs/concepts/storage/volumes#nfs"},
+		"persistent_volume_claim": {Optional: true, MaxItems: 1, Description: "The specification of a persistent volume."},
+		"photon_persistent_disk":  {Optional: true, MaxItems: 1, Description: "Represents a PhotonController persistent disk attached and mounted on kubelets host machine"},
+		"projected":               {Optional: true, Description: "Projected represents a single volume that projects several volume sources into the same directory. More info: https://kubernetes.io/docs/concepts/storage/volumes/#projected"},
+		"quobyte":                 {Optional: true, MaxItems: 1, Description: "Quobyte represents a Quobyte mount on the host that shares a pod's lifetime"},
+		"rbd":                     {Optional: true, MaxItems: 1, Description: "Represents a Rados Block Device mount on the host that shares a pod's lifetime. More info: https://examples.k8s.io/volumes/rbd/README.md"},
+		"secret":                  {Optional: true, MaxItems: 1, Description: "Secret represents a secret that should populate this volume. More info: https://kubernetes.io/docs/concepts/storage/volumes#secrets"},
+		"vsphere_volume":          {Optional: true, MaxItems: 1, Description: "Represents a vSphere volume attached and mounted on kubelets host machine"},
 	},
 }
 
-var kubernetesDaemonSetV1_spec_template_spec_volume_downwardApi_items_resourceFieldRef = ConverterSpec{
-	ResourceName: "kubernetes_daemon_set_v1",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"container_name": toString,
-		"divisor":        toString,
-		"resource":       toString,
-	},
-	Blocks: map[string]ConverterSpec{},
-}
-
-var kubernetesDaemonSetV1_spec_template_spec_volume_downwardApi_items_fieldRef = ConverterSpec{
-	ResourceName: "kubernetes_daemon_set_v1",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"api_version": toString,
-		"field_path":  toString,
-	},
-	Blocks: map[string]ConverterSpec{},
-}
-
-var kubernetesDaemonSetV1_spec_template_spec_volume_configMap = ConverterSpec{
-	ResourceName: "kubernetes_daemon_set_v1",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"default_mode": toString,
-		"name":         toString,
-		"optional":     toBool,
-	},
-	Blocks: map[string]ConverterSpec{
-		"items": kubernetesDaemonSetV1_spec_template_spec_volume_configMap_items,
-	},
-}
-
-var kubernetesDaemonSetV1_spec_template_spec_volume_configMap_items = ConverterSpec{
-	ResourceName: "kubernetes_daemon_set_v1",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"key":  toString,
-		"mode": toString,
-		"path": toString,
-	},
-	Blocks: map[string]ConverterSpec{},
-}
-
-var kubernetesDaemonSetV1_spec_template_spec_volume_vsphereVolume = ConverterSpec{
-	ResourceName: "kubernetes_daemon_set_v1",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"fs_type":     toString,
-		"volume_path": toString,
-	},
-	Blocks: map[string]ConverterSpec{},
-}
-
-var kubernetesDaemonSetV1_spec_template_spec_volume_rbd = ConverterSpec{
-	ResourceName: "kubernetes_daemon_set_v1",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"ceph_monitors": func(a any) (cty.Value, error) {
-			l, ok := a.([]any)
-			if !ok {
-				return cty.Value{}, fmt.Errorf("expected slice, got: %T", a)
-			}
-			vl := make([]cty.Value, 0, len(l))
-			for _, val := range l {
-				v, err := toString(val)
-				if err != nil {
-					return cty.Value{}, err
-				}
-				vl = append(vl, v)
-			}
-			return cty.ListVal(vl), nil
-		},
-		"fs_type":    toString,
-		"keyring":    toString,
-		"rados_user": toString,
-		"rbd_image":  toString,
-		"rbd_pool":   toString,
-		"read_only":  toBool,
-	},
-	Blocks: map[string]ConverterSpec{
-		"secret_ref": kubernetesDaemonSetV1_spec_template_spec_volume_rbd_secretRef,
-	},
-}
-
-var kubernetesDaemonSetV1_spec_template_spec_volume_rbd_secretRef = ConverterSpec{
-	ResourceName: "kubernetes_daemon_set_v1",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"name":      toString,
-		"namespace": toString,
-	},
-	Blocks: map[string]ConverterSpec{},
-}
-
-var kubernetesDaemonSetV1_spec_template_spec_volume_nfs = ConverterSpec{
-	ResourceName: "kubernetes_daemon_set_v1",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"path":      toString,
-		"read_only": toBool,
-		"server":    toString,
-	},
-	Blocks: map[string]ConverterSpec{},
-}
-
-var kubernetesDaemonSetV1_spec_template_spec_volume_iscsi = ConverterSpec{
-	ResourceName: "kubernetes_daemon_set_v1",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"fs_type":         toString,
-		"iqn":             toString,
-		"iscsi_interface": toString,
-		"lun":             toInt,
-		"read_only":       toBool,
-		"target_portal":   toString,
-	},
-	Blocks: map[string]ConverterSpec{},
-}
-
-var kubernetesDaemonSetV1_spec_template_spec_volume_gcePersistentDisk = ConverterSpec{
-	ResourceName: "kubernetes_daemon_set_v1",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"fs_type":   toString,
-		"partition": toInt,
-		"pd_name":   toString,
-		"read_only": toBool,
-	},
-	Blocks: map[string]ConverterSpec{},
-}
-
-var kubernetesDaemonSetV1_spec_template_spec_imagePullSecrets = ConverterSpec{
-	ResourceName: "kubernetes_daemon_set_v1",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"name": toString,
-	},
-	Blocks: map[string]ConverterSpec{},
-}
-
-var kubernetesDaemonSetV1_spec_template_spec_os = ConverterSpec{
-	ResourceName: "kubernetes_daemon_set_v1",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"name": toString,
-	},
-	Blocks: map[string]ConverterSpec{},
-}
-
-var kubernetesDaemonSetV1_spec_template_spec_hostAliases = ConverterSpec{
-	ResourceName: "kubernetes_daemon_set_v1",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"hostnames": func(a any) (cty.Value, error) {
-			l, ok := a.([]any)
-			if !ok {
-				return cty.Value{}, fmt.Errorf("expected slice, got: %T", a)
-			}
-			vl := make([]cty.Value, 0, len(l))
-			for _, val := range l {
-				v, err := toString(val)
-				if err != nil {
-					return cty.Value{}, err
-				}
-				vl = append(vl, v)
-			}
-			return cty.ListVal(vl), nil
-		},
-		"ip": toString,
-	},
-	Blocks: map[string]ConverterSpec{},
-}
-
-var kubernetesDaemonSetV1_spec_template_spec_initContainer = ConverterSpec{
-	ResourceName: "kubernetes_daemon_set_v1",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"args": func(a any) (cty.Value, error) {
-			l, ok := a.([]any)
-			if !ok {
-				return cty.Value{}, fmt.Errorf("expected slice, got: %T", a)
-			}
-			vl := make([]cty.Value, 0, len(l))
-			for _, val := range l {
-				v, err := toString(val)
-				if err != nil {
-					return cty.Value{}, err
-				}
-				vl = append(vl, v)
-			}
-			return cty.ListVal(vl), nil
-		},
-		"command": func(a any) (cty.Value, error) {
-			l, ok := a.([]any)
-			if !ok {
-				return cty.Value{}, fmt.Errorf("expected slice, got: %T", a)
-			}
-			vl := make([]cty.Value, 0, len(l))
-			for _, val := range l {
-				v, err := toString(val)
-				if err != nil {
-					return cty.Value{}, err
-				}
-				vl = append(vl, v)
-			}
-			return cty.ListVal(vl), nil
-		},
-		"image":                      toString,
-		"image_pull_policy":          toString,
-		"name":                       toString,
-		"stdin":                      toBool,
-		"stdin_once":                 toBool,
-		"termination_message_path":   toString,
-		"termination_message_policy": toString,
-		"tty":                        toBool,
-		"working_dir":                toString,
-	},
-	Blocks: map[string]ConverterSpec{
-		"env":              kubernetesDaemonSetV1_spec_template_spec_initContainer_env,
-		"env_from":         kubernetesDaemonSetV1_spec_template_spec_initContainer_envFrom,
-		"lifecycle":        kubernetesDaemonSetV1_spec_template_spec_initContainer_lifecycle,
-		"liveness_probe":   kubernetesDaemonSetV1_spec_template_spec_initContainer_livenessProbe,
-		"port":             kubernetesDaemonSetV1_spec_template_spec_initContainer_port,
-		"readiness_probe":  kubernetesDaemonSetV1_spec_template_spec_initContainer_readinessProbe,
-		"resources":        kubernetesDaemonSetV1_spec_template_spec_initContainer_resources,
-		"security_context": kubernetesDaemonSetV1_spec_template_spec_initContainer_securityContext,
-		"startup_probe":    kubernetesDaemonSetV1_spec_template_spec_initContainer_startupProbe,
-		"volume_device":    kubernetesDaemonSetV1_spec_template_spec_initContainer_volumeDevice,
-		"volume_mount":     kubernetesDaemonSetV1_spec_template_spec_initContainer_volumeMount,
-	},
-}
-
-var kubernetesDaemonSetV1_spec_template_spec_initContainer_env = ConverterSpec{
-	ResourceName: "kubernetes_daemon_set_v1",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"name":  toString,
-		"value": toString,
-	},
-	Blocks: map[string]ConverterSpec{
-		"value_from": kubernetesDaemonSetV1_spec_template_spec_initContainer_env_valueFrom,
-	},
-}
-
-var kubernetesDaemonSetV1_spec_template_spec_initContainer_env_valueFrom = ConverterSpec{
-	ResourceName: "kubernetes_daemon_set_v1",
-	Attributes:   map[string]func(any) (cty.Value, error){},
-	Blocks: map[string]ConverterSpec{
-		"config_map_key_ref": kubernetesDaemonSetV1_spec_template_spec_initContainer_env_valueFrom_configMapKeyRef,
-		"field_ref":          kubernetesDaemonSetV1_spec_template_spec_initContainer_env_valueFrom_fieldRef,
-		"resource_field_ref": kubernetesDaemonSetV1_spec_template_spec_initContainer_env_valueFrom_resourceFieldRef,
-		"secret_key_ref":     kubernetesDaemonSetV1_spec_template_spec_initContainer_env_valueFrom_secretKeyRef,
-	},
-}
-
-var kubernetesDaemonSetV1_spec_template_spec_initContainer_env_valueFrom_fieldRef = ConverterSpec{
-	ResourceName: "kubernetes_daemon_set_v1",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"api_version": toString,
-		"field_path":  toString,
-	},
-	Blocks: map[string]ConverterSpec{},
-}
-
-var kubernetesDaemonSetV1_spec_template_spec_initContainer_env_valueFrom_configMapKeyRef = ConverterSpec{
-	ResourceName: "kubernetes_daemon_set_v1",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"key":      toString,
-		"name":     toString,
-		"optional": toBool,
-	},
-	Blocks: map[string]ConverterSpec{},
-}
-
-var kubernetesDaemonSetV1_spec_template_spec_initContainer_env_valueFrom_secretKeyRef = ConverterSpec{
-	ResourceName: "kubernetes_daemon_set_v1",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"key":      toString,
-		"name":     toString,
-		"optional": toBool,
-	},
-	Blocks: map[string]ConverterSpec{},
-}
-
-var kubernetesDaemonSetV1_spec_template_spec_initContainer_env_valueFrom_resourceFieldRef = ConverterSpec{
-	ResourceName: "kubernetes_daemon_set_v1",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"container_name": toString,
-		"divisor":        toString,
-		"resource":       toString,
-	},
-	Blocks: map[string]ConverterSpec{},
-}
-
-var kubernetesDaemonSetV1_spec_template_spec_initContainer_startupProbe = ConverterSpec{
-	ResourceName: "kubernetes_daemon_set_v1",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"failure_threshold":     toInt,
-		"initial_delay_seconds": toInt,
-		"period_seconds":        toInt,
-		"success_threshold":     toInt,
-		"timeout_seconds":       toInt,
-	},
-	Blocks: map[string]ConverterSpec{
-		"exec":       kubernetesDaemonSetV1_spec_template_spec_initContainer_startupProbe_exec,
-		"grpc":       kubernetesDaemonSetV1_spec_template_spec_initContainer_startupProbe_grpc,
-		"http_get":   kubernetesDaemonSetV1_spec_template_spec_initContainer_startupProbe_httpGet,
-		"tcp_socket": kubernetesDaemonSetV1_spec_template_spec_initContainer_startupProbe_tcpSocket,
-	},
-}
-
-var kubernetesDaemonSetV1_spec_template_spec_initContainer_startupProbe_tcpSocket = ConverterSpec{
-	ResourceName: "kubernetes_daemon_set_v1",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"port": toString,
-	},
-	Blocks: map[string]ConverterSpec{},
-}
-
-var kubernetesDaemonSetV1_spec_template_spec_initContainer_startupProbe_grpc = ConverterSpec{
-	ResourceName: "kubernetes_daemon_set_v1",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"port":    toInt,
-		"service": toString,
-	},
-	Blocks: map[string]ConverterSpec{},
-}
-
-var kubernetesDaemonSetV1_spec_template_spec_initContainer_startupProbe_httpGet = ConverterSpec{
-	ResourceName: "kubernetes_daemon_set_v1",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"host":   toString,
-		"path":   toString,
-		"port":   toString,
-		"scheme": toString,
-	},
-	Blocks: map[string]ConverterSpec{
-		"http_header": kubernetesDaemonSetV1_spec_template_spec_initContainer_startupProbe_httpGet_httpHeader,
-	},
-}
-
-var kubernetesDaemonSetV1_spec_template_spec_initContainer_startupProbe_httpGet_httpHeader = ConverterSpec{
-	ResourceName: "kubernetes_daemon_set_v1",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"name":  toString,
-		"value": toString,
-	},
-	Blocks: map[string]ConverterSpec{},
-}
-
-var kubernetesDaemonSetV1_spec_template_spec_initContainer_startupProbe_exec = ConverterSpec{
-	ResourceName: "kubernetes_daemon_set_v1",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"command": func(a any) (cty.Value, error) {
-			l, ok := a.([]any)
-			if !ok {
-				return cty.Value{}, fmt.Errorf("expected slice, got: %T", a)
-			}
-			vl := make([]cty.Value, 0, len(l))
-			for _, val := range l {
-				v, err := toString(val)
-				if err != nil {
-					return cty.Value{}, err
-				}
-				vl = append(vl, v)
-			}
-			return cty.ListVal(vl), nil
-		},
-	},
-	Blocks: map[string]ConverterSpec{},
-}
-
-var kubernetesDaemonSetV1_spec_template_spec_initContainer_volumeDevice = ConverterSpec{
-	ResourceName: "kubernetes_daemon_set_v1",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"device_path": toString,
-		"name":        toString,
-	},
-	Blocks: map[string]ConverterSpec{},
-}
-
-var kubernetesDaemonSetV1_spec_template_spec_initContainer_lifecycle = ConverterSpec{
-	ResourceName: "kubernetes_daemon_set_v1",
-	Attributes:   map[string]func(any) (cty.Value, error){},
-	Blocks: map[string]ConverterSpec{
-		"post_start": kubernetesDaemonSetV1_spec_template_spec_initContainer_lifecycle_postStart,
-		"pre_stop":   kubernetesDaemonSetV1_spec_template_spec_initContainer_lifecycle_preStop,
-	},
-}
-
-var kubernetesDaemonSetV1_spec_template_spec_initContainer_lifecycle_preStop = ConverterSpec{
-	ResourceName: "kubernetes_daemon_set_v1",
-	Attributes:   map[string]func(any) (cty.Value, error){},
-	Blocks: map[string]ConverterSpec{
-		"exec":       kubernetesDaemonSetV1_spec_template_spec_initContainer_lifecycle_preStop_exec,
-		"http_get":   kubernetesDaemonSetV1_spec_template_spec_initContainer_lifecycle_preStop_httpGet,
-		"tcp_socket": kubernetesDaemonSetV1_spec_template_spec_initContainer_lifecycle_preStop_tcpSocket,
-	},
-}
-
-var kubernetesDaemonSetV1_spec_template_spec_initContainer_lifecycle_preStop_tcpSocket = ConverterSpec{
-	ResourceName: "kubernetes_daemon_set_v1",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"port": toString,
-	},
-	Blocks: map[string]ConverterSpec{},
-}
-
-var kubernetesDaemonSetV1_spec_template_spec_initContainer_lifecycle_preStop_httpGet = ConverterSpec{
-	ResourceName: "kubernetes_daemon_set_v1",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"host":   toString,
-		"path":   toString,
-		"port":   toString,
-		"scheme": toString,
-	},
-	Blocks: map[string]ConverterSpec{
-		"http_header": kubernetesDaemonSetV1_spec_template_spec_initContainer_lifecycle_preStop_httpGet_httpHeader,
-	},
-}
-
-var kubernetesDaemonSetV1_spec_template_spec_initContainer_lifecycle_preStop_httpGet_httpHeader = ConverterSpec{
-	ResourceName: "kubernetes_daemon_set_v1",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"name":  toString,
-		"value": toString,
-	},
-	Blocks: map[string]ConverterSpec{},
-}
-
-var kubernetesDaemonSetV1_spec_template_spec_initContainer_lifecycle_preStop_exec = ConverterSpec{
-	ResourceName: "kubernetes_daemon_set_v1",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"command": func(a any) (cty.Value, error) {
-			l, ok := a.([]any)
-			if !ok {
-				return cty.Value{}, fmt.Errorf("expected slice, got: %T", a)
-			}
-			vl := make([]cty.Value, 0, len(l))
-			for _, val := range l {
-				v, err := toString(val)
-				if err != nil {
-					return cty.Value{}, err
-				}
-				vl = append(vl, v)
-			}
-			return cty.ListVal(vl), nil
-		},
-	},
-	Blocks: map[string]ConverterSpec{},
-}
-
-var kubernetesDaemonSetV1_spec_template_spec_initContainer_lifecycle_postStart = ConverterSpec{
-	ResourceName: "kubernetes_daemon_set_v1",
-	Attributes:   map[string]func(any) (cty.Value, error){},
-	Blocks: map[string]ConverterSpec{
-		"exec":       kubernetesDaemonSetV1_spec_template_spec_initContainer_lifecycle_postStart_exec,
-		"http_get":   kubernetesDaemonSetV1_spec_template_spec_initContainer_lifecycle_postStart_httpGet,
-		"tcp_socket": kubernetesDaemonSetV1_spec_template_spec_initContainer_lifecycle_postStart_tcpSocket,
-	},
-}
-
-var kubernetesDaemonSetV1_spec_template_spec_initContainer_lifecycle_postStart_exec = ConverterSpec{
-	ResourceName: "kubernetes_daemon_set_v1",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"command": func(a any) (cty.Value, error) {
-			l, ok := a.([]any)
-			if !ok {
-				return cty.Value{}, fmt.Errorf("expected slice, got: %T", a)
-			}
-			vl := make([]cty.Value, 0, len(l))
-			for _, val := range l {
-				v, err := toString(val)
-				if err != nil {
-					return cty.Value{}, err
-				}
-				vl = append(vl, v)
-			}
-			return cty.ListVal(vl), nil
-		},
-	},
-	Blocks: map[string]ConverterSpec{},
-}
-
-var kubernetesDaemonSetV1_spec_template_spec_initContainer_lifecycle_postStart_tcpSocket = ConverterSpec{
-	ResourceName: "kubernetes_daemon_set_v1",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"port": toString,
-	},
-	Blocks: map[string]ConverterSpec{},
-}
-
-var kubernetesDaemonSetV1_spec_template_spec_initContainer_lifecycle_postStart_httpGet = ConverterSpec{
-	ResourceName: "kubernetes_daemon_set_v1",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"host":   toString,
-		"path":   toString,
-		"port":   toString,
-		"scheme": toString,
-	},
-	Blocks: map[string]ConverterSpec{
-		"http_header": kubernetesDaemonSetV1_spec_template_spec_initContainer_lifecycle_postStart_httpGet_httpHeader,
-	},
-}
-
-var kubernetesDaemonSetV1_spec_template_spec_initContainer_lifecycle_postStart_httpGet_httpHeader = ConverterSpec{
-	ResourceName: "kubernetes_daemon_set_v1",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"name":  toString,
-		"value": toString,
-	},
-	Blocks: map[string]ConverterSpec{},
-}
-
-var kubernetesDaemonSetV1_spec_template_spec_initContainer_livenessProbe = ConverterSpec{
-	ResourceName: "kubernetes_daemon_set_v1",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"failure_threshold":     toInt,
-		"initial_delay_seconds": toInt,
-		"period_seconds":        toInt,
-		"success_threshold":     toInt,
-		"timeout_seconds":       toInt,
-	},
-	Blocks: map[string]ConverterSpec{
-		"exec":       kubernetesDaemonSetV1_spec_template_spec_initContainer_livenessProbe_exec,
-		"grpc":       kubernetesDaemonSetV1_spec_template_spec_initContainer_livenessProbe_grpc,
-		"http_get":   kubernetesDaemonSetV1_spec_template_spec_initContainer_livenessProbe_httpGet,
-		"tcp_socket": kubernetesDaemonSetV1_spec_template_spec_initContainer_livenessProbe_tcpSocket,
-	},
-}
-
-var kubernetesDaemonSetV1_spec_template_spec_initContainer_livenessProbe_grpc = ConverterSpec{
-	ResourceName: "kubernetes_daemon_set_v1",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"port":    toInt,
-		"service": toString,
-	},
-	Blocks: map[string]ConverterSpec{},
-}
-
-var kubernetesDaemonSetV1_spec_template_spec_initContainer_livenessProbe_tcpSocket = ConverterSpec{
-	ResourceName: "kubernetes_daemon_set_v1",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"port": toString,
-	},
-	Blocks: map[string]ConverterSpec{},
-}
-
-var kubernetesDaemonSetV1_spec_template_spec_initContainer_livenessProbe_exec = ConverterSpec{
-	ResourceName: "kubernetes_daemon_set_v1",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"command": func(a any) (cty.Value, error) {
-			l, ok := a.([]any)
-			if !ok {
-				return cty.Value{}, fmt.Errorf("expected slice, got: %T", a)
-			}
-			vl := make([]cty.Value, 0, len(l))
-			for _, val := range l {
-				v, err := toString(val)
-				if err != nil {
-					return cty.Value{}, err
-				}
-				vl = append(vl, v)
-			}
-			return cty.ListVal(vl), nil
-		},
-	},
-	Blocks: map[string]ConverterSpec{},
-}
-
-var kubernetesDaemonSetV1_spec_template_spec_initContainer_livenessProbe_httpGet = ConverterSpec{
-	ResourceName: "kubernetes_daemon_set_v1",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"host":   toString,
-		"path":   toString,
-		"port":   toString,
-		"scheme": toString,
-	},
-	Blocks: map[string]ConverterSpec{
-		"http_header": kubernetesDaemonSetV1_spec_template_spec_initContainer_livenessProbe_httpGet_httpHeader,
-	},
-}
-
-var kubernetesDaemonSetV1_spec_template_spec_initContainer_livenessProbe_httpGet_httpHeader = ConverterSpec{
-	ResourceName: "kubernetes_daemon_set_v1",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"name":  toString,
-		"value": toString,
-	},
-	Blocks: map[string]ConverterSpec{},
-}
-
-var kubernetesDaemonSetV1_spec_template_spec_initContainer_envFrom = ConverterSpec{
-	ResourceName: "kubernetes_daemon_set_v1",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"prefix": toString,
-	},
-	Blocks: map[string]ConverterSpec{
-		"config_map_ref": kubernetesDaemonSetV1_spec_template_spec_initContainer_envFrom_configMapRef,
-		"secret_ref":     kubernetesDaemonSetV1_spec_template_spec_initContainer_envFrom_secretRef,
-	},
-}
-
-var kubernetesDaemonSetV1_spec_template_spec_initContainer_envFrom_configMapRef = ConverterSpec{
-	ResourceName: "kubernetes_daemon_set_v1",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"name":     toString,
-		"optional": toBool,
-	},
-	Blocks: map[string]ConverterSpec{},
-}
-
-var kubernetesDaemonSetV1_spec_template_spec_initContainer_envFrom_secretRef = ConverterSpec{
-	ResourceName: "kubernetes_daemon_set_v1",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"name":     toString,
-		"optional": toBool,
-	},
-	Blocks: map[string]ConverterSpec{},
-}
-
-var kubernetesDaemonSetV1_spec_template_spec_initContainer_volumeMount = ConverterSpec{
-	ResourceName: "kubernetes_daemon_set_v1",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"mount_path":        toString,
-		"mount_propagation": toString,
-		"name":              toString,
-		"read_only":         toBool,
-		"sub_path":          toString,
-		"sub_path_expr":     toString,
-	},
-	Blocks: map[string]ConverterSpec{},
-}
-
-var kubernetesDaemonSetV1_spec_template_spec_initContainer_securityContext = ConverterSpec{
-	ResourceName: "kubernetes_daemon_set_v1",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"allow_privilege_escalation": toBool,
-		"privileged":                 toBool,
-		"read_only_root_filesystem":  toBool,
-		"run_as_group":               toString,
-		"run_as_non_root":            toBool,
-		"run_as_user":                toString,
-	},
-	Blocks: map[string]ConverterSpec{
-		"capabilities":     kubernetesDaemonSetV1_spec_template_spec_initContainer_securityContext_capabilities,
-		"se_linux_options": kubernetesDaemonSetV1_spec_template_spec_initContainer_securityContext_seLinuxOptions,
-		"seccomp_profile":  kubernetesDaemonSetV1_spec_template_spec_initContainer_securityContext_seccompProfile,
-	},
-}
-
-var kubernetesDaemonSetV1_spec_template_spec_initContainer_securityContext_seLinuxOptions = ConverterSpec{
-	ResourceName: "kubernetes_daemon_set_v1",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"level": toString,
-		"role":  toString,
-		"type":  toString,
-		"user":  toString,
-	},
-	Blocks: map[string]ConverterSpec{},
-}
-
-var kubernetesDaemonSetV1_spec_template_spec_initContainer_securityContext_capabilities = ConverterSpec{
-	ResourceName: "kubernetes_daemon_set_v1",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"add": func(a any) (cty.Value, error) {
-			l, ok := a.([]any)
-			if !ok {
-				return cty.Value{}, fmt.Errorf("expected slice, got: %T", a)
-			}
-			vl := make([]cty.Value, 0, len(l))
-			for _, val := range l {
-				v, err := toString(val)
-				if err != nil {
-					return cty.Value{}, err
-				}
-				vl = append(vl, v)
-			}
-			return cty.ListVal(vl), nil
-		},
-		"drop": func(a any) (cty.Value, error) {
-			l, ok := a.([]any)
-			if !ok {
-				return cty.Value{}, fmt.Errorf("expected slice, got: %T", a)
-			}
-			vl := make([]cty.Value, 0, len(l))
-			for _, val := range l {
-				v, err := toString(val)
-				if err != nil {
-					return cty.Value{}, err
-				}
-				vl = append(vl, v)
-			}
-			return cty.ListVal(vl), nil
-		},
-	},
-	Blocks: map[string]ConverterSpec{},
-}
-
-var kubernetesDaemonSetV1_spec_template_spec_initContainer_securityContext_seccompProfile = ConverterSpec{
-	ResourceName: "kubernetes_daemon_set_v1",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"localhost_profile": toString,
-		"type":              toString,
-	},
-	Blocks: map[string]ConverterSpec{},
-}
-
-var kubernetesDaemonSetV1_spec_template_spec_initContainer_resources = ConverterSpec{
-	ResourceName: "kubernetes_daemon_set_v1",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"limits":   toStringMap,
-		"requests": toStringMap,
-	},
-	Blocks: map[string]ConverterSpec{},
-}
-
-var kubernetesDaemonSetV1_spec_template_spec_initContainer_readinessProbe = ConverterSpec{
-	ResourceName: "kubernetes_daemon_set_v1",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"failure_threshold":     toInt,
-		"initial_delay_seconds": toInt,
-		"period_seconds":        toInt,
-		"success_threshold":     toInt,
-		"timeout_seconds":       toInt,
-	},
-	Blocks: map[string]ConverterSpec{
-		"exec":       kubernetesDaemonSetV1_spec_template_spec_initContainer_readinessProbe_exec,
-		"grpc":       kubernetesDaemonSetV1_spec_template_spec_initContainer_readinessProbe_grpc,
-		"http_get":   kubernetesDaemonSetV1_spec_template_spec_initContainer_readinessProbe_httpGet,
-		"tcp_socket": kubernetesDaemonSetV1_spec_template_spec_initContainer_readinessProbe_tcpSocket,
-	},
-}
-
-var kubernetesDaemonSetV1_spec_template_spec_initContainer_readinessProbe_grpc = ConverterSpec{
-	ResourceName: "kubernetes_daemon_set_v1",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"port":    toInt,
-		"service": toString,
-	},
-	Blocks: map[string]ConverterSpec{},
-}
-
-var kubernetesDaemonSetV1_spec_template_spec_initContainer_readinessProbe_tcpSocket = ConverterSpec{
-	ResourceName: "kubernetes_daemon_set_v1",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"port": toString,
-	},
-	Blocks: map[string]ConverterSpec{},
-}
-
-var kubernetesDaemonSetV1_spec_template_spec_initContainer_readinessProbe_httpGet = ConverterSpec{
-	ResourceName: "kubernetes_daemon_set_v1",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"host":   toString,
-		"path":   toString,
-		"port":   toString,
-		"scheme": toString,
-	},
-	Blocks: map[string]ConverterSpec{
-		"http_header": kubernetesDaemonSetV1_spec_template_spec_initContainer_readinessProbe_httpGet_httpHeader,
-	},
-}
-
-var kubernetesDaemonSetV1_spec_template_spec_initContainer_readinessProbe_httpGet_httpHeader = ConverterSpec{
-	ResourceName: "kubernetes_daemon_set_v1",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"name":  toString,
-		"value": toString,
-	},
-	Blocks: map[string]ConverterSpec{},
-}
-
-var kubernetesDaemonSetV1_spec_template_spec_initContainer_readinessProbe_exec = ConverterSpec{
-	ResourceName: "kubernetes_daemon_set_v1",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"command": func(a any) (cty.Value, error) {
-			l, ok := a.([]any)
-			if !ok {
-				return cty.Value{}, fmt.Errorf("expected slice, got: %T", a)
-			}
-			vl := make([]cty.Value, 0, len(l))
-			for _, val := range l {
-				v, err := toString(val)
-				if err != nil {
-					return cty.Value{}, err
-				}
-				vl = append(vl, v)
-			}
-			return cty.ListVal(vl), nil
-		},
-	},
-	Blocks: map[string]ConverterSpec{},
-}
-
-var kubernetesDaemonSetV1_spec_template_spec_initContainer_port = ConverterSpec{
-	ResourceName: "kubernetes_daemon_set_v1",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"container_port": toInt,
-		"host_ip":        toString,
-		"host_port":      toInt,
-		"name":           toString,
-		"protocol":       toString,
-	},
-	Blocks: map[string]ConverterSpec{},
-}
-
-var kubernetesDaemonSetV1_spec_template_spec_dnsConfig = ConverterSpec{
-	ResourceName: "kubernetes_daemon_set_v1",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"nameservers": func(a any) (cty.Value, error) {
-			l, ok := a.([]any)
-			if !ok {
-				return cty.Value{}, fmt.Errorf("expected slice, got: %T", a)
-			}
-			vl := make([]cty.Value, 0, len(l))
-			for _, val := range l {
-				v, err := toString(val)
-				if err != nil {
-					return cty.Value{}, err
-				}
-				vl = append(vl, v)
-			}
-			return cty.ListVal(vl), nil
-		},
-		"searches": func(a any) (cty.Value, error) {
-			l, ok := a.([]any)
-			if !ok {
-				return cty.Value{}, fmt.Errorf("expected slice, got: %T", a)
-			}
-			vl := make([]cty.Value, 0, len(l))
-			for _, val := range l {
-				v, err := toString(val)
-				if err != nil {
-					return cty.Value{}, err
-				}
-				vl = append(vl, v)
-			}
-			return cty.ListVal(vl), nil
-		},
-	},
-	Blocks: map[string]ConverterSpec{
-		"option": kubernetesDaemonSetV1_spec_template_spec_dnsConfig_option,
-	},
-}
-
-var kubernetesDaemonSetV1_spec_template_spec_dnsConfig_option = ConverterSpec{
-	ResourceName: "kubernetes_daemon_set_v1",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"name":  toString,
-		"value": toString,
-	},
-	Blocks: map[string]ConverterSpec{},
-}
-
-var kubernetesDaemonSetV1_spec_template_spec_affinity = ConverterSpec{
-	ResourceName: "kubernetes_daemon_set_v1",
-	Attributes:   map[string]func(any) (cty.Value, error){},
-	Blocks: map[string]ConverterSpec{
-		"node_affinity":     kubernetesDaemonSetV1_spec_template_spec_affinity_nodeAffinity,
-		"pod_affinity":      kubernetesDaemonSetV1_spec_template_spec_affinity_podAffinity,
-		"pod_anti_affinity": kubernetesDaemonSetV1_spec_template_spec_affinity_podAntiAffinity,
-	},
-}
-
-var kubernetesDaemonSetV1_spec_template_spec_affinity_podAntiAffinity = ConverterSpec{
-	ResourceName: "kubernetes_daemon_set_v1",
-	Attributes:   map[string]func(any) (cty.Value, error){},
-	Blocks: map[string]ConverterSpec{
-		"preferred_during_scheduling_ignored_during_execution": kubernetesDaemonSetV1_spec_template_spec_affinity_podAntiAffinity_preferredDuringSchedulingIgnoredDuringExecution,
-		"required_during_scheduling_ignored_during_execution":  kubernetesDaemonSetV1_spec_template_spec_affinity_podAntiAffinity_requiredDuringSchedulingIgnoredDuringExecution,
-	},
-}
-
-var kubernetesDaemonSetV1_spec_template_spec_affinity_podAntiAffinity_preferredDuringSchedulingIgnoredDuringExecution = ConverterSpec{
-	ResourceName: "kubernetes_daemon_set_v1",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"weight": toInt,
-	},
-	Blocks: map[string]ConverterSpec{
-		"pod_affinity_term": kubernetesDaemonSetV1_spec_template_spec_affinity_podAntiAffinity_preferredDuringSchedulingIgnoredDuringExecution_podAffinityTerm,
-	},
-}
-
-var kubernetesDaemonSetV1_spec_template_spec_affinity_podAntiAffinity_preferredDuringSchedulingIgnoredDuringExecution_podAffinityTerm = ConverterSpec{
-	ResourceName: "kubernetes_daemon_set_v1",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"namespaces": func(a any) (cty.Value, error) {
-			l, ok := a.([]any)
-			if !ok {
-				return cty.Value{}, fmt.Errorf("expected slice, got: %T", a)
-			}
-			vl := make([]cty.Value, 0, len(l))
-			for _, val := range l {
-				v, err := toString(val)
-				if err != nil {
-					return cty.Value{}, err
-				}
-				vl = append(vl, v)
-			}
-			return cty.ListVal(vl), nil
-		},
-		"topology_key": toString,
-	},
-	Blocks: map[string]ConverterSpec{
-		"label_selector":     kubernetesDaemonSetV1_spec_template_spec_affinity_podAntiAffinity_preferredDuringSchedulingIgnoredDuringExecution_podAffinityTerm_labelSelector,
-		"namespace_selector": kubernetesDaemonSetV1_spec_template_spec_affinity_podAntiAffinity_preferredDuringSchedulingIgnoredDuringExecution_podAffinityTerm_namespaceSelector,
-	},
-}
-
-var kubernetesDaemonSetV1_spec_template_spec_affinity_podAntiAffinity_preferredDuringSchedulingIgnoredDuringExecution_podAffinityTerm_labelSelector = ConverterSpec{
-	ResourceName: "kubernetes_daemon_set_v1",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"match_labels": toStringMap,
-	},
-	Blocks: map[string]ConverterSpec{
-		"match_expressions": kubernetesDaemonSetV1_spec_template_spec_affinity_podAntiAffinity_preferredDuringSchedulingIgnoredDuringExecution_podAffinityTerm_labelSelector_matchExpressions,
-	},
-}
-
-var kubernetesDaemonSetV1_spec_template_spec_affinity_podAntiAffinity_preferredDuringSchedulingIgnoredDuringExecution_podAffinityTerm_labelSelector_matchExpressions = ConverterSpec{
-	ResourceName: "kubernetes_daemon_set_v1",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"key":      toString,
-		"operator": toString,
-		"values": func(a any) (cty.Value, error) {
-			l, ok := a.([]any)
-			if !ok {
-				return cty.Value{}, fmt.Errorf("expected slice, got: %T", a)
-			}
-			vl := make([]cty.Value, 0, len(l))
-			for _, val := range l {
-				v, err := toString(val)
-				if err != nil {
-					return cty.Value{}, err
-				}
-				vl = append(vl, v)
-			}
-			return cty.ListVal(vl), nil
-		},
-	},
-	Blocks: map[string]ConverterSpec{},
-}
-
-var kubernetesDaemonSetV1_spec_template_spec_affinity_podAntiAffinity_preferredDuringSchedulingIgnoredDuringExecution_podAffinityTerm_namespaceSelector = ConverterSpec{
-	ResourceName: "kubernetes_daemon_set_v1",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"match_labels": toStringMap,
-	},
-	Blocks: map[string]ConverterSpec{
-		"match_expressions": kubernetesDaemonSetV1_spec_template_spec_affinity_podAntiAffinity_preferredDuringSchedulingIgnoredDuringExecution_podAffinityTerm_namespaceSelector_matchExpressions,
-	},
-}
-
-var kubernetesDaemonSetV1_spec_template_spec_affinity_podAntiAffinity_preferredDuringSchedulingIgnoredDuringExecution_podAffinityTerm_namespaceSelector_matchExpressions = ConverterSpec{
-	ResourceName: "kubernetes_daemon_set_v1",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"key":      toString,
-		"operator": toString,
-		"values": func(a any) (cty.Value, error) {
-			l, ok := a.([]any)
-			if !ok {
-				return cty.Value{}, fmt.Errorf("expected slice, got: %T", a)
-			}
-			vl := make([]cty.Value, 0, len(l))
-			for _, val := range l {
-				v, err := toString(val)
-				if err != nil {
-					return cty.Value{}, err
-				}
-				vl = append(vl, v)
-			}
-			return cty.ListVal(vl), nil
-		},
-	},
-	Blocks: map[string]ConverterSpec{},
-}
-
-var kubernetesDaemonSetV1_spec_template_spec_affinity_podAntiAffinity_requiredDuringSchedulingIgnoredDuringExecution = ConverterSpec{
-	ResourceName: "kubernetes_daemon_set_v1",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"namespaces": func(a any) (cty.Value, error) {
-			l, ok := a.([]any)
-			if !ok {
-				return cty.Value{}, fmt.Errorf("expected slice, got: %T", a)
-			}
-			vl := make([]cty.Value, 0, len(l))
-			for _, val := range l {
-				v, err := toString(val)
-				if err != nil {
-					return cty.Value{}, err
-				}
-				vl = append(vl, v)
-			}
-			return cty.ListVal(vl), nil
-		},
-		"topology_key": toString,
-	},
-	Blocks: map[string]ConverterSpec{
-		"label_selector":     kubernetesDaemonSetV1_spec_template_spec_affinity_podAntiAffinity_requiredDuringSchedulingIgnoredDuringExecution_labelSelector,
-		"namespace_selector": kubernetesDaemonSetV1_spec_template_spec_affinity_podAntiAffinity_requiredDuringSchedulingIgnoredDuringExecution_namespaceSelector,
-	},
-}
-
-var kubernetesDaemonSetV1_spec_template_spec_affinity_podAntiAffinity_requiredDuringSchedulingIgnoredDuringExecution_namespaceSelector = ConverterSpec{
-	ResourceName: "kubernetes_daemon_set_v1",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"match_labels": toStringMap,
-	},
-	Blocks: map[string]ConverterSpec{
-		"match_expressions": kubernetesDaemonSetV1_spec_template_spec_affinity_podAntiAffinity_requiredDuringSchedulingIgnoredDuringExecution_namespaceSelector_matchExpressions,
-	},
-}
-
-var kubernetesDaemonSetV1_spec_template_spec_affinity_podAntiAffinity_requiredDuringSchedulingIgnoredDuringExecution_namespaceSelector_matchExpressions = ConverterSpec{
-	ResourceName: "kubernetes_daemon_set_v1",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"key":      toString,
-		"operator": toString,
-		"values": func(a any) (cty.Value, error) {
-			l, ok := a.([]any)
-			if !ok {
-				return cty.Value{}, fmt.Errorf("expected slice, got: %T", a)
-			}
-			vl := make([]cty.Value, 0, len(l))
-			for _, val := range l {
-				v, err := toString(val)
-				if err != nil {
-					return cty.Value{}, err
-				}
-				vl = append(vl, v)
-			}
-			return cty.ListVal(vl), nil
-		},
-	},
-	Blocks: map[string]ConverterSpec{},
-}
-
-var kubernetesDaemonSetV1_spec_template_spec_affinity_podAntiAffinity_requiredDuringSchedulingIgnoredDuringExecution_labelSelector = ConverterSpec{
-	ResourceName: "kubernetes_daemon_set_v1",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"match_labels": toStringMap,
-	},
-	Blocks: map[string]ConverterSpec{
-		"match_expressions": kubernetesDaemonSetV1_spec_template_spec_affinity_podAntiAffinity_requiredDuringSchedulingIgnoredDuringExecution_labelSelector_matchExpressions,
-	},
-}
-
-var kubernetesDaemonSetV1_spec_template_spec_affinity_podAntiAffinity_requiredDuringSchedulingIgnoredDuringExecution_labelSelector_matchExpressions = ConverterSpec{
-	ResourceName: "kubernetes_daemon_set_v1",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"key":      toString,
-		"operator": toString,
-		"values": func(a any) (cty.Value, error) {
-			l, ok := a.([]any)
-			if !ok {
-				return cty.Value{}, fmt.Errorf("expected slice, got: %T", a)
-			}
-			vl := make([]cty.Value, 0, len(l))
-			for _, val := range l {
-				v, err := toString(val)
-				if err != nil {
-					return cty.Value{}, err
-				}
-				vl = append(vl, v)
-			}
-			return cty.ListVal(vl), nil
-		},
-	},
-	Blocks: map[string]ConverterSpec{},
-}
-
-var kubernetesDaemonSetV1_spec_template_spec_affinity_podAffinity = ConverterSpec{
-	ResourceName: "kubernetes_daemon_set_v1",
-	Attributes:   map[string]func(any) (cty.Value, error){},
-	Blocks: map[string]ConverterSpec{
-		"preferred_during_scheduling_ignored_during_execution": kubernetesDaemonSetV1_spec_template_spec_affinity_podAffinity_preferredDuringSchedulingIgnoredDuringExecution,
-		"required_during_scheduling_ignored_during_execution":  kubernetesDaemonSetV1_spec_template_spec_affinity_podAffinity_requiredDuringSchedulingIgnoredDuringExecution,
-	},
-}
-
-var kubernetesDaemonSetV1_spec_template_spec_affinity_podAffinity_preferredDuringSchedulingIgnoredDuringExecution = ConverterSpec{
-	ResourceName: "kubernetes_daemon_set_v1",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"weight": toInt,
-	},
-	Blocks: map[string]ConverterSpec{
-		"pod_affinity_term": kubernetesDaemonSetV1_spec_template_spec_affinity_podAffinity_preferredDuringSchedulingIgnoredDuringExecution_podAffinityTerm,
-	},
-}
-
-var kubernetesDaemonSetV1_spec_template_spec_affinity_podAffinity_preferredDuringSchedulingIgnoredDuringExecution_podAffinityTerm = ConverterSpec{
-	ResourceName: "kubernetes_daemon_set_v1",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"namespaces": func(a any) (cty.Value, error) {
-			l, ok := a.([]any)
-			if !ok {
-				return cty.Value{}, fmt.Errorf("expected slice, got: %T", a)
-			}
-			vl := make([]cty.Value, 0, len(l))
-			for _, val := range l {
-				v, err := toString(val)
-				if err != nil {
-					return cty.Value{}, err
-				}
-				vl = append(vl, v)
-			}
-			return cty.ListVal(vl), nil
-		},
-		"topology_key": toString,
-	},
-	Blocks: map[string]ConverterSpec{
-		"label_selector":     kubernetesDaemonSetV1_spec_template_spec_affinity_podAffinity_preferredDuringSchedulingIgnoredDuringExecution_podAffinityTerm_labelSelector,
-		"namespace_selector": kubernetesDaemonSetV1_spec_template_spec_affinity_podAffinity_preferredDuringSchedulingIgnoredDuringExecution_podAffinityTerm_namespaceSelector,
-	},
-}
-
-var kubernetesDaemonSetV1_spec_template_spec_affinity_podAffinity_preferredDuringSchedulingIgnoredDuringExecution_podAffinityTerm_namespaceSelector = ConverterSpec{
-	ResourceName: "kubernetes_daemon_set_v1",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"match_labels": toStringMap,
-	},
-	Blocks: map[string]ConverterSpec{
-		"match_expressions": kubernetesDaemonSetV1_spec_template_spec_affinity_podAffinity_preferredDuringSchedulingIgnoredDuringExecution_podAffinityTerm_namespaceSelector_matchExpressions,
-	},
-}
-
-var kubernetesDaemonSetV1_spec_template_spec_affinity_podAffinity_preferredDuringSchedulingIgnoredDuringExecution_podAffinityTerm_namespaceSelector_matchExpressions = ConverterSpec{
-	ResourceName: "kubernetes_daemon_set_v1",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"key":      toString,
-		"operator": toString,
-		"values": func(a any) (cty.Value, error) {
-			l, ok := a.([]any)
-			if !ok {
-				return cty.Value{}, fmt.Errorf("expected slice, got: %T", a)
-			}
-			vl := make([]cty.Value, 0, len(l))
-			for _, val := range l {
-				v, err := toString(val)
-				if err != nil {
-					return cty.Value{}, err
-				}
-				vl = append(vl, v)
-			}
-			return cty.ListVal(vl), nil
-		},
-	},
-	Blocks: map[string]ConverterSpec{},
-}
-
-var kubernetesDaemonSetV1_spec_template_spec_affinity_podAffinity_preferredDuringSchedulingIgnoredDuringExecution_podAffinityTerm_labelSelector = ConverterSpec{
-	ResourceName: "kubernetes_daemon_set_v1",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"match_labels": toStringMap,
-	},
-	Blocks: map[string]ConverterSpec{
-		"match_expressions": kubernetesDaemonSetV1_spec_template_spec_affinity_podAffinity_preferredDuringSchedulingIgnoredDuringExecution_podAffinityTerm_labelSelector_matchExpressions,
-	},
-}
-
-var kubernetesDaemonSetV1_spec_template_spec_affinity_podAffinity_preferredDuringSchedulingIgnoredDuringExecution_podAffinityTerm_labelSelector_matchExpressions = ConverterSpec{
-	ResourceName: "kubernetes_daemon_set_v1",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"key":      toString,
-		"operator": toString,
-		"values": func(a any) (cty.Value, error) {
-			l, ok := a.([]any)
-			if !ok {
-				return cty.Value{}, fmt.Errorf("expected slice, got: %T", a)
-			}
-			vl := make([]cty.Value, 0, len(l))
-			for _, val := range l {
-				v, err := toString(val)
-				if err != nil {
-					return cty.Value{}, err
-				}
-				vl = append(vl, v)
-			}
-			return cty.ListVal(vl), nil
-		},
-	},
-	Blocks: map[string]ConverterSpec{},
-}
-
-var kubernetesDaemonSetV1_spec_template_spec_affinity_podAffinity_requiredDuringSchedulingIgnoredDuringExecution = ConverterSpec{
-	ResourceName: "kubernetes_daemon_set_v1",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"namespaces": func(a any) (cty.Value, error) {
-			l, ok := a.([]any)
-			if !ok {
-				return cty.Value{}, fmt.Errorf("expected slice, got: %T", a)
-			}
-			vl := make([]cty.Value, 0, len(l))
-			for _, val := range l {
-				v, err := toString(val)
-				if err != nil {
-					return cty.Value{}, err
-				}
-				vl = append(vl, v)
-			}
-			return cty.ListVal(vl), nil
-		},
-		"topology_key": toString,
-	},
-	Blocks: map[string]ConverterSpec{
-		"label_selector":     kubernetesDaemonSetV1_spec_template_spec_affinity_podAffinity_requiredDuringSchedulingIgnoredDuringExecution_labelSelector,
-		"namespace_selector": kubernetesDaemonSetV1_spec_template_spec_affinity_podAffinity_requiredDuringSchedulingIgnoredDuringExecution_namespaceSelector,
-	},
-}
-
-var kubernetesDaemonSetV1_spec_template_spec_affinity_podAffinity_requiredDuringSchedulingIgnoredDuringExecution_namespaceSelector = ConverterSpec{
-	ResourceName: "kubernetes_daemon_set_v1",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"match_labels": toStringMap,
-	},
-	Blocks: map[string]ConverterSpec{
-		"match_expressions": kubernetesDaemonSetV1_spec_template_spec_affinity_podAffinity_requiredDuringSchedulingIgnoredDuringExecution_namespaceSelector_matchExpressions,
-	},
-}
-
-var kubernetesDaemonSetV1_spec_template_spec_affinity_podAffinity_requiredDuringSchedulingIgnoredDuringExecution_namespaceSelector_matchExpressions = ConverterSpec{
-	ResourceName: "kubernetes_daemon_set_v1",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"key":      toString,
-		"operator": toString,
-		"values": func(a any) (cty.Value, error) {
-			l, ok := a.([]any)
-			if !ok {
-				return cty.Value{}, fmt.Errorf("expected slice, got: %T", a)
-			}
-			vl := make([]cty.Value, 0, len(l))
-			for _, val := range l {
-				v, err := toString(val)
-				if err != nil {
-					return cty.Value{}, err
-				}
-				vl = append(vl, v)
-			}
-			return cty.ListVal(vl), nil
-		},
-	},
-	Blocks: map[string]ConverterSpec{},
-}
-
-var kubernetesDaemonSetV1_spec_template_spec_affinity_podAffinity_requiredDuringSchedulingIgnoredDuringExecution_labelSelector = ConverterSpec{
-	ResourceName: "kubernetes_daemon_set_v1",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"match_labels": toStringMap,
-	},
-	Blocks: map[string]ConverterSpec{
-		"match_expressions": kubernetesDaemonSetV1_spec_template_spec_affinity_podAffinity_requiredDuringSchedulingIgnoredDuringExecution_labelSelector_matchExpressions,
-	},
-}
-
-var kubernetesDaemonSetV1_spec_template_spec_affinity_podAffinity_requiredDuringSchedulingIgnoredDuringExecution_labelSelector_matchExpressions = ConverterSpec{
-	ResourceName: "kubernetes_daemon_set_v1",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"key":      toString,
-		"operator": toString,
-		"values": func(a any) (cty.Value, error) {
-			l, ok := a.([]any)
-			if !ok {
-				return cty.Value{}, fmt.Errorf("expected slice, got: %T", a)
-			}
-			vl := make([]cty.Value, 0, len(l))
-			for _, val := range l {
-				v, err := toString(val)
-				if err != nil {
-					return cty.Value{}, err
-				}
-				vl = append(vl, v)
-			}
-			return cty.ListVal(vl), nil
-		},
-	},
-	Blocks: map[string]ConverterSpec{},
-}
-
-var kubernetesDaemonSetV1_spec_template_spec_affinity_nodeAffinity = ConverterSpec{
-	ResourceName: "kubernetes_daemon_set_v1",
-	Attributes:   map[string]func(any) (cty.Value, error){},
-	Blocks: map[string]ConverterSpec{
-		"preferred_during_scheduling_ignored_during_execution": kubernetesDaemonSetV1_spec_template_spec_affinity_nodeAffinity_preferredDuringSchedulingIgnoredDuringExecution,
-		"required_during_scheduling_ignored_during_execution":  kubernetesDaemonSetV1_spec_template_spec_affinity_nodeAffinity_requiredDuringSchedulingIgnoredDuringExecution,
-	},
-}
-
-var kubernetesDaemonSetV1_spec_template_spec_affinity_nodeAffinity_preferredDuringSchedulingIgnoredDuringExecution = ConverterSpec{
-	ResourceName: "kubernetes_daemon_set_v1",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"weight": toInt,
-	},
-	Blocks: map[string]ConverterSpec{
-		"preference": kubernetesDaemonSetV1_spec_template_spec_affinity_nodeAffinity_preferredDuringSchedulingIgnoredDuringExecution_preference,
-	},
-}
-
-var kubernetesDaemonSetV1_spec_template_spec_affinity_nodeAffinity_preferredDuringSchedulingIgnoredDuringExecution_preference = ConverterSpec{
-	ResourceName: "kubernetes_daemon_set_v1",
-	Attributes:   map[string]func(any) (cty.Value, error){},
-	Blocks: map[string]ConverterSpec{
-		"match_expressions": kubernetesDaemonSetV1_spec_template_spec_affinity_nodeAffinity_preferredDuringSchedulingIgnoredDuringExecution_preference_matchExpressions,
-		"match_fields":      kubernetesDaemonSetV1_spec_template_spec_affinity_nodeAffinity_preferredDuringSchedulingIgnoredDuringExecution_preference_matchFields,
-	},
-}
-
-var kubernetesDaemonSetV1_spec_template_spec_affinity_nodeAffinity_preferredDuringSchedulingIgnoredDuringExecution_preference_matchFields = ConverterSpec{
-	ResourceName: "kubernetes_daemon_set_v1",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"key":      toString,
-		"operator": toString,
-		"values": func(a any) (cty.Value, error) {
-			l, ok := a.([]any)
-			if !ok {
-				return cty.Value{}, fmt.Errorf("expected slice, got: %T", a)
-			}
-			vl := make([]cty.Value, 0, len(l))
-			for _, val := range l {
-				v, err := toString(val)
-				if err != nil {
-					return cty.Value{}, err
-				}
-				vl = append(vl, v)
-			}
-			return cty.ListVal(vl), nil
-		},
-	},
-	Blocks: map[string]ConverterSpec{},
-}
-
-var kubernetesDaemonSetV1_spec_template_spec_affinity_nodeAffinity_preferredDuringSchedulingIgnoredDuringExecution_preference_matchExpressions = ConverterSpec{
-	ResourceName: "kubernetes_daemon_set_v1",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"key":      toString,
-		"operator": toString,
-		"values": func(a any) (cty.Value, error) {
-			l, ok := a.([]any)
-			if !ok {
-				return cty.Value{}, fmt.Errorf("expected slice, got: %T", a)
-			}
-			vl := make([]cty.Value, 0, len(l))
-			for _, val := range l {
-				v, err := toString(val)
-				if err != nil {
-					return cty.Value{}, err
-				}
-				vl = append(vl, v)
-			}
-			return cty.ListVal(vl), nil
-		},
-	},
-	Blocks: map[string]ConverterSpec{},
-}
-
-var kubernetesDaemonSetV1_spec_template_spec_affinity_nodeAffinity_requiredDuringSchedulingIgnoredDuringExecution = ConverterSpec{
-	ResourceName: "kubernetes_daemon_set_v1",
-	Attributes:   map[string]func(any) (cty.Value, error){},
-	Blocks: map[string]ConverterSpec{
-		"node_selector_term": kubernetesDaemonSetV1_spec_template_spec_affinity_nodeAffinity_requiredDuringSchedulingIgnoredDuringExecution_nodeSelectorTerm,
-	},
-}
-
-var kubernetesDaemonSetV1_spec_template_spec_affinity_nodeAffinity_requiredDuringSchedulingIgnoredDuringExecution_nodeSelectorTerm = ConverterSpec{
-	ResourceName: "kubernetes_daemon_set_v1",
-	Attributes:   map[string]func(any) (cty.Value, error){},
-	Blocks: map[string]ConverterSpec{
-		"match_expressions": kubernetesDaemonSetV1_spec_template_spec_affinity_nodeAffinity_requiredDuringSchedulingIgnoredDuringExecution_nodeSelectorTerm_matchExpressions,
-		"match_fields":      kubernetesDaemonSetV1_spec_template_spec_affinity_nodeAffinity_requiredDuringSchedulingIgnoredDuringExecution_nodeSelectorTerm_matchFields,
-	},
-}
-
-var kubernetesDaemonSetV1_spec_template_spec_affinity_nodeAffinity_requiredDuringSchedulingIgnoredDuringExecution_nodeSelectorTerm_matchFields = ConverterSpec{
-	ResourceName: "kubernetes_daemon_set_v1",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"key":      toString,
-		"operator": toString,
-		"values": func(a any) (cty.Value, error) {
-			l, ok := a.([]any)
-			if !ok {
-				return cty.Value{}, fmt.Errorf("expected slice, got: %T", a)
-			}
-			vl := make([]cty.Value, 0, len(l))
-			for _, val := range l {
-				v, err := toString(val)
-				if err != nil {
-					return cty.Value{}, err
-				}
-				vl = append(vl, v)
-			}
-			return cty.ListVal(vl), nil
-		},
-	},
-	Blocks: map[string]ConverterSpec{},
-}
-
-var kubernetesDaemonSetV1_spec_template_spec_affinity_nodeAffinity_requiredDuringSchedulingIgnoredDuringExecution_nodeSelectorTerm_matchExpressions = ConverterSpec{
-	ResourceName: "kubernetes_daemon_set_v1",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"key":      toString,
-		"operator": toString,
-		"values": func(a any) (cty.Value, error) {
-			l, ok := a.([]any)
-			if !ok {
-				return cty.Value{}, fmt.Errorf("expected slice, got: %T", a)
-			}
-			vl := make([]cty.Value, 0, len(l))
-			for _, val := range l {
-				v, err := toString(val)
-				if err != nil {
-					return cty.Value{}, err
-				}
-				vl = append(vl, v)
-			}
-			return cty.ListVal(vl), nil
-		},
-	},
-	Blocks: map[string]ConverterSpec{},
-}
-
-var kubernetesDaemonSetV1_spec_template_spec_toleration = ConverterSpec{
-	ResourceName: "kubernetes_daemon_set_v1",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"effect":             toString,
-		"key":                toString,
-		"operator":           toString,
-		"toleration_seconds": toString,
-		"value":              toString,
-	},
-	Blocks: map[string]ConverterSpec{},
-}
-
-var kubernetesDaemonSetV1_spec_template_spec_readinessGate = ConverterSpec{
-	ResourceName: "kubernetes_daemon_set_v1",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"condition_type": toString,
-	},
-	Blocks: map[string]ConverterSpec{},
-}
-
-var kubernetesDaemonSetV1_spec_template_spec_topologySpreadConstraint = ConverterSpec{
-	ResourceName: "kubernetes_daemon_set_v1",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"match_label_keys": func(a any) (cty.Value, error) {
-			l, ok := a.([]any)
-			if !ok {
-				return cty.Value{}, fmt.Errorf("expected slice, got: %T", a)
-			}
-			vl := make([]cty.Value, 0, len(l))
-			for _, val := range l {
-				v, err := toString(val)
-				if err != nil {
-					return cty.Value{}, err
-				}
-				vl = append(vl, v)
-			}
-			return cty.ListVal(vl), nil
-		},
-		"max_skew":             toInt,
-		"min_domains":          toInt,
-		"node_affinity_policy": toString,
-		"node_taints_policy":   toString,
-		"topology_key":         toString,
-		"when_unsatisfiable":   toString,
-	},
-	Blocks: map[string]ConverterSpec{
-		"label_selector": kubernetesDaemonSetV1_spec_template_spec_topologySpreadConstraint_labelSelector,
-	},
-}
-
-var kubernetesDaemonSetV1_spec_template_spec_topologySpreadConstraint_labelSelector = ConverterSpec{
-	ResourceName: "kubernetes_daemon_set_v1",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"match_labels": toStringMap,
-	},
-	Blocks: map[string]ConverterSpec{
-		"match_expressions": kubernetesDaemonSetV1_spec_template_spec_topologySpreadConstraint_labelSelector_matchExpressions,
-	},
-}
-
-var kubernetesDaemonSetV1_spec_template_spec_topologySpreadConstraint_labelSelector_matchExpressions = ConverterSpec{
-	ResourceName: "kubernetes_daemon_set_v1",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"key":      toString,
-		"operator": toString,
-		"values": func(a any) (cty.Value, error) {
-			l, ok := a.([]any)
-			if !ok {
-				return cty.Value{}, fmt.Errorf("expected slice, got: %T", a)
-			}
-			vl := make([]cty.Value, 0, len(l))
-			for _, val := range l {
-				v, err := toString(val)
-				if err != nil {
-					return cty.Value{}, err
-				}
-				vl = append(vl, v)
-			}
-			return cty.ListVal(vl), nil
-		},
-	},
-	Blocks: map[string]ConverterSpec{},
-}
-
-var kubernetesDaemonSetV1_spec_template_spec_securityContext = ConverterSpec{
-	ResourceName: "kubernetes_daemon_set_v1",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"fs_group":               toString,
-		"fs_group_change_policy": toString,
-		"run_as_group":           toString,
-		"run_as_non_root":        toBool,
-		"run_as_user":            toString,
-		"supplemental_groups": func(a any) (cty.Value, error) {
-			l, ok := a.([]any)
-			if !ok {
-				return cty.Value{}, fmt.Errorf("expected slice, got: %T", a)
-			}
-			vl := make([]cty.Value, 0, len(l))
-			for _, val := range l {
-				v, err := toInt(val)
-				if err != nil {
-					return cty.Value{}, err
-				}
-				vl = append(vl, v)
-			}
-			return cty.ListVal(vl), nil
-		},
-	},
-	Blocks: map[string]ConverterSpec{
-		"se_linux_options": kubernetesDaemonSetV1_spec_template_spec_securityContext_seLinuxOptions,
-		"seccomp_profile":  kubernetesDaemonSetV1_spec_template_spec_securityContext_seccompProfile,
-		"sysctl":           kubernetesDaemonSetV1_spec_template_spec_securityContext_sysctl,
-		"windows_options":  kubernetesDaemonSetV1_spec_template_spec_securityContext_windowsOptions,
-	},
-}
-
-var kubernetesDaemonSetV1_spec_template_spec_securityContext_sysctl = ConverterSpec{
-	ResourceName: "kubernetes_daemon_set_v1",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"name":  toString,
-		"value": toString,
-	},
-	Blocks: map[string]ConverterSpec{},
-}
-
-var kubernetesDaemonSetV1_spec_template_spec_securityContext_windowsOptions = ConverterSpec{
-	ResourceName: "kubernetes_daemon_set_v1",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"gmsa_credential_spec":      toString,
-		"gmsa_credential_spec_name": toString,
-		"host_process":              toBool,
-		"run_as_username":           toString,
-	},
-	Blocks: map[string]ConverterSpec{},
-}
-
-var kubernetesDaemonSetV1_spec_template_spec_securityContext_seLinuxOptions = ConverterSpec{
-	ResourceName: "kubernetes_daemon_set_v1",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"level": toString,
-		"role":  toString,
-		"type":  toString,
-		"user":  toString,
-	},
-	Blocks: map[string]ConverterSpec{},
-}
-
-var kubernetesDaemonSetV1_spec_template_spec_securityContext_seccompProfile = ConverterSpec{
-	ResourceName: "kubernetes_daemon_set_v1",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"localhost_profile": toString,
-		"type":              toString,
-	},
-	Blocks: map[string]ConverterSpec{},
-}
-
-var kubernetesDaemonSetV1_spec_template_spec_container = ConverterSpec{
-	ResourceName: "kubernetes_daemon_set_v1",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"args": func(a any) (cty.Value, error) {
-			l, ok := a.([]any)
-			if !ok {
-				return cty.Value{}, fmt.Errorf("expected slice, got: %T", a)
-			}
-			vl := make([]cty.Value, 0, len(l))
-			for _, val := range l {
-				v, err := toString(val)
-				if err != nil {
-					return cty.Value{}, err
-				}
-				vl = append(vl, v)
-			}
-			return cty.ListVal(vl), nil
-		},
-		"command": func(a any) (cty.Value, error) {
-			l, ok := a.([]any)
-			if !ok {
-				return cty.Value{}, fmt.Errorf("expected slice, got: %T", a)
-			}
-			vl := make([]cty.Value, 0, len(l))
-			for _, val := range l {
-				v, err := toString(val)
-				if err != nil {
-					return cty.Value{}, err
-				}
-				vl = append(vl, v)
-			}
-			return cty.ListVal(vl), nil
-		},
-		"image":                      toString,
-		"image_pull_policy":          toString,
-		"name":                       toString,
-		"stdin":                      toBool,
-		"stdin_once":                 toBool,
-		"termination_message_path":   toString,
-		"termination_message_policy": toString,
-		"tty":                        toBool,
-		"working_dir":                toString,
-	},
-	Blocks: map[string]ConverterSpec{
-		"env":              kubernetesDaemonSetV1_spec_template_spec_container_env,
-		"env_from":         kubernetesDaemonSetV1_spec_template_spec_container_envFrom,
-		"lifecycle":        kubernetesDaemonSetV1_spec_template_spec_container_lifecycle,
-		"liveness_probe":   kubernetesDaemonSetV1_spec_template_spec_container_livenessProbe,
-		"port":             kubernetesDaemonSetV1_spec_template_spec_container_port,
-		"readiness_probe":  kubernetesDaemonSetV1_spec_template_spec_container_readinessProbe,
-		"resources":        kubernetesDaemonSetV1_spec_template_spec_container_resources,
-		"security_context": kubernetesDaemonSetV1_spec_template_spec_container_securityContext,
-		"startup_probe":    kubernetesDaemonSetV1_spec_template_spec_container_startupProbe,
-		"volume_device":    kubernetesDaemonSetV1_spec_template_spec_container_volumeDevice,
-		"volume_mount":     kubernetesDaemonSetV1_spec_template_spec_container_volumeMount,
-	},
-}
-
-var kubernetesDaemonSetV1_spec_template_spec_container_readinessProbe = ConverterSpec{
-	ResourceName: "kubernetes_daemon_set_v1",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"failure_threshold":     toInt,
-		"initial_delay_seconds": toInt,
-		"period_seconds":        toInt,
-		"success_threshold":     toInt,
-		"timeout_seconds":       toInt,
-	},
-	Blocks: map[string]ConverterSpec{
-		"exec":       kubernetesDaemonSetV1_spec_template_spec_container_readinessProbe_exec,
-		"grpc":       kubernetesDaemonSetV1_spec_template_spec_container_readinessProbe_grpc,
-		"http_get":   kubernetesDaemonSetV1_spec_template_spec_container_readinessProbe_httpGet,
-		"tcp_socket": kubernetesDaemonSetV1_spec_template_spec_container_readinessProbe_tcpSocket,
-	},
-}
-
-var kubernetesDaemonSetV1_spec_template_spec_container_readinessProbe_httpGet = ConverterSpec{
-	ResourceName: "kubernetes_daemon_set_v1",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"host":   toString,
-		"path":   toString,
-		"port":   toString,
-		"scheme": toString,
-	},
-	Blocks: map[string]ConverterSpec{
-		"http_header": kubernetesDaemonSetV1_spec_template_spec_container_readinessProbe_httpGet_httpHeader,
-	},
-}
-
-var kubernetesDaemonSetV1_spec_template_spec_container_readinessProbe_httpGet_httpHeader = ConverterSpec{
-	ResourceName: "kubernetes_daemon_set_v1",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"name":  toString,
-		"value": toString,
-	},
-	Blocks: map[string]ConverterSpec{},
-}
-
-var kubernetesDaemonSetV1_spec_template_spec_container_readinessProbe_exec = ConverterSpec{
-	ResourceName: "kubernetes_daemon_set_v1",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"command": func(a any) (cty.Value, error) {
-			l, ok := a.([]any)
-			if !ok {
-				return cty.Value{}, fmt.Errorf("expected slice, got: %T", a)
-			}
-			vl := make([]cty.Value, 0, len(l))
-			for _, val := range l {
-				v, err := toString(val)
-				if err != nil {
-					return cty.Value{}, err
-				}
-				vl = append(vl, v)
-			}
-			return cty.ListVal(vl), nil
-		},
-	},
-	Blocks: map[string]ConverterSpec{},
-}
-
-var kubernetesDaemonSetV1_spec_template_spec_container_readinessProbe_grpc = ConverterSpec{
-	ResourceName: "kubernetes_daemon_set_v1",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"port":    toInt,
-		"service": toString,
-	},
-	Blocks: map[string]ConverterSpec{},
-}
-
-var kubernetesDaemonSetV1_spec_template_spec_container_readinessProbe_tcpSocket = ConverterSpec{
-	ResourceName: "kubernetes_daemon_set_v1",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"port": toString,
-	},
-	Blocks: map[string]ConverterSpec{},
-}
-
-var kubernetesDaemonSetV1_spec_template_spec_container_volumeMount = ConverterSpec{
-	ResourceName: "kubernetes_daemon_set_v1",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"mount_path":        toString,
-		"mount_propagation": toString,
-		"name":              toString,
-		"read_only":         toBool,
-		"sub_path":          toString,
-		"sub_path_expr":     toString,
-	},
-	Blocks: map[string]ConverterSpec{},
-}
-
-var kubernetesDaemonSetV1_spec_template_spec_container_startupProbe = ConverterSpec{
-	ResourceName: "kubernetes_daemon_set_v1",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"failure_threshold":     toInt,
-		"initial_delay_seconds": toInt,
-		"period_seconds":        toInt,
-		"success_threshold":     toInt,
-		"timeout_seconds":       toInt,
-	},
-	Blocks: map[string]ConverterSpec{
-		"exec":       kubernetesDaemonSetV1_spec_template_spec_container_startupProbe_exec,
-		"grpc":       kubernetesDaemonSetV1_spec_template_spec_container_startupProbe_grpc,
-		"http_get":   kubernetesDaemonSetV1_spec_template_spec_container_startupProbe_httpGet,
-		"tcp_socket": kubernetesDaemonSetV1_spec_template_spec_container_startupProbe_tcpSocket,
-	},
-}
-
-var kubernetesDaemonSetV1_spec_template_spec_container_startupProbe_exec = ConverterSpec{
-	ResourceName: "kubernetes_daemon_set_v1",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"command": func(a any) (cty.Value, error) {
-			l, ok := a.([]any)
-			if !ok {
-				return cty.Value{}, fmt.Errorf("expected slice, got: %T", a)
-			}
-			vl := make([]cty.Value, 0, len(l))
-			for _, val := range l {
-				v, err := toString(val)
-				if err != nil {
-					return cty.Value{}, err
-				}
-				vl = append(vl, v)
-			}
-			return cty.ListVal(vl), nil
-		},
-	},
-	Blocks: map[string]ConverterSpec{},
-}
-
-var kubernetesDaemonSetV1_spec_template_spec_container_startupProbe_httpGet = ConverterSpec{
-	ResourceName: "kubernetes_daemon_set_v1",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"host":   toString,
-		"path":   toString,
-		"port":   toString,
-		"scheme": toString,
-	},
-	Blocks: map[string]ConverterSpec{
-		"http_header": kubernetesDaemonSetV1_spec_template_spec_container_startupProbe_httpGet_httpHeader,
-	},
-}
-
-var kubernetesDaemonSetV1_spec_template_spec_container_startupProbe_httpGet_httpHeader = ConverterSpec{
-	ResourceName: "kubernetes_daemon_set_v1",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"name":  toString,
-		"value": toString,
-	},
-	Blocks: map[string]ConverterSpec{},
-}
-
-var kubernetesDaemonSetV1_spec_template_spec_container_startupProbe_grpc = ConverterSpec{
-	ResourceName: "kubernetes_daemon_set_v1",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"port":    toInt,
-		"service": toString,
-	},
-	Blocks: map[string]ConverterSpec{},
-}
-
-var kubernetesDaemonSetV1_spec_template_spec_container_startupProbe_tcpSocket = ConverterSpec{
-	ResourceName: "kubernetes_daemon_set_v1",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"port": toString,
-	},
-	Blocks: map[string]ConverterSpec{},
-}
-
-var kubernetesDaemonSetV1_spec_template_spec_container_livenessProbe = ConverterSpec{
-	ResourceName: "kubernetes_daemon_set_v1",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"failure_threshold":     toInt,
-		"initial_delay_seconds": toInt,
-		"period_seconds":        toInt,
-		"success_threshold":     toInt,
-		"timeout_seconds":       toInt,
-	},
-	Blocks: map[string]ConverterSpec{
-		"exec":       kubernetesDaemonSetV1_spec_template_spec_container_livenessProbe_exec,
-		"grpc":       kubernetesDaemonSetV1_spec_template_spec_container_livenessProbe_grpc,
-		"http_get":   kubernetesDaemonSetV1_spec_template_spec_container_livenessProbe_httpGet,
-		"tcp_socket": kubernetesDaemonSetV1_spec_template_spec_container_livenessProbe_tcpSocket,
-	},
-}
-
-var kubernetesDaemonSetV1_spec_template_spec_container_livenessProbe_exec = ConverterSpec{
-	ResourceName: "kubernetes_daemon_set_v1",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"command": func(a any) (cty.Value, error) {
-			l, ok := a.([]any)
-			if !ok {
-				return cty.Value{}, fmt.Errorf("expected slice, got: %T", a)
-			}
-			vl := make([]cty.Value, 0, len(l))
-			for _, val := range l {
-				v, err := toString(val)
-				if err != nil {
-					return cty.Value{}, err
-				}
-				vl = append(vl, v)
-			}
-			return cty.ListVal(vl), nil
-		},
-	},
-	Blocks: map[string]ConverterSpec{},
-}
-
-var kubernetesDaemonSetV1_spec_template_spec_container_livenessProbe_grpc = ConverterSpec{
-	ResourceName: "kubernetes_daemon_set_v1",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"port":    toInt,
-		"service": toString,
-	},
-	Blocks: map[string]ConverterSpec{},
-}
-
-var kubernetesDaemonSetV1_spec_template_spec_container_livenessProbe_tcpSocket = ConverterSpec{
-	ResourceName: "kubernetes_daemon_set_v1",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"port": toString,
-	},
-	Blocks: map[string]ConverterSpec{},
-}
-
-var kubernetesDaemonSetV1_spec_template_spec_container_livenessProbe_httpGet = ConverterSpec{
-	ResourceName: "kubernetes_daemon_set_v1",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"host":   toString,
-		"path":   toString,
-		"port":   toString,
-		"scheme": toString,
-	},
-	Blocks: map[string]ConverterSpec{
-		"http_header": kubernetesDaemonSetV1_spec_template_spec_container_livenessProbe_httpGet_httpHeader,
-	},
-}
-
-var kubernetesDaemonSetV1_spec_template_spec_container_livenessProbe_httpGet_httpHeader = ConverterSpec{
-	ResourceName: "kubernetes_daemon_set_v1",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"name":  toString,
-		"value": toString,
-	},
-	Blocks: map[string]ConverterSpec{},
-}
-
-var kubernetesDaemonSetV1_spec_template_spec_container_resources = ConverterSpec{
-	ResourceName: "kubernetes_daemon_set_v1",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"limits":   toStringMap,
-		"requests": toStringMap,
-	},
-	Blocks: map[string]ConverterSpec{},
-}
-
-var kubernetesDaemonSetV1_spec_template_spec_container_env = ConverterSpec{
-	ResourceName: "kubernetes_daemon_set_v1",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"name":  toString,
-		"value": toString,
-	},
-	Blocks: map[string]ConverterSpec{
-		"value_from": kubernetesDaemonSetV1_spec_template_spec_container_env_valueFrom,
-	},
-}
-
-var kubernetesDaemonSetV1_spec_template_spec_container_env_valueFrom = ConverterSpec{
-	ResourceName: "kubernetes_daemon_set_v1",
-	Attributes:   map[string]func(any) (cty.Value, error){},
-	Blocks: map[string]ConverterSpec{
-		"config_map_key_ref": kubernetesDaemonSetV1_spec_template_spec_container_env_valueFrom_configMapKeyRef,
-		"field_ref":          kubernetesDaemonSetV1_spec_template_spec_container_env_valueFrom_fieldRef,
-		"resource_field_ref": kubernetesDaemonSetV1_spec_template_spec_container_env_valueFrom_resourceFieldRef,
-		"secret_key_ref":     kubernetesDaemonSetV1_spec_template_spec_container_env_valueFrom_secretKeyRef,
-	},
-}
-
-var kubernetesDaemonSetV1_spec_template_spec_container_env_valueFrom_secretKeyRef = ConverterSpec{
-	ResourceName: "kubernetes_daemon_set_v1",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"key":      toString,
-		"name":     toString,
-		"optional": toBool,
-	},
-	Blocks: map[string]ConverterSpec{},
-}
-
-var kubernetesDaemonSetV1_spec_template_spec_container_env_valueFrom_resourceFieldRef = ConverterSpec{
-	ResourceName: "kubernetes_daemon_set_v1",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"container_name": toString,
-		"divisor":        toString,
-		"resource":       toString,
-	},
-	Blocks: map[string]ConverterSpec{},
-}
-
-var kubernetesDaemonSetV1_spec_template_spec_container_env_valueFrom_fieldRef = ConverterSpec{
-	ResourceName: "kubernetes_daemon_set_v1",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"api_version": toString,
-		"field_path":  toString,
-	},
-	Blocks: map[string]ConverterSpec{},
-}
-
-var kubernetesDaemonSetV1_spec_template_spec_container_env_valueFrom_configMapKeyRef = ConverterSpec{
-	ResourceName: "kubernetes_daemon_set_v1",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"key":      toString,
-		"name":     toString,
-		"optional": toBool,
-	},
-	Blocks: map[string]ConverterSpec{},
-}
-
-var kubernetesDaemonSetV1_spec_template_spec_container_volumeDevice = ConverterSpec{
-	ResourceName: "kubernetes_daemon_set_v1",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"device_path": toString,
-		"name":        toString,
-	},
-	Blocks: map[string]ConverterSpec{},
-}
-
-var kubernetesDaemonSetV1_spec_template_spec_container_securityContext = ConverterSpec{
-	ResourceName: "kubernetes_daemon_set_v1",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"allow_privilege_escalation": toBool,
-		"privileged":                 toBool,
-		"read_only_root_filesystem":  toBool,
-		"run_as_group":               toString,
-		"run_as_non_root":            toBool,
-		"run_as_user":                toString,
-	},
-	Blocks: map[string]ConverterSpec{
-		"capabilities":     kubernetesDaemonSetV1_spec_template_spec_container_securityContext_capabilities,
-		"se_linux_options": kubernetesDaemonSetV1_spec_template_spec_container_securityContext_seLinuxOptions,
-		"seccomp_profile":  kubernetesDaemonSetV1_spec_template_spec_container_securityContext_seccompProfile,
-	},
-}
-
-var kubernetesDaemonSetV1_spec_template_spec_container_securityContext_capabilities = ConverterSpec{
-	ResourceName: "kubernetes_daemon_set_v1",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"add": func(a any) (cty.Value, error) {
-			l, ok := a.([]any)
-			if !ok {
-				return cty.Value{}, fmt.Errorf("expected slice, got: %T", a)
-			}
-			vl := make([]cty.Value, 0, len(l))
-			for _, val := range l {
-				v, err := toString(val)
-				if err != nil {
-					return cty.Value{}, err
-				}
-				vl = append(vl, v)
-			}
-			return cty.ListVal(vl), nil
-		},
-		"drop": func(a any) (cty.Value, error) {
-			l, ok := a.([]any)
-			if !ok {
-				return cty.Value{}, fmt.Errorf("expected slice, got: %T", a)
-			}
-			vl := make([]cty.Value, 0, len(l))
-			for _, val := range l {
-				v, err := toString(val)
-				if err != nil {
-					return cty.Value{}, err
-				}
-				vl = append(vl, v)
-			}
-			return cty.ListVal(vl), nil
-		},
-	},
-	Blocks: map[string]ConverterSpec{},
-}
-
-var kubernetesDaemonSetV1_spec_template_spec_container_securityContext_seLinuxOptions = ConverterSpec{
-	ResourceName: "kubernetes_daemon_set_v1",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"level": toString,
-		"role":  toString,
-		"type":  toString,
-		"user":  toString,
-	},
-	Blocks: map[string]ConverterSpec{},
-}
-
-var kubernetesDaemonSetV1_spec_template_spec_container_securityContext_seccompProfile = ConverterSpec{
-	ResourceName: "kubernetes_daemon_set_v1",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"localhost_profile": toString,
-		"type":              toString,
-	},
-	Blocks: map[string]ConverterSpec{},
-}
-
-var kubernetesDaemonSetV1_spec_template_spec_container_port = ConverterSpec{
-	ResourceName: "kubernetes_daemon_set_v1",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"container_port": toInt,
-		"host_ip":        toString,
-		"host_port":      toInt,
-		"name":           toString,
-		"protocol":       toString,
-	},
-	Blocks: map[string]ConverterSpec{},
-}
-
-var kubernetesDaemonSetV1_spec_template_spec_container_lifecycle = ConverterSpec{
-	ResourceName: "kubernetes_daemon_set_v1",
-	Attributes:   map[string]func(any) (cty.Value, error){},
-	Blocks: map[string]ConverterSpec{
-		"post_start": kubernetesDaemonSetV1_spec_template_spec_container_lifecycle_postStart,
-		"pre_stop":   kubernetesDaemonSetV1_spec_template_spec_container_lifecycle_preStop,
-	},
-}
-
-var kubernetesDaemonSetV1_spec_template_spec_container_lifecycle_preStop = ConverterSpec{
-	ResourceName: "kubernetes_daemon_set_v1",
-	Attributes:   map[string]func(any) (cty.Value, error){},
-	Blocks: map[string]ConverterSpec{
-		"exec":       kubernetesDaemonSetV1_spec_template_spec_container_lifecycle_preStop_exec,
-		"http_get":   kubernetesDaemonSetV1_spec_template_spec_container_lifecycle_preStop_httpGet,
-		"tcp_socket": kubernetesDaemonSetV1_spec_template_spec_container_lifecycle_preStop_tcpSocket,
-	},
-}
-
-var kubernetesDaemonSetV1_spec_template_spec_container_lifecycle_preStop_tcpSocket = ConverterSpec{
-	ResourceName: "kubernetes_daemon_set_v1",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"port": toString,
-	},
-	Blocks: map[string]ConverterSpec{},
-}
-
-var kubernetesDaemonSetV1_spec_template_spec_container_lifecycle_preStop_httpGet = ConverterSpec{
-	ResourceName: "kubernetes_daemon_set_v1",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"host":   toString,
-		"path":   toString,
-		"port":   toString,
-		"scheme": toString,
-	},
-	Blocks: map[string]ConverterSpec{
-		"http_header": kubernetesDaemonSetV1_spec_template_spec_container_lifecycle_preStop_httpGet_httpHeader,
-	},
-}
-
-var kubernetesDaemonSetV1_spec_template_spec_container_lifecycle_preStop_httpGet_httpHeader = ConverterSpec{
-	ResourceName: "kubernetes_daemon_set_v1",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"name":  toString,
-		"value": toString,
-	},
-	Blocks: map[string]ConverterSpec{},
-}
-
-var kubernetesDaemonSetV1_spec_template_spec_container_lifecycle_preStop_exec = ConverterSpec{
-	ResourceName: "kubernetes_daemon_set_v1",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"command": func(a any) (cty.Value, error) {
-			l, ok := a.([]any)
-			if !ok {
-				return cty.Value{}, fmt.Errorf("expected slice, got: %T", a)
-			}
-			vl := make([]cty.Value, 0, len(l))
-			for _, val := range l {
-				v, err := toString(val)
-				if err != nil {
-					return cty.Value{}, err
-				}
-				vl = append(vl, v)
-			}
-			return cty.ListVal(vl), nil
-		},
-	},
-	Blocks: map[string]ConverterSpec{},
-}
-
-var kubernetesDaemonSetV1_spec_template_spec_container_lifecycle_postStart = ConverterSpec{
-	ResourceName: "kubernetes_daemon_set_v1",
-	Attributes:   map[string]func(any) (cty.Value, error){},
-	Blocks: map[string]ConverterSpec{
-		"exec":       kubernetesDaemonSetV1_spec_template_spec_container_lifecycle_postStart_exec,
-		"http_get":   kubernetesDaemonSetV1_spec_template_spec_container_lifecycle_postStart_httpGet,
-		"tcp_socket": kubernetesDaemonSetV1_spec_template_spec_container_lifecycle_postStart_tcpSocket,
-	},
-}
-
-var kubernetesDaemonSetV1_spec_template_spec_container_lifecycle_postStart_exec = ConverterSpec{
-	ResourceName: "kubernetes_daemon_set_v1",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"command": func(a any) (cty.Value, error) {
-			l, ok := a.([]any)
-			if !ok {
-				return cty.Value{}, fmt.Errorf("expected slice, got: %T", a)
-			}
-			vl := make([]cty.Value, 0, len(l))
-			for _, val := range l {
-				v, err := toString(val)
-				if err != nil {
-					return cty.Value{}, err
-				}
-				vl = append(vl, v)
-			}
-			return cty.ListVal(vl), nil
-		},
-	},
-	Blocks: map[string]ConverterSpec{},
-}
-
-var kubernetesDaemonSetV1_spec_template_spec_container_lifecycle_postStart_tcpSocket = ConverterSpec{
-	ResourceName: "kubernetes_daemon_set_v1",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"port": toString,
-	},
-	Blocks: map[string]ConverterSpec{},
-}
-
-var kubernetesDaemonSetV1_spec_template_spec_container_lifecycle_postStart_httpGet = ConverterSpec{
-	ResourceName: "kubernetes_daemon_set_v1",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"host":   toString,
-		"path":   toString,
-		"port":   toString,
-		"scheme": toString,
-	},
-	Blocks: map[string]ConverterSpec{
-		"http_header": kubernetesDaemonSetV1_spec_template_spec_container_lifecycle_postStart_httpGet_httpHeader,
-	},
-}
-
-var kubernetesDaemonSetV1_spec_template_spec_container_lifecycle_postStart_httpGet_httpHeader = ConverterSpec{
-	ResourceName: "kubernetes_daemon_set_v1",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"name":  toString,
-		"value": toString,
-	},
-	Blocks: map[string]ConverterSpec{},
-}
-
-var kubernetesDaemonSetV1_spec_template_spec_container_envFrom = ConverterSpec{
-	ResourceName: "kubernetes_daemon_set_v1",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"prefix": toString,
-	},
-	Blocks: map[string]ConverterSpec{
-		"config_map_ref": kubernetesDaemonSetV1_spec_template_spec_container_envFrom_configMapRef,
-		"secret_ref":     kubernetesDaemonSetV1_spec_template_spec_container_envFrom_secretRef,
-	},
-}
-
-var kubernetesDaemonSetV1_spec_template_spec_container_envFrom_configMapRef = ConverterSpec{
-	ResourceName: "kubernetes_daemon_set_v1",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"name":     toString,
-		"optional": toBool,
-	},
-	Blocks: map[string]ConverterSpec{},
-}
-
-var kubernetesDaemonSetV1_spec_template_spec_container_envFrom_secretRef = ConverterSpec{
-	ResourceName: "kubernetes_daemon_set_v1",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"name":     toString,
-		"optional": toBool,
-	},
-	Blocks: map[string]ConverterSpec{},
-}
-
-var kubernetesDaemonSetV1_spec_strategy = ConverterSpec{
-	ResourceName: "kubernetes_daemon_set_v1",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"type": toString,
-	},
-	Blocks: map[string]ConverterSpec{
-		"rolling_update": kubernetesDaemonSetV1_spec_strategy_rollingUpdate,
-	},
-}
-
-var kubernetesDaemonSetV1_spec_strategy_rollingUpdate = ConverterSpec{
-	ResourceName: "kubernetes_daemon_set_v1",
+var kubernetesDaemonSetV1_spec_template_spec_volume_emptyDir = ConverterSpec{
 	Attributes: map[string]func(any) (cty.Value, error){
-		"max_surge":       toString,
-		"max_unavailable": toString,
+		"medium":     toString,
+		"size_limit": toString,
 	},
-	Blocks: map[string]ConverterSpec{},
-}
-
-var kubernetesDaemonSetV1_metadata = ConverterSpec{
-	ResourceName: "kubernetes_daemon_set_v1",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"annotations":   toStringMap,
-		"generate_name": toString,
-		"labels":        toStringMap,
-		"name":          toString,
-		"namespace":     toString,
+	Schema: map[string]FieldSchema{
+		"medium":     {Optional: true, Description: "What type of storage medium should back this directory. The default is \"\" which means to use the node's default medium. Must be one of [\"\" \"Memory\" \"HugePages\" \"HugePages-2Mi\" \"HugePages-1Gi\"]. More info: https://kubernetes.io/docs/concepts/storage/volumes#emptydir", Default: cty.StringVal("")},
+		"size_limit": {Optional: true, Description: "Total amount of local storage required for this EmptyDir volume."},
 	},
-	Blocks: map[string]ConverterSpec{},
 }
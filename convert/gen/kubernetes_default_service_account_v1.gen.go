@@ -5,15 +5,9 @@ package gen
 // DO NOT EDIT.
 
 import (
-	"fmt"
-
 	"github.com/zclconf/go-cty/cty"
 )
 
-// Make sure resources with no list attributes don't cause build errors because
-// of an unused import.
-var _ = fmt.Sprintf
-
 func init() {
 	register("kubernetes_default_service_account_v1", kubernetesDefaultServiceAccountV1)
 }
@@ -26,33 +20,36 @@ var kubernetesDefaultServiceAccountV1 = ConverterSpec{
 	Blocks: map[string]ConverterSpec{
 		"image_pull_secret": kubernetesDefaultServiceAccountV1_imagePullSecret,
 		"metadata":          kubernetesDefaultServiceAccountV1_metadata,
-		"secret":            kubernetesDefaultServiceAccountV1_secret,
+		"secret":            kubernetesDefaultServiceAccountV1_imagePullSecret,
 	},
-}
-
-var kubernetesDefaultServiceAccountV1_metadata = ConverterSpec{
-	ResourceName: "kubernetes_default_service_account_v1",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"annotations": toStringMap,
-		"labels":      toStringMap,
-		"name":        toString,
-		"namespace":   toString,
+	Schema: map[string]FieldSchema{
+		"automount_service_account_token": {Optional: true, Description: "Enable automatic mounting of the service account token", Default: cty.BoolVal(true)},
+		"image_pull_secret":               {Optional: true, Description: "A list of references to secrets in the same namespace to use for pulling any images in pods that reference this Service Account. More info: https://kubernetes.io/docs/concepts/containers/images/#specifying-imagepullsecrets-on-a-pod"},
+		"metadata":                        {Required: true, MaxItems: 1, Description: "Standard service account's metadata. More info: https://github.com/kubernetes/community/blob/master/contributors/devel/sig-architecture/api-conventions.md#metadata"},
+		"secret":                          {Optional: true, Description: "A list of secrets allowed to be used by pods running using this Service Account. More info: https://kubernetes.io/docs/concepts/configuration/secret"},
 	},
-	Blocks: map[string]ConverterSpec{},
 }
 
-var kubernetesDefaultServiceAccountV1_secret = ConverterSpec{
-	ResourceName: "kubernetes_default_service_account_v1",
+var kubernetesDefaultServiceAccountV1_imagePullSecret = ConverterSpec{
 	Attributes: map[string]func(any) (cty.Value, error){
 		"name": toString,
 	},
-	Blocks: map[string]ConverterSpec{},
+	Schema: map[string]FieldSchema{
+		"name": {Optional: true, Description: "Name of the referent. More info: https://kubernetes.io/docs/concepts/overview/working-with-objects/names/#names"},
+	},
 }
 
-var kubernetesDefaultServiceAccountV1_imagePullSecret = ConverterSpec{
-	ResourceName: "kubernetes_default_service_account_v1",
+var kubernetesDefaultServiceAccountV1_metadata = ConverterSpec{
 	Attributes: map[string]func(any) (cty.Value, error){
-		"name": toString,
+		"annotations": toStringMap,
+		"labels":      toStringMap,
+		"name":        toString,
+		"namespace":   toString,
+	},
+	Schema: map[string]FieldSchema{
+		"annotations": {Optional: true, Description: "An unstructured key value map stored with the service account that may be used to store arbitrary metadata. More info: https://kubernetes.io/docs/concepts/overview/working-with-objects/annotations/"},
+		"labels":      {Optional: true, Description: "Map of string keys and values that can be used to organize and categorize (scope and select) the service account. May match selectors of replication controllers and services. More info: https://kubernetes.io/docs/concepts/overview/working-with-objects/labels/"},
+		"name":        {Optional: true, ForceNew: true, Description: "Name of the service account, must be unique. Cannot be updated. More info: https://kubernetes.io/docs/concepts/overview/working-with-objects/names/#names", Default: cty.StringVal("default")},
+		"namespace":   {Optional: true, ForceNew: true, Description: "Namespace defines the space within which name of the service account must be unique.", Default: cty.StringVal("default")},
 	},
-	Blocks: map[string]ConverterSpec{},
 }
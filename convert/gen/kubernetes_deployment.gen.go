@@ -5,15 +5,9 @@ package gen
 // DO NOT EDIT.
 
 import (
-	"fmt"
-
 	"github.com/zclconf/go-cty/cty"
 )
 
-// Make sure resources with no list attributes don't cause build errors because
-// of an unused import.
-var _ = fmt.Sprintf
-
 func init() {
 	register("kubernetes_deployment", kubernetesDeployment)
 }
@@ -24,2866 +18,12 @@ var kubernetesDeployment = ConverterSpec{
 		"wait_for_rollout": toBool,
 	},
 	Blocks: map[string]ConverterSpec{
-		"metadata": kubernetesDeployment_metadata,
-		"spec":     kubernetesDeployment_spec,
-	},
-}
-
-var kubernetesDeployment_spec = ConverterSpec{
-	ResourceName: "kubernetes_deployment",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"min_ready_seconds":         toInt,
-		"paused":                    toBool,
-		"progress_deadline_seconds": toInt,
-		"replicas":                  toString,
-		"revision_history_limit":    toInt,
-	},
-	Blocks: map[string]ConverterSpec{
-		"selector": kubernetesDeployment_spec_selector,
-		"strategy": kubernetesDeployment_spec_strategy,
-		"template": kubernetesDeployment_spec_template,
-	},
-}
-
-var kubernetesDeployment_spec_strategy = ConverterSpec{
-	ResourceName: "kubernetes_deployment",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"type": toString,
-	},
-	Blocks: map[string]ConverterSpec{
-		"rolling_update": kubernetesDeployment_spec_strategy_rollingUpdate,
-	},
-}
-
-var kubernetesDeployment_spec_strategy_rollingUpdate = ConverterSpec{
-	ResourceName: "kubernetes_deployment",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"max_surge":       toString,
-		"max_unavailable": toString,
-	},
-	Blocks: map[string]ConverterSpec{},
-}
-
-var kubernetesDeployment_spec_selector = ConverterSpec{
-	ResourceName: "kubernetes_deployment",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"match_labels": toStringMap,
-	},
-	Blocks: map[string]ConverterSpec{
-		"match_expressions": kubernetesDeployment_spec_selector_matchExpressions,
-	},
-}
-
-var kubernetesDeployment_spec_selector_matchExpressions = ConverterSpec{
-	ResourceName: "kubernetes_deployment",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"key":      toString,
-		"operator": toString,
-		"values": func(a any) (cty.Value, error) {
-			l, ok := a.([]any)
-			if !ok {
-				return cty.Value{}, fmt.Errorf("expected slice, got: %T", a)
-			}
-			vl := make([]cty.Value, 0, len(l))
-			for _, val := range l {
-				v, err := toString(val)
-				if err != nil {
-					return cty.Value{}, err
-				}
-				vl = append(vl, v)
-			}
-			return cty.ListVal(vl), nil
-		},
-	},
-	Blocks: map[string]ConverterSpec{},
-}
-
-var kubernetesDeployment_spec_template = ConverterSpec{
-	ResourceName: "kubernetes_deployment",
-	Attributes:   map[string]func(any) (cty.Value, error){},
-	Blocks: map[string]ConverterSpec{
-		"metadata": kubernetesDeployment_spec_template_metadata,
-		"spec":     kubernetesDeployment_spec_template_spec,
-	},
-}
-
-var kubernetesDeployment_spec_template_spec = ConverterSpec{
-	ResourceName: "kubernetes_deployment",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"active_deadline_seconds":          toInt,
-		"automount_service_account_token":  toBool,
-		"dns_policy":                       toString,
-		"enable_service_links":             toBool,
-		"host_ipc":                         toBool,
-		"host_network":                     toBool,
-		"host_pid":                         toBool,
-		"hostname":                         toString,
-		"node_name":                        toString,
-		"node_selector":                    toStringMap,
-		"priority_class_name":              toString,
-		"restart_policy":                   toString,
-		"runtime_class_name":               toString,
-		"scheduler_name":                   toString,
-		"service_account_name":             toString,
-		"share_process_namespace":          toBool,
-		"subdomain":                        toString,
-		"termination_grace_period_seconds": toInt,
-	},
-	Blocks: map[string]ConverterSpec{
-		"affinity":                   kubernetesDeployment_spec_template_spec_affinity,
-		"container":                  kubernetesDeployment_spec_template_spec_container,
-		"dns_config":                 kubernetesDeployment_spec_template_spec_dnsConfig,
-		"host_aliases":               kubernetesDeployment_spec_template_spec_hostAliases,
-		"image_pull_secrets":         kubernetesDeployment_spec_template_spec_imagePullSecrets,
-		"init_container":             kubernetesDeployment_spec_template_spec_initContainer,
-		"os":                         kubernetesDeployment_spec_template_spec_os,
-		"readiness_gate":             kubernetesDeployment_spec_template_spec_readinessGate,
-		"security_context":           kubernetesDeployment_spec_template_spec_securityContext,
-		"toleration":                 kubernetesDeployment_spec_template_spec_toleration,
-		"topology_spread_constraint": kubernetesDeployment_spec_template_spec_topologySpreadConstraint,
-		"volume":                     kubernetesDeployment_spec_template_spec_volume,
-	},
-}
-
-var kubernetesDeployment_spec_template_spec_topologySpreadConstraint = ConverterSpec{
-	ResourceName: "kubernetes_deployment",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"match_label_keys": func(a any) (cty.Value, error) {
-			l, ok := a.([]any)
-			if !ok {
-				return cty.Value{}, fmt.Errorf("expected slice, got: %T", a)
-			}
-			vl := make([]cty.Value, 0, len(l))
-			for _, val := range l {
-				v, err := toString(val)
-				if err != nil {
-					return cty.Value{}, err
-				}
-				vl = append(vl, v)
-			}
-			return cty.ListVal(vl), nil
-		},
-		"max_skew":             toInt,
-		"min_domains":          toInt,
-		"node_affinity_policy": toString,
-		"node_taints_policy":   toString,
-		"topology_key":         toString,
-		"when_unsatisfiable":   toString,
-	},
-	Blocks: map[string]ConverterSpec{
-		"label_selector": kubernetesDeployment_spec_template_spec_topologySpreadConstraint_labelSelector,
-	},
-}
-
-var kubernetesDeployment_spec_template_spec_topologySpreadConstraint_labelSelector = ConverterSpec{
-	ResourceName: "kubernetes_deployment",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"match_labels": toStringMap,
-	},
-	Blocks: map[string]ConverterSpec{
-		"match_expressions": kubernetesDeployment_spec_template_spec_topologySpreadConstraint_labelSelector_matchExpressions,
-	},
-}
-
-var kubernetesDeployment_spec_template_spec_topologySpreadConstraint_labelSelector_matchExpressions = ConverterSpec{
-	ResourceName: "kubernetes_deployment",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"key":      toString,
-		"operator": toString,
-		"values": func(a any) (cty.Value, error) {
-			l, ok := a.([]any)
-			if !ok {
-				return cty.Value{}, fmt.Errorf("expected slice, got: %T", a)
-			}
-			vl := make([]cty.Value, 0, len(l))
-			for _, val := range l {
-				v, err := toString(val)
-				if err != nil {
-					return cty.Value{}, err
-				}
-				vl = append(vl, v)
-			}
-			return cty.ListVal(vl), nil
-		},
-	},
-	Blocks: map[string]ConverterSpec{},
-}
-
-var kubernetesDeployment_spec_template_spec_toleration = ConverterSpec{
-	ResourceName: "kubernetes_deployment",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"effect":             toString,
-		"key":                toString,
-		"operator":           toString,
-		"toleration_seconds": toString,
-		"value":              toString,
-	},
-	Blocks: map[string]ConverterSpec{},
-}
-
-var kubernetesDeployment_spec_template_spec_container = ConverterSpec{
-	ResourceName: "kubernetes_deployment",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"args": func(a any) (cty.Value, error) {
-			l, ok := a.([]any)
-			if !ok {
-				return cty.Value{}, fmt.Errorf("expected slice, got: %T", a)
-			}
-			vl := make([]cty.Value, 0, len(l))
-			for _, val := range l {
-				v, err := toString(val)
-				if err != nil {
-					return cty.Value{}, err
-				}
-				vl = append(vl, v)
-			}
-			return cty.ListVal(vl), nil
-		},
-		"command": func(a any) (cty.Value, error) {
-			l, ok := a.([]any)
-			if !ok {
-				return cty.Value{}, fmt.Errorf("expected slice, got: %T", a)
-			}
-			vl := make([]cty.Value, 0, len(l))
-			for _, val := range l {
-				v, err := toString(val)
-				if err != nil {
-					return cty.Value{}, err
-				}
-				vl = append(vl, v)
-			}
-			return cty.ListVal(vl), nil
-		},
-		"image":                      toString,
-		"image_pull_policy":          toString,
-		"name":                       toString,
-		"stdin":                      toBool,
-		"stdin_once":                 toBool,
-		"termination_message_path":   toString,
-		"termination_message_policy": toString,
-		"tty":                        toBool,
-		"working_dir":                toString,
-	},
-	Blocks: map[string]ConverterSpec{
-		"env":              kubernetesDeployment_spec_template_spec_container_env,
-		"env_from":         kubernetesDeployment_spec_template_spec_container_envFrom,
-		"lifecycle":        kubernetesDeployment_spec_template_spec_container_lifecycle,
-		"liveness_probe":   kubernetesDeployment_spec_template_spec_container_livenessProbe,
-		"port":             kubernetesDeployment_spec_template_spec_container_port,
-		"readiness_probe":  kubernetesDeployment_spec_template_spec_container_readinessProbe,
-		"resources":        kubernetesDeployment_spec_template_spec_container_resources,
-		"security_context": kubernetesDeployment_spec_template_spec_container_securityContext,
-		"startup_probe":    kubernetesDeployment_spec_template_spec_container_startupProbe,
-		"volume_device":    kubernetesDeployment_spec_template_spec_container_volumeDevice,
-		"volume_mount":     kubernetesDeployment_spec_template_spec_container_volumeMount,
-	},
-}
-
-var kubernetesDeployment_spec_template_spec_container_livenessProbe = ConverterSpec{
-	ResourceName: "kubernetes_deployment",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"failure_threshold":     toInt,
-		"initial_delay_seconds": toInt,
-		"period_seconds":        toInt,
-		"success_threshold":     toInt,
-		"timeout_seconds":       toInt,
-	},
-	Blocks: map[string]ConverterSpec{
-		"exec":       kubernetesDeployment_spec_template_spec_container_livenessProbe_exec,
-		"grpc":       kubernetesDeployment_spec_template_spec_container_livenessProbe_grpc,
-		"http_get":   kubernetesDeployment_spec_template_spec_container_livenessProbe_httpGet,
-		"tcp_socket": kubernetesDeployment_spec_template_spec_container_livenessProbe_tcpSocket,
-	},
-}
-
-var kubernetesDeployment_spec_template_spec_container_livenessProbe_httpGet = ConverterSpec{
-	ResourceName: "kubernetes_deployment",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"host":   toString,
-		"path":   toString,
-		"port":   toString,
-		"scheme": toString,
-	},
-	Blocks: map[string]ConverterSpec{
-		"http_header": kubernetesDeployment_spec_template_spec_container_livenessProbe_httpGet_httpHeader,
-	},
-}
-
-var kubernetesDeployment_spec_template_spec_container_livenessProbe_httpGet_httpHeader = ConverterSpec{
-	ResourceName: "kubernetes_deployment",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"name":  toString,
-		"value": toString,
-	},
-	Blocks: map[string]ConverterSpec{},
-}
-
-var kubernetesDeployment_spec_template_spec_container_livenessProbe_grpc = ConverterSpec{
-	ResourceName: "kubernetes_deployment",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"port":    toInt,
-		"service": toString,
-	},
-	Blocks: map[string]ConverterSpec{},
-}
-
-var kubernetesDeployment_spec_template_spec_container_livenessProbe_tcpSocket = ConverterSpec{
-	ResourceName: "kubernetes_deployment",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"port": toString,
-	},
-	Blocks: map[string]ConverterSpec{},
-}
-
-var kubernetesDeployment_spec_template_spec_container_livenessProbe_exec = ConverterSpec{
-	ResourceName: "kubernetes_deployment",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"command": func(a any) (cty.Value, error) {
-			l, ok := a.([]any)
-			if !ok {
-				return cty.Value{}, fmt.Errorf("expected slice, got: %T", a)
-			}
-			vl := make([]cty.Value, 0, len(l))
-			for _, val := range l {
-				v, err := toString(val)
-				if err != nil {
-					return cty.Value{}, err
-				}
-				vl = append(vl, v)
-			}
-			return cty.ListVal(vl), nil
-		},
-	},
-	Blocks: map[string]ConverterSpec{},
-}
-
-var kubernetesDeployment_spec_template_spec_container_lifecycle = ConverterSpec{
-	ResourceName: "kubernetes_deployment",
-	Attributes:   map[string]func(any) (cty.Value, error){},
-	Blocks: map[string]ConverterSpec{
-		"post_start": kubernetesDeployment_spec_template_spec_container_lifecycle_postStart,
-		"pre_stop":   kubernetesDeployment_spec_template_spec_container_lifecycle_preStop,
-	},
-}
-
-var kubernetesDeployment_spec_template_spec_container_lifecycle_preStop = ConverterSpec{
-	ResourceName: "kubernetes_deployment",
-	Attributes:   map[string]func(any) (cty.Value, error){},
-	Blocks: map[string]ConverterSpec{
-		"exec":       kubernetesDeployment_spec_template_spec_container_lifecycle_preStop_exec,
-		"http_get":   kubernetesDeployment_spec_template_spec_container_lifecycle_preStop_httpGet,
-		"tcp_socket": kubernetesDeployment_spec_template_spec_container_lifecycle_preStop_tcpSocket,
-	},
-}
-
-var kubernetesDeployment_spec_template_spec_container_lifecycle_preStop_tcpSocket = ConverterSpec{
-	ResourceName: "kubernetes_deployment",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"port": toString,
-	},
-	Blocks: map[string]ConverterSpec{},
-}
-
-var kubernetesDeployment_spec_template_spec_container_lifecycle_preStop_httpGet = ConverterSpec{
-	ResourceName: "kubernetes_deployment",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"host":   toString,
-		"path":   toString,
-		"port":   toString,
-		"scheme": toString,
-	},
-	Blocks: map[string]ConverterSpec{
-		"http_header": kubernetesDeployment_spec_template_spec_container_lifecycle_preStop_httpGet_httpHeader,
-	},
-}
-
-var kubernetesDeployment_spec_template_spec_container_lifecycle_preStop_httpGet_httpHeader = ConverterSpec{
-	ResourceName: "kubernetes_deployment",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"name":  toString,
-		"value": toString,
-	},
-	Blocks: map[string]ConverterSpec{},
-}
-
-var kubernetesDeployment_spec_template_spec_container_lifecycle_preStop_exec = ConverterSpec{
-	ResourceName: "kubernetes_deployment",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"command": func(a any) (cty.Value, error) {
-			l, ok := a.([]any)
-			if !ok {
-				return cty.Value{}, fmt.Errorf("expected slice, got: %T", a)
-			}
-			vl := make([]cty.Value, 0, len(l))
-			for _, val := range l {
-				v, err := toString(val)
-				if err != nil {
-					return cty.Value{}, err
-				}
-				vl = append(vl, v)
-			}
-			return cty.ListVal(vl), nil
-		},
-	},
-	Blocks: map[string]ConverterSpec{},
-}
-
-var kubernetesDeployment_spec_template_spec_container_lifecycle_postStart = ConverterSpec{
-	ResourceName: "kubernetes_deployment",
-	Attributes:   map[string]func(any) (cty.Value, error){},
-	Blocks: map[string]ConverterSpec{
-		"exec":       kubernetesDeployment_spec_template_spec_container_lifecycle_postStart_exec,
-		"http_get":   kubernetesDeployment_spec_template_spec_container_lifecycle_postStart_httpGet,
-		"tcp_socket": kubernetesDeployment_spec_template_spec_container_lifecycle_postStart_tcpSocket,
-	},
-}
-
-var kubernetesDeployment_spec_template_spec_container_lifecycle_postStart_tcpSocket = ConverterSpec{
-	ResourceName: "kubernetes_deployment",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"port": toString,
-	},
-	Blocks: map[string]ConverterSpec{},
-}
-
-var kubernetesDeployment_spec_template_spec_container_lifecycle_postStart_httpGet = ConverterSpec{
-	ResourceName: "kubernetes_deployment",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"host":   toString,
-		"path":   toString,
-		"port":   toString,
-		"scheme": toString,
-	},
-	Blocks: map[string]ConverterSpec{
-		"http_header": kubernetesDeployment_spec_template_spec_container_lifecycle_postStart_httpGet_httpHeader,
-	},
-}
-
-var kubernetesDeployment_spec_template_spec_container_lifecycle_postStart_httpGet_httpHeader = ConverterSpec{
-	ResourceName: "kubernetes_deployment",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"name":  toString,
-		"value": toString,
-	},
-	Blocks: map[string]ConverterSpec{},
-}
-
-var kubernetesDeployment_spec_template_spec_container_lifecycle_postStart_exec = ConverterSpec{
-	ResourceName: "kubernetes_deployment",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"command": func(a any) (cty.Value, error) {
-			l, ok := a.([]any)
-			if !ok {
-				return cty.Value{}, fmt.Errorf("expected slice, got: %T", a)
-			}
-			vl := make([]cty.Value, 0, len(l))
-			for _, val := range l {
-				v, err := toString(val)
-				if err != nil {
-					return cty.Value{}, err
-				}
-				vl = append(vl, v)
-			}
-			return cty.ListVal(vl), nil
-		},
-	},
-	Blocks: map[string]ConverterSpec{},
-}
-
-var kubernetesDeployment_spec_template_spec_container_env = ConverterSpec{
-	ResourceName: "kubernetes_deployment",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"name":  toString,
-		"value": toString,
-	},
-	Blocks: map[string]ConverterSpec{
-		"value_from": kubernetesDeployment_spec_template_spec_container_env_valueFrom,
-	},
-}
-
-var kubernetesDeployment_spec_template_spec_container_env_valueFrom = ConverterSpec{
-	ResourceName: "kubernetes_deployment",
-	Attributes:   map[string]func(any) (cty.Value, error){},
-	Blocks: map[string]ConverterSpec{
-		"config_map_key_ref": kubernetesDeployment_spec_template_spec_container_env_valueFrom_configMapKeyRef,
-		"field_ref":          kubernetesDeployment_spec_template_spec_container_env_valueFrom_fieldRef,
-		"resource_field_ref": kubernetesDeployment_spec_template_spec_container_env_valueFrom_resourceFieldRef,
-		"secret_key_ref":     kubernetesDeployment_spec_template_spec_container_env_valueFrom_secretKeyRef,
-	},
-}
-
-var kubernetesDeployment_spec_template_spec_container_env_valueFrom_secretKeyRef = ConverterSpec{
-	ResourceName: "kubernetes_deployment",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"key":      toString,
-		"name":     toString,
-		"optional": toBool,
-	},
-	Blocks: map[string]ConverterSpec{},
-}
-
-var kubernetesDeployment_spec_template_spec_container_env_valueFrom_resourceFieldRef = ConverterSpec{
-	ResourceName: "kubernetes_deployment",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"container_name": toString,
-		"divisor":        toString,
-		"resource":       toString,
-	},
-	Blocks: map[string]ConverterSpec{},
-}
-
-var kubernetesDeployment_spec_template_spec_container_env_valueFrom_fieldRef = ConverterSpec{
-	ResourceName: "kubernetes_deployment",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"api_version": toString,
-		"field_path":  toString,
-	},
-	Blocks: map[string]ConverterSpec{},
-}
-
-var kubernetesDeployment_spec_template_spec_container_env_valueFrom_configMapKeyRef = ConverterSpec{
-	ResourceName: "kubernetes_deployment",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"key":      toString,
-		"name":     toString,
-		"optional": toBool,
-	},
-	Blocks: map[string]ConverterSpec{},
-}
-
-var kubernetesDeployment_spec_template_spec_container_envFrom = ConverterSpec{
-	ResourceName: "kubernetes_deployment",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"prefix": toString,
-	},
-	Blocks: map[string]ConverterSpec{
-		"config_map_ref": kubernetesDeployment_spec_template_spec_container_envFrom_configMapRef,
-		"secret_ref":     kubernetesDeployment_spec_template_spec_container_envFrom_secretRef,
-	},
-}
-
-var kubernetesDeployment_spec_template_spec_container_envFrom_secretRef = ConverterSpec{
-	ResourceName: "kubernetes_deployment",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"name":     toString,
-		"optional": toBool,
-	},
-	Blocks: map[string]ConverterSpec{},
-}
-
-var kubernetesDeployment_spec_template_spec_container_envFrom_configMapRef = ConverterSpec{
-	ResourceName: "kubernetes_deployment",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"name":     toString,
-		"optional": toBool,
-	},
-	Blocks: map[string]ConverterSpec{},
-}
-
-var kubernetesDeployment_spec_template_spec_container_volumeDevice = ConverterSpec{
-	ResourceName: "kubernetes_deployment",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"device_path": toString,
-		"name":        toString,
-	},
-	Blocks: map[string]ConverterSpec{},
-}
-
-var kubernetesDeployment_spec_template_spec_container_startupProbe = ConverterSpec{
-	ResourceName: "kubernetes_deployment",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"failure_threshold":     toInt,
-		"initial_delay_seconds": toInt,
-		"period_seconds":        toInt,
-		"success_threshold":     toInt,
-		"timeout_seconds":       toInt,
-	},
-	Blocks: map[string]ConverterSpec{
-		"exec":       kubernetesDeployment_spec_template_spec_container_startupProbe_exec,
-		"grpc":       kubernetesDeployment_spec_template_spec_container_startupProbe_grpc,
-		"http_get":   kubernetesDeployment_spec_template_spec_container_startupProbe_httpGet,
-		"tcp_socket": kubernetesDeployment_spec_template_spec_container_startupProbe_tcpSocket,
-	},
-}
-
-var kubernetesDeployment_spec_template_spec_container_startupProbe_grpc = ConverterSpec{
-	ResourceName: "kubernetes_deployment",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"port":    toInt,
-		"service": toString,
-	},
-	Blocks: map[string]ConverterSpec{},
-}
-
-var kubernetesDeployment_spec_template_spec_container_startupProbe_tcpSocket = ConverterSpec{
-	ResourceName: "kubernetes_deployment",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"port": toString,
-	},
-	Blocks: map[string]ConverterSpec{},
-}
-
-var kubernetesDeployment_spec_template_spec_container_startupProbe_httpGet = ConverterSpec{
-	ResourceName: "kubernetes_deployment",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"host":   toString,
-		"path":   toString,
-		"port":   toString,
-		"scheme": toString,
-	},
-	Blocks: map[string]ConverterSpec{
-		"http_header": kubernetesDeployment_spec_template_spec_container_startupProbe_httpGet_httpHeader,
-	},
-}
-
-var kubernetesDeployment_spec_template_spec_container_startupProbe_httpGet_httpHeader = ConverterSpec{
-	ResourceName: "kubernetes_deployment",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"name":  toString,
-		"value": toString,
+		"metadata": kubernetesDeploymentV1_metadata,
+		"spec":     kubernetesDeploymentV1_spec,
 	},
-	Blocks: map[string]ConverterSpec{},
-}
-
-var kubernetesDeployment_spec_template_spec_container_startupProbe_exec = ConverterSpec{
-	ResourceName: "kubernetes_deployment",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"command": func(a any) (cty.Value, error) {
-			l, ok := a.([]any)
-			if !ok {
-				return cty.Value{}, fmt.Errorf("expected slice, got: %T", a)
-			}
-			vl := make([]cty.Value, 0, len(l))
-			for _, val := range l {
-				v, err := toString(val)
-				if err != nil {
-					return cty.Value{}, err
-				}
-				vl = append(vl, v)
-			}
-			return cty.ListVal(vl), nil
-		},
-	},
-	Blocks: map[string]ConverterSpec{},
-}
-
-var kubernetesDeployment_spec_template_spec_container_volumeMount = ConverterSpec{
-	ResourceName: "kubernetes_deployment",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"mount_path":        toString,
-		"mount_propagation": toString,
-		"name":              toString,
-		"read_only":         toBool,
-		"sub_path":          toString,
-		"sub_path_expr":     toString,
-	},
-	Blocks: map[string]ConverterSpec{},
-}
-
-var kubernetesDeployment_spec_template_spec_container_port = ConverterSpec{
-	ResourceName: "kubernetes_deployment",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"container_port": toInt,
-		"host_ip":        toString,
-		"host_port":      toInt,
-		"name":           toString,
-		"protocol":       toString,
-	},
-	Blocks: map[string]ConverterSpec{},
-}
-
-var kubernetesDeployment_spec_template_spec_container_securityContext = ConverterSpec{
-	ResourceName: "kubernetes_deployment",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"allow_privilege_escalation": toBool,
-		"privileged":                 toBool,
-		"read_only_root_filesystem":  toBool,
-		"run_as_group":               toString,
-		"run_as_non_root":            toBool,
-		"run_as_user":                toString,
-	},
-	Blocks: map[string]ConverterSpec{
-		"capabilities":     kubernetesDeployment_spec_template_spec_container_securityContext_capabilities,
-		"se_linux_options": kubernetesDeployment_spec_template_spec_container_securityContext_seLinuxOptions,
-		"seccomp_profile":  kubernetesDeployment_spec_template_spec_container_securityContext_seccompProfile,
-	},
-}
-
-var kubernetesDeployment_spec_template_spec_container_securityContext_seccompProfile = ConverterSpec{
-	ResourceName: "kubernetes_deployment",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"localhost_profile": toString,
-		"type":              toString,
-	},
-	Blocks: map[string]ConverterSpec{},
-}
-
-var kubernetesDeployment_spec_template_spec_container_securityContext_seLinuxOptions = ConverterSpec{
-	ResourceName: "kubernetes_deployment",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"level": toString,
-		"role":  toString,
-		"type":  toString,
-		"user":  toString,
-	},
-	Blocks: map[string]ConverterSpec{},
-}
-
-var kubernetesDeployment_spec_template_spec_container_securityContext_capabilities = ConverterSpec{
-	ResourceName: "kubernetes_deployment",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"add": func(a any) (cty.Value, error) {
-			l, ok := a.([]any)
-			if !ok {
-				return cty.Value{}, fmt.Errorf("expected slice, got: %T", a)
-			}
-			vl := make([]cty.Value, 0, len(l))
-			for _, val := range l {
-				v, err := toString(val)
-				if err != nil {
-					return cty.Value{}, err
-				}
-				vl = append(vl, v)
-			}
-			return cty.ListVal(vl), nil
-		},
-		"drop": func(a any) (cty.Value, error) {
-			l, ok := a.([]any)
-			if !ok {
-				return cty.Value{}, fmt.Errorf("expected slice, got: %T", a)
-			}
-			vl := make([]cty.Value, 0, len(l))
-			for _, val := range l {
-				v, err := toString(val)
-				if err != nil {
-					return cty.Value{}, err
-				}
-				vl = append(vl, v)
-			}
-			return cty.ListVal(vl), nil
-		},
-	},
-	Blocks: map[string]ConverterSpec{},
-}
-
-var kubernetesDeployment_spec_template_spec_container_resources = ConverterSpec{
-	ResourceName: "kubernetes_deployment",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"limits":   toStringMap,
-		"requests": toStringMap,
-	},
-	Blocks: map[string]ConverterSpec{},
-}
-
-var kubernetesDeployment_spec_template_spec_container_readinessProbe = ConverterSpec{
-	ResourceName: "kubernetes_deployment",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"failure_threshold":     toInt,
-		"initial_delay_seconds": toInt,
-		"period_seconds":        toInt,
-		"success_threshold":     toInt,
-		"timeout_seconds":       toInt,
-	},
-	Blocks: map[string]ConverterSpec{
-		"exec":       kubernetesDeployment_spec_template_spec_container_readinessProbe_exec,
-		"grpc":       kubernetesDeployment_spec_template_spec_container_readinessProbe_grpc,
-		"http_get":   kubernetesDeployment_spec_template_spec_container_readinessProbe_httpGet,
-		"tcp_socket": kubernetesDeployment_spec_template_spec_container_readinessProbe_tcpSocket,
-	},
-}
-
-var kubernetesDeployment_spec_template_spec_container_readinessProbe_grpc = ConverterSpec{
-	ResourceName: "kubernetes_deployment",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"port":    toInt,
-		"service": toString,
-	},
-	Blocks: map[string]ConverterSpec{},
-}
-
-var kubernetesDeployment_spec_template_spec_container_readinessProbe_tcpSocket = ConverterSpec{
-	ResourceName: "kubernetes_deployment",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"port": toString,
-	},
-	Blocks: map[string]ConverterSpec{},
-}
-
-var kubernetesDeployment_spec_template_spec_container_readinessProbe_httpGet = ConverterSpec{
-	ResourceName: "kubernetes_deployment",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"host":   toString,
-		"path":   toString,
-		"port":   toString,
-		"scheme": toString,
-	},
-	Blocks: map[string]ConverterSpec{
-		"http_header": kubernetesDeployment_spec_template_spec_container_readinessProbe_httpGet_httpHeader,
-	},
-}
-
-var kubernetesDeployment_spec_template_spec_container_readinessProbe_httpGet_httpHeader = ConverterSpec{
-	ResourceName: "kubernetes_deployment",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"name":  toString,
-		"value": toString,
-	},
-	Blocks: map[string]ConverterSpec{},
-}
-
-var kubernetesDeployment_spec_template_spec_container_readinessProbe_exec = ConverterSpec{
-	ResourceName: "kubernetes_deployment",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"command": func(a any) (cty.Value, error) {
-			l, ok := a.([]any)
-			if !ok {
-				return cty.Value{}, fmt.Errorf("expected slice, got: %T", a)
-			}
-			vl := make([]cty.Value, 0, len(l))
-			for _, val := range l {
-				v, err := toString(val)
-				if err != nil {
-					return cty.Value{}, err
-				}
-				vl = append(vl, v)
-			}
-			return cty.ListVal(vl), nil
-		},
-	},
-	Blocks: map[string]ConverterSpec{},
-}
-
-var kubernetesDeployment_spec_template_spec_securityContext = ConverterSpec{
-	ResourceName: "kubernetes_deployment",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"fs_group":               toString,
-		"fs_group_change_policy": toString,
-		"run_as_group":           toString,
-		"run_as_non_root":        toBool,
-		"run_as_user":            toString,
-		"supplemental_groups": func(a any) (cty.Value, error) {
-			l, ok := a.([]any)
-			if !ok {
-				return cty.Value{}, fmt.Errorf("expected slice, got: %T", a)
-			}
-			vl := make([]cty.Value, 0, len(l))
-			for _, val := range l {
-				v, err := toInt(val)
-				if err != nil {
-					return cty.Value{}, err
-				}
-				vl = append(vl, v)
-			}
-			return cty.ListVal(vl), nil
-		},
-	},
-	Blocks: map[string]ConverterSpec{
-		"se_linux_options": kubernetesDeployment_spec_template_spec_securityContext_seLinuxOptions,
-		"seccomp_profile":  kubernetesDeployment_spec_template_spec_securityContext_seccompProfile,
-		"sysctl":           kubernetesDeployment_spec_template_spec_securityContext_sysctl,
-		"windows_options":  kubernetesDeployment_spec_template_spec_securityContext_windowsOptions,
-	},
-}
-
-var kubernetesDeployment_spec_template_spec_securityContext_seLinuxOptions = ConverterSpec{
-	ResourceName: "kubernetes_deployment",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"level": toString,
-		"role":  toString,
-		"type":  toString,
-		"user":  toString,
-	},
-	Blocks: map[string]ConverterSpec{},
-}
-
-var kubernetesDeployment_spec_template_spec_securityContext_windowsOptions = ConverterSpec{
-	ResourceName: "kubernetes_deployment",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"gmsa_credential_spec":      toString,
-		"gmsa_credential_spec_name": toString,
-		"host_process":              toBool,
-		"run_as_username":           toString,
-	},
-	Blocks: map[string]ConverterSpec{},
-}
-
-var kubernetesDeployment_spec_template_spec_securityContext_seccompProfile = ConverterSpec{
-	ResourceName: "kubernetes_deployment",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"localhost_profile": toString,
-		"type":              toString,
-	},
-	Blocks: map[string]ConverterSpec{},
-}
-
-var kubernetesDeployment_spec_template_spec_securityContext_sysctl = ConverterSpec{
-	ResourceName: "kubernetes_deployment",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"name":  toString,
-		"value": toString,
-	},
-	Blocks: map[string]ConverterSpec{},
-}
-
-var kubernetesDeployment_spec_template_spec_imagePullSecrets = ConverterSpec{
-	ResourceName: "kubernetes_deployment",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"name": toString,
-	},
-	Blocks: map[string]ConverterSpec{},
-}
-
-var kubernetesDeployment_spec_template_spec_hostAliases = ConverterSpec{
-	ResourceName: "kubernetes_deployment",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"hostnames": func(a any) (cty.Value, error) {
-			l, ok := a.([]any)
-			if !ok {
-				return cty.Value{}, fmt.Errorf("expected slice, got: %T", a)
-			}
-			vl := make([]cty.Value, 0, len(l))
-			for _, val := range l {
-				v, err := toString(val)
-				if err != nil {
-					return cty.Value{}, err
-				}
-				vl = append(vl, v)
-			}
-			return cty.ListVal(vl), nil
-		},
-		"ip": toString,
-	},
-	Blocks: map[string]ConverterSpec{},
-}
-
-var kubernetesDeployment_spec_template_spec_readinessGate = ConverterSpec{
-	ResourceName: "kubernetes_deployment",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"condition_type": toString,
-	},
-	Blocks: map[string]ConverterSpec{},
-}
-
-var kubernetesDeployment_spec_template_spec_affinity = ConverterSpec{
-	ResourceName: "kubernetes_deployment",
-	Attributes:   map[string]func(any) (cty.Value, error){},
-	Blocks: map[string]ConverterSpec{
-		"node_affinity":     kubernetesDeployment_spec_template_spec_affinity_nodeAffinity,
-		"pod_affinity":      kubernetesDeployment_spec_template_spec_affinity_podAffinity,
-		"pod_anti_affinity": kubernetesDeployment_spec_template_spec_affinity_podAntiAffinity,
-	},
-}
-
-var kubernetesDeployment_spec_template_spec_affinity_podAntiAffinity = ConverterSpec{
-	ResourceName: "kubernetes_deployment",
-	Attributes:   map[string]func(any) (cty.Value, error){},
-	Blocks: map[string]ConverterSpec{
-		"preferred_during_scheduling_ignored_during_execution": kubernetesDeployment_spec_template_spec_affinity_podAntiAffinity_preferredDuringSchedulingIgnoredDuringExecution,
-		"required_during_scheduling_ignored_during_execution":  kubernetesDeployment_spec_template_spec_affinity_podAntiAffinity_requiredDuringSchedulingIgnoredDuringExecution,
-	},
-}
-
-var kubernetesDeployment_spec_template_spec_affinity_podAntiAffinity_preferredDuringSchedulingIgnoredDuringExecution = ConverterSpec{
-	ResourceName: "kubernetes_deployment",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"weight": toInt,
-	},
-	Blocks: map[string]ConverterSpec{
-		"pod_affinity_term": kubernetesDeployment_spec_template_spec_affinity_podAntiAffinity_preferredDuringSchedulingIgnoredDuringExecution_podAffinityTerm,
-	},
-}
-
-var kubernetesDeployment_spec_template_spec_affinity_podAntiAffinity_preferredDuringSchedulingIgnoredDuringExecution_podAffinityTerm = ConverterSpec{
-	ResourceName: "kubernetes_deployment",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"namespaces": func(a any) (cty.Value, error) {
-			l, ok := a.([]any)
-			if !ok {
-				return cty.Value{}, fmt.Errorf("expected slice, got: %T", a)
-			}
-			vl := make([]cty.Value, 0, len(l))
-			for _, val := range l {
-				v, err := toString(val)
-				if err != nil {
-					return cty.Value{}, err
-				}
-				vl = append(vl, v)
-			}
-			return cty.ListVal(vl), nil
-		},
-		"topology_key": toString,
-	},
-	Blocks: map[string]ConverterSpec{
-		"label_selector":     kubernetesDeployment_spec_template_spec_affinity_podAntiAffinity_preferredDuringSchedulingIgnoredDuringExecution_podAffinityTerm_labelSelector,
-		"namespace_selector": kubernetesDeployment_spec_template_spec_affinity_podAntiAffinity_preferredDuringSchedulingIgnoredDuringExecution_podAffinityTerm_namespaceSelector,
-	},
-}
-
-var kubernetesDeployment_spec_template_spec_affinity_podAntiAffinity_preferredDuringSchedulingIgnoredDuringExecution_podAffinityTerm_namespaceSelector = ConverterSpec{
-	ResourceName: "kubernetes_deployment",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"match_labels": toStringMap,
-	},
-	Blocks: map[string]ConverterSpec{
-		"match_expressions": kubernetesDeployment_spec_template_spec_affinity_podAntiAffinity_preferredDuringSchedulingIgnoredDuringExecution_podAffinityTerm_namespaceSelector_matchExpressions,
-	},
-}
-
-var kubernetesDeployment_spec_template_spec_affinity_podAntiAffinity_preferredDuringSchedulingIgnoredDuringExecution_podAffinityTerm_namespaceSelector_matchExpressions = ConverterSpec{
-	ResourceName: "kubernetes_deployment",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"key":      toString,
-		"operator": toString,
-		"values": func(a any) (cty.Value, error) {
-			l, ok := a.([]any)
-			if !ok {
-				return cty.Value{}, fmt.Errorf("expected slice, got: %T", a)
-			}
-			vl := make([]cty.Value, 0, len(l))
-			for _, val := range l {
-				v, err := toString(val)
-				if err != nil {
-					return cty.Value{}, err
-				}
-				vl = append(vl, v)
-			}
-			return cty.ListVal(vl), nil
-		},
-	},
-	Blocks: map[string]ConverterSpec{},
-}
-
-var kubernetesDeployment_spec_template_spec_affinity_podAntiAffinity_preferredDuringSchedulingIgnoredDuringExecution_podAffinityTerm_labelSelector = ConverterSpec{
-	ResourceName: "kubernetes_deployment",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"match_labels": toStringMap,
-	},
-	Blocks: map[string]ConverterSpec{
-		"match_expressions": kubernetesDeployment_spec_template_spec_affinity_podAntiAffinity_preferredDuringSchedulingIgnoredDuringExecution_podAffinityTerm_labelSelector_matchExpressions,
-	},
-}
-
-var kubernetesDeployment_spec_template_spec_affinity_podAntiAffinity_preferredDuringSchedulingIgnoredDuringExecution_podAffinityTerm_labelSelector_matchExpressions = ConverterSpec{
-	ResourceName: "kubernetes_deployment",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"key":      toString,
-		"operator": toString,
-		"values": func(a any) (cty.Value, error) {
-			l, ok := a.([]any)
-			if !ok {
-				return cty.Value{}, fmt.Errorf("expected slice, got: %T", a)
-			}
-			vl := make([]cty.Value, 0, len(l))
-			for _, val := range l {
-				v, err := toString(val)
-				if err != nil {
-					return cty.Value{}, err
-				}
-				vl = append(vl, v)
-			}
-			return cty.ListVal(vl), nil
-		},
-	},
-	Blocks: map[string]ConverterSpec{},
-}
-
-var kubernetesDeployment_spec_template_spec_affinity_podAntiAffinity_requiredDuringSchedulingIgnoredDuringExecution = ConverterSpec{
-	ResourceName: "kubernetes_deployment",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"namespaces": func(a any) (cty.Value, error) {
-			l, ok := a.([]any)
-			if !ok {
-				return cty.Value{}, fmt.Errorf("expected slice, got: %T", a)
-			}
-			vl := make([]cty.Value, 0, len(l))
-			for _, val := range l {
-				v, err := toString(val)
-				if err != nil {
-					return cty.Value{}, err
-				}
-				vl = append(vl, v)
-			}
-			return cty.ListVal(vl), nil
-		},
-		"topology_key": toString,
-	},
-	Blocks: map[string]ConverterSpec{
-		"label_selector":     kubernetesDeployment_spec_template_spec_affinity_podAntiAffinity_requiredDuringSchedulingIgnoredDuringExecution_labelSelector,
-		"namespace_selector": kubernetesDeployment_spec_template_spec_affinity_podAntiAffinity_requiredDuringSchedulingIgnoredDuringExecution_namespaceSelector,
-	},
-}
-
-var kubernetesDeployment_spec_template_spec_affinity_podAntiAffinity_requiredDuringSchedulingIgnoredDuringExecution_namespaceSelector = ConverterSpec{
-	ResourceName: "kubernetes_deployment",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"match_labels": toStringMap,
-	},
-	Blocks: map[string]ConverterSpec{
-		"match_expressions": kubernetesDeployment_spec_template_spec_affinity_podAntiAffinity_requiredDuringSchedulingIgnoredDuringExecution_namespaceSelector_matchExpressions,
-	},
-}
-
-var kubernetesDeployment_spec_template_spec_affinity_podAntiAffinity_requiredDuringSchedulingIgnoredDuringExecution_namespaceSelector_matchExpressions = ConverterSpec{
-	ResourceName: "kubernetes_deployment",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"key":      toString,
-		"operator": toString,
-		"values": func(a any) (cty.Value, error) {
-			l, ok := a.([]any)
-			if !ok {
-				return cty.Value{}, fmt.Errorf("expected slice, got: %T", a)
-			}
-			vl := make([]cty.Value, 0, len(l))
-			for _, val := range l {
-				v, err := toString(val)
-				if err != nil {
-					return cty.Value{}, err
-				}
-				vl = append(vl, v)
-			}
-			return cty.ListVal(vl), nil
-		},
-	},
-	Blocks: map[string]ConverterSpec{},
-}
-
-var kubernetesDeployment_spec_template_spec_affinity_podAntiAffinity_requiredDuringSchedulingIgnoredDuringExecution_labelSelector = ConverterSpec{
-	ResourceName: "kubernetes_deployment",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"match_labels": toStringMap,
-	},
-	Blocks: map[string]ConverterSpec{
-		"match_expressions": kubernetesDeployment_spec_template_spec_affinity_podAntiAffinity_requiredDuringSchedulingIgnoredDuringExecution_labelSelector_matchExpressions,
-	},
-}
-
-var kubernetesDeployment_spec_template_spec_affinity_podAntiAffinity_requiredDuringSchedulingIgnoredDuringExecution_labelSelector_matchExpressions = ConverterSpec{
-	ResourceName: "kubernetes_deployment",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"key":      toString,
-		"operator": toString,
-		"values": func(a any) (cty.Value, error) {
-			l, ok := a.([]any)
-			if !ok {
-				return cty.Value{}, fmt.Errorf("expected slice, got: %T", a)
-			}
-			vl := make([]cty.Value, 0, len(l))
-			for _, val := range l {
-				v, err := toString(val)
-				if err != nil {
-					return cty.Value{}, err
-				}
-				vl = append(vl, v)
-			}
-			return cty.ListVal(vl), nil
-		},
-	},
-	Blocks: map[string]ConverterSpec{},
-}
-
-var kubernetesDeployment_spec_template_spec_affinity_podAffinity = ConverterSpec{
-	ResourceName: "kubernetes_deployment",
-	Attributes:   map[string]func(any) (cty.Value, error){},
-	Blocks: map[string]ConverterSpec{
-		"preferred_during_scheduling_ignored_during_execution": kubernetesDeployment_spec_template_spec_affinity_podAffinity_preferredDuringSchedulingIgnoredDuringExecution,
-		"required_during_scheduling_ignored_during_execution":  kubernetesDeployment_spec_template_spec_affinity_podAffinity_requiredDuringSchedulingIgnoredDuringExecution,
-	},
-}
-
-var kubernetesDeployment_spec_template_spec_affinity_podAffinity_preferredDuringSchedulingIgnoredDuringExecution = ConverterSpec{
-	ResourceName: "kubernetes_deployment",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"weight": toInt,
-	},
-	Blocks: map[string]ConverterSpec{
-		"pod_affinity_term": kubernetesDeployment_spec_template_spec_affinity_podAffinity_preferredDuringSchedulingIgnoredDuringExecution_podAffinityTerm,
-	},
-}
-
-var kubernetesDeployment_spec_template_spec_affinity_podAffinity_preferredDuringSchedulingIgnoredDuringExecution_podAffinityTerm = ConverterSpec{
-	ResourceName: "kubernetes_deployment",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"namespaces": func(a any) (cty.Value, error) {
-			l, ok := a.([]any)
-			if !ok {
-				return cty.Value{}, fmt.Errorf("expected slice, got: %T", a)
-			}
-			vl := make([]cty.Value, 0, len(l))
-			for _, val := range l {
-				v, err := toString(val)
-				if err != nil {
-					return cty.Value{}, err
-				}
-				vl = append(vl, v)
-			}
-			return cty.ListVal(vl), nil
-		},
-		"topology_key": toString,
-	},
-	Blocks: map[string]ConverterSpec{
-		"label_selector":     kubernetesDeployment_spec_template_spec_affinity_podAffinity_preferredDuringSchedulingIgnoredDuringExecution_podAffinityTerm_labelSelector,
-		"namespace_selector": kubernetesDeployment_spec_template_spec_affinity_podAffinity_preferredDuringSchedulingIgnoredDuringExecution_podAffinityTerm_namespaceSelector,
-	},
-}
-
-var kubernetesDeployment_spec_template_spec_affinity_podAffinity_preferredDuringSchedulingIgnoredDuringExecution_podAffinityTerm_namespaceSelector = ConverterSpec{
-	ResourceName: "kubernetes_deployment",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"match_labels": toStringMap,
-	},
-	Blocks: map[string]ConverterSpec{
-		"match_expressions": kubernetesDeployment_spec_template_spec_affinity_podAffinity_preferredDuringSchedulingIgnoredDuringExecution_podAffinityTerm_namespaceSelector_matchExpressions,
-	},
-}
-
-var kubernetesDeployment_spec_template_spec_affinity_podAffinity_preferredDuringSchedulingIgnoredDuringExecution_podAffinityTerm_namespaceSelector_matchExpressions = ConverterSpec{
-	ResourceName: "kubernetes_deployment",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"key":      toString,
-		"operator": toString,
-		"values": func(a any) (cty.Value, error) {
-			l, ok := a.([]any)
-			if !ok {
-				return cty.Value{}, fmt.Errorf("expected slice, got: %T", a)
-			}
-			vl := make([]cty.Value, 0, len(l))
-			for _, val := range l {
-				v, err := toString(val)
-				if err != nil {
-					return cty.Value{}, err
-				}
-				vl = append(vl, v)
-			}
-			return cty.ListVal(vl), nil
-		},
-	},
-	Blocks: map[string]ConverterSpec{},
-}
-
-var kubernetesDeployment_spec_template_spec_affinity_podAffinity_preferredDuringSchedulingIgnoredDuringExecution_podAffinityTerm_labelSelector = ConverterSpec{
-	ResourceName: "kubernetes_deployment",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"match_labels": toStringMap,
-	},
-	Blocks: map[string]ConverterSpec{
-		"match_expressions": kubernetesDeployment_spec_template_spec_affinity_podAffinity_preferredDuringSchedulingIgnoredDuringExecution_podAffinityTerm_labelSelector_matchExpressions,
-	},
-}
-
-var kubernetesDeployment_spec_template_spec_affinity_podAffinity_preferredDuringSchedulingIgnoredDuringExecution_podAffinityTerm_labelSelector_matchExpressions = ConverterSpec{
-	ResourceName: "kubernetes_deployment",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"key":      toString,
-		"operator": toString,
-		"values": func(a any) (cty.Value, error) {
-			l, ok := a.([]any)
-			if !ok {
-				return cty.Value{}, fmt.Errorf("expected slice, got: %T", a)
-			}
-			vl := make([]cty.Value, 0, len(l))
-			for _, val := range l {
-				v, err := toString(val)
-				if err != nil {
-					return cty.Value{}, err
-				}
-				vl = append(vl, v)
-			}
-			return cty.ListVal(vl), nil
-		},
-	},
-	Blocks: map[string]ConverterSpec{},
-}
-
-var kubernetesDeployment_spec_template_spec_affinity_podAffinity_requiredDuringSchedulingIgnoredDuringExecution = ConverterSpec{
-	ResourceName: "kubernetes_deployment",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"namespaces": func(a any) (cty.Value, error) {
-			l, ok := a.([]any)
-			if !ok {
-				return cty.Value{}, fmt.Errorf("expected slice, got: %T", a)
-			}
-			vl := make([]cty.Value, 0, len(l))
-			for _, val := range l {
-				v, err := toString(val)
-				if err != nil {
-					return cty.Value{}, err
-				}
-				vl = append(vl, v)
-			}
-			return cty.ListVal(vl), nil
-		},
-		"topology_key": toString,
-	},
-	Blocks: map[string]ConverterSpec{
-		"label_selector":     kubernetesDeployment_spec_template_spec_affinity_podAffinity_requiredDuringSchedulingIgnoredDuringExecution_labelSelector,
-		"namespace_selector": kubernetesDeployment_spec_template_spec_affinity_podAffinity_requiredDuringSchedulingIgnoredDuringExecution_namespaceSelector,
-	},
-}
-
-var kubernetesDeployment_spec_template_spec_affinity_podAffinity_requiredDuringSchedulingIgnoredDuringExecution_namespaceSelector = ConverterSpec{
-	ResourceName: "kubernetes_deployment",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"match_labels": toStringMap,
-	},
-	Blocks: map[string]ConverterSpec{
-		"match_expressions": kubernetesDeployment_spec_template_spec_affinity_podAffinity_requiredDuringSchedulingIgnoredDuringExecution_namespaceSelector_matchExpressions,
-	},
-}
-
-var kubernetesDeployment_spec_template_spec_affinity_podAffinity_requiredDuringSchedulingIgnoredDuringExecution_namespaceSelector_matchExpressions = ConverterSpec{
-	ResourceName: "kubernetes_deployment",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"key":      toString,
-		"operator": toString,
-		"values": func(a any) (cty.Value, error) {
-			l, ok := a.([]any)
-			if !ok {
-				return cty.Value{}, fmt.Errorf("expected slice, got: %T", a)
-			}
-			vl := make([]cty.Value, 0, len(l))
-			for _, val := range l {
-				v, err := toString(val)
-				if err != nil {
-					return cty.Value{}, err
-				}
-				vl = append(vl, v)
-			}
-			return cty.ListVal(vl), nil
-		},
-	},
-	Blocks: map[string]ConverterSpec{},
-}
-
-var kubernetesDeployment_spec_template_spec_affinity_podAffinity_requiredDuringSchedulingIgnoredDuringExecution_labelSelector = ConverterSpec{
-	ResourceName: "kubernetes_deployment",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"match_labels": toStringMap,
-	},
-	Blocks: map[string]ConverterSpec{
-		"match_expressions": kubernetesDeployment_spec_template_spec_affinity_podAffinity_requiredDuringSchedulingIgnoredDuringExecution_labelSelector_matchExpressions,
-	},
-}
-
-var kubernetesDeployment_spec_template_spec_affinity_podAffinity_requiredDuringSchedulingIgnoredDuringExecution_labelSelector_matchExpressions = ConverterSpec{
-	ResourceName: "kubernetes_deployment",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"key":      toString,
-		"operator": toString,
-		"values": func(a any) (cty.Value, error) {
-			l, ok := a.([]any)
-			if !ok {
-				return cty.Value{}, fmt.Errorf("expected slice, got: %T", a)
-			}
-			vl := make([]cty.Value, 0, len(l))
-			for _, val := range l {
-				v, err := toString(val)
-				if err != nil {
-					return cty.Value{}, err
-				}
-				vl = append(vl, v)
-			}
-			return cty.ListVal(vl), nil
-		},
-	},
-	Blocks: map[string]ConverterSpec{},
-}
-
-var kubernetesDeployment_spec_template_spec_affinity_nodeAffinity = ConverterSpec{
-	ResourceName: "kubernetes_deployment",
-	Attributes:   map[string]func(any) (cty.Value, error){},
-	Blocks: map[string]ConverterSpec{
-		"preferred_during_scheduling_ignored_during_execution": kubernetesDeployment_spec_template_spec_affinity_nodeAffinity_preferredDuringSchedulingIgnoredDuringExecution,
-		"required_during_scheduling_ignored_during_execution":  kubernetesDeployment_spec_template_spec_affinity_nodeAffinity_requiredDuringSchedulingIgnoredDuringExecution,
-	},
-}
-
-var kubernetesDeployment_spec_template_spec_affinity_nodeAffinity_preferredDuringSchedulingIgnoredDuringExecution = ConverterSpec{
-	ResourceName: "kubernetes_deployment",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"weight": toInt,
-	},
-	Blocks: map[string]ConverterSpec{
-		"preference": kubernetesDeployment_spec_template_spec_affinity_nodeAffinity_preferredDuringSchedulingIgnoredDuringExecution_preference,
-	},
-}
-
-var kubernetesDeployment_spec_template_spec_affinity_nodeAffinity_preferredDuringSchedulingIgnoredDuringExecution_preference = ConverterSpec{
-	ResourceName: "kubernetes_deployment",
-	Attributes:   map[string]func(any) (cty.Value, error){},
-	Blocks: map[string]ConverterSpec{
-		"match_expressions": kubernetesDeployment_spec_template_spec_affinity_nodeAffinity_preferredDuringSchedulingIgnoredDuringExecution_preference_matchExpressions,
-		"match_fields":      kubernetesDeployment_spec_template_spec_affinity_nodeAffinity_preferredDuringSchedulingIgnoredDuringExecution_preference_matchFields,
-	},
-}
-
-var kubernetesDeployment_spec_template_spec_affinity_nodeAffinity_preferredDuringSchedulingIgnoredDuringExecution_preference_matchFields = ConverterSpec{
-	ResourceName: "kubernetes_deployment",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"key":      toString,
-		"operator": toString,
-		"values": func(a any) (cty.Value, error) {
-			l, ok := a.([]any)
-			if !ok {
-				return cty.Value{}, fmt.Errorf("expected slice, got: %T", a)
-			}
-			vl := make([]cty.Value, 0, len(l))
-			for _, val := range l {
-				v, err := toString(val)
-				if err != nil {
-					return cty.Value{}, err
-				}
-				vl = append(vl, v)
-			}
-			return cty.ListVal(vl), nil
-		},
-	},
-	Blocks: map[string]ConverterSpec{},
-}
-
-var kubernetesDeployment_spec_template_spec_affinity_nodeAffinity_preferredDuringSchedulingIgnoredDuringExecution_preference_matchExpressions = ConverterSpec{
-	ResourceName: "kubernetes_deployment",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"key":      toString,
-		"operator": toString,
-		"values": func(a any) (cty.Value, error) {
-			l, ok := a.([]any)
-			if !ok {
-				return cty.Value{}, fmt.Errorf("expected slice, got: %T", a)
-			}
-			vl := make([]cty.Value, 0, len(l))
-			for _, val := range l {
-				v, err := toString(val)
-				if err != nil {
-					return cty.Value{}, err
-				}
-				vl = append(vl, v)
-			}
-			return cty.ListVal(vl), nil
-		},
-	},
-	Blocks: map[string]ConverterSpec{},
-}
-
-var kubernetesDeployment_spec_template_spec_affinity_nodeAffinity_requiredDuringSchedulingIgnoredDuringExecution = ConverterSpec{
-	ResourceName: "kubernetes_deployment",
-	Attributes:   map[string]func(any) (cty.Value, error){},
-	Blocks: map[string]ConverterSpec{
-		"node_selector_term": kubernetesDeployment_spec_template_spec_affinity_nodeAffinity_requiredDuringSchedulingIgnoredDuringExecution_nodeSelectorTerm,
-	},
-}
-
-var kubernetesDeployment_spec_template_spec_affinity_nodeAffinity_requiredDuringSchedulingIgnoredDuringExecution_nodeSelectorTerm = ConverterSpec{
-	ResourceName: "kubernetes_deployment",
-	Attributes:   map[string]func(any) (cty.Value, error){},
-	Blocks: map[string]ConverterSpec{
-		"match_expressions": kubernetesDeployment_spec_template_spec_affinity_nodeAffinity_requiredDuringSchedulingIgnoredDuringExecution_nodeSelectorTerm_matchExpressions,
-		"match_fields":      kubernetesDeployment_spec_template_spec_affinity_nodeAffinity_requiredDuringSchedulingIgnoredDuringExecution_nodeSelectorTerm_matchFields,
-	},
-}
-
-var kubernetesDeployment_spec_template_spec_affinity_nodeAffinity_requiredDuringSchedulingIgnoredDuringExecution_nodeSelectorTerm_matchFields = ConverterSpec{
-	ResourceName: "kubernetes_deployment",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"key":      toString,
-		"operator": toString,
-		"values": func(a any) (cty.Value, error) {
-			l, ok := a.([]any)
-			if !ok {
-				return cty.Value{}, fmt.Errorf("expected slice, got: %T", a)
-			}
-			vl := make([]cty.Value, 0, len(l))
-			for _, val := range l {
-				v, err := toString(val)
-				if err != nil {
-					return cty.Value{}, err
-				}
-				vl = append(vl, v)
-			}
-			return cty.ListVal(vl), nil
-		},
-	},
-	Blocks: map[string]ConverterSpec{},
-}
-
-var kubernetesDeployment_spec_template_spec_affinity_nodeAffinity_requiredDuringSchedulingIgnoredDuringExecution_nodeSelectorTerm_matchExpressions = ConverterSpec{
-	ResourceName: "kubernetes_deployment",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"key":      toString,
-		"operator": toString,
-		"values": func(a any) (cty.Value, error) {
-			l, ok := a.([]any)
-			if !ok {
-				return cty.Value{}, fmt.Errorf("expected slice, got: %T", a)
-			}
-			vl := make([]cty.Value, 0, len(l))
-			for _, val := range l {
-				v, err := toString(val)
-				if err != nil {
-					return cty.Value{}, err
-				}
-				vl = append(vl, v)
-			}
-			return cty.ListVal(vl), nil
-		},
-	},
-	Blocks: map[string]ConverterSpec{},
-}
-
-var kubernetesDeployment_spec_template_spec_volume = ConverterSpec{
-	ResourceName: "kubernetes_deployment",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"name": toString,
-	},
-	Blocks: map[string]ConverterSpec{
-		"aws_elastic_block_store": kubernetesDeployment_spec_template_spec_volume_awsElasticBlockStore,
-		"azure_disk":              kubernetesDeployment_spec_template_spec_volume_azureDisk,
-		"azure_file":              kubernetesDeployment_spec_template_spec_volume_azureFile,
-		"ceph_fs":                 kubernetesDeployment_spec_template_spec_volume_cephFs,
-		"cinder":                  kubernetesDeployment_spec_template_spec_volume_cinder,
-		"config_map":              kubernetesDeployment_spec_template_spec_volume_configMap,
-		"csi":                     kubernetesDeployment_spec_template_spec_volume_csi,
-		"downward_api":            kubernetesDeployment_spec_template_spec_volume_downwardApi,
-		"empty_dir":               kubernetesDeployment_spec_template_spec_volume_emptyDir,
-		"ephemeral":               kubernetesDeployment_spec_template_spec_volume_ephemeral,
-		"fc":                      kubernetesDeployment_spec_template_spec_volume_fc,
-		"flex_volume":             kubernetesDeployment_spec_template_spec_volume_flexVolume,
-		"flocker":                 kubernetesDeployment_spec_template_spec_volume_flocker,
-		"gce_persistent_disk":     kubernetesDeployment_spec_template_spec_volume_gcePersistentDisk,
-		"git_repo":                kubernetesDeployment_spec_template_spec_volume_gitRepo,
-		"glusterfs":               kubernetesDeployment_spec_template_spec_volume_glusterfs,
-		"host_path":               kubernetesDeployment_spec_template_spec_volume_hostPath,
-		"iscsi":                   kubernetesDeployment_spec_template_spec_volume_iscsi,
-		"local":                   kubernetesDeployment_spec_template_spec_volume_local,
-		"nfs":                     kubernetesDeployment_spec_template_spec_volume_nfs,
-		"persistent_volume_claim": kubernetesDeployment_spec_template_spec_volume_persistentVolumeClaim,
-		"photon_persistent_disk":  kubernetesDeployment_spec_template_spec_volume_photonPersistentDisk,
-		"projected":               kubernetesDeployment_spec_template_spec_volume_projected,
-		"quobyte":                 kubernetesDeployment_spec_template_spec_volume_quobyte,
-		"rbd":                     kubernetesDeployment_spec_template_spec_volume_rbd,
-		"secret":                  kubernetesDeployment_spec_template_spec_volume_secret,
-		"vsphere_volume":          kubernetesDeployment_spec_template_spec_volume_vsphereVolume,
-	},
-}
-
-var kubernetesDeployment_spec_template_spec_volume_nfs = ConverterSpec{
-	ResourceName: "kubernetes_deployment",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"path":      toString,
-		"read_only": toBool,
-		"server":    toString,
-	},
-	Blocks: map[string]ConverterSpec{},
-}
-
-var kubernetesDeployment_spec_template_spec_volume_iscsi = ConverterSpec{
-	ResourceName: "kubernetes_deployment",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"fs_type":         toString,
-		"iqn":             toString,
-		"iscsi_interface": toString,
-		"lun":             toInt,
-		"read_only":       toBool,
-		"target_portal":   toString,
-	},
-	Blocks: map[string]ConverterSpec{},
-}
-
-var kubernetesDeployment_spec_template_spec_volume_flexVolume = ConverterSpec{
-	ResourceName: "kubernetes_deployment",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"driver":    toString,
-		"fs_type":   toString,
-		"options":   toStringMap,
-		"read_only": toBool,
-	},
-	Blocks: map[string]ConverterSpec{
-		"secret_ref": kubernetesDeployment_spec_template_spec_volume_flexVolume_secretRef,
-	},
-}
-
-var kubernetesDeployment_spec_template_spec_volume_flexVolume_secretRef = ConverterSpec{
-	ResourceName: "kubernetes_deployment",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"name":      toString,
-		"namespace": toString,
-	},
-	Blocks: map[string]ConverterSpec{},
-}
-
-var kubernetesDeployment_spec_template_spec_volume_fc = ConverterSpec{
-	ResourceName: "kubernetes_deployment",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"fs_type":   toString,
-		"lun":       toInt,
-		"read_only": toBool,
-		"target_ww_ns": func(a any) (cty.Value, error) {
-			l, ok := a.([]any)
-			if !ok {
-				return cty.Value{}, fmt.Errorf("expected slice, got: %T", a)
-			}
-			vl := make([]cty.Value, 0, len(l))
-			for _, val := range l {
-				v, err := toString(val)
-				if err != nil {
-					return cty.Value{}, err
-				}
-				vl = append(vl, v)
-			}
-			return cty.ListVal(vl), nil
-		},
-	},
-	Blocks: map[string]ConverterSpec{},
-}
-
-var kubernetesDeployment_spec_template_spec_volume_azureFile = ConverterSpec{
-	ResourceName: "kubernetes_deployment",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"read_only":        toBool,
-		"secret_name":      toString,
-		"secret_namespace": toString,
-		"share_name":       toString,
-	},
-	Blocks: map[string]ConverterSpec{},
-}
-
-var kubernetesDeployment_spec_template_spec_volume_ephemeral = ConverterSpec{
-	ResourceName: "kubernetes_deployment",
-	Attributes:   map[string]func(any) (cty.Value, error){},
-	Blocks: map[string]ConverterSpec{
-		"volume_claim_template": kubernetesDeployment_spec_template_spec_volume_ephemeral_volumeClaimTemplate,
-	},
-}
-
-var kubernetesDeployment_spec_template_spec_volume_ephemeral_volumeClaimTemplate = ConverterSpec{
-	ResourceName: "kubernetes_deployment",
-	Attributes:   map[string]func(any) (cty.Value, error){},
-	Blocks: map[string]ConverterSpec{
-		"metadata": kubernetesDeployment_spec_template_spec_volume_ephemeral_volumeClaimTemplate_metadata,
-		"spec":     kubernetesDeployment_spec_template_spec_volume_ephemeral_volumeClaimTemplate_spec,
-	},
-}
-
-var kubernetesDeployment_spec_template_spec_volume_ephemeral_volumeClaimTemplate_metadata = ConverterSpec{
-	ResourceName: "kubernetes_deployment",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"annotations": toStringMap,
-		"labels":      toStringMap,
-	},
-	Blocks: map[string]ConverterSpec{},
-}
-
-var kubernetesDeployment_spec_template_spec_volume_ephemeral_volumeClaimTemplate_spec = ConverterSpec{
-	ResourceName: "kubernetes_deployment",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"access_modes": func(a any) (cty.Value, error) {
-			l, ok := a.([]any)
-			if !ok {
-				return cty.Value{}, fmt.Errorf("expected slice, got: %T", a)
-			}
-			vl := make([]cty.Value, 0, len(l))
-			for _, val := range l {
-				v, err := toString(val)
-				if err != nil {
-					return cty.Value{}, err
-				}
-				vl = append(vl, v)
-			}
-			return cty.ListVal(vl), nil
-		},
-		"storage_class_name": toString,
-		"volume_mode":        toString,
-		"volume_name":        toString,
-	},
-	Blocks: map[string]ConverterSpec{
-		"resources": kubernetesDeployment_spec_template_spec_volume_ephemeral_volumeClaimTemplate_spec_resources,
-		"selector":  kubernetesDeployment_spec_template_spec_volume_ephemeral_volumeClaimTemplate_spec_selector,
-	},
-}
-
-var kubernetesDeployment_spec_template_spec_volume_ephemeral_volumeClaimTemplate_spec_resources = ConverterSpec{
-	ResourceName: "kubernetes_deployment",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"limits":   toStringMap,
-		"requests": toStringMap,
-	},
-	Blocks: map[string]ConverterSpec{},
-}
-
-var kubernetesDeployment_spec_template_spec_volume_ephemeral_volumeClaimTemplate_spec_selector = ConverterSpec{
-	ResourceName: "kubernetes_deployment",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"match_labels": toStringMap,
-	},
-	Blocks: map[string]ConverterSpec{
-		"match_expressions": kubernetesDeployment_spec_template_spec_volume_ephemeral_volumeClaimTemplate_spec_selector_matchExpressions,
-	},
-}
-
-var kubernetesDeployment_spec_template_spec_volume_ephemeral_volumeClaimTemplate_spec_selector_matchExpressions = ConverterSpec{
-	ResourceName: "kubernetes_deployment",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"key":      toString,
-		"operator": toString,
-		"values": func(a any) (cty.Value, error) {
-			l, ok := a.([]any)
-			if !ok {
-				return cty.Value{}, fmt.Errorf("expected slice, got: %T", a)
-			}
-			vl := make([]cty.Value, 0, len(l))
-			for _, val := range l {
-				v, err := toString(val)
-				if err != nil {
-					return cty.Value{}, err
-				}
-				vl = append(vl, v)
-			}
-			return cty.ListVal(vl), nil
-		},
-	},
-	Blocks: map[string]ConverterSpec{},
-}
-
-var kubernetesDeployment_spec_template_spec_volume_gitRepo = ConverterSpec{
-	ResourceName: "kubernetes_deployment",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"directory":  toString,
-		"repository": toString,
-		"revision":   toString,
-	},
-	Blocks: map[string]ConverterSpec{},
-}
-
-var kubernetesDeployment_spec_template_spec_volume_photonPersistentDisk = ConverterSpec{
-	ResourceName: "kubernetes_deployment",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"fs_type": toString,
-		"pd_id":   toString,
-	},
-	Blocks: map[string]ConverterSpec{},
-}
-
-var kubernetesDeployment_spec_template_spec_volume_glusterfs = ConverterSpec{
-	ResourceName: "kubernetes_deployment",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"endpoints_name": toString,
-		"path":           toString,
-		"read_only":      toBool,
-	},
-	Blocks: map[string]ConverterSpec{},
-}
-
-var kubernetesDeployment_spec_template_spec_volume_gcePersistentDisk = ConverterSpec{
-	ResourceName: "kubernetes_deployment",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"fs_type":   toString,
-		"partition": toInt,
-		"pd_name":   toString,
-		"read_only": toBool,
-	},
-	Blocks: map[string]ConverterSpec{},
-}
-
-var kubernetesDeployment_spec_template_spec_volume_flocker = ConverterSpec{
-	ResourceName: "kubernetes_deployment",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"dataset_name": toString,
-		"dataset_uuid": toString,
-	},
-	Blocks: map[string]ConverterSpec{},
-}
-
-var kubernetesDeployment_spec_template_spec_volume_local = ConverterSpec{
-	ResourceName: "kubernetes_deployment",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"path": toString,
-	},
-	Blocks: map[string]ConverterSpec{},
-}
-
-var kubernetesDeployment_spec_template_spec_volume_hostPath = ConverterSpec{
-	ResourceName: "kubernetes_deployment",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"path": toString,
-		"type": toString,
-	},
-	Blocks: map[string]ConverterSpec{},
-}
-
-var kubernetesDeployment_spec_template_spec_volume_projected = ConverterSpec{
-	ResourceName: "kubernetes_deployment",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"default_mode": toString,
-	},
-	Blocks: map[string]ConverterSpec{
-		"sources": kubernetesDeployment_spec_template_spec_volume_projected_sources,
-	},
-}
-
-var kubernetesDeployment_spec_template_spec_volume_projected_sources = ConverterSpec{
-	ResourceName: "kubernetes_deployment",
-	Attributes:   map[string]func(any) (cty.Value, error){},
-	Blocks: map[string]ConverterSpec{
-		"config_map":            kubernetesDeployment_spec_template_spec_volume_projected_sources_configMap,
-		"downward_api":          kubernetesDeployment_spec_template_spec_volume_projected_sources_downwardApi,
-		"secret":                kubernetesDeployment_spec_template_spec_volume_projected_sources_secret,
-		"service_account_token": kubernetesDeployment_spec_template_spec_volume_projected_sources_serviceAccountToken,
-	},
-}
-
-var kubernetesDeployment_spec_template_spec_volume_projected_sources_downwardApi = ConverterSpec{
-	ResourceName: "kubernetes_deployment",
-	Attributes:   map[string]func(any) (cty.Value, error){},
-	Blocks: map[string]ConverterSpec{
-		"items": kubernetesDeployment_spec_template_spec_volume_projected_sources_downwardApi_items,
-	},
-}
-
-var kubernetesDeployment_spec_template_spec_volume_projected_sources_downwardApi_items = ConverterSpec{
-	ResourceName: "kubernetes_deployment",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"mode": toString,
-		"path": toString,
-	},
-	Blocks: map[string]ConverterSpec{
-		"field_ref":          kubernetesDeployment_spec_template_spec_volume_projected_sources_downwardApi_items_fieldRef,
-		"resource_field_ref": kubernetesDeployment_spec_template_spec_volume_projected_sources_downwardApi_items_resourceFieldRef,
-	},
-}
-
-var kubernetesDeployment_spec_template_spec_volume_projected_sources_downwardApi_items_resourceFieldRef = ConverterSpec{
-	ResourceName: "kubernetes_deployment",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"container_name": toString,
-		"divisor":        toString,
-		"resource":       toString,
-	},
-	Blocks: map[string]ConverterSpec{},
-}
-
-var kubernetesDeployment_spec_template_spec_volume_projected_sources_downwardApi_items_fieldRef = ConverterSpec{
-	ResourceName: "kubernetes_deployment",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"api_version": toString,
-		"field_path":  toString,
-	},
-	Blocks: map[string]ConverterSpec{},
-}
-
-var kubernetesDeployment_spec_template_spec_volume_projected_sources_configMap = ConverterSpec{
-	ResourceName: "kubernetes_deployment",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"name":     toString,
-		"optional": toBool,
-	},
-	Blocks: map[string]ConverterSpec{
-		"items": kubernetesDeployment_spec_template_spec_volume_projected_sources_configMap_items,
-	},
-}
-
-var kubernetesDeployment_spec_template_spec_volume_projected_sources_configMap_items = ConverterSpec{
-	ResourceName: "kubernetes_deployment",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"key":  toString,
-		"mode": toString,
-		"path": toString,
-	},
-	Blocks: map[string]ConverterSpec{},
-}
-
-var kubernetesDeployment_spec_template_spec_volume_projected_sources_secret = ConverterSpec{
-	ResourceName: "kubernetes_deployment",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"name":     toString,
-		"optional": toBool,
-	},
-	Blocks: map[string]ConverterSpec{
-		"items": kubernetesDeployment_spec_template_spec_volume_projected_sources_secret_items,
-	},
-}
-
-var kubernetesDeployment_spec_template_spec_volume_projected_sources_secret_items = ConverterSpec{
-	ResourceName: "kubernetes_deployment",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"key":  toString,
-		"mode": toString,
-		"path": toString,
-	},
-	Blocks: map[string]ConverterSpec{},
-}
-
-var kubernetesDeployment_spec_template_spec_volume_projected_sources_serviceAccountToken = ConverterSpec{
-	ResourceName: "kubernetes_deployment",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"audience":           toString,
-		"expiration_seconds": toInt,
-		"path":               toString,
-	},
-	Blocks: map[string]ConverterSpec{},
-}
-
-var kubernetesDeployment_spec_template_spec_volume_secret = ConverterSpec{
-	ResourceName: "kubernetes_deployment",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"default_mode": toString,
-		"optional":     toBool,
-		"secret_name":  toString,
-	},
-	Blocks: map[string]ConverterSpec{
-		"items": kubernetesDeployment_spec_template_spec_volume_secret_items,
-	},
-}
-
-var kubernetesDeployment_spec_template_spec_volume_secret_items = ConverterSpec{
-	ResourceName: "kubernetes_deployment",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"key":  toString,
-		"mode": toString,
-		"path": toString,
-	},
-	Blocks: map[string]ConverterSpec{},
-}
-
-var kubernetesDeployment_spec_template_spec_volume_persistentVolumeClaim = ConverterSpec{
-	ResourceName: "kubernetes_deployment",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"claim_name": toString,
-		"read_only":  toBool,
-	},
-	Blocks: map[string]ConverterSpec{},
-}
-
-var kubernetesDeployment_spec_template_spec_volume_vsphereVolume = ConverterSpec{
-	ResourceName: "kubernetes_deployment",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"fs_type":     toString,
-		"volume_path": toString,
-	},
-	Blocks: map[string]ConverterSpec{},
-}
-
-var kubernetesDeployment_spec_template_spec_volume_quobyte = ConverterSpec{
-	ResourceName: "kubernetes_deployment",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"group":     toString,
-		"read_only": toBool,
-		"registry":  toString,
-		"user":      toString,
-		"volume":    toString,
-	},
-	Blocks: map[string]ConverterSpec{},
-}
-
-var kubernetesDeployment_spec_template_spec_volume_cinder = ConverterSpec{
-	ResourceName: "kubernetes_deployment",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"fs_type":   toString,
-		"read_only": toBool,
-		"volume_id": toString,
-	},
-	Blocks: map[string]ConverterSpec{},
-}
-
-var kubernetesDeployment_spec_template_spec_volume_azureDisk = ConverterSpec{
-	ResourceName: "kubernetes_deployment",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"caching_mode":  toString,
-		"data_disk_uri": toString,
-		"disk_name":     toString,
-		"fs_type":       toString,
-		"kind":          toString,
-		"read_only":     toBool,
-	},
-	Blocks: map[string]ConverterSpec{},
-}
-
-var kubernetesDeployment_spec_template_spec_volume_awsElasticBlockStore = ConverterSpec{
-	ResourceName: "kubernetes_deployment",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"fs_type":   toString,
-		"partition": toInt,
-		"read_only": toBool,
-		"volume_id": toString,
-	},
-	Blocks: map[string]ConverterSpec{},
-}
-
-var kubernetesDeployment_spec_template_spec_volume_downwardApi = ConverterSpec{
-	ResourceName: "kubernetes_deployment",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"default_mode": toString,
-	},
-	Blocks: map[string]ConverterSpec{
-		"items": kubernetesDeployment_spec_template_spec_volume_downwardApi_items,
-	},
-}
-
-var kubernetesDeployment_spec_template_spec_volume_downwardApi_items = ConverterSpec{
-	ResourceName: "kubernetes_deployment",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"mode": toString,
-		"path": toString,
-	},
-	Blocks: map[string]ConverterSpec{
-		"field_ref":          kubernetesDeployment_spec_template_spec_volume_downwardApi_items_fieldRef,
-		"resource_field_ref": kubernetesDeployment_spec_template_spec_volume_downwardApi_items_resourceFieldRef,
-	},
-}
-
-var kubernetesDeployment_spec_template_spec_volume_downwardApi_items_resourceFieldRef = ConverterSpec{
-	ResourceName: "kubernetes_deployment",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"container_name": toString,
-		"divisor":        toString,
-		"resource":       toString,
-	},
-	Blocks: map[string]ConverterSpec{},
-}
-
-var kubernetesDeployment_spec_template_spec_volume_downwardApi_items_fieldRef = ConverterSpec{
-	ResourceName: "kubernetes_deployment",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"api_version": toString,
-		"field_path":  toString,
-	},
-	Blocks: map[string]ConverterSpec{},
-}
-
-var kubernetesDeployment_spec_template_spec_volume_rbd = ConverterSpec{
-	ResourceName: "kubernetes_deployment",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"ceph_monitors": func(a any) (cty.Value, error) {
-			l, ok := a.([]any)
-			if !ok {
-				return cty.Value{}, fmt.Errorf("expected slice, got: %T", a)
-			}
-			vl := make([]cty.Value, 0, len(l))
-			for _, val := range l {
-				v, err := toString(val)
-				if err != nil {
-					return cty.Value{}, err
-				}
-				vl = append(vl, v)
-			}
-			return cty.ListVal(vl), nil
-		},
-		"fs_type":    toString,
-		"keyring":    toString,
-		"rados_user": toString,
-		"rbd_image":  toString,
-		"rbd_pool":   toString,
-		"read_only":  toBool,
-	},
-	Blocks: map[string]ConverterSpec{
-		"secret_ref": kubernetesDeployment_spec_template_spec_volume_rbd_secretRef,
-	},
-}
-
-var kubernetesDeployment_spec_template_spec_volume_rbd_secretRef = ConverterSpec{
-	ResourceName: "kubernetes_deployment",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"name":      toString,
-		"namespace": toString,
-	},
-	Blocks: map[string]ConverterSpec{},
-}
-
-var kubernetesDeployment_spec_template_spec_volume_cephFs = ConverterSpec{
-	ResourceName: "kubernetes_deployment",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"monitors": func(a any) (cty.Value, error) {
-			l, ok := a.([]any)
-			if !ok {
-				return cty.Value{}, fmt.Errorf("expected slice, got: %T", a)
-			}
-			vl := make([]cty.Value, 0, len(l))
-			for _, val := range l {
-				v, err := toString(val)
-				if err != nil {
-					return cty.Value{}, err
-				}
-				vl = append(vl, v)
-			}
-			return cty.ListVal(vl), nil
-		},
-		"path":        toString,
-		"read_only":   toBool,
-		"secret_file": toString,
-		"user":        toString,
-	},
-	Blocks: map[string]ConverterSpec{
-		"secret_ref": kubernetesDeployment_spec_template_spec_volume_cephFs_secretRef,
-	},
-}
-
-var kubernetesDeployment_spec_template_spec_volume_cephFs_secretRef = ConverterSpec{
-	ResourceName: "kubernetes_deployment",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"name":      toString,
-		"namespace": toString,
-	},
-	Blocks: map[string]ConverterSpec{},
-}
-
-var kubernetesDeployment_spec_template_spec_volume_csi = ConverterSpec{
-	ResourceName: "kubernetes_deployment",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"driver":            toString,
-		"fs_type":           toString,
-		"read_only":         toBool,
-		"volume_attributes": toStringMap,
-	},
-	Blocks: map[string]ConverterSpec{
-		"node_publish_secret_ref": kubernetesDeployment_spec_template_spec_volume_csi_nodePublishSecretRef,
-	},
-}
-
-var kubernetesDeployment_spec_template_spec_volume_csi_nodePublishSecretRef = ConverterSpec{
-	ResourceName: "kubernetes_deployment",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"name": toString,
-	},
-	Blocks: map[string]ConverterSpec{},
-}
-
-var kubernetesDeployment_spec_template_spec_volume_emptyDir = ConverterSpec{
-	ResourceName: "kubernetes_deployment",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"medium":     toString,
-		"size_limit": toString,
-	},
-	Blocks: map[string]ConverterSpec{},
-}
-
-var kubernetesDeployment_spec_template_spec_volume_configMap = ConverterSpec{
-	ResourceName: "kubernetes_deployment",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"default_mode": toString,
-		"name":         toString,
-		"optional":     toBool,
-	},
-	Blocks: map[string]ConverterSpec{
-		"items": kubernetesDeployment_spec_template_spec_volume_configMap_items,
-	},
-}
-
-var kubernetesDeployment_spec_template_spec_volume_configMap_items = ConverterSpec{
-	ResourceName: "kubernetes_deployment",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"key":  toString,
-		"mode": toString,
-		"path": toString,
-	},
-	Blocks: map[string]ConverterSpec{},
-}
-
-var kubernetesDeployment_spec_template_spec_initContainer = ConverterSpec{
-	ResourceName: "kubernetes_deployment",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"args": func(a any) (cty.Value, error) {
-			l, ok := a.([]any)
-			if !ok {
-				return cty.Value{}, fmt.Errorf("expected slice, got: %T", a)
-			}
-			vl := make([]cty.Value, 0, len(l))
-			for _, val := range l {
-				v, err := toString(val)
-				if err != nil {
-					return cty.Value{}, err
-				}
-				vl = append(vl, v)
-			}
-			return cty.ListVal(vl), nil
-		},
-		"command": func(a any) (cty.Value, error) {
-			l, ok := a.([]any)
-			if !ok {
-				return cty.Value{}, fmt.Errorf("expected slice, got: %T", a)
-			}
-			vl := make([]cty.Value, 0, len(l))
-			for _, val := range l {
-				v, err := toString(val)
-				if err != nil {
-					return cty.Value{}, err
-				}
-				vl = append(vl, v)
-			}
-			return cty.ListVal(vl), nil
-		},
-		"image":                      toString,
-		"image_pull_policy":          toString,
-		"name":                       toString,
-		"stdin":                      toBool,
-		"stdin_once":                 toBool,
-		"termination_message_path":   toString,
-		"termination_message_policy": toString,
-		"tty":                        toBool,
-		"working_dir":                toString,
-	},
-	Blocks: map[string]ConverterSpec{
-		"env":              kubernetesDeployment_spec_template_spec_initContainer_env,
-		"env_from":         kubernetesDeployment_spec_template_spec_initContainer_envFrom,
-		"lifecycle":        kubernetesDeployment_spec_template_spec_initContainer_lifecycle,
-		"liveness_probe":   kubernetesDeployment_spec_template_spec_initContainer_livenessProbe,
-		"port":             kubernetesDeployment_spec_template_spec_initContainer_port,
-		"readiness_probe":  kubernetesDeployment_spec_template_spec_initContainer_readinessProbe,
-		"resources":        kubernetesDeployment_spec_template_spec_initContainer_resources,
-		"security_context": kubernetesDeployment_spec_template_spec_initContainer_securityContext,
-		"startup_probe":    kubernetesDeployment_spec_template_spec_initContainer_startupProbe,
-		"volume_device":    kubernetesDeployment_spec_template_spec_initContainer_volumeDevice,
-		"volume_mount":     kubernetesDeployment_spec_template_spec_initContainer_volumeMount,
-	},
-}
-
-var kubernetesDeployment_spec_template_spec_initContainer_volumeDevice = ConverterSpec{
-	ResourceName: "kubernetes_deployment",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"device_path": toString,
-		"name":        toString,
-	},
-	Blocks: map[string]ConverterSpec{},
-}
-
-var kubernetesDeployment_spec_template_spec_initContainer_resources = ConverterSpec{
-	ResourceName: "kubernetes_deployment",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"limits":   toStringMap,
-		"requests": toStringMap,
-	},
-	Blocks: map[string]ConverterSpec{},
-}
-
-var kubernetesDeployment_spec_template_spec_initContainer_port = ConverterSpec{
-	ResourceName: "kubernetes_deployment",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"container_port": toInt,
-		"host_ip":        toString,
-		"host_port":      toInt,
-		"name":           toString,
-		"protocol":       toString,
-	},
-	Blocks: map[string]ConverterSpec{},
-}
-
-var kubernetesDeployment_spec_template_spec_initContainer_livenessProbe = ConverterSpec{
-	ResourceName: "kubernetes_deployment",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"failure_threshold":     toInt,
-		"initial_delay_seconds": toInt,
-		"period_seconds":        toInt,
-		"success_threshold":     toInt,
-		"timeout_seconds":       toInt,
-	},
-	Blocks: map[string]ConverterSpec{
-		"exec":       kubernetesDeployment_spec_template_spec_initContainer_livenessProbe_exec,
-		"grpc":       kubernetesDeployment_spec_template_spec_initContainer_livenessProbe_grpc,
-		"http_get":   kubernetesDeployment_spec_template_spec_initContainer_livenessProbe_httpGet,
-		"tcp_socket": kubernetesDeployment_spec_template_spec_initContainer_livenessProbe_tcpSocket,
-	},
-}
-
-var kubernetesDeployment_spec_template_spec_initContainer_livenessProbe_tcpSocket = ConverterSpec{
-	ResourceName: "kubernetes_deployment",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"port": toString,
-	},
-	Blocks: map[string]ConverterSpec{},
-}
-
-var kubernetesDeployment_spec_template_spec_initContainer_livenessProbe_httpGet = ConverterSpec{
-	ResourceName: "kubernetes_deployment",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"host":   toString,
-		"path":   toString,
-		"port":   toString,
-		"scheme": toString,
-	},
-	Blocks: map[string]ConverterSpec{
-		"http_header": kubernetesDeployment_spec_template_spec_initContainer_livenessProbe_httpGet_httpHeader,
-	},
-}
-
-var kubernetesDeployment_spec_template_spec_initContainer_livenessProbe_httpGet_httpHeader = ConverterSpec{
-	ResourceName: "kubernetes_deployment",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"name":  toString,
-		"value": toString,
-	},
-	Blocks: map[string]ConverterSpec{},
-}
-
-var kubernetesDeployment_spec_template_spec_initContainer_livenessProbe_grpc = ConverterSpec{
-	ResourceName: "kubernetes_deployment",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"port":    toInt,
-		"service": toString,
-	},
-	Blocks: map[string]ConverterSpec{},
-}
-
-var kubernetesDeployment_spec_template_spec_initContainer_livenessProbe_exec = ConverterSpec{
-	ResourceName: "kubernetes_deployment",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"command": func(a any) (cty.Value, error) {
-			l, ok := a.([]any)
-			if !ok {
-				return cty.Value{}, fmt.Errorf("expected slice, got: %T", a)
-			}
-			vl := make([]cty.Value, 0, len(l))
-			for _, val := range l {
-				v, err := toString(val)
-				if err != nil {
-					return cty.Value{}, err
-				}
-				vl = append(vl, v)
-			}
-			return cty.ListVal(vl), nil
-		},
-	},
-	Blocks: map[string]ConverterSpec{},
-}
-
-var kubernetesDeployment_spec_template_spec_initContainer_volumeMount = ConverterSpec{
-	ResourceName: "kubernetes_deployment",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"mount_path":        toString,
-		"mount_propagation": toString,
-		"name":              toString,
-		"read_only":         toBool,
-		"sub_path":          toString,
-		"sub_path_expr":     toString,
-	},
-	Blocks: map[string]ConverterSpec{},
-}
-
-var kubernetesDeployment_spec_template_spec_initContainer_envFrom = ConverterSpec{
-	ResourceName: "kubernetes_deployment",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"prefix": toString,
-	},
-	Blocks: map[string]ConverterSpec{
-		"config_map_ref": kubernetesDeployment_spec_template_spec_initContainer_envFrom_configMapRef,
-		"secret_ref":     kubernetesDeployment_spec_template_spec_initContainer_envFrom_secretRef,
-	},
-}
-
-var kubernetesDeployment_spec_template_spec_initContainer_envFrom_secretRef = ConverterSpec{
-	ResourceName: "kubernetes_deployment",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"name":     toString,
-		"optional": toBool,
-	},
-	Blocks: map[string]ConverterSpec{},
-}
-
-var kubernetesDeployment_spec_template_spec_initContainer_envFrom_configMapRef = ConverterSpec{
-	ResourceName: "kubernetes_deployment",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"name":     toString,
-		"optional": toBool,
-	},
-	Blocks: map[string]ConverterSpec{},
-}
-
-var kubernetesDeployment_spec_template_spec_initContainer_env = ConverterSpec{
-	ResourceName: "kubernetes_deployment",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"name":  toString,
-		"value": toString,
-	},
-	Blocks: map[string]ConverterSpec{
-		"value_from": kubernetesDeployment_spec_template_spec_initContainer_env_valueFrom,
-	},
-}
-
-var kubernetesDeployment_spec_template_spec_initContainer_env_valueFrom = ConverterSpec{
-	ResourceName: "kubernetes_deployment",
-	Attributes:   map[string]func(any) (cty.Value, error){},
-	Blocks: map[string]ConverterSpec{
-		"config_map_key_ref": kubernetesDeployment_spec_template_spec_initContainer_env_valueFrom_configMapKeyRef,
-		"field_ref":          kubernetesDeployment_spec_template_spec_initContainer_env_valueFrom_fieldRef,
-		"resource_field_ref": kubernetesDeployment_spec_template_spec_initContainer_env_valueFrom_resourceFieldRef,
-		"secret_key_ref":     kubernetesDeployment_spec_template_spec_initContainer_env_valueFrom_secretKeyRef,
-	},
-}
-
-var kubernetesDeployment_spec_template_spec_initContainer_env_valueFrom_secretKeyRef = ConverterSpec{
-	ResourceName: "kubernetes_deployment",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"key":      toString,
-		"name":     toString,
-		"optional": toBool,
-	},
-	Blocks: map[string]ConverterSpec{},
-}
-
-var kubernetesDeployment_spec_template_spec_initContainer_env_valueFrom_resourceFieldRef = ConverterSpec{
-	ResourceName: "kubernetes_deployment",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"container_name": toString,
-		"divisor":        toString,
-		"resource":       toString,
-	},
-	Blocks: map[string]ConverterSpec{},
-}
-
-var kubernetesDeployment_spec_template_spec_initContainer_env_valueFrom_fieldRef = ConverterSpec{
-	ResourceName: "kubernetes_deployment",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"api_version": toString,
-		"field_path":  toString,
-	},
-	Blocks: map[string]ConverterSpec{},
-}
-
-var kubernetesDeployment_spec_template_spec_initContainer_env_valueFrom_configMapKeyRef = ConverterSpec{
-	ResourceName: "kubernetes_deployment",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"key":      toString,
-		"name":     toString,
-		"optional": toBool,
-	},
-	Blocks: map[string]ConverterSpec{},
-}
-
-var kubernetesDeployment_spec_template_spec_initContainer_startupProbe = ConverterSpec{
-	ResourceName: "kubernetes_deployment",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"failure_threshold":     toInt,
-		"initial_delay_seconds": toInt,
-		"period_seconds":        toInt,
-		"success_threshold":     toInt,
-		"timeout_seconds":       toInt,
-	},
-	Blocks: map[string]ConverterSpec{
-		"exec":       kubernetesDeployment_spec_template_spec_initContainer_startupProbe_exec,
-		"grpc":       kubernetesDeployment_spec_template_spec_initContainer_startupProbe_grpc,
-		"http_get":   kubernetesDeployment_spec_template_spec_initContainer_startupProbe_httpGet,
-		"tcp_socket": kubernetesDeployment_spec_template_spec_initContainer_startupProbe_tcpSocket,
-	},
-}
-
-var kubernetesDeployment_spec_template_spec_initContainer_startupProbe_grpc = ConverterSpec{
-	ResourceName: "kubernetes_deployment",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"port":    toInt,
-		"service": toString,
-	},
-	Blocks: map[string]ConverterSpec{},
-}
-
-var kubernetesDeployment_spec_template_spec_initContainer_startupProbe_tcpSocket = ConverterSpec{
-	ResourceName: "kubernetes_deployment",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"port": toString,
-	},
-	Blocks: map[string]ConverterSpec{},
-}
-
-var kubernetesDeployment_spec_template_spec_initContainer_startupProbe_exec = ConverterSpec{
-	ResourceName: "kubernetes_deployment",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"command": func(a any) (cty.Value, error) {
-			l, ok := a.([]any)
-			if !ok {
-				return cty.Value{}, fmt.Errorf("expected slice, got: %T", a)
-			}
-			vl := make([]cty.Value, 0, len(l))
-			for _, val := range l {
-				v, err := toString(val)
-				if err != nil {
-					return cty.Value{}, err
-				}
-				vl = append(vl, v)
-			}
-			return cty.ListVal(vl), nil
-		},
-	},
-	Blocks: map[string]ConverterSpec{},
-}
-
-var kubernetesDeployment_spec_template_spec_initContainer_startupProbe_httpGet = ConverterSpec{
-	ResourceName: "kubernetes_deployment",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"host":   toString,
-		"path":   toString,
-		"port":   toString,
-		"scheme": toString,
-	},
-	Blocks: map[string]ConverterSpec{
-		"http_header": kubernetesDeployment_spec_template_spec_initContainer_startupProbe_httpGet_httpHeader,
-	},
-}
-
-var kubernetesDeployment_spec_template_spec_initContainer_startupProbe_httpGet_httpHeader = ConverterSpec{
-	ResourceName: "kubernetes_deployment",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"name":  toString,
-		"value": toString,
-	},
-	Blocks: map[string]ConverterSpec{},
-}
-
-var kubernetesDeployment_spec_template_spec_initContainer_securityContext = ConverterSpec{
-	ResourceName: "kubernetes_deployment",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"allow_privilege_escalation": toBool,
-		"privileged":                 toBool,
-		"read_only_root_filesystem":  toBool,
-		"run_as_group":               toString,
-		"run_as_non_root":            toBool,
-		"run_as_user":                toString,
-	},
-	Blocks: map[string]ConverterSpec{
-		"capabilities":     kubernetesDeployment_spec_template_spec_initContainer_securityContext_capabilities,
-		"se_linux_options": kubernetesDeployment_spec_template_spec_initContainer_securityContext_seLinuxOptions,
-		"seccomp_profile":  kubernetesDeployment_spec_template_spec_initContainer_securityContext_seccompProfile,
-	},
-}
-
-var kubernetesDeployment_spec_template_spec_initContainer_securityContext_seLinuxOptions = ConverterSpec{
-	ResourceName: "kubernetes_deployment",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"level": toString,
-		"role":  toString,
-		"type":  toString,
-		"user":  toString,
-	},
-	Blocks: map[string]ConverterSpec{},
-}
-
-var kubernetesDeployment_spec_template_spec_initContainer_securityContext_seccompProfile = ConverterSpec{
-	ResourceName: "kubernetes_deployment",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"localhost_profile": toString,
-		"type":              toString,
-	},
-	Blocks: map[string]ConverterSpec{},
-}
-
-var kubernetesDeployment_spec_template_spec_initContainer_securityContext_capabilities = ConverterSpec{
-	ResourceName: "kubernetes_deployment",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"add": func(a any) (cty.Value, error) {
-			l, ok := a.([]any)
-			if !ok {
-				return cty.Value{}, fmt.Errorf("expected slice, got: %T", a)
-			}
-			vl := make([]cty.Value, 0, len(l))
-			for _, val := range l {
-				v, err := toString(val)
-				if err != nil {
-					return cty.Value{}, err
-				}
-				vl = append(vl, v)
-			}
-			return cty.ListVal(vl), nil
-		},
-		"drop": func(a any) (cty.Value, error) {
-			l, ok := a.([]any)
-			if !ok {
-				return cty.Value{}, fmt.Errorf("expected slice, got: %T", a)
-			}
-			vl := make([]cty.Value, 0, len(l))
-			for _, val := range l {
-				v, err := toString(val)
-				if err != nil {
-					return cty.Value{}, err
-				}
-				vl = append(vl, v)
-			}
-			return cty.ListVal(vl), nil
-		},
-	},
-	Blocks: map[string]ConverterSpec{},
-}
-
-var kubernetesDeployment_spec_template_spec_initContainer_readinessProbe = ConverterSpec{
-	ResourceName: "kubernetes_deployment",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"failure_threshold":     toInt,
-		"initial_delay_seconds": toInt,
-		"period_seconds":        toInt,
-		"success_threshold":     toInt,
-		"timeout_seconds":       toInt,
-	},
-	Blocks: map[string]ConverterSpec{
-		"exec":       kubernetesDeployment_spec_template_spec_initContainer_readinessProbe_exec,
-		"grpc":       kubernetesDeployment_spec_template_spec_initContainer_readinessProbe_grpc,
-		"http_get":   kubernetesDeployment_spec_template_spec_initContainer_readinessProbe_httpGet,
-		"tcp_socket": kubernetesDeployment_spec_template_spec_initContainer_readinessProbe_tcpSocket,
-	},
-}
-
-var kubernetesDeployment_spec_template_spec_initContainer_readinessProbe_tcpSocket = ConverterSpec{
-	ResourceName: "kubernetes_deployment",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"port": toString,
-	},
-	Blocks: map[string]ConverterSpec{},
-}
-
-var kubernetesDeployment_spec_template_spec_initContainer_readinessProbe_httpGet = ConverterSpec{
-	ResourceName: "kubernetes_deployment",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"host":   toString,
-		"path":   toString,
-		"port":   toString,
-		"scheme": toString,
-	},
-	Blocks: map[string]ConverterSpec{
-		"http_header": kubernetesDeployment_spec_template_spec_initContainer_readinessProbe_httpGet_httpHeader,
-	},
-}
-
-var kubernetesDeployment_spec_template_spec_initContainer_readinessProbe_httpGet_httpHeader = ConverterSpec{
-	ResourceName: "kubernetes_deployment",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"name":  toString,
-		"value": toString,
-	},
-	Blocks: map[string]ConverterSpec{},
-}
-
-var kubernetesDeployment_spec_template_spec_initContainer_readinessProbe_exec = ConverterSpec{
-	ResourceName: "kubernetes_deployment",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"command": func(a any) (cty.Value, error) {
-			l, ok := a.([]any)
-			if !ok {
-				return cty.Value{}, fmt.Errorf("expected slice, got: %T", a)
-			}
-			vl := make([]cty.Value, 0, len(l))
-			for _, val := range l {
-				v, err := toString(val)
-				if err != nil {
-					return cty.Value{}, err
-				}
-				vl = append(vl, v)
-			}
-			return cty.ListVal(vl), nil
-		},
-	},
-	Blocks: map[string]ConverterSpec{},
-}
-
-var kubernetesDeployment_spec_template_spec_initContainer_readinessProbe_grpc = ConverterSpec{
-	ResourceName: "kubernetes_deployment",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"port":    toInt,
-		"service": toString,
-	},
-	Blocks: map[string]ConverterSpec{},
-}
-
-var kubernetesDeployment_spec_template_spec_initContainer_lifecycle = ConverterSpec{
-	ResourceName: "kubernetes_deployment",
-	Attributes:   map[string]func(any) (cty.Value, error){},
-	Blocks: map[string]ConverterSpec{
-		"post_start": kubernetesDeployment_spec_template_spec_initContainer_lifecycle_postStart,
-		"pre_stop":   kubernetesDeployment_spec_template_spec_initContainer_lifecycle_preStop,
-	},
-}
-
-var kubernetesDeployment_spec_template_spec_initContainer_lifecycle_postStart = ConverterSpec{
-	ResourceName: "kubernetes_deployment",
-	Attributes:   map[string]func(any) (cty.Value, error){},
-	Blocks: map[string]ConverterSpec{
-		"exec":       kubernetesDeployment_spec_template_spec_initContainer_lifecycle_postStart_exec,
-		"http_get":   kubernetesDeployment_spec_template_spec_initContainer_lifecycle_postStart_httpGet,
-		"tcp_socket": kubernetesDeployment_spec_template_spec_initContainer_lifecycle_postStart_tcpSocket,
-	},
-}
-
-var kubernetesDeployment_spec_template_spec_initContainer_lifecycle_postStart_tcpSocket = ConverterSpec{
-	ResourceName: "kubernetes_deployment",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"port": toString,
-	},
-	Blocks: map[string]ConverterSpec{},
-}
-
-var kubernetesDeployment_spec_template_spec_initContainer_lifecycle_postStart_httpGet = ConverterSpec{
-	ResourceName: "kubernetes_deployment",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"host":   toString,
-		"path":   toString,
-		"port":   toString,
-		"scheme": toString,
-	},
-	Blocks: map[string]ConverterSpec{
-		"http_header": kubernetesDeployment_spec_template_spec_initContainer_lifecycle_postStart_httpGet_httpHeader,
-	},
-}
-
-var kubernetesDeployment_spec_template_spec_initContainer_lifecycle_postStart_httpGet_httpHeader = ConverterSpec{
-	ResourceName: "kubernetes_deployment",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"name":  toString,
-		"value": toString,
-	},
-	Blocks: map[string]ConverterSpec{},
-}
-
-var kubernetesDeployment_spec_template_spec_initContainer_lifecycle_postStart_exec = ConverterSpec{
-	ResourceName: "kubernetes_deployment",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"command": func(a any) (cty.Value, error) {
-			l, ok := a.([]any)
-			if !ok {
-				return cty.Value{}, fmt.Errorf("expected slice, got: %T", a)
-			}
-			vl := make([]cty.Value, 0, len(l))
-			for _, val := range l {
-				v, err := toString(val)
-				if err != nil {
-					return cty.Value{}, err
-				}
-				vl = append(vl, v)
-			}
-			return cty.ListVal(vl), nil
-		},
-	},
-	Blocks: map[string]ConverterSpec{},
-}
-
-var kubernetesDeployment_spec_template_spec_initContainer_lifecycle_preStop = ConverterSpec{
-	ResourceName: "kubernetes_deployment",
-	Attributes:   map[string]func(any) (cty.Value, error){},
-	Blocks: map[string]ConverterSpec{
-		"exec":       kubernetesDeployment_spec_template_spec_initContainer_lifecycle_preStop_exec,
-		"http_get":   kubernetesDeployment_spec_template_spec_initContainer_lifecycle_preStop_httpGet,
-		"tcp_socket": kubernetesDeployment_spec_template_spec_initContainer_lifecycle_preStop_tcpSocket,
-	},
-}
-
-var kubernetesDeployment_spec_template_spec_initContainer_lifecycle_preStop_httpGet = ConverterSpec{
-	ResourceName: "kubernetes_deployment",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"host":   toString,
-		"path":   toString,
-		"port":   toString,
-		"scheme": toString,
-	},
-	Blocks: map[string]ConverterSpec{
-		"http_header": kubernetesDeployment_spec_template_spec_initContainer_lifecycle_preStop_httpGet_httpHeader,
-	},
-}
-
-var kubernetesDeployment_spec_template_spec_initContainer_lifecycle_preStop_httpGet_httpHeader = ConverterSpec{
-	ResourceName: "kubernetes_deployment",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"name":  toString,
-		"value": toString,
-	},
-	Blocks: map[string]ConverterSpec{},
-}
-
-var kubernetesDeployment_spec_template_spec_initContainer_lifecycle_preStop_exec = ConverterSpec{
-	ResourceName: "kubernetes_deployment",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"command": func(a any) (cty.Value, error) {
-			l, ok := a.([]any)
-			if !ok {
-				return cty.Value{}, fmt.Errorf("expected slice, got: %T", a)
-			}
-			vl := make([]cty.Value, 0, len(l))
-			for _, val := range l {
-				v, err := toString(val)
-				if err != nil {
-					return cty.Value{}, err
-				}
-				vl = append(vl, v)
-			}
-			return cty.ListVal(vl), nil
-		},
-	},
-	Blocks: map[string]ConverterSpec{},
-}
-
-var kubernetesDeployment_spec_template_spec_initContainer_lifecycle_preStop_tcpSocket = ConverterSpec{
-	ResourceName: "kubernetes_deployment",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"port": toString,
-	},
-	Blocks: map[string]ConverterSpec{},
-}
-
-var kubernetesDeployment_spec_template_spec_os = ConverterSpec{
-	ResourceName: "kubernetes_deployment",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"name": toString,
-	},
-	Blocks: map[string]ConverterSpec{},
-}
-
-var kubernetesDeployment_spec_template_spec_dnsConfig = ConverterSpec{
-	ResourceName: "kubernetes_deployment",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"nameservers": func(a any) (cty.Value, error) {
-			l, ok := a.([]any)
-			if !ok {
-				return cty.Value{}, fmt.Errorf("expected slice, got: %T", a)
-			}
-			vl := make([]cty.Value, 0, len(l))
-			for _, val := range l {
-				v, err := toString(val)
-				if err != nil {
-					return cty.Value{}, err
-				}
-				vl = append(vl, v)
-			}
-			return cty.ListVal(vl), nil
-		},
-		"searches": func(a any) (cty.Value, error) {
-			l, ok := a.([]any)
-			if !ok {
-				return cty.Value{}, fmt.Errorf("expected slice, got: %T", a)
-			}
-			vl := make([]cty.Value, 0, len(l))
-			for _, val := range l {
-				v, err := toString(val)
-				if err != nil {
-					return cty.Value{}, err
-				}
-				vl = append(vl, v)
-			}
-			return cty.ListVal(vl), nil
-		},
-	},
-	Blocks: map[string]ConverterSpec{
-		"option": kubernetesDeployment_spec_template_spec_dnsConfig_option,
-	},
-}
-
-var kubernetesDeployment_spec_template_spec_dnsConfig_option = ConverterSpec{
-	ResourceName: "kubernetes_deployment",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"name":  toString,
-		"value": toString,
-	},
-	Blocks: map[string]ConverterSpec{},
-}
-
-var kubernetesDeployment_spec_template_metadata = ConverterSpec{
-	ResourceName: "kubernetes_deployment",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"annotations":   toStringMap,
-		"generate_name": toString,
-		"labels":        toStringMap,
-		"name":          toString,
-		"namespace":     toString,
-	},
-	Blocks: map[string]ConverterSpec{},
-}
-
-var kubernetesDeployment_metadata = ConverterSpec{
-	ResourceName: "kubernetes_deployment",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"annotations":   toStringMap,
-		"generate_name": toString,
-		"labels":        toStringMap,
-		"name":          toString,
-		"namespace":     toString,
+	Schema: map[string]FieldSchema{
+		"metadata":         {Required: true, MaxItems: 1, Description: "Standard deployment's metadata. More info: https://github.com/kubernetes/community/blob/master/contributors/devel/sig-architecture/api-conventions.md#metadata"},
+		"spec":             {Required: true, MaxItems: 1, Description: "Spec defines the specification of the desired behavior of the deployment. More info: https://kubernetes.io/docs/reference/generated/kubernetes-api/v1.9/#deployment-v1-apps"},
+		"wait_for_rollout": {Optional: true, Description: "Wait for the rollout of the deployment to complete. Defaults to true.", Default: cty.BoolVal(true)},
 	},
-	Blocks: map[string]ConverterSpec{},
 }
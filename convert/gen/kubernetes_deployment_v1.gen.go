@@ -5,15 +5,9 @@ package gen
 // DO NOT EDIT.
 
 import (
-	"fmt"
-
 	"github.com/zclconf/go-cty/cty"
 )
 
-// Make sure resources with no list attributes don't cause build errors because
-// of an unused import.
-var _ = fmt.Sprintf
-
 func init() {
 	register("kubernetes_deployment_v1", kubernetesDeploymentV1)
 }
@@ -27,10 +21,31 @@ var kubernetesDeploymentV1 = ConverterSpec{
 		"metadata": kubernetesDeploymentV1_metadata,
 		"spec":     kubernetesDeploymentV1_spec,
 	},
+	Schema: map[string]FieldSchema{
+		"metadata":         {Required: true, MaxItems: 1, Description: "Standard deployment's metadata. More info: https://github.com/kubernetes/community/blob/master/contributors/devel/sig-architecture/api-conventions.md#metadata"},
+		"spec":             {Required: true, MaxItems: 1, Description: "Spec defines the specification of the desired behavior of the deployment. More info: https://kubernetes.io/docs/reference/generated/kubernetes-api/v1.9/#deployment-v1-apps"},
+		"wait_for_rollout": {Optional: true, Description: "Wait for the rollout of the deployment to complete. Defaults to true.", Default: cty.BoolVal(true)},
+	},
+}
+
+var kubernetesDeploymentV1_metadata = ConverterSpec{
+	Attributes: map[string]func(any) (cty.Value, error){
+		"annotations":   toStringMap,
+		"generate_name": toString,
+		"labels":        toStringMap,
+		"name":          toString,
+		"namespace":     toString,
+	},
+	Schema: map[string]FieldSchema{
+		"annotations":   {Optional: true, Description: "An unstructured key value map stored with the deployment that may be used to store arbitrary metadata. More info: https://kubernetes.io/docs/concepts/overview/working-with-objects/annotations/"},
+		"generate_name": {Optional: true, ForceNew: true, ConflictsWith: []string{"metadata.name"}, Description: "Prefix, used by the server, to generate a unique name ONLY IF the `name` field has not been provided. This value will also be combined with a unique suffix. More info: https://github.com/kubernetes/community/blob/master/contributors/devel/sig-architecture/api-conventions.md#idempotency"},
+		"labels":        {Optional: true, Description: "Map of string keys and values that can be used to organize and categorize (scope and select) the deployment. May match selectors of replication controllers and services. More info: https://kubernetes.io/docs/concepts/overview/working-with-objects/labels/"},
+		"name":          {Optional: true, ForceNew: true, ConflictsWith: []string{"metadata.generate_name"}, Description: "Name of the deployment, must be unique. Cannot be updated. More info: https://kubernetes.io/docs/concepts/overview/working-with-objects/names/#names"},
+		"namespace":     {Optional: true, ForceNew: true, Description: "Namespace defines the space within which name of the deployment must be unique.", Default: cty.StringVal("default")},
+	},
 }
 
 var kubernetesDeploymentV1_spec = ConverterSpec{
-	ResourceName: "kubernetes_deployment_v1",
 	Attributes: map[string]func(any) (cty.Value, error){
 		"min_ready_seconds":         toInt,
 		"paused":                    toBool,
@@ -43,2847 +58,162 @@ var kubernetesDeploymentV1_spec = ConverterSpec{
 		"strategy": kubernetesDeploymentV1_spec_strategy,
 		"template": kubernetesDeploymentV1_spec_template,
 	},
-}
-
-var kubernetesDeploymentV1_spec_template = ConverterSpec{
-	ResourceName: "kubernetes_deployment_v1",
-	Attributes:   map[string]func(any) (cty.Value, error){},
-	Blocks: map[string]ConverterSpec{
-		"metadata": kubernetesDeploymentV1_spec_template_metadata,
-		"spec":     kubernetesDeploymentV1_spec_template_spec,
-	},
-}
-
-var kubernetesDeploymentV1_spec_template_spec = ConverterSpec{
-	ResourceName: "kubernetes_deployment_v1",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"active_deadline_seconds":          toInt,
-		"automount_service_account_token":  toBool,
-		"dns_policy":                       toString,
-		"enable_service_links":             toBool,
-		"host_ipc":                         toBool,
-		"host_network":                     toBool,
-		"host_pid":                         toBool,
-		"hostname":                         toString,
-		"node_name":                        toString,
-		"node_selector":                    toStringMap,
-		"priority_class_name":              toString,
-		"restart_policy":                   toString,
-		"runtime_class_name":               toString,
-		"scheduler_name":                   toString,
-		"service_account_name":             toString,
-		"share_process_namespace":          toBool,
-		"subdomain":                        toString,
-		"termination_grace_period_seconds": toInt,
-	},
-	Blocks: map[string]ConverterSpec{
-		"affinity":                   kubernetesDeploymentV1_spec_template_spec_affinity,
-		"container":                  kubernetesDeploymentV1_spec_template_spec_container,
-		"dns_config":                 kubernetesDeploymentV1_spec_template_spec_dnsConfig,
-		"host_aliases":               kubernetesDeploymentV1_spec_template_spec_hostAliases,
-		"image_pull_secrets":         kubernetesDeploymentV1_spec_template_spec_imagePullSecrets,
-		"init_container":             kubernetesDeploymentV1_spec_template_spec_initContainer,
-		"os":                         kubernetesDeploymentV1_spec_template_spec_os,
-		"readiness_gate":             kubernetesDeploymentV1_spec_template_spec_readinessGate,
-		"security_context":           kubernetesDeploymentV1_spec_template_spec_securityContext,
-		"toleration":                 kubernetesDeploymentV1_spec_template_spec_toleration,
-		"topology_spread_constraint": kubernetesDeploymentV1_spec_template_spec_topologySpreadConstraint,
-		"volume":                     kubernetesDeploymentV1_spec_template_spec_volume,
-	},
-}
-
-var kubernetesDeploymentV1_spec_template_spec_toleration = ConverterSpec{
-	ResourceName: "kubernetes_deployment_v1",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"effect":             toString,
-		"key":                toString,
-		"operator":           toString,
-		"toleration_seconds": toString,
-		"value":              toString,
-	},
-	Blocks: map[string]ConverterSpec{},
-}
-
-var kubernetesDeploymentV1_spec_template_spec_securityContext = ConverterSpec{
-	ResourceName: "kubernetes_deployment_v1",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"fs_group":               toString,
-		"fs_group_change_policy": toString,
-		"run_as_group":           toString,
-		"run_as_non_root":        toBool,
-		"run_as_user":            toString,
-		"supplemental_groups": func(a any) (cty.Value, error) {
-			l, ok := a.([]any)
-			if !ok {
-				return cty.Value{}, fmt.Errorf("expected slice, got: %T", a)
-			}
-			vl := make([]cty.Value, 0, len(l))
-			for _, val := range l {
-				v, err := toInt(val)
-				if err != nil {
-					return cty.Value{}, err
-				}
-				vl = append(vl, v)
-			}
-			return cty.ListVal(vl), nil
-		},
-	},
-	Blocks: map[string]ConverterSpec{
-		"se_linux_options": kubernetesDeploymentV1_spec_template_spec_securityContext_seLinuxOptions,
-		"seccomp_profile":  kubernetesDeploymentV1_spec_template_spec_securityContext_seccompProfile,
-		"sysctl":           kubernetesDeploymentV1_spec_template_spec_securityContext_sysctl,
-		"windows_options":  kubernetesDeploymentV1_spec_template_spec_securityContext_windowsOptions,
-	},
-}
-
-var kubernetesDeploymentV1_spec_template_spec_securityContext_sysctl = ConverterSpec{
-	ResourceName: "kubernetes_deployment_v1",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"name":  toString,
-		"value": toString,
-	},
-	Blocks: map[string]ConverterSpec{},
-}
-
-var kubernetesDeploymentV1_spec_template_spec_securityContext_windowsOptions = ConverterSpec{
-	ResourceName: "kubernetes_deployment_v1",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"gmsa_credential_spec":      toString,
-		"gmsa_credential_spec_name": toString,
-		"host_process":              toBool,
-		"run_as_username":           toString,
-	},
-	Blocks: map[string]ConverterSpec{},
-}
-
-var kubernetesDeploymentV1_spec_template_spec_securityContext_seccompProfile = ConverterSpec{
-	ResourceName: "kubernetes_deployment_v1",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"localhost_profile": toString,
-		"type":              toString,
-	},
-	Blocks: map[string]ConverterSpec{},
-}
-
-var kubernetesDeploymentV1_spec_template_spec_securityContext_seLinuxOptions = ConverterSpec{
-	ResourceName: "kubernetes_deployment_v1",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"level": toString,
-		"role":  toString,
-		"type":  toString,
-		"user":  toString,
-	},
-	Blocks: map[string]ConverterSpec{},
-}
-
-var kubernetesDeploymentV1_spec_template_spec_dnsConfig = ConverterSpec{
-	ResourceName: "kubernetes_deployment_v1",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"nameservers": func(a any) (cty.Value, error) {
-			l, ok := a.([]any)
-			if !ok {
-				return cty.Value{}, fmt.Errorf("expected slice, got: %T", a)
-			}
-			vl := make([]cty.Value, 0, len(l))
-			for _, val := range l {
-				v, err := toString(val)
-				if err != nil {
-					return cty.Value{}, err
-				}
-				vl = append(vl, v)
-			}
-			return cty.ListVal(vl), nil
-		},
-		"searches": func(a any) (cty.Value, error) {
-			l, ok := a.([]any)
-			if !ok {
-				return cty.Value{}, fmt.Errorf("expected slice, got: %T", a)
-			}
-			vl := make([]cty.Value, 0, len(l))
-			for _, val := range l {
-				v, err := toString(val)
-				if err != nil {
-					return cty.Value{}, err
-				}
-				vl = append(vl, v)
-			}
-			return cty.ListVal(vl), nil
-		},
-	},
-	Blocks: map[string]ConverterSpec{
-		"option": kubernetesDeploymentV1_spec_template_spec_dnsConfig_option,
-	},
-}
-
-var kubernetesDeploymentV1_spec_template_spec_dnsConfig_option = ConverterSpec{
-	ResourceName: "kubernetes_deployment_v1",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"name":  toString,
-		"value": toString,
-	},
-	Blocks: map[string]ConverterSpec{},
-}
-
-var kubernetesDeploymentV1_spec_template_spec_topologySpreadConstraint = ConverterSpec{
-	ResourceName: "kubernetes_deployment_v1",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"match_label_keys": func(a any) (cty.Value, error) {
-			l, ok := a.([]any)
-			if !ok {
-				return cty.Value{}, fmt.Errorf("expected slice, got: %T", a)
-			}
-			vl := make([]cty.Value, 0, len(l))
-			for _, val := range l {
-				v, err := toString(val)
-				if err != nil {
-					return cty.Value{}, err
-				}
-				vl = append(vl, v)
-			}
-			return cty.ListVal(vl), nil
-		},
-		"max_skew":             toInt,
-		"min_domains":          toInt,
-		"node_affinity_policy": toString,
-		"node_taints_policy":   toString,
-		"topology_key":         toString,
-		"when_unsatisfiable":   toString,
-	},
-	Blocks: map[string]ConverterSpec{
-		"label_selector": kubernetesDeploymentV1_spec_template_spec_topologySpreadConstraint_labelSelector,
+	Schema: map[string]FieldSchema{
+		"min_ready_seconds":         {Optional: true, Description: "Minimum number of seconds for which a newly created pod should be ready without any of its container crashing, for it to be considered available. Defaults to 0 (pod will be considered available as soon as it is ready)", Default: cty.NumberIntVal(0)},
+		"paused":                    {Optional: true, Description: "Indicates that the deployment is paused.", Default: cty.BoolVal(false)},
+		"progress_deadline_seconds": {Optional: true, Description: "The maximum time in seconds for a deployment to make progress before it is considered to be failed. The deployment controller will continue to process failed deployments and a condition with a ProgressDeadlineExceeded reason will be surfaced in the deployment status. Note that progress will not be estimated during the time a deployment is paused. Defaults to 600s.", Default: cty.NumberIntVal(600)},
+		"replicas":                  {Optional: true, Description: "Number of desired pods. This is a string to be able to distinguish between explicit zero and not specified."},
+		"revision_history_limit":    {Optional: true, Description: "The number of old ReplicaSets to retain to allow rollback. This is a pointer to distinguish between explicit zero and not specified. Defaults to 10.", Default: cty.NumberIntVal(10)},
+		"selector":                  {Optional: true, ForceNew: true, MaxItems: 1, Description: "A label query over pods that should match the Replicas count."},
+		"strategy":                  {Optional: true, MaxItems: 1, Description: "The deployment strategy to use to replace existing pods with new ones."},
+		"template":                  {Required: true, MaxItems: 1, Description: "Template describes the pods that will be created."},
 	},
 }
 
-var kubernetesDeploymentV1_spec_template_spec_topologySpreadConstraint_labelSelector = ConverterSpec{
-	ResourceName: "kubernetes_deployment_v1",
+var kubernetesDeploymentV1_spec_selector = ConverterSpec{
 	Attributes: map[string]func(any) (cty.Value, error){
 		"match_labels": toStringMap,
 	},
 	Blocks: map[string]ConverterSpec{
-		"match_expressions": kubernetesDeploymentV1_spec_template_spec_topologySpreadConstraint_labelSelector_matchExpressions,
+		"match_expressions": kubernetesDeploymentV1_spec_selector_matchExpressions,
+	},
+	Schema: map[string]FieldSchema{
+		"match_expressions": {Optional: true, ForceNew: true, Description: "A list of label selector requirements. The requirements are ANDed."},
+		"match_labels":      {Optional: true, ForceNew: true, Description: "A map of {key,value} pairs. A single {key,value} in the matchLabels map is equivalent to an element of `match_expressions`, whose key field is \"key\", the operator is \"In\", and the values array contains only \"value\". The requirements are ANDed."},
 	},
 }
 
-var kubernetesDeploymentV1_spec_template_spec_topologySpreadConstraint_labelSelector_matchExpressions = ConverterSpec{
-	ResourceName: "kubernetes_deployment_v1",
+var kubernetesDeploymentV1_spec_selector_matchExpressions = ConverterSpec{
 	Attributes: map[string]func(any) (cty.Value, error){
 		"key":      toString,
 		"operator": toString,
-		"values": func(a any) (cty.Value, error) {
-			l, ok := a.([]any)
-			if !ok {
-				return cty.Value{}, fmt.Errorf("expected slice, got: %T", a)
-			}
-			vl := make([]cty.Value, 0, len(l))
-			for _, val := range l {
-				v, err := toString(val)
-				if err != nil {
-					return cty.Value{}, err
-				}
-				vl = append(vl, v)
-			}
-			return cty.ListVal(vl), nil
-		},
-	},
-	Blocks: map[string]ConverterSpec{},
-}
-
-var kubernetesDeploymentV1_spec_template_spec_container = ConverterSpec{
-	ResourceName: "kubernetes_deployment_v1",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"args": func(a any) (cty.Value, error) {
-			l, ok := a.([]any)
-			if !ok {
-				return cty.Value{}, fmt.Errorf("expected slice, got: %T", a)
-			}
-			vl := make([]cty.Value, 0, len(l))
-			for _, val := range l {
-				v, err := toString(val)
-				if err != nil {
-					return cty.Value{}, err
-				}
-				vl = append(vl, v)
-			}
-			return cty.ListVal(vl), nil
-		},
-		"command": func(a any) (cty.Value, error) {
-			l, ok := a.([]any)
-			if !ok {
-				return cty.Value{}, fmt.Errorf("expected slice, got: %T", a)
-			}
-			vl := make([]cty.Value, 0, len(l))
-			for _, val := range l {
-				v, err := toString(val)
-				if err != nil {
-					return cty.Value{}, err
-				}
-				vl = append(vl, v)
-			}
-			return cty.ListVal(vl), nil
-		},
-		"image":                      toString,
-		"image_pull_policy":          toString,
-		"name":                       toString,
-		"stdin":                      toBool,
-		"stdin_once":                 toBool,
-		"termination_message_path":   toString,
-		"termination_message_policy": toString,
-		"tty":                        toBool,
-		"working_dir":                toString,
-	},
-	Blocks: map[string]ConverterSpec{
-		"env":              kubernetesDeploymentV1_spec_template_spec_container_env,
-		"env_from":         kubernetesDeploymentV1_spec_template_spec_container_envFrom,
-		"lifecycle":        kubernetesDeploymentV1_spec_template_spec_container_lifecycle,
-		"liveness_probe":   kubernetesDeploymentV1_spec_template_spec_container_livenessProbe,
-		"port":             kubernetesDeploymentV1_spec_template_spec_container_port,
-		"readiness_probe":  kubernetesDeploymentV1_spec_template_spec_container_readinessProbe,
-		"resources":        kubernetesDeploymentV1_spec_template_spec_container_resources,
-		"security_context": kubernetesDeploymentV1_spec_template_spec_container_securityContext,
-		"startup_probe":    kubernetesDeploymentV1_spec_template_spec_container_startupProbe,
-		"volume_device":    kubernetesDeploymentV1_spec_template_spec_container_volumeDevice,
-		"volume_mount":     kubernetesDeploymentV1_spec_template_spec_container_volumeMount,
-	},
-}
-
-var kubernetesDeploymentV1_spec_template_spec_container_startupProbe = ConverterSpec{
-	ResourceName: "kubernetes_deployment_v1",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"failure_threshold":     toInt,
-		"initial_delay_seconds": toInt,
-		"period_seconds":        toInt,
-		"success_threshold":     toInt,
-		"timeout_seconds":       toInt,
-	},
-	Blocks: map[string]ConverterSpec{
-		"exec":       kubernetesDeploymentV1_spec_template_spec_container_startupProbe_exec,
-		"grpc":       kubernetesDeploymentV1_spec_template_spec_container_startupProbe_grpc,
-		"http_get":   kubernetesDeploymentV1_spec_template_spec_container_startupProbe_httpGet,
-		"tcp_socket": kubernetesDeploymentV1_spec_template_spec_container_startupProbe_tcpSocket,
-	},
-}
-
-var kubernetesDeploymentV1_spec_template_spec_container_startupProbe_tcpSocket = ConverterSpec{
-	ResourceName: "kubernetes_deployment_v1",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"port": toString,
-	},
-	Blocks: map[string]ConverterSpec{},
-}
-
-var kubernetesDeploymentV1_spec_template_spec_container_startupProbe_httpGet = ConverterSpec{
-	ResourceName: "kubernetes_deployment_v1",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"host":   toString,
-		"path":   toString,
-		"port":   toString,
-		"scheme": toString,
-	},
-	Blocks: map[string]ConverterSpec{
-		"http_header": kubernetesDeploymentV1_spec_template_spec_container_startupProbe_httpGet_httpHeader,
-	},
-}
-
-var kubernetesDeploymentV1_spec_template_spec_container_startupProbe_httpGet_httpHeader = ConverterSpec{
-	ResourceName: "kubernetes_deployment_v1",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"name":  toString,
-		"value": toString,
-	},
-	Blocks: map[string]ConverterSpec{},
-}
-
-var kubernetesDeploymentV1_spec_template_spec_container_startupProbe_grpc = ConverterSpec{
-	ResourceName: "kubernetes_deployment_v1",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"port":    toInt,
-		"service": toString,
-	},
-	Blocks: map[string]ConverterSpec{},
-}
-
-var kubernetesDeploymentV1_spec_template_spec_container_startupProbe_exec = ConverterSpec{
-	ResourceName: "kubernetes_deployment_v1",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"command": func(a any) (cty.Value, error) {
-			l, ok := a.([]any)
-			if !ok {
-				return cty.Value{}, fmt.Errorf("expected slice, got: %T", a)
-			}
-			vl := make([]cty.Value, 0, len(l))
-			for _, val := range l {
-				v, err := toString(val)
-				if err != nil {
-					return cty.Value{}, err
-				}
-				vl = append(vl, v)
-			}
-			return cty.ListVal(vl), nil
-		},
-	},
-	Blocks: map[string]ConverterSpec{},
-}
-
-var kubernetesDeploymentV1_spec_template_spec_container_readinessProbe = ConverterSpec{
-	ResourceName: "kubernetes_deployment_v1",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"failure_threshold":     toInt,
-		"initial_delay_seconds": toInt,
-		"period_seconds":        toInt,
-		"success_threshold":     toInt,
-		"timeout_seconds":       toInt,
-	},
-	Blocks: map[string]ConverterSpec{
-		"exec":       kubernetesDeploymentV1_spec_template_spec_container_readinessProbe_exec,
-		"grpc":       kubernetesDeploymentV1_spec_template_spec_container_readinessProbe_grpc,
-		"http_get":   kubernetesDeploymentV1_spec_template_spec_container_readinessProbe_httpGet,
-		"tcp_socket": kubernetesDeploymentV1_spec_template_spec_container_readinessProbe_tcpSocket,
-	},
-}
-
-var kubernetesDeploymentV1_spec_template_spec_container_readinessProbe_exec = ConverterSpec{
-	ResourceName: "kubernetes_deployment_v1",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"command": func(a any) (cty.Value, error) {
-			l, ok := a.([]any)
-			if !ok {
-				return cty.Value{}, fmt.Errorf("expected slice, got: %T", a)
-			}
-			vl := make([]cty.Value, 0, len(l))
-			for _, val := range l {
-				v, err := toString(val)
-				if err != nil {
-					return cty.Value{}, err
-				}
-				vl = append(vl, v)
-			}
-			return cty.ListVal(vl), nil
-		},
-	},
-	Blocks: map[string]ConverterSpec{},
-}
-
-var kubernetesDeploymentV1_spec_template_spec_container_readinessProbe_grpc = ConverterSpec{
-	ResourceName: "kubernetes_deployment_v1",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"port":    toInt,
-		"service": toString,
-	},
-	Blocks: map[string]ConverterSpec{},
-}
-
-var kubernetesDeploymentV1_spec_template_spec_container_readinessProbe_tcpSocket = ConverterSpec{
-	ResourceName: "kubernetes_deployment_v1",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"port": toString,
+		"values":   toList(toString),
 	},
-	Blocks: map[string]ConverterSpec{},
-}
-
-var kubernetesDeploymentV1_spec_template_spec_container_readinessProbe_httpGet = ConverterSpec{
-	ResourceName: "kubernetes_deployment_v1",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"host":   toString,
-		"path":   toString,
-		"port":   toString,
-		"scheme": toString,
-	},
-	Blocks: map[string]ConverterSpec{
-		"http_header": kubernetesDeploymentV1_spec_template_spec_container_readinessProbe_httpGet_httpHeader,
+	Schema: map[string]FieldSchema{
+		"key":      {Optional: true, ForceNew: true, Description: "The label key that the selector applies to."},
+		"operator": {Optional: true, ForceNew: true, Description: "A key's relationship to a set of values. Valid operators ard `In`, `NotIn`, `Exists` and `DoesNotExist`."},
+		"values":   {Optional: true, ForceNew: true, Description: "An array of string values. If the operator is `In` or `NotIn`, the values array must be non-empty. If the operator is `Exists` or `DoesNotExist`, the values array must be empty. This array is replaced during a strategic merge patch."},
 	},
 }
 
-var kubernetesDeploymentV1_spec_template_spec_container_readinessProbe_httpGet_httpHeader = ConverterSpec{
-	ResourceName: "kubernetes_deployment_v1",
+var kubernetesDeploymentV1_spec_strategy = ConverterSpec{
 	Attributes: map[string]func(any) (cty.Value, error){
-		"name":  toString,
-		"value": toString,
-	},
-	Blocks: map[string]ConverterSpec{},
-}
-
-var kubernetesDeploymentV1_spec_template_spec_container_lifecycle = ConverterSpec{
-	ResourceName: "kubernetes_deployment_v1",
-	Attributes:   map[string]func(any) (cty.Value, error){},
-	Blocks: map[string]ConverterSpec{
-		"post_start": kubernetesDeploymentV1_spec_template_spec_container_lifecycle_postStart,
-		"pre_stop":   kubernetesDeploymentV1_spec_template_spec_container_lifecycle_preStop,
+		"type": toString,
 	},
-}
-
-var kubernetesDeploymentV1_spec_template_spec_container_lifecycle_preStop = ConverterSpec{
-	ResourceName: "kubernetes_deployment_v1",
-	Attributes:   map[string]func(any) (cty.Value, error){},
 	Blocks: map[string]ConverterSpec{
-		"exec":       kubernetesDeploymentV1_spec_template_spec_container_lifecycle_preStop_exec,
-		"http_get":   kubernetesDeploymentV1_spec_template_spec_container_lifecycle_preStop_httpGet,
-		"tcp_socket": kubernetesDeploymentV1_spec_template_spec_container_lifecycle_preStop_tcpSocket,
-	},
-}
-
-var kubernetesDeploymentV1_spec_template_spec_container_lifecycle_preStop_tcpSocket = ConverterSpec{
-	ResourceName: "kubernetes_deployment_v1",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"port": toString,
-	},
-	Blocks: map[string]ConverterSpec{},
-}
-
-var kubernetesDeploymentV1_spec_template_spec_container_lifecycle_preStop_httpGet = ConverterSpec{
-	ResourceName: "kubernetes_deployment_v1",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"host":   toString,
-		"path":   toString,
-		"port":   toString,
-		"scheme": toString,
+		"rolling_update": kubernetesDeploymentV1_spec_strategy_rollingUpdate,
 	},
-	Blocks: map[string]ConverterSpec{
-		"http_header": kubernetesDeploymentV1_spec_template_spec_container_lifecycle_preStop_httpGet_httpHeader,
+	Schema: map[string]FieldSchema{
+		"rolling_update": {Optional: true, MaxItems: 1, Description: "Rolling update config params. Present only if DeploymentStrategyType = RollingUpdate."},
+		"type":           {Optional: true, Description: "Type of deployment. Can be 'Recreate' or 'RollingUpdate'. Default is RollingUpdate.", Default: cty.StringVal("RollingUpdate")},
 	},
 }
 
-var kubernetesDeploymentV1_spec_template_spec_container_lifecycle_preStop_httpGet_httpHeader = ConverterSpec{
-	ResourceName: "kubernetes_deployment_v1",
+var kubernetesDeploymentV1_spec_strategy_rollingUpdate = ConverterSpec{
 	Attributes: map[string]func(any) (cty.Value, error){
-		"name":  toString,
-		"value": toString,
+		"max_surge":       toString,
+		"max_unavailable": toString,
 	},
-	Blocks: map[string]ConverterSpec{},
-}
-
-var kubernetesDeploymentV1_spec_template_spec_container_lifecycle_preStop_exec = ConverterSpec{
-	ResourceName: "kubernetes_deployment_v1",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"command": func(a any) (cty.Value, error) {
-			l, ok := a.([]any)
-			if !ok {
-				return cty.Value{}, fmt.Errorf("expected slice, got: %T", a)
-			}
-			vl := make([]cty.Value, 0, len(l))
-			for _, val := range l {
-				v, err := toString(val)
-				if err != nil {
-					return cty.Value{}, err
-				}
-				vl = append(vl, v)
-			}
-			return cty.ListVal(vl), nil
-		},
+	Schema: map[string]FieldSchema{
+		"max_surge":       {Optional: true, Description: "The maximum number of pods that can be scheduled above the desired number of pods. Value can be an absolute number (ex: 5) or a percentage of desired pods (ex: 10%). This can not be 0 if MaxUnavailable is 0. Absolute number is calculated from percentage by rounding up. Defaults to 25%. Example: when this is set to 30%, the new RC can be scaled up immediately when the rolling update starts, such that the total number of old and new pods do not exceed 130% of desired pods. Once old pods have been killed, new RC can be scaled up further, ensuring that total number of pods running at any time during the update is atmost 130% of desired pods.", Default: cty.StringVal("25%")},
+		"max_unavailable": {Optional: true, Description: "The maximum number of pods that can be unavailable during the update. Value can be an absolute number (ex: 5) or a percentage of desired pods (ex: 10%). Absolute number is calculated from percentage by rounding down. This can not be 0 if MaxSurge is 0. Defaults to 25%. Example: when this is set to 30%, the old RC can be scaled down to 70% of desired pods immediately when the rolling update starts. Once new pods are ready, old RC can be scaled down further, followed by scaling up the new RC, ensuring that the total number of pods available at all times during the update is at least 70% of desired pods.", Default: cty.StringVal("25%")},
 	},
-	Blocks: map[string]ConverterSpec{},
 }
 
-var kubernetesDeploymentV1_spec_template_spec_container_lifecycle_postStart = ConverterSpec{
-	ResourceName: "kubernetes_deployment_v1",
-	Attributes:   map[string]func(any) (cty.Value, error){},
+var kubernetesDeploymentV1_spec_template = ConverterSpec{
+	Attributes: map[string]func(any) (cty.Value, error){},
 	Blocks: map[string]ConverterSpec{
-		"exec":       kubernetesDeploymentV1_spec_template_spec_container_lifecycle_postStart_exec,
-		"http_get":   kubernetesDeploymentV1_spec_template_spec_container_lifecycle_postStart_httpGet,
-		"tcp_socket": kubernetesDeploymentV1_spec_template_spec_container_lifecycle_postStart_tcpSocket,
-	},
-}
-
-var kubernetesDeploymentV1_spec_template_spec_container_lifecycle_postStart_tcpSocket = ConverterSpec{
-	ResourceName: "kubernetes_deployment_v1",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"port": toString,
-	},
-	Blocks: map[string]ConverterSpec{},
-}
-
-var kubernetesDeploymentV1_spec_template_spec_container_lifecycle_postStart_httpGet = ConverterSpec{
-	ResourceName: "kubernetes_deployment_v1",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"host":   toString,
-		"path":   toString,
-		"port":   toString,
-		"scheme": toString,
+		"metadata": kubernetesDeploymentV1_spec_template_metadata,
+		"spec":     kubernetesDeploymentV1_spec_template_spec,
 	},
-	Blocks: map[string]ConverterSpec{
-		"http_header": kubernetesDeploymentV1_spec_template_spec_container_lifecycle_postStart_httpGet_httpHeader,
+	Schema: map[string]FieldSchema{
+		"metadata": {Required: true, MaxItems: 1, Description: "Standard pod's metadata. More info: https://github.com/kubernetes/community/blob/master/contributors/devel/sig-architecture/api-conventions.md#metadata"},
+		"spec":     {Required: true, MaxItems: 1, Description: "Spec defines the specification of the desired behavior of the deployment. More info: https://kubernetes.io/docs/reference/generated/kubernetes-api/v1.9/#deployment-v1-apps"},
 	},
 }
 
-var kubernetesDeploymentV1_spec_template_spec_container_lifecycle_postStart_httpGet_httpHeader = ConverterSpec{
-	ResourceName: "kubernetes_deployment_v1",
+var kubernetesDeploymentV1_spec_template_metadata = ConverterSpec{
 	Attributes: map[string]func(any) (cty.Value, error){
-		"name":  toString,
-		"value": toString,
+		"annotations":   toStringMap,
+		"generate_name": toString,
+		"labels":        toStringMap,
+		"name":          toString,
+		"namespace":     toString,
 	},
-	Blocks: map[string]ConverterSpec{},
-}
-
-var kubernetesDeploymentV1_spec_template_spec_container_lifecycle_postStart_exec = ConverterSpec{
-	ResourceName: "kubernetes_deployment_v1",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"command": func(a any) (cty.Value, error) {
-			l, ok := a.([]any)
-			if !ok {
-				return cty.Value{}, fmt.Errorf("expected slice, got: %T", a)
-			}
-			vl := make([]cty.Value, 0, len(l))
-			for _, val := range l {
-				v, err := toString(val)
-				if err != nil {
-					return cty.Value{}, err
-				}
-				vl = append(vl, v)
-			}
-			return cty.ListVal(vl), nil
-		},
+	Schema: map[string]FieldSchema{
+		"annotations":   {Optional: true, Description: "An unstructured key value map stored with the pod that may be used to store arbitrary metadata. More info: https://kubernetes.io/docs/concepts/overview/working-with-objects/annotations/"},
+		"generate_name": {Optional: true, ForceNew: true, ConflictsWith: []string{"metadata.name"}, Description: "Prefix, used by the server, to generate a unique name ONLY IF the `name` field has not been provided. This value will also be combined with a unique suffix. More info: https://github.com/kubernetes/community/blob/master/contributors/devel/sig-architecture/api-conventions.md#idempotency"},
+		"labels":        {Optional: true, Description: "Map of string keys and values that can be used to organize and categorize (scope and select) the pod. May match selectors of replication controllers and services. More info: https://kubernetes.io/docs/concepts/overview/working-with-objects/labels/"},
+		"name":          {Optional: true, ForceNew: true, ConflictsWith: []string{"metadata.generate_name"}, Description: "Name of the pod, must be unique. Cannot be updated. More info: https://kubernetes.io/docs/concepts/overview/working-with-objects/names/#names"},
+		"namespace":     {Optional: true, ForceNew: true, Description: "Namespace defines the space within which name of the pod must be unique."},
 	},
-	Blocks: map[string]ConverterSpec{},
 }
 
-var kubernetesDeploymentV1_spec_template_spec_container_envFrom = ConverterSpec{
-	ResourceName: "kubernetes_deployment_v1",
+var kubernetesDeploymentV1_spec_template_spec = ConverterSpec{
 	Attributes: map[string]func(any) (cty.Value, error){
-		"prefix": toString,
+		"active_deadline_seconds":          toInt,
+		"automount_service_account_token":  toBool,
+		"dns_policy":                       toString,
+		"enable_service_links":             toBool,
+		"host_ipc":                         toBool,
+		"host_network":                     toBool,
+		"host_pid":                         toBool,
+		"hostname":                         toString,
+		"node_name":                        toString,
+		"node_selector":                    toStringMap,
+		"priority_class_name":              toString,
+		"restart_policy":                   toString,
+		"runtime_class_name":               toString,
+		"scheduler_name":                   toString,
+		"service_account_name":             toString,
+		"share_process_namespace":          toBool,
+		"subdomain":                        toString,
+		"termination_grace_period_seconds": toInt,
 	},
 	Blocks: map[string]ConverterSpec{
-		"config_map_ref": kubernetesDeploymentV1_spec_template_spec_container_envFrom_configMapRef,
-		"secret_ref":     kubernetesDeploymentV1_spec_template_spec_container_envFrom_secretRef,
-	},
-}
-
-var kubernetesDeploymentV1_spec_template_spec_container_envFrom_secretRef = ConverterSpec{
-	ResourceName: "kubernetes_deployment_v1",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"name":     toString,
-		"optional": toBool,
-	},
-	Blocks: map[string]ConverterSpec{},
-}
-
-var kubernetesDeploymentV1_spec_template_spec_container_envFrom_configMapRef = ConverterSpec{
-	ResourceName: "kubernetes_deployment_v1",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"name":     toString,
-		"optional": toBool,
-	},
-	Blocks: map[string]ConverterSpec{},
-}
-
-var kubernetesDeploymentV1_spec_template_spec_container_volumeMount = ConverterSpec{
-	ResourceName: "kubernetes_deployment_v1",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"mount_path":        toString,
-		"mount_propagation": toString,
-		"name":              toString,
-		"read_only":         toBool,
-		"sub_path":          toString,
-		"sub_path_expr":     toString,
-	},
-	Blocks: map[string]ConverterSpec{},
-}
-
-var kubernetesDeploymentV1_spec_template_spec_container_livenessProbe = ConverterSpec{
-	ResourceName: "kubernetes_deployment_v1",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"failure_threshold":     toInt,
-		"initial_delay_seconds": toInt,
-		"period_seconds":        toInt,
-		"success_threshold":     toInt,
-		"timeout_seconds":       toInt,
-	},
-	Blocks: map[string]ConverterSpec{
-		"exec":       kubernetesDeploymentV1_spec_template_spec_container_livenessProbe_exec,
-		"grpc":       kubernetesDeploymentV1_spec_template_spec_container_livenessProbe_grpc,
-		"http_get":   kubernetesDeploymentV1_spec_template_spec_container_livenessProbe_httpGet,
-		"tcp_socket": kubernetesDeploymentV1_spec_template_spec_container_livenessProbe_tcpSocket,
-	},
-}
-
-var kubernetesDeploymentV1_spec_template_spec_container_livenessProbe_grpc = ConverterSpec{
-	ResourceName: "kubernetes_deployment_v1",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"port":    toInt,
-		"service": toString,
-	},
-	Blocks: map[string]ConverterSpec{},
-}
-
-var kubernetesDeploymentV1_spec_template_spec_container_livenessProbe_tcpSocket = ConverterSpec{
-	ResourceName: "kubernetes_deployment_v1",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"port": toString,
-	},
-	Blocks: map[string]ConverterSpec{},
-}
-
-var kubernetesDeploymentV1_spec_template_spec_container_livenessProbe_httpGet = ConverterSpec{
-	ResourceName: "kubernetes_deployment_v1",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"host":   toString,
-		"path":   toString,
-		"port":   toString,
-		"scheme": toString,
-	},
-	Blocks: map[string]ConverterSpec{
-		"http_header": kubernetesDeploymentV1_spec_template_spec_container_livenessProbe_httpGet_httpHeader,
-	},
-}
-
-var kubernetesDeploymentV1_spec_template_spec_container_livenessProbe_httpGet_httpHeader = ConverterSpec{
-	ResourceName: "kubernetes_deployment_v1",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"name":  toString,
-		"value": toString,
-	},
-	Blocks: map[string]ConverterSpec{},
-}
-
-var kubernetesDeploymentV1_spec_template_spec_container_livenessProbe_exec = ConverterSpec{
-	ResourceName: "kubernetes_deployment_v1",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"command": func(a any) (cty.Value, error) {
-			l, ok := a.([]any)
-			if !ok {
-				return cty.Value{}, fmt.Errorf("expected slice, got: %T", a)
-			}
-			vl := make([]cty.Value, 0, len(l))
-			for _, val := range l {
-				v, err := toString(val)
-				if err != nil {
-					return cty.Value{}, err
-				}
-				vl = append(vl, v)
-			}
-			return cty.ListVal(vl), nil
-		},
-	},
-	Blocks: map[string]ConverterSpec{},
-}
-
-var kubernetesDeploymentV1_spec_template_spec_container_env = ConverterSpec{
-	ResourceName: "kubernetes_deployment_v1",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"name":  toString,
-		"value": toString,
-	},
-	Blocks: map[string]ConverterSpec{
-		"value_from": kubernetesDeploymentV1_spec_template_spec_container_env_valueFrom,
-	},
-}
-
-var kubernetesDeploymentV1_spec_template_spec_container_env_valueFrom = ConverterSpec{
-	ResourceName: "kubernetes_deployment_v1",
-	Attributes:   map[string]func(any) (cty.Value, error){},
-	Blocks: map[string]ConverterSpec{
-		"config_map_key_ref": kubernetesDeploymentV1_spec_template_spec_container_env_valueFrom_configMapKeyRef,
-		"field_ref":          kubernetesDeploymentV1_spec_template_spec_container_env_valueFrom_fieldRef,
-		"resource_field_ref": kubernetesDeploymentV1_spec_template_spec_container_env_valueFrom_resourceFieldRef,
-		"secret_key_ref":     kubernetesDeploymentV1_spec_template_spec_container_env_valueFrom_secretKeyRef,
-	},
-}
-
-var kubernetesDeploymentV1_spec_template_spec_container_env_valueFrom_secretKeyRef = ConverterSpec{
-	ResourceName: "kubernetes_deployment_v1",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"key":      toString,
-		"name":     toString,
-		"optional": toBool,
-	},
-	Blocks: map[string]ConverterSpec{},
-}
-
-var kubernetesDeploymentV1_spec_template_spec_container_env_valueFrom_resourceFieldRef = ConverterSpec{
-	ResourceName: "kubernetes_deployment_v1",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"container_name": toString,
-		"divisor":        toString,
-		"resource":       toString,
-	},
-	Blocks: map[string]ConverterSpec{},
-}
-
-var kubernetesDeploymentV1_spec_template_spec_container_env_valueFrom_fieldRef = ConverterSpec{
-	ResourceName: "kubernetes_deployment_v1",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"api_version": toString,
-		"field_path":  toString,
-	},
-	Blocks: map[string]ConverterSpec{},
-}
-
-var kubernetesDeploymentV1_spec_template_spec_container_env_valueFrom_configMapKeyRef = ConverterSpec{
-	ResourceName: "kubernetes_deployment_v1",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"key":      toString,
-		"name":     toString,
-		"optional": toBool,
-	},
-	Blocks: map[string]ConverterSpec{},
-}
-
-var kubernetesDeploymentV1_spec_template_spec_container_volumeDevice = ConverterSpec{
-	ResourceName: "kubernetes_deployment_v1",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"device_path": toString,
-		"name":        toString,
-	},
-	Blocks: map[string]ConverterSpec{},
-}
-
-var kubernetesDeploymentV1_spec_template_spec_container_securityContext = ConverterSpec{
-	ResourceName: "kubernetes_deployment_v1",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"allow_privilege_escalation": toBool,
-		"privileged":                 toBool,
-		"read_only_root_filesystem":  toBool,
-		"run_as_group":               toString,
-		"run_as_non_root":            toBool,
-		"run_as_user":                toString,
-	},
-	Blocks: map[string]ConverterSpec{
-		"capabilities":     kubernetesDeploymentV1_spec_template_spec_container_securityContext_capabilities,
-		"se_linux_options": kubernetesDeploymentV1_spec_template_spec_container_securityContext_seLinuxOptions,
-		"seccomp_profile":  kubernetesDeploymentV1_spec_template_spec_container_securityContext_seccompProfile,
-	},
-}
-
-var kubernetesDeploymentV1_spec_template_spec_container_securityContext_seLinuxOptions = ConverterSpec{
-	ResourceName: "kubernetes_deployment_v1",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"level": toString,
-		"role":  toString,
-		"type":  toString,
-		"user":  toString,
-	},
-	Blocks: map[string]ConverterSpec{},
-}
-
-var kubernetesDeploymentV1_spec_template_spec_container_securityContext_seccompProfile = ConverterSpec{
-	ResourceName: "kubernetes_deployment_v1",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"localhost_profile": toString,
-		"type":              toString,
-	},
-	Blocks: map[string]ConverterSpec{},
-}
-
-var kubernetesDeploymentV1_spec_template_spec_container_securityContext_capabilities = ConverterSpec{
-	ResourceName: "kubernetes_deployment_v1",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"add": func(a any) (cty.Value, error) {
-			l, ok := a.([]any)
-			if !ok {
-				return cty.Value{}, fmt.Errorf("expected slice, got: %T", a)
-			}
-			vl := make([]cty.Value, 0, len(l))
-			for _, val := range l {
-				v, err := toString(val)
-				if err != nil {
-					return cty.Value{}, err
-				}
-				vl = append(vl, v)
-			}
-			return cty.ListVal(vl), nil
-		},
-		"drop": func(a any) (cty.Value, error) {
-			l, ok := a.([]any)
-			if !ok {
-				return cty.Value{}, fmt.Errorf("expected slice, got: %T", a)
-			}
-			vl := make([]cty.Value, 0, len(l))
-			for _, val := range l {
-				v, err := toString(val)
-				if err != nil {
-					return cty.Value{}, err
-				}
-				vl = append(vl, v)
-			}
-			return cty.ListVal(vl), nil
-		},
-	},
-	Blocks: map[string]ConverterSpec{},
-}
-
-var kubernetesDeploymentV1_spec_template_spec_container_resources = ConverterSpec{
-	ResourceName: "kubernetes_deployment_v1",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"limits":   toStringMap,
-		"requests": toStringMap,
-	},
-	Blocks: map[string]ConverterSpec{},
-}
-
-var kubernetesDeploymentV1_spec_template_spec_container_port = ConverterSpec{
-	ResourceName: "kubernetes_deployment_v1",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"container_port": toInt,
-		"host_ip":        toString,
-		"host_port":      toInt,
-		"name":           toString,
-		"protocol":       toString,
-	},
-	Blocks: map[string]ConverterSpec{},
-}
-
-var kubernetesDeploymentV1_spec_template_spec_os = ConverterSpec{
-	ResourceName: "kubernetes_deployment_v1",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"name": toString,
-	},
-	Blocks: map[string]ConverterSpec{},
-}
-
-var kubernetesDeploymentV1_spec_template_spec_affinity = ConverterSpec{
-	ResourceName: "kubernetes_deployment_v1",
-	Attributes:   map[string]func(any) (cty.Value, error){},
-	Blocks: map[string]ConverterSpec{
-		"node_affinity":     kubernetesDeploymentV1_spec_template_spec_affinity_nodeAffinity,
-		"pod_affinity":      kubernetesDeploymentV1_spec_template_spec_affinity_podAffinity,
-		"pod_anti_affinity": kubernetesDeploymentV1_spec_template_spec_affinity_podAntiAffinity,
-	},
-}
-
-var kubernetesDeploymentV1_spec_template_spec_affinity_podAffinity = ConverterSpec{
-	ResourceName: "kubernetes_deployment_v1",
-	Attributes:   map[string]func(any) (cty.Value, error){},
-	Blocks: map[string]ConverterSpec{
-		"preferred_during_scheduling_ignored_during_execution": kubernetesDeploymentV1_spec_template_spec_affinity_podAffinity_preferredDuringSchedulingIgnoredDuringExecution,
-		"required_during_scheduling_ignored_during_execution":  kubernetesDeploymentV1_spec_template_spec_affinity_podAffinity_requiredDuringSchedulingIgnoredDuringExecution,
-	},
-}
-
-var kubernetesDeploymentV1_spec_template_spec_affinity_podAffinity_preferredDuringSchedulingIgnoredDuringExecution = ConverterSpec{
-	ResourceName: "kubernetes_deployment_v1",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"weight": toInt,
-	},
-	Blocks: map[string]ConverterSpec{
-		"pod_affinity_term": kubernetesDeploymentV1_spec_template_spec_affinity_podAffinity_preferredDuringSchedulingIgnoredDuringExecution_podAffinityTerm,
-	},
-}
-
-var kubernetesDeploymentV1_spec_template_spec_affinity_podAffinity_preferredDuringSchedulingIgnoredDuringExecution_podAffinityTerm = ConverterSpec{
-	ResourceName: "kubernetes_deployment_v1",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"namespaces": func(a any) (cty.Value, error) {
-			l, ok := a.([]any)
-			if !ok {
-				return cty.Value{}, fmt.Errorf("expected slice, got: %T", a)
-			}
-			vl := make([]cty.Value, 0, len(l))
-			for _, val := range l {
-				v, err := toString(val)
-				if err != nil {
-					return cty.Value{}, err
-				}
-				vl = append(vl, v)
-			}
-			return cty.ListVal(vl), nil
-		},
-		"topology_key": toString,
-	},
-	Blocks: map[string]ConverterSpec{
-		"label_selector":     kubernetesDeploymentV1_spec_template_spec_affinity_podAffinity_preferredDuringSchedulingIgnoredDuringExecution_podAffinityTerm_labelSelector,
-		"namespace_selector": kubernetesDeploymentV1_spec_template_spec_affinity_podAffinity_preferredDuringSchedulingIgnoredDuringExecution_podAffinityTerm_namespaceSelector,
-	},
-}
-
-var kubernetesDeploymentV1_spec_template_spec_affinity_podAffinity_preferredDuringSchedulingIgnoredDuringExecution_podAffinityTerm_namespaceSelector = ConverterSpec{
-	ResourceName: "kubernetes_deployment_v1",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"match_labels": toStringMap,
-	},
-	Blocks: map[string]ConverterSpec{
-		"match_expressions": kubernetesDeploymentV1_spec_template_spec_affinity_podAffinity_preferredDuringSchedulingIgnoredDuringExecution_podAffinityTerm_namespaceSelector_matchExpressions,
-	},
-}
-
-var kubernetesDeploymentV1_spec_template_spec_affinity_podAffinity_preferredDuringSchedulingIgnoredDuringExecution_podAffinityTerm_namespaceSelector_matchExpressions = ConverterSpec{
-	ResourceName: "kubernetes_deployment_v1",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"key":      toString,
-		"operator": toString,
-		"values": func(a any) (cty.Value, error) {
-			l, ok := a.([]any)
-			if !ok {
-				return cty.Value{}, fmt.Errorf("expected slice, got: %T", a)
-			}
-			vl := make([]cty.Value, 0, len(l))
-			for _, val := range l {
-				v, err := toString(val)
-				if err != nil {
-					return cty.Value{}, err
-				}
-				vl = append(vl, v)
-			}
-			return cty.ListVal(vl), nil
-		},
-	},
-	Blocks: map[string]ConverterSpec{},
-}
-
-var kubernetesDeploymentV1_spec_template_spec_affinity_podAffinity_preferredDuringSchedulingIgnoredDuringExecution_podAffinityTerm_labelSelector = ConverterSpec{
-	ResourceName: "kubernetes_deployment_v1",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"match_labels": toStringMap,
-	},
-	Blocks: map[string]ConverterSpec{
-		"match_expressions": kubernetesDeploymentV1_spec_template_spec_affinity_podAffinity_preferredDuringSchedulingIgnoredDuringExecution_podAffinityTerm_labelSelector_matchExpressions,
-	},
-}
-
-var kubernetesDeploymentV1_spec_template_spec_affinity_podAffinity_preferredDuringSchedulingIgnoredDuringExecution_podAffinityTerm_labelSelector_matchExpressions = ConverterSpec{
-	ResourceName: "kubernetes_deployment_v1",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"key":      toString,
-		"operator": toString,
-		"values": func(a any) (cty.Value, error) {
-			l, ok := a.([]any)
-			if !ok {
-				return cty.Value{}, fmt.Errorf("expected slice, got: %T", a)
-			}
-			vl := make([]cty.Value, 0, len(l))
-			for _, val := range l {
-				v, err := toString(val)
-				if err != nil {
-					return cty.Value{}, err
-				}
-				vl = append(vl, v)
-			}
-			return cty.ListVal(vl), nil
-		},
-	},
-	Blocks: map[string]ConverterSpec{},
-}
-
-var kubernetesDeploymentV1_spec_template_spec_affinity_podAffinity_requiredDuringSchedulingIgnoredDuringExecution = ConverterSpec{
-	ResourceName: "kubernetes_deployment_v1",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"namespaces": func(a any) (cty.Value, error) {
-			l, ok := a.([]any)
-			if !ok {
-				return cty.Value{}, fmt.Errorf("expected slice, got: %T", a)
-			}
-			vl := make([]cty.Value, 0, len(l))
-			for _, val := range l {
-				v, err := toString(val)
-				if err != nil {
-					return cty.Value{}, err
-				}
-				vl = append(vl, v)
-			}
-			return cty.ListVal(vl), nil
-		},
-		"topology_key": toString,
-	},
-	Blocks: map[string]ConverterSpec{
-		"label_selector":     kubernetesDeploymentV1_spec_template_spec_affinity_podAffinity_requiredDuringSchedulingIgnoredDuringExecution_labelSelector,
-		"namespace_selector": kubernetesDeploymentV1_spec_template_spec_affinity_podAffinity_requiredDuringSchedulingIgnoredDuringExecution_namespaceSelector,
-	},
-}
-
-var kubernetesDeploymentV1_spec_template_spec_affinity_podAffinity_requiredDuringSchedulingIgnoredDuringExecution_namespaceSelector = ConverterSpec{
-	ResourceName: "kubernetes_deployment_v1",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"match_labels": toStringMap,
-	},
-	Blocks: map[string]ConverterSpec{
-		"match_expressions": kubernetesDeploymentV1_spec_template_spec_affinity_podAffinity_requiredDuringSchedulingIgnoredDuringExecution_namespaceSelector_matchExpressions,
-	},
-}
-
-var kubernetesDeploymentV1_spec_template_spec_affinity_podAffinity_requiredDuringSchedulingIgnoredDuringExecution_namespaceSelector_matchExpressions = ConverterSpec{
-	ResourceName: "kubernetes_deployment_v1",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"key":      toString,
-		"operator": toString,
-		"values": func(a any) (cty.Value, error) {
-			l, ok := a.([]any)
-			if !ok {
-				return cty.Value{}, fmt.Errorf("expected slice, got: %T", a)
-			}
-			vl := make([]cty.Value, 0, len(l))
-			for _, val := range l {
-				v, err := toString(val)
-				if err != nil {
-					return cty.Value{}, err
-				}
-				vl = append(vl, v)
-			}
-			return cty.ListVal(vl), nil
-		},
-	},
-	Blocks: map[string]ConverterSpec{},
-}
-
-var kubernetesDeploymentV1_spec_template_spec_affinity_podAffinity_requiredDuringSchedulingIgnoredDuringExecution_labelSelector = ConverterSpec{
-	ResourceName: "kubernetes_deployment_v1",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"match_labels": toStringMap,
-	},
-	Blocks: map[string]ConverterSpec{
-		"match_expressions": kubernetesDeploymentV1_spec_template_spec_affinity_podAffinity_requiredDuringSchedulingIgnoredDuringExecution_labelSelector_matchExpressions,
-	},
-}
-
-var kubernetesDeploymentV1_spec_template_spec_affinity_podAffinity_requiredDuringSchedulingIgnoredDuringExecution_labelSelector_matchExpressions = ConverterSpec{
-	ResourceName: "kubernetes_deployment_v1",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"key":      toString,
-		"operator": toString,
-		"values": func(a any) (cty.Value, error) {
-			l, ok := a.([]any)
-			if !ok {
-				return cty.Value{}, fmt.Errorf("expected slice, got: %T", a)
-			}
-			vl := make([]cty.Value, 0, len(l))
-			for _, val := range l {
-				v, err := toString(val)
-				if err != nil {
-					return cty.Value{}, err
-				}
-				vl = append(vl, v)
-			}
-			return cty.ListVal(vl), nil
-		},
-	},
-	Blocks: map[string]ConverterSpec{},
-}
-
-var kubernetesDeploymentV1_spec_template_spec_affinity_nodeAffinity = ConverterSpec{
-	ResourceName: "kubernetes_deployment_v1",
-	Attributes:   map[string]func(any) (cty.Value, error){},
-	Blocks: map[string]ConverterSpec{
-		"preferred_during_scheduling_ignored_during_execution": kubernetesDeploymentV1_spec_template_spec_affinity_nodeAffinity_preferredDuringSchedulingIgnoredDuringExecution,
-		"required_during_scheduling_ignored_during_execution":  kubernetesDeploymentV1_spec_template_spec_affinity_nodeAffinity_requiredDuringSchedulingIgnoredDuringExecution,
-	},
-}
-
-var kubernetesDeploymentV1_spec_template_spec_affinity_nodeAffinity_preferredDuringSchedulingIgnoredDuringExecution = ConverterSpec{
-	ResourceName: "kubernetes_deployment_v1",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"weight": toInt,
-	},
-	Blocks: map[string]ConverterSpec{
-		"preference": kubernetesDeploymentV1_spec_template_spec_affinity_nodeAffinity_preferredDuringSchedulingIgnoredDuringExecution_preference,
-	},
-}
-
-var kubernetesDeploymentV1_spec_template_spec_affinity_nodeAffinity_preferredDuringSchedulingIgnoredDuringExecution_preference = ConverterSpec{
-	ResourceName: "kubernetes_deployment_v1",
-	Attributes:   map[string]func(any) (cty.Value, error){},
-	Blocks: map[string]ConverterSpec{
-		"match_expressions": kubernetesDeploymentV1_spec_template_spec_affinity_nodeAffinity_preferredDuringSchedulingIgnoredDuringExecution_preference_matchExpressions,
-		"match_fields":      kubernetesDeploymentV1_spec_template_spec_affinity_nodeAffinity_preferredDuringSchedulingIgnoredDuringExecution_preference_matchFields,
-	},
-}
-
-var kubernetesDeploymentV1_spec_template_spec_affinity_nodeAffinity_preferredDuringSchedulingIgnoredDuringExecution_preference_matchFields = ConverterSpec{
-	ResourceName: "kubernetes_deployment_v1",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"key":      toString,
-		"operator": toString,
-		"values": func(a any) (cty.Value, error) {
-			l, ok := a.([]any)
-			if !ok {
-				return cty.Value{}, fmt.Errorf("expected slice, got: %T", a)
-			}
-			vl := make([]cty.Value, 0, len(l))
-			for _, val := range l {
-				v, err := toString(val)
-				if err != nil {
-					return cty.Value{}, err
-				}
-				vl = append(vl, v)
-			}
-			return cty.ListVal(vl), nil
-		},
-	},
-	Blocks: map[string]ConverterSpec{},
-}
-
-var kubernetesDeploymentV1_spec_template_spec_affinity_nodeAffinity_preferredDuringSchedulingIgnoredDuringExecution_preference_matchExpressions = ConverterSpec{
-	ResourceName: "kubernetes_deployment_v1",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"key":      toString,
-		"operator": toString,
-		"values": func(a any) (cty.Value, error) {
-			l, ok := a.([]any)
-			if !ok {
-				return cty.Value{}, fmt.Errorf("expected slice, got: %T", a)
-			}
-			vl := make([]cty.Value, 0, len(l))
-			for _, val := range l {
-				v, err := toString(val)
-				if err != nil {
-					return cty.Value{}, err
-				}
-				vl = append(vl, v)
-			}
-			return cty.ListVal(vl), nil
-		},
-	},
-	Blocks: map[string]ConverterSpec{},
-}
-
-var kubernetesDeploymentV1_spec_template_spec_affinity_nodeAffinity_requiredDuringSchedulingIgnoredDuringExecution = ConverterSpec{
-	ResourceName: "kubernetes_deployment_v1",
-	Attributes:   map[string]func(any) (cty.Value, error){},
-	Blocks: map[string]ConverterSpec{
-		"node_selector_term": kubernetesDeploymentV1_spec_template_spec_affinity_nodeAffinity_requiredDuringSchedulingIgnoredDuringExecution_nodeSelectorTerm,
-	},
-}
-
-var kubernetesDeploymentV1_spec_template_spec_affinity_nodeAffinity_requiredDuringSchedulingIgnoredDuringExecution_nodeSelectorTerm = ConverterSpec{
-	ResourceName: "kubernetes_deployment_v1",
-	Attributes:   map[string]func(any) (cty.Value, error){},
-	Blocks: map[string]ConverterSpec{
-		"match_expressions": kubernetesDeploymentV1_spec_template_spec_affinity_nodeAffinity_requiredDuringSchedulingIgnoredDuringExecution_nodeSelectorTerm_matchExpressions,
-		"match_fields":      kubernetesDeploymentV1_spec_template_spec_affinity_nodeAffinity_requiredDuringSchedulingIgnoredDuringExecution_nodeSelectorTerm_matchFields,
-	},
-}
-
-var kubernetesDeploymentV1_spec_template_spec_affinity_nodeAffinity_requiredDuringSchedulingIgnoredDuringExecution_nodeSelectorTerm_matchFields = ConverterSpec{
-	ResourceName: "kubernetes_deployment_v1",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"key":      toString,
-		"operator": toString,
-		"values": func(a any) (cty.Value, error) {
-			l, ok := a.([]any)
-			if !ok {
-				return cty.Value{}, fmt.Errorf("expected slice, got: %T", a)
-			}
-			vl := make([]cty.Value, 0, len(l))
-			for _, val := range l {
-				v, err := toString(val)
-				if err != nil {
-					return cty.Value{}, err
-				}
-				vl = append(vl, v)
-			}
-			return cty.ListVal(vl), nil
-		},
-	},
-	Blocks: map[string]ConverterSpec{},
-}
-
-var kubernetesDeploymentV1_spec_template_spec_affinity_nodeAffinity_requiredDuringSchedulingIgnoredDuringExecution_nodeSelectorTerm_matchExpressions = ConverterSpec{
-	ResourceName: "kubernetes_deployment_v1",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"key":      toString,
-		"operator": toString,
-		"values": func(a any) (cty.Value, error) {
-			l, ok := a.([]any)
-			if !ok {
-				return cty.Value{}, fmt.Errorf("expected slice, got: %T", a)
-			}
-			vl := make([]cty.Value, 0, len(l))
-			for _, val := range l {
-				v, err := toString(val)
-				if err != nil {
-					return cty.Value{}, err
-				}
-				vl = append(vl, v)
-			}
-			return cty.ListVal(vl), nil
-		},
-	},
-	Blocks: map[string]ConverterSpec{},
-}
-
-var kubernetesDeploymentV1_spec_template_spec_affinity_podAntiAffinity = ConverterSpec{
-	ResourceName: "kubernetes_deployment_v1",
-	Attributes:   map[string]func(any) (cty.Value, error){},
-	Blocks: map[string]ConverterSpec{
-		"preferred_during_scheduling_ignored_during_execution": kubernetesDeploymentV1_spec_template_spec_affinity_podAntiAffinity_preferredDuringSchedulingIgnoredDuringExecution,
-		"required_during_scheduling_ignored_during_execution":  kubernetesDeploymentV1_spec_template_spec_affinity_podAntiAffinity_requiredDuringSchedulingIgnoredDuringExecution,
-	},
-}
-
-var kubernetesDeploymentV1_spec_template_spec_affinity_podAntiAffinity_preferredDuringSchedulingIgnoredDuringExecution = ConverterSpec{
-	ResourceName: "kubernetes_deployment_v1",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"weight": toInt,
-	},
-	Blocks: map[string]ConverterSpec{
-		"pod_affinity_term": kubernetesDeploymentV1_spec_template_spec_affinity_podAntiAffinity_preferredDuringSchedulingIgnoredDuringExecution_podAffinityTerm,
-	},
-}
-
-var kubernetesDeploymentV1_spec_template_spec_affinity_podAntiAffinity_preferredDuringSchedulingIgnoredDuringExecution_podAffinityTerm = ConverterSpec{
-	ResourceName: "kubernetes_deployment_v1",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"namespaces": func(a any) (cty.Value, error) {
-			l, ok := a.([]any)
-			if !ok {
-				return cty.Value{}, fmt.Errorf("expected slice, got: %T", a)
-			}
-			vl := make([]cty.Value, 0, len(l))
-			for _, val := range l {
-				v, err := toString(val)
-				if err != nil {
-					return cty.Value{}, err
-				}
-				vl = append(vl, v)
-			}
-			return cty.ListVal(vl), nil
-		},
-		"topology_key": toString,
-	},
-	Blocks: map[string]ConverterSpec{
-		"label_selector":     kubernetesDeploymentV1_spec_template_spec_affinity_podAntiAffinity_preferredDuringSchedulingIgnoredDuringExecution_podAffinityTerm_labelSelector,
-		"namespace_selector": kubernetesDeploymentV1_spec_template_spec_affinity_podAntiAffinity_preferredDuringSchedulingIgnoredDuringExecution_podAffinityTerm_namespaceSelector,
-	},
-}
-
-var kubernetesDeploymentV1_spec_template_spec_affinity_podAntiAffinity_preferredDuringSchedulingIgnoredDuringExecution_podAffinityTerm_namespaceSelector = ConverterSpec{
-	ResourceName: "kubernetes_deployment_v1",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"match_labels": toStringMap,
-	},
-	Blocks: map[string]ConverterSpec{
-		"match_expressions": kubernetesDeploymentV1_spec_template_spec_affinity_podAntiAffinity_preferredDuringSchedulingIgnoredDuringExecution_podAffinityTerm_namespaceSelector_matchExpressions,
-	},
-}
-
-var kubernetesDeploymentV1_spec_template_spec_affinity_podAntiAffinity_preferredDuringSchedulingIgnoredDuringExecution_podAffinityTerm_namespaceSelector_matchExpressions = ConverterSpec{
-	ResourceName: "kubernetes_deployment_v1",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"key":      toString,
-		"operator": toString,
-		"values": func(a any) (cty.Value, error) {
-			l, ok := a.([]any)
-			if !ok {
-				return cty.Value{}, fmt.Errorf("expected slice, got: %T", a)
-			}
-			vl := make([]cty.Value, 0, len(l))
-			for _, val := range l {
-				v, err := toString(val)
-				if err != nil {
-					return cty.Value{}, err
-				}
-				vl = append(vl, v)
-			}
-			return cty.ListVal(vl), nil
-		},
-	},
-	Blocks: map[string]ConverterSpec{},
-}
-
-var kubernetesDeploymentV1_spec_template_spec_affinity_podAntiAffinity_preferredDuringSchedulingIgnoredDuringExecution_podAffinityTerm_labelSelector = ConverterSpec{
-	ResourceName: "kubernetes_deployment_v1",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"match_labels": toStringMap,
-	},
-	Blocks: map[string]ConverterSpec{
-		"match_expressions": kubernetesDeploymentV1_spec_template_spec_affinity_podAntiAffinity_preferredDuringSchedulingIgnoredDuringExecution_podAffinityTerm_labelSelector_matchExpressions,
-	},
-}
-
-var kubernetesDeploymentV1_spec_template_spec_affinity_podAntiAffinity_preferredDuringSchedulingIgnoredDuringExecution_podAffinityTerm_labelSelector_matchExpressions = ConverterSpec{
-	ResourceName: "kubernetes_deployment_v1",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"key":      toString,
-		"operator": toString,
-		"values": func(a any) (cty.Value, error) {
-			l, ok := a.([]any)
-			if !ok {
-				return cty.Value{}, fmt.Errorf("expected slice, got: %T", a)
-			}
-			vl := make([]cty.Value, 0, len(l))
-			for _, val := range l {
-				v, err := toString(val)
-				if err != nil {
-					return cty.Value{}, err
-				}
-				vl = append(vl, v)
-			}
-			return cty.ListVal(vl), nil
-		},
-	},
-	Blocks: map[string]ConverterSpec{},
-}
-
-var kubernetesDeploymentV1_spec_template_spec_affinity_podAntiAffinity_requiredDuringSchedulingIgnoredDuringExecution = ConverterSpec{
-	ResourceName: "kubernetes_deployment_v1",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"namespaces": func(a any) (cty.Value, error) {
-			l, ok := a.([]any)
-			if !ok {
-				return cty.Value{}, fmt.Errorf("expected slice, got: %T", a)
-			}
-			vl := make([]cty.Value, 0, len(l))
-			for _, val := range l {
-				v, err := toString(val)
-				if err != nil {
-					return cty.Value{}, err
-				}
-				vl = append(vl, v)
-			}
-			return cty.ListVal(vl), nil
-		},
-		"topology_key": toString,
-	},
-	Blocks: map[string]ConverterSpec{
-		"label_selector":     kubernetesDeploymentV1_spec_template_spec_affinity_podAntiAffinity_requiredDuringSchedulingIgnoredDuringExecution_labelSelector,
-		"namespace_selector": kubernetesDeploymentV1_spec_template_spec_affinity_podAntiAffinity_requiredDuringSchedulingIgnoredDuringExecution_namespaceSelector,
-	},
-}
-
-var kubernetesDeploymentV1_spec_template_spec_affinity_podAntiAffinity_requiredDuringSchedulingIgnoredDuringExecution_namespaceSelector = ConverterSpec{
-	ResourceName: "kubernetes_deployment_v1",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"match_labels": toStringMap,
-	},
-	Blocks: map[string]ConverterSpec{
-		"match_expressions": kubernetesDeploymentV1_spec_template_spec_affinity_podAntiAffinity_requiredDuringSchedulingIgnoredDuringExecution_namespaceSelector_matchExpressions,
-	},
-}
-
-var kubernetesDeploymentV1_spec_template_spec_affinity_podAntiAffinity_requiredDuringSchedulingIgnoredDuringExecution_namespaceSelector_matchExpressions = ConverterSpec{
-	ResourceName: "kubernetes_deployment_v1",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"key":      toString,
-		"operator": toString,
-		"values": func(a any) (cty.Value, error) {
-			l, ok := a.([]any)
-			if !ok {
-				return cty.Value{}, fmt.Errorf("expected slice, got: %T", a)
-			}
-			vl := make([]cty.Value, 0, len(l))
-			for _, val := range l {
-				v, err := toString(val)
-				if err != nil {
-					return cty.Value{}, err
-				}
-				vl = append(vl, v)
-			}
-			return cty.ListVal(vl), nil
-		},
-	},
-	Blocks: map[string]ConverterSpec{},
-}
-
-var kubernetesDeploymentV1_spec_template_spec_affinity_podAntiAffinity_requiredDuringSchedulingIgnoredDuringExecution_labelSelector = ConverterSpec{
-	ResourceName: "kubernetes_deployment_v1",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"match_labels": toStringMap,
-	},
-	Blocks: map[string]ConverterSpec{
-		"match_expressions": kubernetesDeploymentV1_spec_template_spec_affinity_podAntiAffinity_requiredDuringSchedulingIgnoredDuringExecution_labelSelector_matchExpressions,
-	},
-}
-
-var kubernetesDeploymentV1_spec_template_spec_affinity_podAntiAffinity_requiredDuringSchedulingIgnoredDuringExecution_labelSelector_matchExpressions = ConverterSpec{
-	ResourceName: "kubernetes_deployment_v1",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"key":      toString,
-		"operator": toString,
-		"values": func(a any) (cty.Value, error) {
-			l, ok := a.([]any)
-			if !ok {
-				return cty.Value{}, fmt.Errorf("expected slice, got: %T", a)
-			}
-			vl := make([]cty.Value, 0, len(l))
-			for _, val := range l {
-				v, err := toString(val)
-				if err != nil {
-					return cty.Value{}, err
-				}
-				vl = append(vl, v)
-			}
-			return cty.ListVal(vl), nil
-		},
-	},
-	Blocks: map[string]ConverterSpec{},
-}
-
-var kubernetesDeploymentV1_spec_template_spec_imagePullSecrets = ConverterSpec{
-	ResourceName: "kubernetes_deployment_v1",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"name": toString,
-	},
-	Blocks: map[string]ConverterSpec{},
-}
-
-var kubernetesDeploymentV1_spec_template_spec_hostAliases = ConverterSpec{
-	ResourceName: "kubernetes_deployment_v1",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"hostnames": func(a any) (cty.Value, error) {
-			l, ok := a.([]any)
-			if !ok {
-				return cty.Value{}, fmt.Errorf("expected slice, got: %T", a)
-			}
-			vl := make([]cty.Value, 0, len(l))
-			for _, val := range l {
-				v, err := toString(val)
-				if err != nil {
-					return cty.Value{}, err
-				}
-				vl = append(vl, v)
-			}
-			return cty.ListVal(vl), nil
-		},
-		"ip": toString,
-	},
-	Blocks: map[string]ConverterSpec{},
-}
-
-var kubernetesDeploymentV1_spec_template_spec_volume = ConverterSpec{
-	ResourceName: "kubernetes_deployment_v1",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"name": toString,
-	},
-	Blocks: map[string]ConverterSpec{
-		"aws_elastic_block_store": kubernetesDeploymentV1_spec_template_spec_volume_awsElasticBlockStore,
-		"azure_disk":              kubernetesDeploymentV1_spec_template_spec_volume_azureDisk,
-		"azure_file":              kubernetesDeploymentV1_spec_template_spec_volume_azureFile,
-		"ceph_fs":                 kubernetesDeploymentV1_spec_template_spec_volume_cephFs,
-		"cinder":                  kubernetesDeploymentV1_spec_template_spec_volume_cinder,
-		"config_map":              kubernetesDeploymentV1_spec_template_spec_volume_configMap,
-		"csi":                     kubernetesDeploymentV1_spec_template_spec_volume_csi,
-		"downward_api":            kubernetesDeploymentV1_spec_template_spec_volume_downwardApi,
-		"empty_dir":               kubernetesDeploymentV1_spec_template_spec_volume_emptyDir,
-		"ephemeral":               kubernetesDeploymentV1_spec_template_spec_volume_ephemeral,
-		"fc":                      kubernetesDeploymentV1_spec_template_spec_volume_fc,
-		"flex_volume":             kubernetesDeploymentV1_spec_template_spec_volume_flexVolume,
-		"flocker":                 kubernetesDeploymentV1_spec_template_spec_volume_flocker,
-		"gce_persistent_disk":     kubernetesDeploymentV1_spec_template_spec_volume_gcePersistentDisk,
-		"git_repo":                kubernetesDeploymentV1_spec_template_spec_volume_gitRepo,
-		"glusterfs":               kubernetesDeploymentV1_spec_template_spec_volume_glusterfs,
-		"host_path":               kubernetesDeploymentV1_spec_template_spec_volume_hostPath,
-		"iscsi":                   kubernetesDeploymentV1_spec_template_spec_volume_iscsi,
-		"local":                   kubernetesDeploymentV1_spec_template_spec_volume_local,
-		"nfs":                     kubernetesDeploymentV1_spec_template_spec_volume_nfs,
-		"persistent_volume_claim": kubernetesDeploymentV1_spec_template_spec_volume_persistentVolumeClaim,
-		"photon_persistent_disk":  kubernetesDeploymentV1_spec_template_spec_volume_photonPersistentDisk,
-		"projected":               kubernetesDeploymentV1_spec_template_spec_volume_projected,
-		"quobyte":                 kubernetesDeploymentV1_spec_template_spec_volume_quobyte,
-		"rbd":                     kubernetesDeploymentV1_spec_template_spec_volume_rbd,
-		"secret":                  kubernetesDeploymentV1_spec_template_spec_volume_secret,
-		"vsphere_volume":          kubernetesDeploymentV1_spec_template_spec_volume_vsphereVolume,
-	},
-}
-
-var kubernetesDeploymentV1_spec_template_spec_volume_local = ConverterSpec{
-	ResourceName: "kubernetes_deployment_v1",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"path": toString,
-	},
-	Blocks: map[string]ConverterSpec{},
-}
-
-var kubernetesDeploymentV1_spec_template_spec_volume_vsphereVolume = ConverterSpec{
-	ResourceName: "kubernetes_deployment_v1",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"fs_type":     toString,
-		"volume_path": toString,
-	},
-	Blocks: map[string]ConverterSpec{},
-}
-
-var kubernetesDeploymentV1_spec_template_spec_volume_rbd = ConverterSpec{
-	ResourceName: "kubernetes_deployment_v1",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"ceph_monitors": func(a any) (cty.Value, error) {
-			l, ok := a.([]any)
-			if !ok {
-				return cty.Value{}, fmt.Errorf("expected slice, got: %T", a)
-			}
-			vl := make([]cty.Value, 0, len(l))
-			for _, val := range l {
-				v, err := toString(val)
-				if err != nil {
-					return cty.Value{}, err
-				}
-				vl = append(vl, v)
-			}
-			return cty.ListVal(vl), nil
-		},
-		"fs_type":    toString,
-		"keyring":    toString,
-		"rados_user": toString,
-		"rbd_image":  toString,
-		"rbd_pool":   toString,
-		"read_only":  toBool,
-	},
-	Blocks: map[string]ConverterSpec{
-		"secret_ref": kubernetesDeploymentV1_spec_template_spec_volume_rbd_secretRef,
-	},
-}
-
-var kubernetesDeploymentV1_spec_template_spec_volume_rbd_secretRef = ConverterSpec{
-	ResourceName: "kubernetes_deployment_v1",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"name":      toString,
-		"namespace": toString,
-	},
-	Blocks: map[string]ConverterSpec{},
-}
-
-var kubernetesDeploymentV1_spec_template_spec_volume_photonPersistentDisk = ConverterSpec{
-	ResourceName: "kubernetes_deployment_v1",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"fs_type": toString,
-		"pd_id":   toString,
-	},
-	Blocks: map[string]ConverterSpec{},
-}
-
-var kubernetesDeploymentV1_spec_template_spec_volume_nfs = ConverterSpec{
-	ResourceName: "kubernetes_deployment_v1",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"path":      toString,
-		"read_only": toBool,
-		"server":    toString,
-	},
-	Blocks: map[string]ConverterSpec{},
-}
-
-var kubernetesDeploymentV1_spec_template_spec_volume_glusterfs = ConverterSpec{
-	ResourceName: "kubernetes_deployment_v1",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"endpoints_name": toString,
-		"path":           toString,
-		"read_only":      toBool,
-	},
-	Blocks: map[string]ConverterSpec{},
-}
-
-var kubernetesDeploymentV1_spec_template_spec_volume_flexVolume = ConverterSpec{
-	ResourceName: "kubernetes_deployment_v1",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"driver":    toString,
-		"fs_type":   toString,
-		"options":   toStringMap,
-		"read_only": toBool,
-	},
-	Blocks: map[string]ConverterSpec{
-		"secret_ref": kubernetesDeploymentV1_spec_template_spec_volume_flexVolume_secretRef,
-	},
-}
-
-var kubernetesDeploymentV1_spec_template_spec_volume_flexVolume_secretRef = ConverterSpec{
-	ResourceName: "kubernetes_deployment_v1",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"name":      toString,
-		"namespace": toString,
-	},
-	Blocks: map[string]ConverterSpec{},
-}
-
-var kubernetesDeploymentV1_spec_template_spec_volume_azureFile = ConverterSpec{
-	ResourceName: "kubernetes_deployment_v1",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"read_only":        toBool,
-		"secret_name":      toString,
-		"secret_namespace": toString,
-		"share_name":       toString,
-	},
-	Blocks: map[string]ConverterSpec{},
-}
-
-var kubernetesDeploymentV1_spec_template_spec_volume_hostPath = ConverterSpec{
-	ResourceName: "kubernetes_deployment_v1",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"path": toString,
-		"type": toString,
-	},
-	Blocks: map[string]ConverterSpec{},
-}
-
-var kubernetesDeploymentV1_spec_template_spec_volume_iscsi = ConverterSpec{
-	ResourceName: "kubernetes_deployment_v1",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"fs_type":         toString,
-		"iqn":             toString,
-		"iscsi_interface": toString,
-		"lun":             toInt,
-		"read_only":       toBool,
-		"target_portal":   toString,
-	},
-	Blocks: map[string]ConverterSpec{},
-}
-
-var kubernetesDeploymentV1_spec_template_spec_volume_gcePersistentDisk = ConverterSpec{
-	ResourceName: "kubernetes_deployment_v1",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"fs_type":   toString,
-		"partition": toInt,
-		"pd_name":   toString,
-		"read_only": toBool,
-	},
-	Blocks: map[string]ConverterSpec{},
-}
-
-var kubernetesDeploymentV1_spec_template_spec_volume_flocker = ConverterSpec{
-	ResourceName: "kubernetes_deployment_v1",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"dataset_name": toString,
-		"dataset_uuid": toString,
-	},
-	Blocks: map[string]ConverterSpec{},
-}
-
-var kubernetesDeploymentV1_spec_template_spec_volume_fc = ConverterSpec{
-	ResourceName: "kubernetes_deployment_v1",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"fs_type":   toString,
-		"lun":       toInt,
-		"read_only": toBool,
-		"target_ww_ns": func(a any) (cty.Value, error) {
-			l, ok := a.([]any)
-			if !ok {
-				return cty.Value{}, fmt.Errorf("expected slice, got: %T", a)
-			}
-			vl := make([]cty.Value, 0, len(l))
-			for _, val := range l {
-				v, err := toString(val)
-				if err != nil {
-					return cty.Value{}, err
-				}
-				vl = append(vl, v)
-			}
-			return cty.ListVal(vl), nil
-		},
-	},
-	Blocks: map[string]ConverterSpec{},
-}
-
-var kubernetesDeploymentV1_spec_template_spec_volume_cinder = ConverterSpec{
-	ResourceName: "kubernetes_deployment_v1",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"fs_type":   toString,
-		"read_only": toBool,
-		"volume_id": toString,
-	},
-	Blocks: map[string]ConverterSpec{},
-}
-
-var kubernetesDeploymentV1_spec_template_spec_volume_cephFs = ConverterSpec{
-	ResourceName: "kubernetes_deployment_v1",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"monitors": func(a any) (cty.Value, error) {
-			l, ok := a.([]any)
-			if !ok {
-				return cty.Value{}, fmt.Errorf("expected slice, got: %T", a)
-			}
-			vl := make([]cty.Value, 0, len(l))
-			for _, val := range l {
-				v, err := toString(val)
-				if err != nil {
-					return cty.Value{}, err
-				}
-				vl = append(vl, v)
-			}
-			return cty.ListVal(vl), nil
-		},
-		"path":        toString,
-		"read_only":   toBool,
-		"secret_file": toString,
-		"user":        toString,
-	},
-	Blocks: map[string]ConverterSpec{
-		"secret_ref": kubernetesDeploymentV1_spec_template_spec_volume_cephFs_secretRef,
-	},
-}
-
-var kubernetesDeploymentV1_spec_template_spec_volume_cephFs_secretRef = ConverterSpec{
-	ResourceName: "kubernetes_deployment_v1",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"name":      toString,
-		"namespace": toString,
-	},
-	Blocks: map[string]ConverterSpec{},
-}
-
-var kubernetesDeploymentV1_spec_template_spec_volume_azureDisk = ConverterSpec{
-	ResourceName: "kubernetes_deployment_v1",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"caching_mode":  toString,
-		"data_disk_uri": toString,
-		"disk_name":     toString,
-		"fs_type":       toString,
-		"kind":          toString,
-		"read_only":     toBool,
-	},
-	Blocks: map[string]ConverterSpec{},
-}
-
-var kubernetesDeploymentV1_spec_template_spec_volume_awsElasticBlockStore = ConverterSpec{
-	ResourceName: "kubernetes_deployment_v1",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"fs_type":   toString,
-		"partition": toInt,
-		"read_only": toBool,
-		"volume_id": toString,
-	},
-	Blocks: map[string]ConverterSpec{},
-}
-
-var kubernetesDeploymentV1_spec_template_spec_volume_csi = ConverterSpec{
-	ResourceName: "kubernetes_deployment_v1",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"driver":            toString,
-		"fs_type":           toString,
-		"read_only":         toBool,
-		"volume_attributes": toStringMap,
-	},
-	Blocks: map[string]ConverterSpec{
-		"node_publish_secret_ref": kubernetesDeploymentV1_spec_template_spec_volume_csi_nodePublishSecretRef,
-	},
-}
-
-var kubernetesDeploymentV1_spec_template_spec_volume_csi_nodePublishSecretRef = ConverterSpec{
-	ResourceName: "kubernetes_deployment_v1",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"name": toString,
-	},
-	Blocks: map[string]ConverterSpec{},
-}
-
-var kubernetesDeploymentV1_spec_template_spec_volume_persistentVolumeClaim = ConverterSpec{
-	ResourceName: "kubernetes_deployment_v1",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"claim_name": toString,
-		"read_only":  toBool,
-	},
-	Blocks: map[string]ConverterSpec{},
-}
-
-var kubernetesDeploymentV1_spec_template_spec_volume_ephemeral = ConverterSpec{
-	ResourceName: "kubernetes_deployment_v1",
-	Attributes:   map[string]func(any) (cty.Value, error){},
-	Blocks: map[string]ConverterSpec{
-		"volume_claim_template": kubernetesDeploymentV1_spec_template_spec_volume_ephemeral_volumeClaimTemplate,
-	},
-}
-
-var kubernetesDeploymentV1_spec_template_spec_volume_ephemeral_volumeClaimTemplate = ConverterSpec{
-	ResourceName: "kubernetes_deployment_v1",
-	Attributes:   map[string]func(any) (cty.Value, error){},
-	Blocks: map[string]ConverterSpec{
-		"metadata": kubernetesDeploymentV1_spec_template_spec_volume_ephemeral_volumeClaimTemplate_metadata,
-		"spec":     kubernetesDeploymentV1_spec_template_spec_volume_ephemeral_volumeClaimTemplate_spec,
-	},
-}
-
-var kubernetesDeploymentV1_spec_template_spec_volume_ephemeral_volumeClaimTemplate_spec = ConverterSpec{
-	ResourceName: "kubernetes_deployment_v1",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"access_modes": func(a any) (cty.Value, error) {
-			l, ok := a.([]any)
-			if !ok {
-				return cty.Value{}, fmt.Errorf("expected slice, got: %T", a)
-			}
-			vl := make([]cty.Value, 0, len(l))
-			for _, val := range l {
-				v, err := toString(val)
-				if err != nil {
-					return cty.Value{}, err
-				}
-				vl = append(vl, v)
-			}
-			return cty.ListVal(vl), nil
-		},
-		"storage_class_name": toString,
-		"volume_mode":        toString,
-		"volume_name":        toString,
-	},
-	Blocks: map[string]ConverterSpec{
-		"resources": kubernetesDeploymentV1_spec_template_spec_volume_ephemeral_volumeClaimTemplate_spec_resources,
-		"selector":  kubernetesDeploymentV1_spec_template_spec_volume_ephemeral_volumeClaimTemplate_spec_selector,
-	},
-}
-
-var kubernetesDeploymentV1_spec_template_spec_volume_ephemeral_volumeClaimTemplate_spec_selector = ConverterSpec{
-	ResourceName: "kubernetes_deployment_v1",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"match_labels": toStringMap,
-	},
-	Blocks: map[string]ConverterSpec{
-		"match_expressions": kubernetesDeploymentV1_spec_template_spec_volume_ephemeral_volumeClaimTemplate_spec_selector_matchExpressions,
-	},
-}
-
-var kubernetesDeploymentV1_spec_template_spec_volume_ephemeral_volumeClaimTemplate_spec_selector_matchExpressions = ConverterSpec{
-	ResourceName: "kubernetes_deployment_v1",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"key":      toString,
-		"operator": toString,
-		"values": func(a any) (cty.Value, error) {
-			l, ok := a.([]any)
-			if !ok {
-				return cty.Value{}, fmt.Errorf("expected slice, got: %T", a)
-			}
-			vl := make([]cty.Value, 0, len(l))
-			for _, val := range l {
-				v, err := toString(val)
-				if err != nil {
-					return cty.Value{}, err
-				}
-				vl = append(vl, v)
-			}
-			return cty.ListVal(vl), nil
-		},
-	},
-	Blocks: map[string]ConverterSpec{},
-}
-
-var kubernetesDeploymentV1_spec_template_spec_volume_ephemeral_volumeClaimTemplate_spec_resources = ConverterSpec{
-	ResourceName: "kubernetes_deployment_v1",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"limits":   toStringMap,
-		"requests": toStringMap,
-	},
-	Blocks: map[string]ConverterSpec{},
-}
-
-var kubernetesDeploymentV1_spec_template_spec_volume_ephemeral_volumeClaimTemplate_metadata = ConverterSpec{
-	ResourceName: "kubernetes_deployment_v1",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"annotations": toStringMap,
-		"labels":      toStringMap,
-	},
-	Blocks: map[string]ConverterSpec{},
-}
-
-var kubernetesDeploymentV1_spec_template_spec_volume_projected = ConverterSpec{
-	ResourceName: "kubernetes_deployment_v1",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"default_mode": toString,
-	},
-	Blocks: map[string]ConverterSpec{
-		"sources": kubernetesDeploymentV1_spec_template_spec_volume_projected_sources,
-	},
-}
-
-var kubernetesDeploymentV1_spec_template_spec_volume_projected_sources = ConverterSpec{
-	ResourceName: "kubernetes_deployment_v1",
-	Attributes:   map[string]func(any) (cty.Value, error){},
-	Blocks: map[string]ConverterSpec{
-		"config_map":            kubernetesDeploymentV1_spec_template_spec_volume_projected_sources_configMap,
-		"downward_api":          kubernetesDeploymentV1_spec_template_spec_volume_projected_sources_downwardApi,
-		"secret":                kubernetesDeploymentV1_spec_template_spec_volume_projected_sources_secret,
-		"service_account_token": kubernetesDeploymentV1_spec_template_spec_volume_projected_sources_serviceAccountToken,
-	},
-}
-
-var kubernetesDeploymentV1_spec_template_spec_volume_projected_sources_configMap = ConverterSpec{
-	ResourceName: "kubernetes_deployment_v1",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"name":     toString,
-		"optional": toBool,
-	},
-	Blocks: map[string]ConverterSpec{
-		"items": kubernetesDeploymentV1_spec_template_spec_volume_projected_sources_configMap_items,
-	},
-}
-
-var kubernetesDeploymentV1_spec_template_spec_volume_projected_sources_configMap_items = ConverterSpec{
-	ResourceName: "kubernetes_deployment_v1",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"key":  toString,
-		"mode": toString,
-		"path": toString,
-	},
-	Blocks: map[string]ConverterSpec{},
-}
-
-var kubernetesDeploymentV1_spec_template_spec_volume_projected_sources_secret = ConverterSpec{
-	ResourceName: "kubernetes_deployment_v1",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"name":     toString,
-		"optional": toBool,
-	},
-	Blocks: map[string]ConverterSpec{
-		"items": kubernetesDeploymentV1_spec_template_spec_volume_projected_sources_secret_items,
-	},
-}
-
-var kubernetesDeploymentV1_spec_template_spec_volume_projected_sources_secret_items = ConverterSpec{
-	ResourceName: "kubernetes_deployment_v1",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"key":  toString,
-		"mode": toString,
-		"path": toString,
-	},
-	Blocks: map[string]ConverterSpec{},
-}
-
-var kubernetesDeploymentV1_spec_template_spec_volume_projected_sources_serviceAccountToken = ConverterSpec{
-	ResourceName: "kubernetes_deployment_v1",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"audience":           toString,
-		"expiration_seconds": toInt,
-		"path":               toString,
-	},
-	Blocks: map[string]ConverterSpec{},
-}
-
-var kubernetesDeploymentV1_spec_template_spec_volume_projected_sources_downwardApi = ConverterSpec{
-	ResourceName: "kubernetes_deployment_v1",
-	Attributes:   map[string]func(any) (cty.Value, error){},
-	Blocks: map[string]ConverterSpec{
-		"items": kubernetesDeploymentV1_spec_template_spec_volume_projected_sources_downwardApi_items,
-	},
-}
-
-var kubernetesDeploymentV1_spec_template_spec_volume_projected_sources_downwardApi_items = ConverterSpec{
-	ResourceName: "kubernetes_deployment_v1",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"mode": toString,
-		"path": toString,
-	},
-	Blocks: map[string]ConverterSpec{
-		"field_ref":          kubernetesDeploymentV1_spec_template_spec_volume_projected_sources_downwardApi_items_fieldRef,
-		"resource_field_ref": kubernetesDeploymentV1_spec_template_spec_volume_projected_sources_downwardApi_items_resourceFieldRef,
-	},
-}
-
-var kubernetesDeploymentV1_spec_template_spec_volume_projected_sources_downwardApi_items_resourceFieldRef = ConverterSpec{
-	ResourceName: "kubernetes_deployment_v1",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"container_name": toString,
-		"divisor":        toString,
-		"resource":       toString,
-	},
-	Blocks: map[string]ConverterSpec{},
-}
-
-var kubernetesDeploymentV1_spec_template_spec_volume_projected_sources_downwardApi_items_fieldRef = ConverterSpec{
-	ResourceName: "kubernetes_deployment_v1",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"api_version": toString,
-		"field_path":  toString,
-	},
-	Blocks: map[string]ConverterSpec{},
-}
-
-var kubernetesDeploymentV1_spec_template_spec_volume_secret = ConverterSpec{
-	ResourceName: "kubernetes_deployment_v1",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"default_mode": toString,
-		"optional":     toBool,
-		"secret_name":  toString,
-	},
-	Blocks: map[string]ConverterSpec{
-		"items": kubernetesDeploymentV1_spec_template_spec_volume_secret_items,
-	},
-}
-
-var kubernetesDeploymentV1_spec_template_spec_volume_secret_items = ConverterSpec{
-	ResourceName: "kubernetes_deployment_v1",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"key":  toString,
-		"mode": toString,
-		"path": toString,
-	},
-	Blocks: map[string]ConverterSpec{},
-}
-
-var kubernetesDeploymentV1_spec_template_spec_volume_emptyDir = ConverterSpec{
-	ResourceName: "kubernetes_deployment_v1",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"medium":     toString,
-		"size_limit": toString,
-	},
-	Blocks: map[string]ConverterSpec{},
-}
-
-var kubernetesDeploymentV1_spec_template_spec_volume_downwardApi = ConverterSpec{
-	ResourceName: "kubernetes_deployment_v1",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"default_mode": toString,
-	},
-	Blocks: map[string]ConverterSpec{
-		"items": kubernetesDeploymentV1_spec_template_spec_volume_downwardApi_items,
-	},
-}
-
-var kubernetesDeploymentV1_spec_template_spec_volume_downwardApi_items = ConverterSpec{
-	ResourceName: "kubernetes_deployment_v1",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"mode": toString,
-		"path": toString,
-	},
-	Blocks: map[string]ConverterSpec{
-		"field_ref":          kubernetesDeploymentV1_spec_template_spec_volume_downwardApi_items_fieldRef,
-		"resource_field_ref": kubernetesDeploymentV1_spec_template_spec_volume_downwardApi_items_resourceFieldRef,
-	},
-}
-
-var kubernetesDeploymentV1_spec_template_spec_volume_downwardApi_items_resourceFieldRef = ConverterSpec{
-	ResourceName: "kubernetes_deployment_v1",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"container_name": toString,
-		"divisor":        toString,
-		"resource":       toString,
-	},
-	Blocks: map[string]ConverterSpec{},
-}
-
-var kubernetesDeploymentV1_spec_template_spec_volume_downwardApi_items_fieldRef = ConverterSpec{
-	ResourceName: "kubernetes_deployment_v1",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"api_version": toString,
-		"field_path":  toString,
-	},
-	Blocks: map[string]ConverterSpec{},
-}
-
-var kubernetesDeploymentV1_spec_template_spec_volume_gitRepo = ConverterSpec{
-	ResourceName: "kubernetes_deployment_v1",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"directory":  toString,
-		"repository": toString,
-		"revision":   toString,
-	},
-	Blocks: map[string]ConverterSpec{},
-}
-
-var kubernetesDeploymentV1_spec_template_spec_volume_configMap = ConverterSpec{
-	ResourceName: "kubernetes_deployment_v1",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"default_mode": toString,
-		"name":         toString,
-		"optional":     toBool,
-	},
-	Blocks: map[string]ConverterSpec{
-		"items": kubernetesDeploymentV1_spec_template_spec_volume_configMap_items,
-	},
-}
-
-var kubernetesDeploymentV1_spec_template_spec_volume_configMap_items = ConverterSpec{
-	ResourceName: "kubernetes_deployment_v1",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"key":  toString,
-		"mode": toString,
-		"path": toString,
-	},
-	Blocks: map[string]ConverterSpec{},
-}
-
-var kubernetesDeploymentV1_spec_template_spec_volume_quobyte = ConverterSpec{
-	ResourceName: "kubernetes_deployment_v1",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"group":     toString,
-		"read_only": toBool,
-		"registry":  toString,
-		"user":      toString,
-		"volume":    toString,
-	},
-	Blocks: map[string]ConverterSpec{},
-}
-
-var kubernetesDeploymentV1_spec_template_spec_readinessGate = ConverterSpec{
-	ResourceName: "kubernetes_deployment_v1",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"condition_type": toString,
-	},
-	Blocks: map[string]ConverterSpec{},
-}
-
-var kubernetesDeploymentV1_spec_template_spec_initContainer = ConverterSpec{
-	ResourceName: "kubernetes_deployment_v1",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"args": func(a any) (cty.Value, error) {
-			l, ok := a.([]any)
-			if !ok {
-				return cty.Value{}, fmt.Errorf("expected slice, got: %T", a)
-			}
-			vl := make([]cty.Value, 0, len(l))
-			for _, val := range l {
-				v, err := toString(val)
-				if err != nil {
-					return cty.Value{}, err
-				}
-				vl = append(vl, v)
-			}
-			return cty.ListVal(vl), nil
-		},
-		"command": func(a any) (cty.Value, error) {
-			l, ok := a.([]any)
-			if !ok {
-				return cty.Value{}, fmt.Errorf("expected slice, got: %T", a)
-			}
-			vl := make([]cty.Value, 0, len(l))
-			for _, val := range l {
-				v, err := toString(val)
-				if err != nil {
-					return cty.Value{}, err
-				}
-				vl = append(vl, v)
-			}
-			return cty.ListVal(vl), nil
-		},
-		"image":                      toString,
-		"image_pull_policy":          toString,
-		"name":                       toString,
-		"stdin":                      toBool,
-		"stdin_once":                 toBool,
-		"termination_message_path":   toString,
-		"termination_message_policy": toString,
-		"tty":                        toBool,
-		"working_dir":                toString,
-	},
-	Blocks: map[string]ConverterSpec{
-		"env":              kubernetesDeploymentV1_spec_template_spec_initContainer_env,
-		"env_from":         kubernetesDeploymentV1_spec_template_spec_initContainer_envFrom,
-		"lifecycle":        kubernetesDeploymentV1_spec_template_spec_initContainer_lifecycle,
-		"liveness_probe":   kubernetesDeploymentV1_spec_template_spec_initContainer_livenessProbe,
-		"port":             kubernetesDeploymentV1_spec_template_spec_initContainer_port,
-		"readiness_probe":  kubernetesDeploymentV1_spec_template_spec_initContainer_readinessProbe,
-		"resources":        kubernetesDeploymentV1_spec_template_spec_initContainer_resources,
-		"security_context": kubernetesDeploymentV1_spec_template_spec_initContainer_securityContext,
-		"startup_probe":    kubernetesDeploymentV1_spec_template_spec_initContainer_startupProbe,
-		"volume_device":    kubernetesDeploymentV1_spec_template_spec_initContainer_volumeDevice,
-		"volume_mount":     kubernetesDeploymentV1_spec_template_spec_initContainer_volumeMount,
-	},
-}
-
-var kubernetesDeploymentV1_spec_template_spec_initContainer_startupProbe = ConverterSpec{
-	ResourceName: "kubernetes_deployment_v1",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"failure_threshold":     toInt,
-		"initial_delay_seconds": toInt,
-		"period_seconds":        toInt,
-		"success_threshold":     toInt,
-		"timeout_seconds":       toInt,
-	},
-	Blocks: map[string]ConverterSpec{
-		"exec":       kubernetesDeploymentV1_spec_template_spec_initContainer_startupProbe_exec,
-		"grpc":       kubernetesDeploymentV1_spec_template_spec_initContainer_startupProbe_grpc,
-		"http_get":   kubernetesDeploymentV1_spec_template_spec_initContainer_startupProbe_httpGet,
-		"tcp_socket": kubernetesDeploymentV1_spec_template_spec_initContainer_startupProbe_tcpSocket,
-	},
-}
-
-var kubernetesDeploymentV1_spec_template_spec_initContainer_startupProbe_exec = ConverterSpec{
-	ResourceName: "kubernetes_deployment_v1",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"command": func(a any) (cty.Value, error) {
-			l, ok := a.([]any)
-			if !ok {
-				return cty.Value{}, fmt.Errorf("expected slice, got: %T", a)
-			}
-			vl := make([]cty.Value, 0, len(l))
-			for _, val := range l {
-				v, err := toString(val)
-				if err != nil {
-					return cty.Value{}, err
-				}
-				vl = append(vl, v)
-			}
-			return cty.ListVal(vl), nil
-		},
-	},
-	Blocks: map[string]ConverterSpec{},
-}
-
-var kubernetesDeploymentV1_spec_template_spec_initContainer_startupProbe_grpc = ConverterSpec{
-	ResourceName: "kubernetes_deployment_v1",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"port":    toInt,
-		"service": toString,
-	},
-	Blocks: map[string]ConverterSpec{},
-}
-
-var kubernetesDeploymentV1_spec_template_spec_initContainer_startupProbe_tcpSocket = ConverterSpec{
-	ResourceName: "kubernetes_deployment_v1",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"port": toString,
-	},
-	Blocks: map[string]ConverterSpec{},
-}
-
-var kubernetesDeploymentV1_spec_template_spec_initContainer_startupProbe_httpGet = ConverterSpec{
-	ResourceName: "kubernetes_deployment_v1",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"host":   toString,
-		"path":   toString,
-		"port":   toString,
-		"scheme": toString,
-	},
-	Blocks: map[string]ConverterSpec{
-		"http_header": kubernetesDeploymentV1_spec_template_spec_initContainer_startupProbe_httpGet_httpHeader,
-	},
-}
-
-var kubernetesDeploymentV1_spec_template_spec_initContainer_startupProbe_httpGet_httpHeader = ConverterSpec{
-	ResourceName: "kubernetes_deployment_v1",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"name":  toString,
-		"value": toString,
-	},
-	Blocks: map[string]ConverterSpec{},
-}
-
-var kubernetesDeploymentV1_spec_template_spec_initContainer_port = ConverterSpec{
-	ResourceName: "kubernetes_deployment_v1",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"container_port": toInt,
-		"host_ip":        toString,
-		"host_port":      toInt,
-		"name":           toString,
-		"protocol":       toString,
-	},
-	Blocks: map[string]ConverterSpec{},
-}
-
-var kubernetesDeploymentV1_spec_template_spec_initContainer_securityContext = ConverterSpec{
-	ResourceName: "kubernetes_deployment_v1",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"allow_privilege_escalation": toBool,
-		"privileged":                 toBool,
-		"read_only_root_filesystem":  toBool,
-		"run_as_group":               toString,
-		"run_as_non_root":            toBool,
-		"run_as_user":                toString,
-	},
-	Blocks: map[string]ConverterSpec{
-		"capabilities":     kubernetesDeploymentV1_spec_template_spec_initContainer_securityContext_capabilities,
-		"se_linux_options": kubernetesDeploymentV1_spec_template_spec_initContainer_securityContext_seLinuxOptions,
-		"seccomp_profile":  kubernetesDeploymentV1_spec_template_spec_initContainer_securityContext_seccompProfile,
-	},
-}
-
-var kubernetesDeploymentV1_spec_template_spec_initContainer_securityContext_seLinuxOptions = ConverterSpec{
-	ResourceName: "kubernetes_deployment_v1",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"level": toString,
-		"role":  toString,
-		"type":  toString,
-		"user":  toString,
-	},
-	Blocks: map[string]ConverterSpec{},
-}
-
-var kubernetesDeploymentV1_spec_template_spec_initContainer_securityContext_capabilities = ConverterSpec{
-	ResourceName: "kubernetes_deployment_v1",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"add": func(a any) (cty.Value, error) {
-			l, ok := a.([]any)
-			if !ok {
-				return cty.Value{}, fmt.Errorf("expected slice, got: %T", a)
-			}
-			vl := make([]cty.Value, 0, len(l))
-			for _, val := range l {
-				v, err := toString(val)
-				if err != nil {
-					return cty.Value{}, err
-				}
-				vl = append(vl, v)
-			}
-			return cty.ListVal(vl), nil
-		},
-		"drop": func(a any) (cty.Value, error) {
-			l, ok := a.([]any)
-			if !ok {
-				return cty.Value{}, fmt.Errorf("expected slice, got: %T", a)
-			}
-			vl := make([]cty.Value, 0, len(l))
-			for _, val := range l {
-				v, err := toString(val)
-				if err != nil {
-					return cty.Value{}, err
-				}
-				vl = append(vl, v)
-			}
-			return cty.ListVal(vl), nil
-		},
-	},
-	Blocks: map[string]ConverterSpec{},
-}
-
-var kubernetesDeploymentV1_spec_template_spec_initContainer_securityContext_seccompProfile = ConverterSpec{
-	ResourceName: "kubernetes_deployment_v1",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"localhost_profile": toString,
-		"type":              toString,
-	},
-	Blocks: map[string]ConverterSpec{},
-}
-
-var kubernetesDeploymentV1_spec_template_spec_initContainer_readinessProbe = ConverterSpec{
-	ResourceName: "kubernetes_deployment_v1",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"failure_threshold":     toInt,
-		"initial_delay_seconds": toInt,
-		"period_seconds":        toInt,
-		"success_threshold":     toInt,
-		"timeout_seconds":       toInt,
-	},
-	Blocks: map[string]ConverterSpec{
-		"exec":       kubernetesDeploymentV1_spec_template_spec_initContainer_readinessProbe_exec,
-		"grpc":       kubernetesDeploymentV1_spec_template_spec_initContainer_readinessProbe_grpc,
-		"http_get":   kubernetesDeploymentV1_spec_template_spec_initContainer_readinessProbe_httpGet,
-		"tcp_socket": kubernetesDeploymentV1_spec_template_spec_initContainer_readinessProbe_tcpSocket,
-	},
-}
-
-var kubernetesDeploymentV1_spec_template_spec_initContainer_readinessProbe_grpc = ConverterSpec{
-	ResourceName: "kubernetes_deployment_v1",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"port":    toInt,
-		"service": toString,
-	},
-	Blocks: map[string]ConverterSpec{},
-}
-
-var kubernetesDeploymentV1_spec_template_spec_initContainer_readinessProbe_tcpSocket = ConverterSpec{
-	ResourceName: "kubernetes_deployment_v1",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"port": toString,
-	},
-	Blocks: map[string]ConverterSpec{},
-}
-
-var kubernetesDeploymentV1_spec_template_spec_initContainer_readinessProbe_exec = ConverterSpec{
-	ResourceName: "kubernetes_deployment_v1",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"command": func(a any) (cty.Value, error) {
-			l, ok := a.([]any)
-			if !ok {
-				return cty.Value{}, fmt.Errorf("expected slice, got: %T", a)
-			}
-			vl := make([]cty.Value, 0, len(l))
-			for _, val := range l {
-				v, err := toString(val)
-				if err != nil {
-					return cty.Value{}, err
-				}
-				vl = append(vl, v)
-			}
-			return cty.ListVal(vl), nil
-		},
-	},
-	Blocks: map[string]ConverterSpec{},
-}
-
-var kubernetesDeploymentV1_spec_template_spec_initContainer_readinessProbe_httpGet = ConverterSpec{
-	ResourceName: "kubernetes_deployment_v1",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"host":   toString,
-		"path":   toString,
-		"port":   toString,
-		"scheme": toString,
-	},
-	Blocks: map[string]ConverterSpec{
-		"http_header": kubernetesDeploymentV1_spec_template_spec_initContainer_readinessProbe_httpGet_httpHeader,
-	},
-}
-
-var kubernetesDeploymentV1_spec_template_spec_initContainer_readinessProbe_httpGet_httpHeader = ConverterSpec{
-	ResourceName: "kubernetes_deployment_v1",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"name":  toString,
-		"value": toString,
-	},
-	Blocks: map[string]ConverterSpec{},
-}
-
-var kubernetesDeploymentV1_spec_template_spec_initContainer_lifecycle = ConverterSpec{
-	ResourceName: "kubernetes_deployment_v1",
-	Attributes:   map[string]func(any) (cty.Value, error){},
-	Blocks: map[string]ConverterSpec{
-		"post_start": kubernetesDeploymentV1_spec_template_spec_initContainer_lifecycle_postStart,
-		"pre_stop":   kubernetesDeploymentV1_spec_template_spec_initContainer_lifecycle_preStop,
-	},
-}
-
-var kubernetesDeploymentV1_spec_template_spec_initContainer_lifecycle_preStop = ConverterSpec{
-	ResourceName: "kubernetes_deployment_v1",
-	Attributes:   map[string]func(any) (cty.Value, error){},
-	Blocks: map[string]ConverterSpec{
-		"exec":       kubernetesDeploymentV1_spec_template_spec_initContainer_lifecycle_preStop_exec,
-		"http_get":   kubernetesDeploymentV1_spec_template_spec_initContainer_lifecycle_preStop_httpGet,
-		"tcp_socket": kubernetesDeploymentV1_spec_template_spec_initContainer_lifecycle_preStop_tcpSocket,
-	},
-}
-
-var kubernetesDeploymentV1_spec_template_spec_initContainer_lifecycle_preStop_httpGet = ConverterSpec{
-	ResourceName: "kubernetes_deployment_v1",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"host":   toString,
-		"path":   toString,
-		"port":   toString,
-		"scheme": toString,
-	},
-	Blocks: map[string]ConverterSpec{
-		"http_header": kubernetesDeploymentV1_spec_template_spec_initContainer_lifecycle_preStop_httpGet_httpHeader,
-	},
-}
-
-var kubernetesDeploymentV1_spec_template_spec_initContainer_lifecycle_preStop_httpGet_httpHeader = ConverterSpec{
-	ResourceName: "kubernetes_deployment_v1",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"name":  toString,
-		"value": toString,
-	},
-	Blocks: map[string]ConverterSpec{},
-}
-
-var kubernetesDeploymentV1_spec_template_spec_initContainer_lifecycle_preStop_exec = ConverterSpec{
-	ResourceName: "kubernetes_deployment_v1",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"command": func(a any) (cty.Value, error) {
-			l, ok := a.([]any)
-			if !ok {
-				return cty.Value{}, fmt.Errorf("expected slice, got: %T", a)
-			}
-			vl := make([]cty.Value, 0, len(l))
-			for _, val := range l {
-				v, err := toString(val)
-				if err != nil {
-					return cty.Value{}, err
-				}
-				vl = append(vl, v)
-			}
-			return cty.ListVal(vl), nil
-		},
-	},
-	Blocks: map[string]ConverterSpec{},
-}
-
-var kubernetesDeploymentV1_spec_template_spec_initContainer_lifecycle_preStop_tcpSocket = ConverterSpec{
-	ResourceName: "kubernetes_deployment_v1",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"port": toString,
-	},
-	Blocks: map[string]ConverterSpec{},
-}
-
-var kubernetesDeploymentV1_spec_template_spec_initContainer_lifecycle_postStart = ConverterSpec{
-	ResourceName: "kubernetes_deployment_v1",
-	Attributes:   map[string]func(any) (cty.Value, error){},
-	Blocks: map[string]ConverterSpec{
-		"exec":       kubernetesDeploymentV1_spec_template_spec_initContainer_lifecycle_postStart_exec,
-		"http_get":   kubernetesDeploymentV1_spec_template_spec_initContainer_lifecycle_postStart_httpGet,
-		"tcp_socket": kubernetesDeploymentV1_spec_template_spec_initContainer_lifecycle_postStart_tcpSocket,
-	},
-}
-
-var kubernetesDeploymentV1_spec_template_spec_initContainer_lifecycle_postStart_httpGet = ConverterSpec{
-	ResourceName: "kubernetes_deployment_v1",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"host":   toString,
-		"path":   toString,
-		"port":   toString,
-		"scheme": toString,
-	},
-	Blocks: map[string]ConverterSpec{
-		"http_header": kubernetesDeploymentV1_spec_template_spec_initContainer_lifecycle_postStart_httpGet_httpHeader,
-	},
-}
-
-var kubernetesDeploymentV1_spec_template_spec_initContainer_lifecycle_postStart_httpGet_httpHeader = ConverterSpec{
-	ResourceName: "kubernetes_deployment_v1",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"name":  toString,
-		"value": toString,
-	},
-	Blocks: map[string]ConverterSpec{},
-}
-
-var kubernetesDeploymentV1_spec_template_spec_initContainer_lifecycle_postStart_exec = ConverterSpec{
-	ResourceName: "kubernetes_deployment_v1",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"command": func(a any) (cty.Value, error) {
-			l, ok := a.([]any)
-			if !ok {
-				return cty.Value{}, fmt.Errorf("expected slice, got: %T", a)
-			}
-			vl := make([]cty.Value, 0, len(l))
-			for _, val := range l {
-				v, err := toString(val)
-				if err != nil {
-					return cty.Value{}, err
-				}
-				vl = append(vl, v)
-			}
-			return cty.ListVal(vl), nil
-		},
-	},
-	Blocks: map[string]ConverterSpec{},
-}
-
-var kubernetesDeploymentV1_spec_template_spec_initContainer_lifecycle_postStart_tcpSocket = ConverterSpec{
-	ResourceName: "kubernetes_deployment_v1",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"port": toString,
-	},
-	Blocks: map[string]ConverterSpec{},
-}
-
-var kubernetesDeploymentV1_spec_template_spec_initContainer_livenessProbe = ConverterSpec{
-	ResourceName: "kubernetes_deployment_v1",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"failure_threshold":     toInt,
-		"initial_delay_seconds": toInt,
-		"period_seconds":        toInt,
-		"success_threshold":     toInt,
-		"timeout_seconds":       toInt,
-	},
-	Blocks: map[string]ConverterSpec{
-		"exec":       kubernetesDeploymentV1_spec_template_spec_initContainer_livenessProbe_exec,
-		"grpc":       kubernetesDeploymentV1_spec_template_spec_initContainer_livenessProbe_grpc,
-		"http_get":   kubernetesDeploymentV1_spec_template_spec_initContainer_livenessProbe_httpGet,
-		"tcp_socket": kubernetesDeploymentV1_spec_template_spec_initContainer_livenessProbe_tcpSocket,
-	},
-}
-
-var kubernetesDeploymentV1_spec_template_spec_initContainer_livenessProbe_exec = ConverterSpec{
-	ResourceName: "kubernetes_deployment_v1",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"command": func(a any) (cty.Value, error) {
-			l, ok := a.([]any)
-			if !ok {
-				return cty.Value{}, fmt.Errorf("expected slice, got: %T", a)
-			}
-			vl := make([]cty.Value, 0, len(l))
-			for _, val := range l {
-				v, err := toString(val)
-				if err != nil {
-					return cty.Value{}, err
-				}
-				vl = append(vl, v)
-			}
-			return cty.ListVal(vl), nil
-		},
-	},
-	Blocks: map[string]ConverterSpec{},
-}
-
-var kubernetesDeploymentV1_spec_template_spec_initContainer_livenessProbe_grpc = ConverterSpec{
-	ResourceName: "kubernetes_deployment_v1",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"port":    toInt,
-		"service": toString,
-	},
-	Blocks: map[string]ConverterSpec{},
-}
-
-var kubernetesDeploymentV1_spec_template_spec_initContainer_livenessProbe_tcpSocket = ConverterSpec{
-	ResourceName: "kubernetes_deployment_v1",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"port": toString,
-	},
-	Blocks: map[string]ConverterSpec{},
-}
-
-var kubernetesDeploymentV1_spec_template_spec_initContainer_livenessProbe_httpGet = ConverterSpec{
-	ResourceName: "kubernetes_deployment_v1",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"host":   toString,
-		"path":   toString,
-		"port":   toString,
-		"scheme": toString,
-	},
-	Blocks: map[string]ConverterSpec{
-		"http_header": kubernetesDeploymentV1_spec_template_spec_initContainer_livenessProbe_httpGet_httpHeader,
-	},
-}
-
-var kubernetesDeploymentV1_spec_template_spec_initContainer_livenessProbe_httpGet_httpHeader = ConverterSpec{
-	ResourceName: "kubernetes_deployment_v1",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"name":  toString,
-		"value": toString,
-	},
-	Blocks: map[string]ConverterSpec{},
-}
-
-var kubernetesDeploymentV1_spec_template_spec_initContainer_envFrom = ConverterSpec{
-	ResourceName: "kubernetes_deployment_v1",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"prefix": toString,
-	},
-	Blocks: map[string]ConverterSpec{
-		"config_map_ref": kubernetesDeploymentV1_spec_template_spec_initContainer_envFrom_configMapRef,
-		"secret_ref":     kubernetesDeploymentV1_spec_template_spec_initContainer_envFrom_secretRef,
-	},
-}
-
-var kubernetesDeploymentV1_spec_template_spec_initContainer_envFrom_secretRef = ConverterSpec{
-	ResourceName: "kubernetes_deployment_v1",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"name":     toString,
-		"optional": toBool,
-	},
-	Blocks: map[string]ConverterSpec{},
-}
-
-var kubernetesDeploymentV1_spec_template_spec_initContainer_envFrom_configMapRef = ConverterSpec{
-	ResourceName: "kubernetes_deployment_v1",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"name":     toString,
-		"optional": toBool,
-	},
-	Blocks: map[string]ConverterSpec{},
-}
-
-var kubernetesDeploymentV1_spec_template_spec_initContainer_env = ConverterSpec{
-	ResourceName: "kubernetes_deployment_v1",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"name":  toString,
-		"value": toString,
-	},
-	Blocks: map[string]ConverterSpec{
-		"value_from": kubernetesDeploymentV1_spec_template_spec_initContainer_env_valueFrom,
-	},
-}
-
-var kubernetesDeploymentV1_spec_template_spec_initContainer_env_valueFrom = ConverterSpec{
-	ResourceName: "kubernetes_deployment_v1",
-	Attributes:   map[string]func(any) (cty.Value, error){},
-	Blocks: map[string]ConverterSpec{
-		"config_map_key_ref": kubernetesDeploymentV1_spec_template_spec_initContainer_env_valueFrom_configMapKeyRef,
-		"field_ref":          kubernetesDeploymentV1_spec_template_spec_initContainer_env_valueFrom_fieldRef,
-		"resource_field_ref": kubernetesDeploymentV1_spec_template_spec_initContainer_env_valueFrom_resourceFieldRef,
-		"secret_key_ref":     kubernetesDeploymentV1_spec_template_spec_initContainer_env_valueFrom_secretKeyRef,
-	},
-}
-
-var kubernetesDeploymentV1_spec_template_spec_initContainer_env_valueFrom_secretKeyRef = ConverterSpec{
-	ResourceName: "kubernetes_deployment_v1",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"key":      toString,
-		"name":     toString,
-		"optional": toBool,
-	},
-	Blocks: map[string]ConverterSpec{},
-}
-
-var kubernetesDeploymentV1_spec_template_spec_initContainer_env_valueFrom_resourceFieldRef = ConverterSpec{
-	ResourceName: "kubernetes_deployment_v1",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"container_name": toString,
-		"divisor":        toString,
-		"resource":       toString,
-	},
-	Blocks: map[string]ConverterSpec{},
-}
-
-var kubernetesDeploymentV1_spec_template_spec_initContainer_env_valueFrom_fieldRef = ConverterSpec{
-	ResourceName: "kubernetes_deployment_v1",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"api_version": toString,
-		"field_path":  toString,
-	},
-	Blocks: map[string]ConverterSpec{},
-}
-
-var kubernetesDeploymentV1_spec_template_spec_initContainer_env_valueFrom_configMapKeyRef = ConverterSpec{
-	ResourceName: "kubernetes_deployment_v1",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"key":      toString,
-		"name":     toString,
-		"optional": toBool,
-	},
-	Blocks: map[string]ConverterSpec{},
-}
-
-var kubernetesDeploymentV1_spec_template_spec_initContainer_volumeDevice = ConverterSpec{
-	ResourceName: "kubernetes_deployment_v1",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"device_path": toString,
-		"name":        toString,
-	},
-	Blocks: map[string]ConverterSpec{},
-}
-
-var kubernetesDeploymentV1_spec_template_spec_initContainer_resources = ConverterSpec{
-	ResourceName: "kubernetes_deployment_v1",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"limits":   toStringMap,
-		"requests": toStringMap,
-	},
-	Blocks: map[string]ConverterSpec{},
-}
-
-var kubernetesDeploymentV1_spec_template_spec_initContainer_volumeMount = ConverterSpec{
-	ResourceName: "kubernetes_deployment_v1",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"mount_path":        toString,
-		"mount_propagation": toString,
-		"name":              toString,
-		"read_only":         toBool,
-		"sub_path":          toString,
-		"sub_path_expr":     toString,
-	},
-	Blocks: map[string]ConverterSpec{},
-}
-
-var kubernetesDeploymentV1_spec_template_metadata = ConverterSpec{
-	ResourceName: "kubernetes_deployment_v1",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"annotations":   toStringMap,
-		"generate_name": toString,
-		"labels":        toStringMap,
-		"name":          toString,
-		"namespace":     toString,
-	},
-	Blocks: map[string]ConverterSpec{},
-}
-
-var kubernetesDeploymentV1_spec_strategy = ConverterSpec{
-	ResourceName: "kubernetes_deployment_v1",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"type": toString,
-	},
-	Blocks: map[string]ConverterSpec{
-		"rolling_update": kubernetesDeploymentV1_spec_strategy_rollingUpdate,
-	},
-}
-
-var kubernetesDeploymentV1_spec_strategy_rollingUpdate = ConverterSpec{
-	ResourceName: "kubernetes_deployment_v1",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"max_surge":       toString,
-		"max_unavailable": toString,
-	},
-	Blocks: map[string]ConverterSpec{},
-}
-
-var kubernetesDeploymentV1_spec_selector = ConverterSpec{
-	ResourceName: "kubernetes_deployment_v1",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"match_labels": toStringMap,
-	},
-	Blocks: map[string]ConverterSpec{
-		"match_expressions": kubernetesDeploymentV1_spec_selector_matchExpressions,
-	},
-}
-
-var kubernetesDeploymentV1_spec_selector_matchExpressions = ConverterSpec{
-	ResourceName: "kubernetes_deployment_v1",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"key":      toString,
-		"operator": toString,
-		"values": func(a any) (cty.Value, error) {
-			l, ok := a.([]any)
-			if !ok {
-				return cty.Value{}, fmt.Errorf("expected slice, got: %T", a)
-			}
-			vl := make([]cty.Value, 0, len(l))
-			for _, val := range l {
-				v, err := toString(val)
-				if err != nil {
-					return cty.Value{}, err
-				}
-				vl = append(vl, v)
-			}
-			return cty.ListVal(vl), nil
-		},
-	},
-	Blocks: map[string]ConverterSpec{},
-}
-
-var kubernetesDeploymentV1_metadata = ConverterSpec{
-	ResourceName: "kubernetes_deployment_v1",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"annotations":   toStringMap,
-		"generate_name": toString,
-		"labels":        toStringMap,
-		"name":          toString,
-		"namespace":     toString,
+		"affinity":                   kubernetesPodV1_spec_affinity,
+		"container":                  kubernetesDaemonSetV1_spec_template_spec_container,
+		"dns_config":                 kubernetesDaemonSetV1_spec_template_spec_dnsConfig,
+		"host_aliases":               kubernetesDaemonSetV1_spec_template_spec_hostAliases,
+		"image_pull_secrets":         kubernetesDaemonSetV1_spec_template_spec_imagePullSecrets,
+		"init_container":             kubernetesDaemonSetV1_spec_template_spec_container,
+		"os":                         kubernetesPodV1_spec_os,
+		"readiness_gate":             kubernetesDaemonSetV1_spec_template_spec_readinessGate,
+		"security_context":           kubernetesDaemonSetV1_spec_template_spec_securityContext,
+		"toleration":                 kubernetesDaemonSetV1_spec_template_spec_toleration,
+		"topology_spread_constraint": kubernetesDaemonSetV1_spec_template_spec_topologySpreadConstraint,
+		"volume":                     kubernetesDaemonSetV1_spec_template_spec_volume,
+	},
+	Schema: map[string]FieldSchema{
+		"active_deadline_seconds":          {Optional: true, Description: "Optional duration in seconds the pod may be active on the node relative to StartTime before the system will actively try to mark it failed and kill associated containers. Value must be a positive integer."},
+		"affinity":                         {Optional: true, MaxItems: 1, Description: "Optional pod scheduling constraints."},
+		"automount_service_account_token":  {Optional: true, Description: "AutomountServiceAccountToken indicates whether a service account token should be automatically mounted.", Default: cty.BoolVal(true)},
+		"container":                        {Optional: true, Description: "List of containers belonging to the pod. Containers cannot currently be added or removed. There must be at least one container in a Pod. Cannot be updated. More info: https://kubernetes.io/docs/concepts/containers/"},
+		"dns_config":                       {Optional: true, MaxItems: 1, Description: "Specifies the DNS parameters of a pod. Parameters specified here will be merged to the generated DNS configuration based on DNSPolicy. Optional: Defaults to empty"},
+		"dns_policy":                       {Optional: true, Description: "Set DNS policy for containers within the pod. Valid values are 'ClusterFirstWithHostNet', 'ClusterFirst', 'Default' or 'None'. DNS parameters given in DNSConfig will be merged with the policy selected with DNSPolicy. To have DNS options set along with hostNetwork, you have to specify DNS policy explicitly to 'ClusterFirstWithHostNet'. Defaults to 'ClusterFirst'. More info: https://kubernetes.io/docs/concepts/services-networking/dns-pod-service/#pod-s-dns-policy", Default: cty.StringVal("ClusterFirst")},
+		"enable_service_links":             {Optional: true, Description: "Enables generating environment variables for service discovery. Defaults to true.", Default: cty.BoolVal(true)},
+		"host_aliases":                     {Optional: true, Description: "List of hosts and IPs that will be injected into the pod's hosts file if specified. Optional: Defaults to empty."},
+		"host_ipc":                         {Optional: true, Description: "Use the host's ipc namespace. Optional: Defaults to false.", Default: cty.BoolVal(false)},
+		"host_network":                     {Optional: true, Description: "Host networking requested for this pod. Use the host's network namespace. If this option is set, the ports that will be used must be specified.", Default: cty.BoolVal(false)},
+		"host_pid":                         {Optional: true, Description: "Use the host's pid namespace.", Default: cty.BoolVal(false)},
+		"hostname":                         {Optional: true, Description: "Specifies the hostname of the Pod If not specified, the pod's hostname will be set to a system-defined value."},
+		"image_pull_secrets":               {Optional: true, Description: "ImagePullSecrets is an optional list of references to secrets in the same namespace to use for pulling any of the images used by this PodSpec. If specified, these secrets will be passed to individual puller implementations for them to use. For example, in the case of docker, only DockerConfig type secrets are honored. More info: https://kubernetes.io/docs/concepts/containers/images/#specifying-imagepullsecrets-on-a-pod"},
+		"init_container":                   {Optional: true, Description: "List of init containers belonging to the pod. Init containers always run to completion and each must complete successfully before the next is started. More info: https://kubernetes.io/docs/concepts/workloads/pods/init-containers/"},
+		"node_name":                        {Optional: true, Description: "NodeName is a request to schedule this pod onto a specific node. If it is non-empty, the scheduler simply schedules this pod onto that node, assuming that it fits resource requirements."},
+		"node_selector":                    {Optional: true, Description: "NodeSelector is a selector which must be true for the pod to fit on a node. Selector which must match a node's labels for the pod to be scheduled on that node. More info: https://kubernetes.io/docs/concepts/configuration/assign-pod-node/."},
+		"os":                               {Optional: true, MaxItems: 1, Description: "Specifies the OS of the containers in the pod."},
+		"priority_class_name":              {Optional: true, Description: "If specified, indicates the pod's priority. \"system-node-critical\" and \"system-cluster-critical\" are two special keywords which indicate the highest priorities with the former being the highest priority. Any other name must be defined by creating a PriorityClass object with that name. If not specified, the pod priority will be default or zero if there is no default."},
+		"readiness_gate":                   {Optional: true, Description: "If specified, all readiness gates will be evaluated for pod readiness. A pod is ready when all its containers are ready AND all conditions specified in the readiness gates have status equal to \"True\" More info: https://git.k8s.io/enhancements/keps/sig-network/0007-pod-ready%2B%2B.md"},
+		"restart_policy":                   {Optional: true, Description: "Restart policy for all containers within the pod. Defaults to Always as the only option. More info: https://kubernetes.io/docs/concepts/workloads/pods/pod-lifecycle/#restart-policy.", Default: cty.StringVal("Always")},
+		"runtime_class_name":               {Optional: true, Description: "RuntimeClassName is a feature for selecting the container runtime configuration. The container runtime configuration is used to run a Pod's containers. More info: https://kubernetes.io/docs/concepts/containers/runtime-class"},
+		"scheduler_name":                   {Optional: true, Description: "If specified, the pod will be dispatched by specified scheduler. If not specified, the pod will be dispatched by default scheduler."},
+		"security_context":                 {Optional: true, MaxItems: 1, Description: "SecurityContext holds pod-level security attributes and common container settings. Optional: Defaults to empty"},
+		"service_account_name":             {Optional: true, Description: "ServiceAccountName is the name of the ServiceAccount to use to run this pod. More info: http://releases.k8s.io/HEAD/docs/design/service_accounts.md."},
+		"share_process_namespace":          {Optional: true, Description: "Share a single process namespace between all of the containers in a pod. When this is set containers will be able to view and signal processes from other containers in the same pod, and the first process in each container will not be assigned PID 1. HostPID and ShareProcessNamespace cannot both be set. Optional: Defaults to false.", Default: cty.BoolVal(false)},
+		"subdomain":                        {Optional: true, Description: "If specified, the fully qualified Pod hostname will be \"...svc.\". If not specified, the pod will not have a domainname at all.."},
+		"termination_grace_period_seconds": {Optional: true, Description: "Optional duration in seconds the pod needs to terminate gracefully. May be decreased in delete request. Value must be non-negative integer. The value zero indicates delete immediately. If this value is nil, the default grace period will be used instead. The grace period is the duration in seconds after the processes running in the pod are sent a termination signal and the time when the processes are forcibly halted with a kill signal. Set this value longer than the expected cleanup time for your process.", Default: cty.NumberIntVal(30)},
+		"toleration":                       {Optional: true, Description: "If specified, the pod's toleration. Optional: Defaults to empty"},
+		"topology_spread_constraint":       {Optional: true, Description: "describes how a group of pods ought to spread across topology domains. Scheduler will schedule pods in a way which abides by the constraints."},
+		"volume":                           {Optional: true, Description: "List of volumes that can be mounted by containers belonging to the pod. More info: https://kubernetes.io/docs/concepts/storage/volumes"},
 	},
-	Blocks: map[string]ConverterSpec{},
 }
@@ -5,15 +5,9 @@ package gen
 // DO NOT EDIT.
 
 import (
-	"fmt"
-
 	"github.com/zclconf/go-cty/cty"
 )
 
-// Make sure resources with no list attributes don't cause build errors because
-// of an unused import.
-var _ = fmt.Sprintf
-
 func init() {
 	register("kubernetes_endpoint_slice_v1", kubernetesEndpointSliceV1)
 }
@@ -28,37 +22,17 @@ var kubernetesEndpointSliceV1 = ConverterSpec{
 		"metadata": kubernetesEndpointSliceV1_metadata,
 		"port":     kubernetesEndpointSliceV1_port,
 	},
-}
-
-var kubernetesEndpointSliceV1_port = ConverterSpec{
-	ResourceName: "kubernetes_endpoint_slice_v1",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"app_protocol": toString,
-		"name":         toString,
-		"port":         toString,
-		"protocol":     toString,
+	Schema: map[string]FieldSchema{
+		"address_type": {Required: true, ForceNew: true, Description: "address_type specifies the type of address carried by this EndpointSlice. All addresses in this slice must be the same type. This field is immutable after creation."},
+		"endpoint":     {Required: true, MaxItems: 1000, Description: "endpoint is a list of unique endpoints in this slice. Each slice may include a maximum of 1000 endpoints."},
+		"metadata":     {Required: true, MaxItems: 1, Description: "Standard endpoint_slice's metadata. More info: https://github.com/kubernetes/community/blob/master/contributors/devel/sig-architecture/api-conventions.md#metadata"},
+		"port":         {Required: true, MaxItems: 100, Description: "port specifies the list of network ports exposed by each endpoint in this slice. Each port must have a unique name. Each slice may include a maximum of 100 ports."},
 	},
-	Blocks: map[string]ConverterSpec{},
 }
 
 var kubernetesEndpointSliceV1_endpoint = ConverterSpec{
-	ResourceName: "kubernetes_endpoint_slice_v1",
 	Attributes: map[string]func(any) (cty.Value, error){
-		"addresses": func(a any) (cty.Value, error) {
-			l, ok := a.([]any)
-			if !ok {
-				return cty.Value{}, fmt.Errorf("expected slice, got: %T", a)
-			}
-			vl := make([]cty.Value, 0, len(l))
-			for _, val := range l {
-				v, err := toString(val)
-				if err != nil {
-					return cty.Value{}, err
-				}
-				vl = append(vl, v)
-			}
-			return cty.ListVal(vl), nil
-		},
+		"addresses": toList(toString),
 		"hostname":  toString,
 		"node_name": toString,
 		"zone":      toString,
@@ -67,10 +41,30 @@ var kubernetesEndpointSliceV1_endpoint = ConverterSpec{
 		"condition":  kubernetesEndpointSliceV1_endpoint_condition,
 		"target_ref": kubernetesEndpointSliceV1_endpoint_targetRef,
 	},
+	Schema: map[string]FieldSchema{
+		"addresses":  {Required: true, Description: "addresses of this endpoint. The contents of this field are interpreted according to the corresponding EndpointSlice addressType field."},
+		"condition":  {Optional: true, MaxItems: 1, Description: "condition contains information about the current status of the endpoint."},
+		"hostname":   {Optional: true, Description: "hostname of this endpoint. This field may be used by consumers of endpoints to distinguish endpoints from each other."},
+		"node_name":  {Optional: true, Description: "nodeName represents the name of the Node hosting this endpoint. This can be used to determine endpoints local to a Node."},
+		"target_ref": {Optional: true, MaxItems: 1, Description: "targetRef is a reference to a Kubernetes object that represents this endpoint."},
+		"zone":       {Optional: true, Description: "zone is the name of the Zone this endpoint exists in."},
+	},
+}
+
+var kubernetesEndpointSliceV1_endpoint_condition = ConverterSpec{
+	Attributes: map[string]func(any) (cty.Value, error){
+		"ready":       toBool,
+		"serving":     toBool,
+		"terminating": toBool,
+	},
+	Schema: map[string]FieldSchema{
+		"ready":       {Optional: true, Description: "ready indicates that this endpoint is prepared to receive traffic, according to whatever system is managing the endpoint."},
+		"serving":     {Optional: true, Description: "serving is identical to ready except that it is set regardless of the terminating state of endpoints."},
+		"terminating": {Optional: true, Description: "terminating indicates that this endpoint is terminating."},
+	},
 }
 
 var kubernetesEndpointSliceV1_endpoint_targetRef = ConverterSpec{
-	ResourceName: "kubernetes_endpoint_slice_v1",
 	Attributes: map[string]func(any) (cty.Value, error){
 		"field_path":       toString,
 		"name":             toString,
@@ -78,21 +72,16 @@ var kubernetesEndpointSliceV1_endpoint_targetRef = ConverterSpec{
 		"resource_version": toString,
 		"uid":              toString,
 	},
-	Blocks: map[string]ConverterSpec{},
-}
-
-var kubernetesEndpointSliceV1_endpoint_condition = ConverterSpec{
-	ResourceName: "kubernetes_endpoint_slice_v1",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"ready":       toBool,
-		"serving":     toBool,
-		"terminating": toBool,
+	Schema: map[string]FieldSchema{
+		"field_path":       {Optional: true, Description: "If referring to a piece of an object instead of an entire object, this string should contain a valid JSON/Go field access statement, such as desiredState.manifest.containers[2]."},
+		"name":             {Required: true, Description: "Name of the referent."},
+		"namespace":        {Optional: true, Description: "Namespace of the referent.", Default: cty.StringVal("default")},
+		"resource_version": {Optional: true, Description: "Specific resourceVersion to which this reference is made, if any."},
+		"uid":              {Optional: true, Description: "If referring to a piece of an object instead of an entire object, this string should contain a valid JSON/Go field access statement, such as desiredState.manifest.containers[2]."},
 	},
-	Blocks: map[string]ConverterSpec{},
 }
 
 var kubernetesEndpointSliceV1_metadata = ConverterSpec{
-	ResourceName: "kubernetes_endpoint_slice_v1",
 	Attributes: map[string]func(any) (cty.Value, error){
 		"annotations":   toStringMap,
 		"generate_name": toString,
@@ -100,5 +89,26 @@ var kubernetesEndpointSliceV1_metadata = ConverterSpec{
 		"name":          toString,
 		"namespace":     toString,
 	},
-	Blocks: map[string]ConverterSpec{},
+	Schema: map[string]FieldSchema{
+		"annotations":   {Optional: true, Description: "An unstructured key value map stored with the endpoint_slice that may be used to store arbitrary metadata. More info: https://kubernetes.io/docs/concepts/overview/working-with-objects/annotations/"},
+		"generate_name": {Optional: true, ForceNew: true, ConflictsWith: []string{"metadata.name"}, Description: "Prefix, used by the server, to generate a unique name ONLY IF the `name` field has not been provided. This value will also be combined with a unique suffix. More info: https://github.com/kubernetes/community/blob/master/contributors/devel/sig-architecture/api-conventions.md#idempotency"},
+		"labels":        {Optional: true, Description: "Map of string keys and values that can be used to organize and categorize (scope and select) the endpoint_slice. May match selectors of replication controllers and services. More info: https://kubernetes.io/docs/concepts/overview/working-with-objects/labels/"},
+		"name":          {Optional: true, ForceNew: true, ConflictsWith: []string{"metadata.generate_name"}, Description: "Name of the endpoint_slice, must be unique. Cannot be updated. More info: https://kubernetes.io/docs/concepts/overview/working-with-objects/names/#names"},
+		"namespace":     {Optional: true, ForceNew: true, Description: "Namespace defines the space within which name of the endpoint_slice must be unique.", Default: cty.StringVal("default")},
+	},
+}
+
+var kubernetesEndpointSliceV1_port = ConverterSpec{
+	Attributes: map[string]func(any) (cty.Value, error){
+		"app_protocol": toString,
+		"name":         toString,
+		"port":         toString,
+		"protocol":     toString,
+	},
+	Schema: map[string]FieldSchema{
+		"app_protocol": {Required: true, Description: "The application protocol for this port. This is used as a hint for implementations to offer richer behavior for protocols that they understand."},
+		"name":         {Optional: true, Description: "name represents the name of this port. All ports in an EndpointSlice must have a unique name."},
+		"port":         {Required: true, Description: "port represents the port number of the endpoint."},
+		"protocol":     {Optional: true, Description: "protocol represents the IP protocol for this port. Must be UDP, TCP, or SCTP. Default is TCP.", Default: cty.StringVal("TCP")},
+	},
 }
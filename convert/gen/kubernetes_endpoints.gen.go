@@ -5,15 +5,9 @@ package gen
 // DO NOT EDIT.
 
 import (
-	"fmt"
-
 	"github.com/zclconf/go-cty/cty"
 )
 
-// Make sure resources with no list attributes don't cause build errors because
-// of an unused import.
-var _ = fmt.Sprintf
-
 func init() {
 	register("kubernetes_endpoints", kubernetesEndpoints)
 }
@@ -22,59 +16,11 @@ var kubernetesEndpoints = ConverterSpec{
 	ResourceName: "kubernetes_endpoints",
 	Attributes:   map[string]func(any) (cty.Value, error){},
 	Blocks: map[string]ConverterSpec{
-		"metadata": kubernetesEndpoints_metadata,
-		"subset":   kubernetesEndpoints_subset,
-	},
-}
-
-var kubernetesEndpoints_subset = ConverterSpec{
-	ResourceName: "kubernetes_endpoints",
-	Attributes:   map[string]func(any) (cty.Value, error){},
-	Blocks: map[string]ConverterSpec{
-		"address":           kubernetesEndpoints_subset_address,
-		"not_ready_address": kubernetesEndpoints_subset_notReadyAddress,
-		"port":              kubernetesEndpoints_subset_port,
-	},
-}
-
-var kubernetesEndpoints_subset_address = ConverterSpec{
-	ResourceName: "kubernetes_endpoints",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"hostname":  toString,
-		"ip":        toString,
-		"node_name": toString,
+		"metadata": kubernetesEndpointsV1_metadata,
+		"subset":   kubernetesEndpointsV1_subset,
 	},
-	Blocks: map[string]ConverterSpec{},
-}
-
-var kubernetesEndpoints_subset_port = ConverterSpec{
-	ResourceName: "kubernetes_endpoints",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"name":     toString,
-		"port":     toInt,
-		"protocol": toString,
-	},
-	Blocks: map[string]ConverterSpec{},
-}
-
-var kubernetesEndpoints_subset_notReadyAddress = ConverterSpec{
-	ResourceName: "kubernetes_endpoints",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"hostname":  toString,
-		"ip":        toString,
-		"node_name": toString,
-	},
-	Blocks: map[string]ConverterSpec{},
-}
-
-var kubernetesEndpoints_metadata = ConverterSpec{
-	ResourceName: "kubernetes_endpoints",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"annotations":   toStringMap,
-		"generate_name": toString,
-		"labels":        toStringMap,
-		"name":          toString,
-		"namespace":     toString,
+	Schema: map[string]FieldSchema{
+		"metadata": {Required: true, MaxItems: 1, Description: "Standard endpoints's metadata. More info: https://github.com/kubernetes/community/blob/master/contributors/devel/sig-architecture/api-conventions.md#metadata"},
+		"subset":   {Optional: true, Description: "Set of addresses and ports that comprise a service. More info: https://kubernetes.io/docs/concepts/services-networking/service/#services-without-selectors"},
 	},
-	Blocks: map[string]ConverterSpec{},
 }
@@ -5,15 +5,9 @@ package gen
 // DO NOT EDIT.
 
 import (
-	"fmt"
-
 	"github.com/zclconf/go-cty/cty"
 )
 
-// Make sure resources with no list attributes don't cause build errors because
-// of an unused import.
-var _ = fmt.Sprintf
-
 func init() {
 	register("kubernetes_endpoints_v1", kubernetesEndpointsV1)
 }
@@ -25,56 +19,65 @@ var kubernetesEndpointsV1 = ConverterSpec{
 		"metadata": kubernetesEndpointsV1_metadata,
 		"subset":   kubernetesEndpointsV1_subset,
 	},
-}
-
-var kubernetesEndpointsV1_subset = ConverterSpec{
-	ResourceName: "kubernetes_endpoints_v1",
-	Attributes:   map[string]func(any) (cty.Value, error){},
-	Blocks: map[string]ConverterSpec{
-		"address":           kubernetesEndpointsV1_subset_address,
-		"not_ready_address": kubernetesEndpointsV1_subset_notReadyAddress,
-		"port":              kubernetesEndpointsV1_subset_port,
+	Schema: map[string]FieldSchema{
+		"metadata": {Required: true, MaxItems: 1, Description: "Standard endpoints's metadata. More info: https://github.com/kubernetes/community/blob/master/contributors/devel/sig-architecture/api-conventions.md#metadata"},
+		"subset":   {Optional: true, Description: "Set of addresses and ports that comprise a service. More info: https://kubernetes.io/docs/concepts/services-networking/service/#services-without-selectors"},
 	},
 }
 
-var kubernetesEndpointsV1_subset_port = ConverterSpec{
-	ResourceName: "kubernetes_endpoints_v1",
+var kubernetesEndpointsV1_metadata = ConverterSpec{
 	Attributes: map[string]func(any) (cty.Value, error){
-		"name":     toString,
-		"port":     toInt,
-		"protocol": toString,
+		"annotations":   toStringMap,
+		"generate_name": toString,
+		"labels":        toStringMap,
+		"name":          toString,
+		"namespace":     toString,
+	},
+	Schema: map[string]FieldSchema{
+		"annotations":   {Optional: true, Description: "An unstructured key value map stored with the endpoints that may be used to store arbitrary metadata. More info: https://kubernetes.io/docs/concepts/overview/working-with-objects/annotations/"},
+		"generate_name": {Optional: true, ForceNew: true, ConflictsWith: []string{"metadata.name"}, Description: "Prefix, used by the server, to generate a unique name ONLY IF the `name` field has not been provided. This value will also be combined with a unique suffix. More info: https://github.com/kubernetes/community/blob/master/contributors/devel/sig-architecture/api-conventions.md#idempotency"},
+		"labels":        {Optional: true, Description: "Map of string keys and values that can be used to organize and categorize (scope and select) the endpoints. May match selectors of replication controllers and services. More info: https://kubernetes.io/docs/concepts/overview/working-with-objects/labels/"},
+		"name":          {Optional: true, ForceNew: true, ConflictsWith: []string{"metadata.generate_name"}, Description: "Name of the endpoints, must be unique. Cannot be updated. More info: https://kubernetes.io/docs/concepts/overview/working-with-objects/names/#names"},
+		"namespace":     {Optional: true, ForceNew: true, Description: "Namespace defines the space within which name of the endpoints must be unique.", Default: cty.StringVal("default")},
 	},
-	Blocks: map[string]ConverterSpec{},
 }
 
-var kubernetesEndpointsV1_subset_notReadyAddress = ConverterSpec{
-	ResourceName: "kubernetes_endpoints_v1",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"hostname":  toString,
-		"ip":        toString,
-		"node_name": toString,
+var kubernetesEndpointsV1_subset = ConverterSpec{
+	Attributes: map[string]func(any) (cty.Value, error){},
+	Blocks: map[string]ConverterSpec{
+		"address":           kubernetesEndpointsV1_subset_address,
+		"not_ready_address": kubernetesEndpointsV1_subset_address,
+		"port":              kubernetesEndpointsV1_subset_port,
+	},
+	Schema: map[string]FieldSchema{
+		"address":           {Optional: true, MinItems: 1, Description: "IP address which offers the related ports that are marked as ready. These endpoints should be considered safe for load balancers and clients to utilize."},
+		"not_ready_address": {Optional: true, MinItems: 1, Description: "IP address which offers the related ports but is not currently marked as ready because it have not yet finished starting, have recently failed a readiness check, or have recently failed a liveness check."},
+		"port":              {Optional: true, MinItems: 1, Description: "Port number available on the related IP addresses."},
 	},
-	Blocks: map[string]ConverterSpec{},
 }
 
 var kubernetesEndpointsV1_subset_address = ConverterSpec{
-	ResourceName: "kubernetes_endpoints_v1",
 	Attributes: map[string]func(any) (cty.Value, error){
 		"hostname":  toString,
 		"ip":        toString,
 		"node_name": toString,
 	},
-	Blocks: map[string]ConverterSpec{},
+	Schema: map[string]FieldSchema{
+		"hostname":  {Optional: true, Description: "The Hostname of this endpoint."},
+		"ip":        {Required: true, Description: "The IP of this endpoint. May not be loopback (127.0.0.0/8), link-local (169.254.0.0/16), or link-local multicast ((224.0.0.0/24)."},
+		"node_name": {Optional: true, Description: "Node hosting this endpoint. This can be used to determine endpoints local to a node."},
+	},
 }
 
-var kubernetesEndpointsV1_metadata = ConverterSpec{
-	ResourceName: "kubernetes_endpoints_v1",
+var kubernetesEndpointsV1_subset_port = ConverterSpec{
 	Attributes: map[string]func(any) (cty.Value, error){
-		"annotations":   toStringMap,
-		"generate_name": toString,
-		"labels":        toStringMap,
-		"name":          toString,
-		"namespace":     toString,
+		"name":     toString,
+		"port":     toInt,
+		"protocol": toString,
+	},
+	Schema: map[string]FieldSchema{
+		"name":     {Optional: true, Description: "The name of this port within the endpoint. Must be a DNS_LABEL. Optional if only one Port is defined on this endpoint."},
+		"port":     {Required: true, Description: "The port that will be exposed by this endpoint."},
+		"protocol": {Optional: true, Description: "The IP protocol for this port. Supports `TCP` and `UDP`. Default is `TCP`.", Default: cty.StringVal("TCP")},
 	},
-	Blocks: map[string]ConverterSpec{},
 }
@@ -5,15 +5,9 @@ package gen
 // DO NOT EDIT.
 
 import (
-	"fmt"
-
 	"github.com/zclconf/go-cty/cty"
 )
 
-// Make sure resources with no list attributes don't cause build errors because
-// of an unused import.
-var _ = fmt.Sprintf
-
 func init() {
 	register("kubernetes_env", kubernetesEnv)
 }
@@ -30,12 +24,21 @@ var kubernetesEnv = ConverterSpec{
 	},
 	Blocks: map[string]ConverterSpec{
 		"env":      kubernetesEnv_env,
-		"metadata": kubernetesEnv_metadata,
+		"metadata": kubernetesAnnotations_metadata,
+	},
+	Schema: map[string]FieldSchema{
+		"api_version":    {Required: true, Description: "Resource API version"},
+		"container":      {Optional: true, Description: "Name of the container for which we are updating the environment variables."},
+		"env":            {Required: true, Description: "List of custom values used to represent environment variables"},
+		"field_manager":  {Optional: true, Description: "Set the name of the field manager for the specified environment variables.", Default: cty.StringVal("Terraform")},
+		"force":          {Optional: true, Description: "Force overwriting environments that were created or edited outside of Terraform."},
+		"init_container": {Optional: true, Description: "Name of the initContainer for which we are updating the environment variables."},
+		"kind":           {Required: true, Description: "Resource Kind"},
+		"metadata":       {Required: true, MaxItems: 1},
 	},
 }
 
 var kubernetesEnv_env = ConverterSpec{
-	ResourceName: "kubernetes_env",
 	Attributes: map[string]func(any) (cty.Value, error){
 		"name":  toString,
 		"value": toString,
@@ -43,63 +46,75 @@ var kubernetesEnv_env = ConverterSpec{
 	Blocks: map[string]ConverterSpec{
 		"value_from": kubernetesEnv_env_valueFrom,
 	},
+	Schema: map[string]FieldSchema{
+		"name":       {Required: true, Description: "Name of the environment variable. Must be a C_IDENTIFIER"},
+		"value":      {Optional: true, Description: "Variable references $(VAR_NAME) are expanded using the previous defined environment variables in the container and any service environment variables. If a variable cannot be resolved, the reference in the input string will be unchanged. The $(VAR_NAME) syntax can be escaped with a double $$, ie: $$(VAR_NAME). Escaped references will never be expanded, regardless of whether the variable exists or not. Defaults to \"\"."},
+		"value_from": {Optional: true, MaxItems: 1, Description: "Source for the environment variable's value"},
+	},
 }
 
 var kubernetesEnv_env_valueFrom = ConverterSpec{
-	ResourceName: "kubernetes_env",
-	Attributes:   map[string]func(any) (cty.Value, error){},
+	Attributes: map[string]func(any) (cty.Value, error){},
 	Blocks: map[string]ConverterSpec{
 		"config_map_key_ref": kubernetesEnv_env_valueFrom_configMapKeyRef,
 		"field_ref":          kubernetesEnv_env_valueFrom_fieldRef,
 		"resource_field_ref": kubernetesEnv_env_valueFrom_resourceFieldRef,
 		"secret_key_ref":     kubernetesEnv_env_valueFrom_secretKeyRef,
 	},
+	Schema: map[string]FieldSchema{
+		"config_map_key_ref": {Optional: true, MaxItems: 1, Description: "Selects a key of a ConfigMap."},
+		"field_ref":          {Optional: true, MaxItems: 1, Description: "Selects a field of the pod: supports metadata.name, metadata.namespace, metadata.labels, metadata.annotations, spec.nodeName, spec.serviceAccountName, status.podIP."},
+		"resource_field_ref": {Optional: true, MaxItems: 1, Description: "Selects a resource of the container: only resources limits and requests (limits.cpu, limits.memory, limits.ephemeral-storage, requests.cpu, requests.memory and requests.ephemeral-storage) are currently supported."},
+		"secret_key_ref":     {Optional: true, MaxItems: 1, Description: "Selects a key of a secret in the pod's namespace."},
+	},
 }
 
-var kubernetesEnv_env_valueFrom_secretKeyRef = ConverterSpec{
-	ResourceName: "kubernetes_env",
+var kubernetesEnv_env_valueFrom_configMapKeyRef = ConverterSpec{
 	Attributes: map[string]func(any) (cty.Value, error){
 		"key":      toString,
 		"name":     toString,
 		"optional": toBool,
 	},
-	Blocks: map[string]ConverterSpec{},
+	Schema: map[string]FieldSchema{
+		"key":      {Optional: true, Description: "The key to select."},
+		"name":     {Optional: true, Description: "Name of the referent. More info: https://kubernetes.io/docs/concepts/overview/working-with-objects/names/#names"},
+		"optional": {Optional: true, Description: "Specify whether the ConfigMap or its key must be defined."},
+	},
+}
+
+var kubernetesEnv_env_valueFrom_fieldRef = ConverterSpec{
+	Attributes: map[string]func(any) (cty.Value, error){
+		"api_version": toString,
+		"field_path":  toString,
+	},
+	Schema: map[string]FieldSchema{
+		"api_version": {Optional: true, Description: "Version of the schema the FieldPath is written in terms of, defaults to \"v1\".", Default: cty.StringVal("v1")},
+		"field_path":  {Optional: true, Description: "Path of the field to select in the specified API version"},
+	},
 }
 
 var kubernetesEnv_env_valueFrom_resourceFieldRef = ConverterSpec{
-	ResourceName: "kubernetes_env",
 	Attributes: map[string]func(any) (cty.Value, error){
 		"container_name": toString,
 		"divisor":        toString,
 		"resource":       toString,
 	},
-	Blocks: map[string]ConverterSpec{},
-}
-
-var kubernetesEnv_env_valueFrom_fieldRef = ConverterSpec{
-	ResourceName: "kubernetes_env",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"api_version": toString,
-		"field_path":  toString,
+	Schema: map[string]FieldSchema{
+		"container_name": {Optional: true},
+		"divisor":        {Optional: true, Default: cty.StringVal("1")},
+		"resource":       {Required: true, Description: "Resource to select"},
 	},
-	Blocks: map[string]ConverterSpec{},
 }
 
-var kubernetesEnv_env_valueFrom_configMapKeyRef = ConverterSpec{
-	ResourceName: "kubernetes_env",
+var kubernetesEnv_env_valueFrom_secretKeyRef = ConverterSpec{
 	Attributes: map[string]func(any) (cty.Value, error){
 		"key":      toString,
 		"name":     toString,
 		"optional": toBool,
 	},
-	Blocks: map[string]ConverterSpec{},
-}
-
-var kubernetesEnv_metadata = ConverterSpec{
-	ResourceName: "kubernetes_env",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"name":      toString,
-		"namespace": toString,
+	Schema: map[string]FieldSchema{
+		"key":      {Optional: true, Description: "The key of the secret to select from. Must be a valid secret key."},
+		"name":     {Optional: true, Description: "Name of the referent. More info: https://kubernetes.io/docs/concepts/overview/working-with-objects/names/#names"},
+		"optional": {Optional: true, Description: "Specify whether the Secret or its key must be defined."},
 	},
-	Blocks: map[string]ConverterSpec{},
 }
@@ -5,15 +5,9 @@ package gen
 // DO NOT EDIT.
 
 import (
-	"fmt"
-
 	"github.com/zclconf/go-cty/cty"
 )
 
-// Make sure resources with no list attributes don't cause build errors because
-// of an unused import.
-var _ = fmt.Sprintf
-
 func init() {
 	register("kubernetes_horizontal_pod_autoscaler", kubernetesHorizontalPodAutoscaler)
 }
@@ -22,354 +16,11 @@ var kubernetesHorizontalPodAutoscaler = ConverterSpec{
 	ResourceName: "kubernetes_horizontal_pod_autoscaler",
 	Attributes:   map[string]func(any) (cty.Value, error){},
 	Blocks: map[string]ConverterSpec{
-		"metadata": kubernetesHorizontalPodAutoscaler_metadata,
-		"spec":     kubernetesHorizontalPodAutoscaler_spec,
-	},
-}
-
-var kubernetesHorizontalPodAutoscaler_spec = ConverterSpec{
-	ResourceName: "kubernetes_horizontal_pod_autoscaler",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"max_replicas":                      toInt,
-		"min_replicas":                      toInt,
-		"target_cpu_utilization_percentage": toInt,
-	},
-	Blocks: map[string]ConverterSpec{
-		"behavior":         kubernetesHorizontalPodAutoscaler_spec_behavior,
-		"metric":           kubernetesHorizontalPodAutoscaler_spec_metric,
-		"scale_target_ref": kubernetesHorizontalPodAutoscaler_spec_scaleTargetRef,
-	},
-}
-
-var kubernetesHorizontalPodAutoscaler_spec_metric = ConverterSpec{
-	ResourceName: "kubernetes_horizontal_pod_autoscaler",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"type": toString,
-	},
-	Blocks: map[string]ConverterSpec{
-		"container_resource": kubernetesHorizontalPodAutoscaler_spec_metric_containerResource,
-		"external":           kubernetesHorizontalPodAutoscaler_spec_metric_external,
-		"object":             kubernetesHorizontalPodAutoscaler_spec_metric_object,
-		"pods":               kubernetesHorizontalPodAutoscaler_spec_metric_pods,
-		"resource":           kubernetesHorizontalPodAutoscaler_spec_metric_resource,
-	},
-}
-
-var kubernetesHorizontalPodAutoscaler_spec_metric_resource = ConverterSpec{
-	ResourceName: "kubernetes_horizontal_pod_autoscaler",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"name": toString,
-	},
-	Blocks: map[string]ConverterSpec{
-		"target": kubernetesHorizontalPodAutoscaler_spec_metric_resource_target,
-	},
-}
-
-var kubernetesHorizontalPodAutoscaler_spec_metric_resource_target = ConverterSpec{
-	ResourceName: "kubernetes_horizontal_pod_autoscaler",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"average_utilization": toInt,
-		"average_value":       toString,
-		"type":                toString,
-		"value":               toString,
-	},
-	Blocks: map[string]ConverterSpec{},
-}
-
-var kubernetesHorizontalPodAutoscaler_spec_metric_pods = ConverterSpec{
-	ResourceName: "kubernetes_horizontal_pod_autoscaler",
-	Attributes:   map[string]func(any) (cty.Value, error){},
-	Blocks: map[string]ConverterSpec{
-		"metric": kubernetesHorizontalPodAutoscaler_spec_metric_pods_metric,
-		"target": kubernetesHorizontalPodAutoscaler_spec_metric_pods_target,
-	},
-}
-
-var kubernetesHorizontalPodAutoscaler_spec_metric_pods_target = ConverterSpec{
-	ResourceName: "kubernetes_horizontal_pod_autoscaler",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"average_utilization": toInt,
-		"average_value":       toString,
-		"type":                toString,
-		"value":               toString,
-	},
-	Blocks: map[string]ConverterSpec{},
-}
-
-var kubernetesHorizontalPodAutoscaler_spec_metric_pods_metric = ConverterSpec{
-	ResourceName: "kubernetes_horizontal_pod_autoscaler",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"name": toString,
+		"metadata": kubernetesHorizontalPodAutoscalerV1_metadata,
+		"spec":     kubernetesHorizontalPodAutoscalerV2_spec,
 	},
-	Blocks: map[string]ConverterSpec{
-		"selector": kubernetesHorizontalPodAutoscaler_spec_metric_pods_metric_selector,
-	},
-}
-
-var kubernetesHorizontalPodAutoscaler_spec_metric_pods_metric_selector = ConverterSpec{
-	ResourceName: "kubernetes_horizontal_pod_autoscaler",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"match_labels": toStringMap,
-	},
-	Blocks: map[string]ConverterSpec{
-		"match_expressions": kubernetesHorizontalPodAutoscaler_spec_metric_pods_metric_selector_matchExpressions,
-	},
-}
-
-var kubernetesHorizontalPodAutoscaler_spec_metric_pods_metric_selector_matchExpressions = ConverterSpec{
-	ResourceName: "kubernetes_horizontal_pod_autoscaler",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"key":      toString,
-		"operator": toString,
-		"values": func(a any) (cty.Value, error) {
-			l, ok := a.([]any)
-			if !ok {
-				return cty.Value{}, fmt.Errorf("expected slice, got: %T", a)
-			}
-			vl := make([]cty.Value, 0, len(l))
-			for _, val := range l {
-				v, err := toString(val)
-				if err != nil {
-					return cty.Value{}, err
-				}
-				vl = append(vl, v)
-			}
-			return cty.ListVal(vl), nil
-		},
-	},
-	Blocks: map[string]ConverterSpec{},
-}
-
-var kubernetesHorizontalPodAutoscaler_spec_metric_object = ConverterSpec{
-	ResourceName: "kubernetes_horizontal_pod_autoscaler",
-	Attributes:   map[string]func(any) (cty.Value, error){},
-	Blocks: map[string]ConverterSpec{
-		"described_object": kubernetesHorizontalPodAutoscaler_spec_metric_object_describedObject,
-		"metric":           kubernetesHorizontalPodAutoscaler_spec_metric_object_metric,
-		"target":           kubernetesHorizontalPodAutoscaler_spec_metric_object_target,
-	},
-}
-
-var kubernetesHorizontalPodAutoscaler_spec_metric_object_target = ConverterSpec{
-	ResourceName: "kubernetes_horizontal_pod_autoscaler",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"average_utilization": toInt,
-		"average_value":       toString,
-		"type":                toString,
-		"value":               toString,
-	},
-	Blocks: map[string]ConverterSpec{},
-}
-
-var kubernetesHorizontalPodAutoscaler_spec_metric_object_metric = ConverterSpec{
-	ResourceName: "kubernetes_horizontal_pod_autoscaler",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"name": toString,
-	},
-	Blocks: map[string]ConverterSpec{
-		"selector": kubernetesHorizontalPodAutoscaler_spec_metric_object_metric_selector,
-	},
-}
-
-var kubernetesHorizontalPodAutoscaler_spec_metric_object_metric_selector = ConverterSpec{
-	ResourceName: "kubernetes_horizontal_pod_autoscaler",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"match_labels": toStringMap,
-	},
-	Blocks: map[string]ConverterSpec{
-		"match_expressions": kubernetesHorizontalPodAutoscaler_spec_metric_object_metric_selector_matchExpressions,
-	},
-}
-
-var kubernetesHorizontalPodAutoscaler_spec_metric_object_metric_selector_matchExpressions = ConverterSpec{
-	ResourceName: "kubernetes_horizontal_pod_autoscaler",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"key":      toString,
-		"operator": toString,
-		"values": func(a any) (cty.Value, error) {
-			l, ok := a.([]any)
-			if !ok {
-				return cty.Value{}, fmt.Errorf("expected slice, got: %T", a)
-			}
-			vl := make([]cty.Value, 0, len(l))
-			for _, val := range l {
-				v, err := toString(val)
-				if err != nil {
-					return cty.Value{}, err
-				}
-				vl = append(vl, v)
-			}
-			return cty.ListVal(vl), nil
-		},
-	},
-	Blocks: map[string]ConverterSpec{},
-}
-
-var kubernetesHorizontalPodAutoscaler_spec_metric_object_describedObject = ConverterSpec{
-	ResourceName: "kubernetes_horizontal_pod_autoscaler",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"api_version": toString,
-		"kind":        toString,
-		"name":        toString,
-	},
-	Blocks: map[string]ConverterSpec{},
-}
-
-var kubernetesHorizontalPodAutoscaler_spec_metric_external = ConverterSpec{
-	ResourceName: "kubernetes_horizontal_pod_autoscaler",
-	Attributes:   map[string]func(any) (cty.Value, error){},
-	Blocks: map[string]ConverterSpec{
-		"metric": kubernetesHorizontalPodAutoscaler_spec_metric_external_metric,
-		"target": kubernetesHorizontalPodAutoscaler_spec_metric_external_target,
-	},
-}
-
-var kubernetesHorizontalPodAutoscaler_spec_metric_external_target = ConverterSpec{
-	ResourceName: "kubernetes_horizontal_pod_autoscaler",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"average_utilization": toInt,
-		"average_value":       toString,
-		"type":                toString,
-		"value":               toString,
-	},
-	Blocks: map[string]ConverterSpec{},
-}
-
-var kubernetesHorizontalPodAutoscaler_spec_metric_external_metric = ConverterSpec{
-	ResourceName: "kubernetes_horizontal_pod_autoscaler",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"name": toString,
-	},
-	Blocks: map[string]ConverterSpec{
-		"selector": kubernetesHorizontalPodAutoscaler_spec_metric_external_metric_selector,
-	},
-}
-
-var kubernetesHorizontalPodAutoscaler_spec_metric_external_metric_selector = ConverterSpec{
-	ResourceName: "kubernetes_horizontal_pod_autoscaler",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"match_labels": toStringMap,
-	},
-	Blocks: map[string]ConverterSpec{
-		"match_expressions": kubernetesHorizontalPodAutoscaler_spec_metric_external_metric_selector_matchExpressions,
-	},
-}
-
-var kubernetesHorizontalPodAutoscaler_spec_metric_external_metric_selector_matchExpressions = ConverterSpec{
-	ResourceName: "kubernetes_horizontal_pod_autoscaler",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"key":      toString,
-		"operator": toString,
-		"values": func(a any) (cty.Value, error) {
-			l, ok := a.([]any)
-			if !ok {
-				return cty.Value{}, fmt.Errorf("expected slice, got: %T", a)
-			}
-			vl := make([]cty.Value, 0, len(l))
-			for _, val := range l {
-				v, err := toString(val)
-				if err != nil {
-					return cty.Value{}, err
-				}
-				vl = append(vl, v)
-			}
-			return cty.ListVal(vl), nil
-		},
-	},
-	Blocks: map[string]ConverterSpec{},
-}
-
-var kubernetesHorizontalPodAutoscaler_spec_metric_containerResource = ConverterSpec{
-	ResourceName: "kubernetes_horizontal_pod_autoscaler",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"container": toString,
-		"name":      toString,
-	},
-	Blocks: map[string]ConverterSpec{
-		"target": kubernetesHorizontalPodAutoscaler_spec_metric_containerResource_target,
-	},
-}
-
-var kubernetesHorizontalPodAutoscaler_spec_metric_containerResource_target = ConverterSpec{
-	ResourceName: "kubernetes_horizontal_pod_autoscaler",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"average_utilization": toInt,
-		"average_value":       toString,
-		"type":                toString,
-		"value":               toString,
-	},
-	Blocks: map[string]ConverterSpec{},
-}
-
-var kubernetesHorizontalPodAutoscaler_spec_scaleTargetRef = ConverterSpec{
-	ResourceName: "kubernetes_horizontal_pod_autoscaler",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"api_version": toString,
-		"kind":        toString,
-		"name":        toString,
-	},
-	Blocks: map[string]ConverterSpec{},
-}
-
-var kubernetesHorizontalPodAutoscaler_spec_behavior = ConverterSpec{
-	ResourceName: "kubernetes_horizontal_pod_autoscaler",
-	Attributes:   map[string]func(any) (cty.Value, error){},
-	Blocks: map[string]ConverterSpec{
-		"scale_down": kubernetesHorizontalPodAutoscaler_spec_behavior_scaleDown,
-		"scale_up":   kubernetesHorizontalPodAutoscaler_spec_behavior_scaleUp,
-	},
-}
-
-var kubernetesHorizontalPodAutoscaler_spec_behavior_scaleDown = ConverterSpec{
-	ResourceName: "kubernetes_horizontal_pod_autoscaler",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"select_policy":                toString,
-		"stabilization_window_seconds": toInt,
-	},
-	Blocks: map[string]ConverterSpec{
-		"policy": kubernetesHorizontalPodAutoscaler_spec_behavior_scaleDown_policy,
-	},
-}
-
-var kubernetesHorizontalPodAutoscaler_spec_behavior_scaleDown_policy = ConverterSpec{
-	ResourceName: "kubernetes_horizontal_pod_autoscaler",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"period_seconds": toInt,
-		"type":           toString,
-		"value":          toInt,
-	},
-	Blocks: map[string]ConverterSpec{},
-}
-
-var kubernetesHorizontalPodAutoscaler_spec_behavior_scaleUp = ConverterSpec{
-	ResourceName: "kubernetes_horizontal_pod_autoscaler",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"select_policy":                toString,
-		"stabilization_window_seconds": toInt,
-	},
-	Blocks: map[string]ConverterSpec{
-		"policy": kubernetesHorizontalPodAutoscaler_spec_behavior_scaleUp_policy,
-	},
-}
-
-var kubernetesHorizontalPodAutoscaler_spec_behavior_scaleUp_policy = ConverterSpec{
-	ResourceName: "kubernetes_horizontal_pod_autoscaler",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"period_seconds": toInt,
-		"type":           toString,
-		"value":          toInt,
-	},
-	Blocks: map[string]ConverterSpec{},
-}
-
-var kubernetesHorizontalPodAutoscaler_metadata = ConverterSpec{
-	ResourceName: "kubernetes_horizontal_pod_autoscaler",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"annotations":   toStringMap,
-		"generate_name": toString,
-		"labels":        toStringMap,
-		"name":          toString,
-		"namespace":     toString,
+	Schema: map[string]FieldSchema{
+		"metadata": {Required: true, MaxItems: 1, Description: "Standard horizontal pod autoscaler's metadata. More info: https://github.com/kubernetes/community/blob/master/contributors/devel/sig-architecture/api-conventions.md#metadata"},
+		"spec":     {Required: true, MaxItems: 1, Description: "Behaviour of the autoscaler. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#spec-and-status"},
 	},
-	Blocks: map[string]ConverterSpec{},
 }
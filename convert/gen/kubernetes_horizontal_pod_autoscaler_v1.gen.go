@@ -5,15 +5,9 @@ package gen
 // DO NOT EDIT.
 
 import (
-	"fmt"
-
 	"github.com/zclconf/go-cty/cty"
 )
 
-// Make sure resources with no list attributes don't cause build errors because
-// of an unused import.
-var _ = fmt.Sprintf
-
 func init() {
 	register("kubernetes_horizontal_pod_autoscaler_v1", kubernetesHorizontalPodAutoscalerV1)
 }
@@ -25,10 +19,13 @@ var kubernetesHorizontalPodAutoscalerV1 = ConverterSpec{
 		"metadata": kubernetesHorizontalPodAutoscalerV1_metadata,
 		"spec":     kubernetesHorizontalPodAutoscalerV1_spec,
 	},
+	Schema: map[string]FieldSchema{
+		"metadata": {Required: true, MaxItems: 1, Description: "Standard horizontal pod autoscaler's metadata. More info: https://github.com/kubernetes/community/blob/master/contributors/devel/sig-architecture/api-conventions.md#metadata"},
+		"spec":     {Required: true, MaxItems: 1, Description: "Behaviour of the autoscaler. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#spec-and-status"},
+	},
 }
 
 var kubernetesHorizontalPodAutoscalerV1_metadata = ConverterSpec{
-	ResourceName: "kubernetes_horizontal_pod_autoscaler_v1",
 	Attributes: map[string]func(any) (cty.Value, error){
 		"annotations":   toStringMap,
 		"generate_name": toString,
@@ -36,11 +33,16 @@ var kubernetesHorizontalPodAutoscalerV1_metadata = ConverterSpec{
 		"name":          toString,
 		"namespace":     toString,
 	},
-	Blocks: map[string]ConverterSpec{},
+	Schema: map[string]FieldSchema{
+		"annotations":   {Optional: true, Description: "An unstructured key value map stored with the horizontal pod autoscaler that may be used to store arbitrary metadata. More info: https://kubernetes.io/docs/concepts/overview/working-with-objects/annotations/"},
+		"generate_name": {Optional: true, ForceNew: true, ConflictsWith: []string{"metadata.name"}, Description: "Prefix, used by the server, to generate a unique name ONLY IF the `name` field has not been provided. This value will also be combined with a unique suffix. More info: https://github.com/kubernetes/community/blob/master/contributors/devel/sig-architecture/api-conventions.md#idempotency"},
+		"labels":        {Optional: true, Description: "Map of string keys and values that can be used to organize and categorize (scope and select) the horizontal pod autoscaler. May match selectors of replication controllers and services. More info: https://kubernetes.io/docs/concepts/overview/working-with-objects/labels/"},
+		"name":          {Optional: true, ForceNew: true, ConflictsWith: []string{"metadata.generate_name"}, Description: "Name of the horizontal pod autoscaler, must be unique. Cannot be updated. More info: https://kubernetes.io/docs/concepts/overview/working-with-objects/names/#names"},
+		"namespace":     {Optional: true, ForceNew: true, Description: "Namespace defines the space within which name of the horizontal pod autoscaler must be unique.", Default: cty.StringVal("default")},
+	},
 }
 
 var kubernetesHorizontalPodAutoscalerV1_spec = ConverterSpec{
-	ResourceName: "kubernetes_horizontal_pod_autoscaler_v1",
 	Attributes: map[string]func(any) (cty.Value, error){
 		"max_replicas":                      toInt,
 		"min_replicas":                      toInt,
@@ -49,14 +51,23 @@ var kubernetesHorizontalPodAutoscalerV1_spec = ConverterSpec{
 	Blocks: map[string]ConverterSpec{
 		"scale_target_ref": kubernetesHorizontalPodAutoscalerV1_spec_scaleTargetRef,
 	},
+	Schema: map[string]FieldSchema{
+		"max_replicas":                      {Required: true, Description: "Upper limit for the number of pods that can be set by the autoscaler."},
+		"min_replicas":                      {Optional: true, Description: "Lower limit for the number of pods that can be set by the autoscaler, defaults to `1`.", Default: cty.NumberIntVal(1)},
+		"scale_target_ref":                  {Required: true, MaxItems: 1, Description: "Reference to scaled resource. e.g. Replication Controller"},
+		"target_cpu_utilization_percentage": {Optional: true, Description: "Target average CPU utilization (represented as a percentage of requested CPU) over all the pods. If not specified the default autoscaling policy will be used."},
+	},
 }
 
 var kubernetesHorizontalPodAutoscalerV1_spec_scaleTargetRef = ConverterSpec{
-	ResourceName: "kubernetes_horizontal_pod_autoscaler_v1",
 	Attributes: map[string]func(any) (cty.Value, error){
 		"api_version": toString,
 		"kind":        toString,
 		"name":        toString,
 	},
-	Blocks: map[string]ConverterSpec{},
+	Schema: map[string]FieldSchema{
+		"api_version": {Optional: true, Description: "API version of the referent"},
+		"kind":        {Required: true, Description: "Kind of the referent. e.g. `ReplicationController`. More info: http://releases.k8s.io/HEAD/docs/devel/api-conventions.md#types-kinds"},
+		"name":        {Required: true, Description: "Name of the referent. More info: https://kubernetes.io/docs/concepts/overview/working-with-objects/names/#names"},
+	},
 }
@@ -5,15 +5,9 @@ package gen
 // DO NOT EDIT.
 
 import (
-	"fmt"
-
 	"github.com/zclconf/go-cty/cty"
 )
 
-// Make sure resources with no list attributes don't cause build errors because
-// of an unused import.
-var _ = fmt.Sprintf
-
 func init() {
 	register("kubernetes_horizontal_pod_autoscaler_v2", kubernetesHorizontalPodAutoscalerV2)
 }
@@ -22,13 +16,16 @@ var kubernetesHorizontalPodAutoscalerV2 = ConverterSpec{
 	ResourceName: "kubernetes_horizontal_pod_autoscaler_v2",
 	Attributes:   map[string]func(any) (cty.Value, error){},
 	Blocks: map[string]ConverterSpec{
-		"metadata": kubernetesHorizontalPodAutoscalerV2_metadata,
+		"metadata": kubernetesHorizontalPodAutoscalerV1_metadata,
 		"spec":     kubernetesHorizontalPodAutoscalerV2_spec,
 	},
+	Schema: map[string]FieldSchema{
+		"metadata": {Required: true, MaxItems: 1, Description: "Standard horizontal pod autoscaler's metadata. More info: https://github.com/kubernetes/community/blob/master/contributors/devel/sig-architecture/api-conventions.md#metadata"},
+		"spec":     {Required: true, MaxItems: 1, Description: "Behaviour of the autoscaler. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#spec-and-status"},
+	},
 }
 
 var kubernetesHorizontalPodAutoscalerV2_spec = ConverterSpec{
-	ResourceName: "kubernetes_horizontal_pod_autoscaler_v2",
 	Attributes: map[string]func(any) (cty.Value, error){
 		"max_replicas":                      toInt,
 		"min_replicas":                      toInt,
@@ -37,31 +34,31 @@ var kubernetesHorizontalPodAutoscalerV2_spec = ConverterSpec{
 	Blocks: map[string]ConverterSpec{
 		"behavior":         kubernetesHorizontalPodAutoscalerV2_spec_behavior,
 		"metric":           kubernetesHorizontalPodAutoscalerV2_spec_metric,
-		"scale_target_ref": kubernetesHorizontalPodAutoscalerV2_spec_scaleTargetRef,
+		"scale_target_ref": kubernetesHorizontalPodAutoscalerV1_spec_scaleTargetRef,
 	},
-}
-
-var kubernetesHorizontalPodAutoscalerV2_spec_scaleTargetRef = ConverterSpec{
-	ResourceName: "kubernetes_horizontal_pod_autoscaler_v2",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"api_version": toString,
-		"kind":        toString,
-		"name":        toString,
+	Schema: map[string]FieldSchema{
+		"behavior":                          {Optional: true, MaxItems: 1, Description: "Behavior configures the scaling behavior of the target in both Up and Down directions (`scale_up` and `scale_down` fields respectively)."},
+		"max_replicas":                      {Required: true, Description: "Upper limit for the number of pods that can be set by the autoscaler."},
+		"metric":                            {Optional: true, Description: "The specifications for which to use to calculate the desired replica count (the maximum replica count across all metrics will be used). The desired replica count is calculated multiplying the ratio between the target value and the current value by the current number of pods. Ergo, metrics used must decrease as the pod count is increased, and vice-versa. See the individual metric source types for more information about how each type of metric must respond. If not set, the default metric will be set to 80% average CPU utilization."},
+		"min_replicas":                      {Optional: true, Description: "Lower limit for the number of pods that can be set by the autoscaler, defaults to `1`.", Default: cty.NumberIntVal(1)},
+		"scale_target_ref":                  {Required: true, MaxItems: 1, Description: "Reference to scaled resource. e.g. Replication Controller"},
+		"target_cpu_utilization_percentage": {Optional: true, Description: "Target average CPU utilization (represented as a percentage of requested CPU) over all the pods. If not specified the default autoscaling policy will be used."},
 	},
-	Blocks: map[string]ConverterSpec{},
 }
 
 var kubernetesHorizontalPodAutoscalerV2_spec_behavior = ConverterSpec{
-	ResourceName: "kubernetes_horizontal_pod_autoscaler_v2",
-	Attributes:   map[string]func(any) (cty.Value, error){},
+	Attributes: map[string]func(any) (cty.Value, error){},
 	Blocks: map[string]ConverterSpec{
 		"scale_down": kubernetesHorizontalPodAutoscalerV2_spec_behavior_scaleDown,
-		"scale_up":   kubernetesHorizontalPodAutoscalerV2_spec_behavior_scaleUp,
+		"scale_up":   kubernetesHorizontalPodAutoscalerV2_spec_behavior_scaleDown,
+	},
+	Schema: map[string]FieldSchema{
+		"scale_down": {Optional: true, Description: "Scaling policy for scaling Down"},
+		"scale_up":   {Optional: true, Description: "Scaling policy for scaling Up"},
 	},
 }
 
 var kubernetesHorizontalPodAutoscalerV2_spec_behavior_scaleDown = ConverterSpec{
-	ResourceName: "kubernetes_horizontal_pod_autoscaler_v2",
 	Attributes: map[string]func(any) (cty.Value, error){
 		"select_policy":                toString,
 		"stabilization_window_seconds": toInt,
@@ -69,41 +66,27 @@ var kubernetesHorizontalPodAutoscalerV2_spec_behavior_scaleDown = ConverterSpec{
 	Blocks: map[string]ConverterSpec{
 		"policy": kubernetesHorizontalPodAutoscalerV2_spec_behavior_scaleDown_policy,
 	},
+	Schema: map[string]FieldSchema{
+		"policy":                       {Required: true, MinItems: 1, Description: "List of potential scaling polices which can be used during scaling. At least one policy must be specified, otherwise the scaling rule will be discarded as invalid."},
+		"select_policy":                {Optional: true, Description: "Used to specify which policy should be used. If not set, the default value Max is used."},
+		"stabilization_window_seconds": {Optional: true, Description: "Number of seconds for which past recommendations should be considered while scaling up or scaling down. This value must be greater than or equal to zero and less than or equal to 3600 (one hour). If not set, use the default values: - For scale up: 0 (i.e. no stabilization is done). - For scale down: 300 (i.e. the stabilization window is 300 seconds long)."},
+	},
 }
 
 var kubernetesHorizontalPodAutoscalerV2_spec_behavior_scaleDown_policy = ConverterSpec{
-	ResourceName: "kubernetes_horizontal_pod_autoscaler_v2",
 	Attributes: map[string]func(any) (cty.Value, error){
 		"period_seconds": toInt,
 		"type":           toString,
 		"value":          toInt,
 	},
-	Blocks: map[string]ConverterSpec{},
-}
-
-var kubernetesHorizontalPodAutoscalerV2_spec_behavior_scaleUp = ConverterSpec{
-	ResourceName: "kubernetes_horizontal_pod_autoscaler_v2",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"select_policy":                toString,
-		"stabilization_window_seconds": toInt,
-	},
-	Blocks: map[string]ConverterSpec{
-		"policy": kubernetesHorizontalPodAutoscalerV2_spec_behavior_scaleUp_policy,
+	Schema: map[string]FieldSchema{
+		"period_seconds": {Required: true, Description: "Period specifies the window of time for which the policy should hold true. PeriodSeconds must be greater than zero and less than or equal to 1800 (30 min)."},
+		"type":           {Required: true, Description: "Type is used to specify the scaling policy: Percent or Pods"},
+		"value":          {Required: true, Description: "Value contains the amount of change which is permitted by the policy. It must be greater than zero."},
 	},
 }
 
-var kubernetesHorizontalPodAutoscalerV2_spec_behavior_scaleUp_policy = ConverterSpec{
-	ResourceName: "kubernetes_horizontal_pod_autoscaler_v2",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"period_seconds": toInt,
-		"type":           toString,
-		"value":          toInt,
-	},
-	Blocks: map[string]ConverterSpec{},
-}
-
 var kubernetesHorizontalPodAutoscalerV2_spec_metric = ConverterSpec{
-	ResourceName: "kubernetes_horizontal_pod_autoscaler_v2",
 	Attributes: map[string]func(any) (cty.Value, error){
 		"type": toString,
 	},
@@ -111,265 +94,110 @@ var kubernetesHorizontalPodAutoscalerV2_spec_metric = ConverterSpec{
 		"container_resource": kubernetesHorizontalPodAutoscalerV2_spec_metric_containerResource,
 		"external":           kubernetesHorizontalPodAutoscalerV2_spec_metric_external,
 		"object":             kubernetesHorizontalPodAutoscalerV2_spec_metric_object,
-		"pods":               kubernetesHorizontalPodAutoscalerV2_spec_metric_pods,
+		"pods":               kubernetesHorizontalPodAutoscalerV2_spec_metric_external,
 		"resource":           kubernetesHorizontalPodAutoscalerV2_spec_metric_resource,
 	},
+	Schema: map[string]FieldSchema{
+		"container_resource": {Optional: true, MaxItems: 1},
+		"external":           {Optional: true, MaxItems: 1},
+		"object":             {Optional: true, MaxItems: 1},
+		"pods":               {Optional: true, MaxItems: 1},
+		"resource":           {Optional: true, MaxItems: 1},
+		"type":               {Required: true, Description: "type is the type of metric source. It should be one of \"ContainerResource\", \"External\", \"Object\", \"Pods\" or \"Resource\", each mapping to a matching field in the object. Note: \"ContainerResource\" type is available on when the feature-gate HPAContainerMetrics is enabled"},
+	},
 }
 
-var kubernetesHorizontalPodAutoscalerV2_spec_metric_resource = ConverterSpec{
-	ResourceName: "kubernetes_horizontal_pod_autoscaler_v2",
+var kubernetesHorizontalPodAutoscalerV2_spec_metric_containerResource = ConverterSpec{
 	Attributes: map[string]func(any) (cty.Value, error){
-		"name": toString,
+		"container": toString,
+		"name":      toString,
 	},
 	Blocks: map[string]ConverterSpec{
-		"target": kubernetesHorizontalPodAutoscalerV2_spec_metric_resource_target,
+		"target": kubernetesHorizontalPodAutoscalerV2_spec_metric_containerResource_target,
+	},
+	Schema: map[string]FieldSchema{
+		"container": {Required: true, Description: "name of the container in the pods of the scaling target"},
+		"name":      {Required: true, Description: "name of the resource in question"},
+		"target":    {Optional: true, MaxItems: 1, Description: "target specifies the target value for the given metric"},
 	},
 }
 
-var kubernetesHorizontalPodAutoscalerV2_spec_metric_resource_target = ConverterSpec{
-	ResourceName: "kubernetes_horizontal_pod_autoscaler_v2",
+var kubernetesHorizontalPodAutoscalerV2_spec_metric_containerResource_target = ConverterSpec{
 	Attributes: map[string]func(any) (cty.Value, error){
 		"average_utilization": toInt,
 		"average_value":       toString,
 		"type":                toString,
 		"value":               toString,
 	},
-	Blocks: map[string]ConverterSpec{},
+	Schema: map[string]FieldSchema{
+		"average_utilization": {Optional: true, Description: "averageUtilization is the target value of the average of the resource metric across all relevant pods, represented as a percentage of the requested value of the resource for the pods. Currently only valid for Resource metric source type"},
+		"average_value":       {Optional: true, Description: "averageValue is the target value of the average of the metric across all relevant pods (as a quantity)"},
+		"type":                {Required: true, Description: "type represents whether the metric type is Utilization, Value, or AverageValue"},
+		"value":               {Optional: true, Description: "value is the target value of the metric (as a quantity)."},
+	},
 }
 
-var kubernetesHorizontalPodAutoscalerV2_spec_metric_pods = ConverterSpec{
-	ResourceName: "kubernetes_horizontal_pod_autoscaler_v2",
-	Attributes:   map[string]func(any) (cty.Value, error){},
+var kubernetesHorizontalPodAutoscalerV2_spec_metric_external = ConverterSpec{
+	Attributes: map[string]func(any) (cty.Value, error){},
 	Blocks: map[string]ConverterSpec{
-		"metric": kubernetesHorizontalPodAutoscalerV2_spec_metric_pods_metric,
-		"target": kubernetesHorizontalPodAutoscalerV2_spec_metric_pods_target,
+		"metric": kubernetesHorizontalPodAutoscalerV2_spec_metric_external_metric,
+		"target": kubernetesHorizontalPodAutoscalerV2_spec_metric_containerResource_target,
 	},
-}
-
-var kubernetesHorizontalPodAutoscalerV2_spec_metric_pods_target = ConverterSpec{
-	ResourceName: "kubernetes_horizontal_pod_autoscaler_v2",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"average_utilization": toInt,
-		"average_value":       toString,
-		"type":                toString,
-		"value":               toString,
+	Schema: map[string]FieldSchema{
+		"metric": {Required: true, MaxItems: 1, Description: "metric identifies the target metric by name and selector"},
+		"target": {Optional: true, MaxItems: 1, Description: "target specifies the target value for the given metric"},
 	},
-	Blocks: map[string]ConverterSpec{},
 }
 
-var kubernetesHorizontalPodAutoscalerV2_spec_metric_pods_metric = ConverterSpec{
-	ResourceName: "kubernetes_horizontal_pod_autoscaler_v2",
+var kubernetesHorizontalPodAutoscalerV2_spec_metric_external_metric = ConverterSpec{
 	Attributes: map[string]func(any) (cty.Value, error){
 		"name": toString,
 	},
 	Blocks: map[string]ConverterSpec{
-		"selector": kubernetesHorizontalPodAutoscalerV2_spec_metric_pods_metric_selector,
-	},
-}
-
-var kubernetesHorizontalPodAutoscalerV2_spec_metric_pods_metric_selector = ConverterSpec{
-	ResourceName: "kubernetes_horizontal_pod_autoscaler_v2",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"match_labels": toStringMap,
+		"selector": kubernetesClusterRoleV1_aggregationRule_clusterRoleSelectors,
 	},
-	Blocks: map[string]ConverterSpec{
-		"match_expressions": kubernetesHorizontalPodAutoscalerV2_spec_metric_pods_metric_selector_matchExpressions,
+	Schema: map[string]FieldSchema{
+		"name":     {Required: true, Description: "name is the name of the given metric"},
+		"selector": {Optional: true, Description: "selector is the string-encoded form of a standard kubernetes label selector for the given metric When set, it is passed as an additional parameter to the metrics server for more specific metrics scoping. When unset, just the metricName will be used to gather metrics."},
 	},
 }
 
-var kubernetesHorizontalPodAutoscalerV2_spec_metric_pods_metric_selector_matchExpressions = ConverterSpec{
-	ResourceName: "kubernetes_horizontal_pod_autoscaler_v2",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"key":      toString,
-		"operator": toString,
-		"values": func(a any) (cty.Value, error) {
-			l, ok := a.([]any)
-			if !ok {
-				return cty.Value{}, fmt.Errorf("expected slice, got: %T", a)
-			}
-			vl := make([]cty.Value, 0, len(l))
-			for _, val := range l {
-				v, err := toString(val)
-				if err != nil {
-					return cty.Value{}, err
-				}
-				vl = append(vl, v)
-			}
-			return cty.ListVal(vl), nil
-		},
-	},
-	Blocks: map[string]ConverterSpec{},
-}
-
 var kubernetesHorizontalPodAutoscalerV2_spec_metric_object = ConverterSpec{
-	ResourceName: "kubernetes_horizontal_pod_autoscaler_v2",
-	Attributes:   map[string]func(any) (cty.Value, error){},
+	Attributes: map[string]func(any) (cty.Value, error){},
 	Blocks: map[string]ConverterSpec{
 		"described_object": kubernetesHorizontalPodAutoscalerV2_spec_metric_object_describedObject,
-		"metric":           kubernetesHorizontalPodAutoscalerV2_spec_metric_object_metric,
-		"target":           kubernetesHorizontalPodAutoscalerV2_spec_metric_object_target,
+		"metric":           kubernetesHorizontalPodAutoscalerV2_spec_metric_external_metric,
+		"target":           kubernetesHorizontalPodAutoscalerV2_spec_metric_containerResource_target,
 	},
-}
-
-var kubernetesHorizontalPodAutoscalerV2_spec_metric_object_target = ConverterSpec{
-	ResourceName: "kubernetes_horizontal_pod_autoscaler_v2",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"average_utilization": toInt,
-		"average_value":       toString,
-		"type":                toString,
-		"value":               toString,
-	},
-	Blocks: map[string]ConverterSpec{},
-}
-
-var kubernetesHorizontalPodAutoscalerV2_spec_metric_object_metric = ConverterSpec{
-	ResourceName: "kubernetes_horizontal_pod_autoscaler_v2",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"name": toString,
-	},
-	Blocks: map[string]ConverterSpec{
-		"selector": kubernetesHorizontalPodAutoscalerV2_spec_metric_object_metric_selector,
-	},
-}
-
-var kubernetesHorizontalPodAutoscalerV2_spec_metric_object_metric_selector = ConverterSpec{
-	ResourceName: "kubernetes_horizontal_pod_autoscaler_v2",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"match_labels": toStringMap,
-	},
-	Blocks: map[string]ConverterSpec{
-		"match_expressions": kubernetesHorizontalPodAutoscalerV2_spec_metric_object_metric_selector_matchExpressions,
+	Schema: map[string]FieldSchema{
+		"described_object": {Required: true, MaxItems: 1},
+		"metric":           {Required: true, MaxItems: 1, Description: "metric identifies the target metric by name and selector"},
+		"target":           {Optional: true, MaxItems: 1, Description: "target specifies the target value for the given metric"},
 	},
 }
 
-var kubernetesHorizontalPodAutoscalerV2_spec_metric_object_metric_selector_matchExpressions = ConverterSpec{
-	ResourceName: "kubernetes_horizontal_pod_autoscaler_v2",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"key":      toString,
-		"operator": toString,
-		"values": func(a any) (cty.Value, error) {
-			l, ok := a.([]any)
-			if !ok {
-				return cty.Value{}, fmt.Errorf("expected slice, got: %T", a)
-			}
-			vl := make([]cty.Value, 0, len(l))
-			for _, val := range l {
-				v, err := toString(val)
-				if err != nil {
-					return cty.Value{}, err
-				}
-				vl = append(vl, v)
-			}
-			return cty.ListVal(vl), nil
-		},
-	},
-	Blocks: map[string]ConverterSpec{},
-}
-
 var kubernetesHorizontalPodAutoscalerV2_spec_metric_object_describedObject = ConverterSpec{
-	ResourceName: "kubernetes_horizontal_pod_autoscaler_v2",
 	Attributes: map[string]func(any) (cty.Value, error){
 		"api_version": toString,
 		"kind":        toString,
 		"name":        toString,
 	},
-	Blocks: map[string]ConverterSpec{},
-}
-
-var kubernetesHorizontalPodAutoscalerV2_spec_metric_external = ConverterSpec{
-	ResourceName: "kubernetes_horizontal_pod_autoscaler_v2",
-	Attributes:   map[string]func(any) (cty.Value, error){},
-	Blocks: map[string]ConverterSpec{
-		"metric": kubernetesHorizontalPodAutoscalerV2_spec_metric_external_metric,
-		"target": kubernetesHorizontalPodAutoscalerV2_spec_metric_external_target,
-	},
-}
-
-var kubernetesHorizontalPodAutoscalerV2_spec_metric_external_target = ConverterSpec{
-	ResourceName: "kubernetes_horizontal_pod_autoscaler_v2",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"average_utilization": toInt,
-		"average_value":       toString,
-		"type":                toString,
-		"value":               toString,
+	Schema: map[string]FieldSchema{
+		"api_version": {Required: true, Description: "API version of the referent"},
+		"kind":        {Required: true, Description: "Kind of the referent; More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds"},
+		"name":        {Required: true, Description: "Name of the referent; More info: https://kubernetes.io/docs/concepts/overview/working-with-objects/names/#names"},
 	},
-	Blocks: map[string]ConverterSpec{},
 }
 
-var kubernetesHorizontalPodAutoscalerV2_spec_metric_external_metric = ConverterSpec{
-	ResourceName: "kubernetes_horizontal_pod_autoscaler_v2",
+var kubernetesHorizontalPodAutoscalerV2_spec_metric_resource = ConverterSpec{
 	Attributes: map[string]func(any) (cty.Value, error){
 		"name": toString,
 	},
-	Blocks: map[string]ConverterSpec{
-		"selector": kubernetesHorizontalPodAutoscalerV2_spec_metric_external_metric_selector,
-	},
-}
-
-var kubernetesHorizontalPodAutoscalerV2_spec_metric_external_metric_selector = ConverterSpec{
-	ResourceName: "kubernetes_horizontal_pod_autoscaler_v2",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"match_labels": toStringMap,
-	},
-	Blocks: map[string]ConverterSpec{
-		"match_expressions": kubernetesHorizontalPodAutoscalerV2_spec_metric_external_metric_selector_matchExpressions,
-	},
-}
-
-var kubernetesHorizontalPodAutoscalerV2_spec_metric_external_metric_selector_matchExpressions = ConverterSpec{
-	ResourceName: "kubernetes_horizontal_pod_autoscaler_v2",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"key":      toString,
-		"operator": toString,
-		"values": func(a any) (cty.Value, error) {
-			l, ok := a.([]any)
-			if !ok {
-				return cty.Value{}, fmt.Errorf("expected slice, got: %T", a)
-			}
-			vl := make([]cty.Value, 0, len(l))
-			for _, val := range l {
-				v, err := toString(val)
-				if err != nil {
-					return cty.Value{}, err
-				}
-				vl = append(vl, v)
-			}
-			return cty.ListVal(vl), nil
-		},
-	},
-	Blocks: map[string]ConverterSpec{},
-}
-
-var kubernetesHorizontalPodAutoscalerV2_spec_metric_containerResource = ConverterSpec{
-	ResourceName: "kubernetes_horizontal_pod_autoscaler_v2",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"container": toString,
-		"name":      toString,
-	},
 	Blocks: map[string]ConverterSpec{
 		"target": kubernetesHorizontalPodAutoscalerV2_spec_metric_containerResource_target,
 	},
-}
-
-var kubernetesHorizontalPodAutoscalerV2_spec_metric_containerResource_target = ConverterSpec{
-	ResourceName: "kubernetes_horizontal_pod_autoscaler_v2",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"average_utilization": toInt,
-		"average_value":       toString,
-		"type":                toString,
-		"value":               toString,
-	},
-	Blocks: map[string]ConverterSpec{},
-}
-
-var kubernetesHorizontalPodAutoscalerV2_metadata = ConverterSpec{
-	ResourceName: "kubernetes_horizontal_pod_autoscaler_v2",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"annotations":   toStringMap,
-		"generate_name": toString,
-		"labels":        toStringMap,
-		"name":          toString,
-		"namespace":     toString,
+	Schema: map[string]FieldSchema{
+		"name":   {Required: true, Description: "name is the name of the resource in question."},
+		"target": {Optional: true, MaxItems: 1, Description: "Target specifies the target value for the given metric"},
 	},
-	Blocks: map[string]ConverterSpec{},
 }
@@ -5,15 +5,9 @@ package gen
 // DO NOT EDIT.
 
 import (
-	"fmt"
-
 	"github.com/zclconf/go-cty/cty"
 )
 
-// Make sure resources with no list attributes don't cause build errors because
-// of an unused import.
-var _ = fmt.Sprintf
-
 func init() {
 	register("kubernetes_horizontal_pod_autoscaler_v2beta2", kubernetesHorizontalPodAutoscalerV2beta2)
 }
@@ -22,354 +16,11 @@ var kubernetesHorizontalPodAutoscalerV2beta2 = ConverterSpec{
 	ResourceName: "kubernetes_horizontal_pod_autoscaler_v2beta2",
 	Attributes:   map[string]func(any) (cty.Value, error){},
 	Blocks: map[string]ConverterSpec{
-		"metadata": kubernetesHorizontalPodAutoscalerV2beta2_metadata,
-		"spec":     kubernetesHorizontalPodAutoscalerV2beta2_spec,
-	},
-}
-
-var kubernetesHorizontalPodAutoscalerV2beta2_spec = ConverterSpec{
-	ResourceName: "kubernetes_horizontal_pod_autoscaler_v2beta2",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"max_replicas":                      toInt,
-		"min_replicas":                      toInt,
-		"target_cpu_utilization_percentage": toInt,
-	},
-	Blocks: map[string]ConverterSpec{
-		"behavior":         kubernetesHorizontalPodAutoscalerV2beta2_spec_behavior,
-		"metric":           kubernetesHorizontalPodAutoscalerV2beta2_spec_metric,
-		"scale_target_ref": kubernetesHorizontalPodAutoscalerV2beta2_spec_scaleTargetRef,
-	},
-}
-
-var kubernetesHorizontalPodAutoscalerV2beta2_spec_metric = ConverterSpec{
-	ResourceName: "kubernetes_horizontal_pod_autoscaler_v2beta2",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"type": toString,
-	},
-	Blocks: map[string]ConverterSpec{
-		"container_resource": kubernetesHorizontalPodAutoscalerV2beta2_spec_metric_containerResource,
-		"external":           kubernetesHorizontalPodAutoscalerV2beta2_spec_metric_external,
-		"object":             kubernetesHorizontalPodAutoscalerV2beta2_spec_metric_object,
-		"pods":               kubernetesHorizontalPodAutoscalerV2beta2_spec_metric_pods,
-		"resource":           kubernetesHorizontalPodAutoscalerV2beta2_spec_metric_resource,
-	},
-}
-
-var kubernetesHorizontalPodAutoscalerV2beta2_spec_metric_object = ConverterSpec{
-	ResourceName: "kubernetes_horizontal_pod_autoscaler_v2beta2",
-	Attributes:   map[string]func(any) (cty.Value, error){},
-	Blocks: map[string]ConverterSpec{
-		"described_object": kubernetesHorizontalPodAutoscalerV2beta2_spec_metric_object_describedObject,
-		"metric":           kubernetesHorizontalPodAutoscalerV2beta2_spec_metric_object_metric,
-		"target":           kubernetesHorizontalPodAutoscalerV2beta2_spec_metric_object_target,
-	},
-}
-
-var kubernetesHorizontalPodAutoscalerV2beta2_spec_metric_object_target = ConverterSpec{
-	ResourceName: "kubernetes_horizontal_pod_autoscaler_v2beta2",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"average_utilization": toInt,
-		"average_value":       toString,
-		"type":                toString,
-		"value":               toString,
+		"metadata": kubernetesHorizontalPodAutoscalerV1_metadata,
+		"spec":     kubernetesHorizontalPodAutoscalerV2_spec,
 	},
-	Blocks: map[string]ConverterSpec{},
-}
-
-var kubernetesHorizontalPodAutoscalerV2beta2_spec_metric_object_metric = ConverterSpec{
-	ResourceName: "kubernetes_horizontal_pod_autoscaler_v2beta2",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"name": toString,
-	},
-	Blocks: map[string]ConverterSpec{
-		"selector": kubernetesHorizontalPodAutoscalerV2beta2_spec_metric_object_metric_selector,
-	},
-}
-
-var kubernetesHorizontalPodAutoscalerV2beta2_spec_metric_object_metric_selector = ConverterSpec{
-	ResourceName: "kubernetes_horizontal_pod_autoscaler_v2beta2",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"match_labels": toStringMap,
-	},
-	Blocks: map[string]ConverterSpec{
-		"match_expressions": kubernetesHorizontalPodAutoscalerV2beta2_spec_metric_object_metric_selector_matchExpressions,
-	},
-}
-
-var kubernetesHorizontalPodAutoscalerV2beta2_spec_metric_object_metric_selector_matchExpressions = ConverterSpec{
-	ResourceName: "kubernetes_horizontal_pod_autoscaler_v2beta2",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"key":      toString,
-		"operator": toString,
-		"values": func(a any) (cty.Value, error) {
-			l, ok := a.([]any)
-			if !ok {
-				return cty.Value{}, fmt.Errorf("expected slice, got: %T", a)
-			}
-			vl := make([]cty.Value, 0, len(l))
-			for _, val := range l {
-				v, err := toString(val)
-				if err != nil {
-					return cty.Value{}, err
-				}
-				vl = append(vl, v)
-			}
-			return cty.ListVal(vl), nil
-		},
-	},
-	Blocks: map[string]ConverterSpec{},
-}
-
-var kubernetesHorizontalPodAutoscalerV2beta2_spec_metric_object_describedObject = ConverterSpec{
-	ResourceName: "kubernetes_horizontal_pod_autoscaler_v2beta2",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"api_version": toString,
-		"kind":        toString,
-		"name":        toString,
-	},
-	Blocks: map[string]ConverterSpec{},
-}
-
-var kubernetesHorizontalPodAutoscalerV2beta2_spec_metric_external = ConverterSpec{
-	ResourceName: "kubernetes_horizontal_pod_autoscaler_v2beta2",
-	Attributes:   map[string]func(any) (cty.Value, error){},
-	Blocks: map[string]ConverterSpec{
-		"metric": kubernetesHorizontalPodAutoscalerV2beta2_spec_metric_external_metric,
-		"target": kubernetesHorizontalPodAutoscalerV2beta2_spec_metric_external_target,
-	},
-}
-
-var kubernetesHorizontalPodAutoscalerV2beta2_spec_metric_external_target = ConverterSpec{
-	ResourceName: "kubernetes_horizontal_pod_autoscaler_v2beta2",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"average_utilization": toInt,
-		"average_value":       toString,
-		"type":                toString,
-		"value":               toString,
-	},
-	Blocks: map[string]ConverterSpec{},
-}
-
-var kubernetesHorizontalPodAutoscalerV2beta2_spec_metric_external_metric = ConverterSpec{
-	ResourceName: "kubernetes_horizontal_pod_autoscaler_v2beta2",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"name": toString,
-	},
-	Blocks: map[string]ConverterSpec{
-		"selector": kubernetesHorizontalPodAutoscalerV2beta2_spec_metric_external_metric_selector,
-	},
-}
-
-var kubernetesHorizontalPodAutoscalerV2beta2_spec_metric_external_metric_selector = ConverterSpec{
-	ResourceName: "kubernetes_horizontal_pod_autoscaler_v2beta2",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"match_labels": toStringMap,
-	},
-	Blocks: map[string]ConverterSpec{
-		"match_expressions": kubernetesHorizontalPodAutoscalerV2beta2_spec_metric_external_metric_selector_matchExpressions,
-	},
-}
-
-var kubernetesHorizontalPodAutoscalerV2beta2_spec_metric_external_metric_selector_matchExpressions = ConverterSpec{
-	ResourceName: "kubernetes_horizontal_pod_autoscaler_v2beta2",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"key":      toString,
-		"operator": toString,
-		"values": func(a any) (cty.Value, error) {
-			l, ok := a.([]any)
-			if !ok {
-				return cty.Value{}, fmt.Errorf("expected slice, got: %T", a)
-			}
-			vl := make([]cty.Value, 0, len(l))
-			for _, val := range l {
-				v, err := toString(val)
-				if err != nil {
-					return cty.Value{}, err
-				}
-				vl = append(vl, v)
-			}
-			return cty.ListVal(vl), nil
-		},
-	},
-	Blocks: map[string]ConverterSpec{},
-}
-
-var kubernetesHorizontalPodAutoscalerV2beta2_spec_metric_containerResource = ConverterSpec{
-	ResourceName: "kubernetes_horizontal_pod_autoscaler_v2beta2",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"container": toString,
-		"name":      toString,
-	},
-	Blocks: map[string]ConverterSpec{
-		"target": kubernetesHorizontalPodAutoscalerV2beta2_spec_metric_containerResource_target,
-	},
-}
-
-var kubernetesHorizontalPodAutoscalerV2beta2_spec_metric_containerResource_target = ConverterSpec{
-	ResourceName: "kubernetes_horizontal_pod_autoscaler_v2beta2",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"average_utilization": toInt,
-		"average_value":       toString,
-		"type":                toString,
-		"value":               toString,
-	},
-	Blocks: map[string]ConverterSpec{},
-}
-
-var kubernetesHorizontalPodAutoscalerV2beta2_spec_metric_resource = ConverterSpec{
-	ResourceName: "kubernetes_horizontal_pod_autoscaler_v2beta2",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"name": toString,
-	},
-	Blocks: map[string]ConverterSpec{
-		"target": kubernetesHorizontalPodAutoscalerV2beta2_spec_metric_resource_target,
-	},
-}
-
-var kubernetesHorizontalPodAutoscalerV2beta2_spec_metric_resource_target = ConverterSpec{
-	ResourceName: "kubernetes_horizontal_pod_autoscaler_v2beta2",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"average_utilization": toInt,
-		"average_value":       toString,
-		"type":                toString,
-		"value":               toString,
-	},
-	Blocks: map[string]ConverterSpec{},
-}
-
-var kubernetesHorizontalPodAutoscalerV2beta2_spec_metric_pods = ConverterSpec{
-	ResourceName: "kubernetes_horizontal_pod_autoscaler_v2beta2",
-	Attributes:   map[string]func(any) (cty.Value, error){},
-	Blocks: map[string]ConverterSpec{
-		"metric": kubernetesHorizontalPodAutoscalerV2beta2_spec_metric_pods_metric,
-		"target": kubernetesHorizontalPodAutoscalerV2beta2_spec_metric_pods_target,
-	},
-}
-
-var kubernetesHorizontalPodAutoscalerV2beta2_spec_metric_pods_metric = ConverterSpec{
-	ResourceName: "kubernetes_horizontal_pod_autoscaler_v2beta2",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"name": toString,
-	},
-	Blocks: map[string]ConverterSpec{
-		"selector": kubernetesHorizontalPodAutoscalerV2beta2_spec_metric_pods_metric_selector,
-	},
-}
-
-var kubernetesHorizontalPodAutoscalerV2beta2_spec_metric_pods_metric_selector = ConverterSpec{
-	ResourceName: "kubernetes_horizontal_pod_autoscaler_v2beta2",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"match_labels": toStringMap,
-	},
-	Blocks: map[string]ConverterSpec{
-		"match_expressions": kubernetesHorizontalPodAutoscalerV2beta2_spec_metric_pods_metric_selector_matchExpressions,
-	},
-}
-
-var kubernetesHorizontalPodAutoscalerV2beta2_spec_metric_pods_metric_selector_matchExpressions = ConverterSpec{
-	ResourceName: "kubernetes_horizontal_pod_autoscaler_v2beta2",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"key":      toString,
-		"operator": toString,
-		"values": func(a any) (cty.Value, error) {
-			l, ok := a.([]any)
-			if !ok {
-				return cty.Value{}, fmt.Errorf("expected slice, got: %T", a)
-			}
-			vl := make([]cty.Value, 0, len(l))
-			for _, val := range l {
-				v, err := toString(val)
-				if err != nil {
-					return cty.Value{}, err
-				}
-				vl = append(vl, v)
-			}
-			return cty.ListVal(vl), nil
-		},
-	},
-	Blocks: map[string]ConverterSpec{},
-}
-
-var kubernetesHorizontalPodAutoscalerV2beta2_spec_metric_pods_target = ConverterSpec{
-	ResourceName: "kubernetes_horizontal_pod_autoscaler_v2beta2",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"average_utilization": toInt,
-		"average_value":       toString,
-		"type":                toString,
-		"value":               toString,
-	},
-	Blocks: map[string]ConverterSpec{},
-}
-
-var kubernetesHorizontalPodAutoscalerV2beta2_spec_scaleTargetRef = ConverterSpec{
-	ResourceName: "kubernetes_horizontal_pod_autoscaler_v2beta2",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"api_version": toString,
-		"kind":        toString,
-		"name":        toString,
-	},
-	Blocks: map[string]ConverterSpec{},
-}
-
-var kubernetesHorizontalPodAutoscalerV2beta2_spec_behavior = ConverterSpec{
-	ResourceName: "kubernetes_horizontal_pod_autoscaler_v2beta2",
-	Attributes:   map[string]func(any) (cty.Value, error){},
-	Blocks: map[string]ConverterSpec{
-		"scale_down": kubernetesHorizontalPodAutoscalerV2beta2_spec_behavior_scaleDown,
-		"scale_up":   kubernetesHorizontalPodAutoscalerV2beta2_spec_behavior_scaleUp,
-	},
-}
-
-var kubernetesHorizontalPodAutoscalerV2beta2_spec_behavior_scaleDown = ConverterSpec{
-	ResourceName: "kubernetes_horizontal_pod_autoscaler_v2beta2",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"select_policy":                toString,
-		"stabilization_window_seconds": toInt,
-	},
-	Blocks: map[string]ConverterSpec{
-		"policy": kubernetesHorizontalPodAutoscalerV2beta2_spec_behavior_scaleDown_policy,
-	},
-}
-
-var kubernetesHorizontalPodAutoscalerV2beta2_spec_behavior_scaleDown_policy = ConverterSpec{
-	ResourceName: "kubernetes_horizontal_pod_autoscaler_v2beta2",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"period_seconds": toInt,
-		"type":           toString,
-		"value":          toInt,
-	},
-	Blocks: map[string]ConverterSpec{},
-}
-
-var kubernetesHorizontalPodAutoscalerV2beta2_spec_behavior_scaleUp = ConverterSpec{
-	ResourceName: "kubernetes_horizontal_pod_autoscaler_v2beta2",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"select_policy":                toString,
-		"stabilization_window_seconds": toInt,
-	},
-	Blocks: map[string]ConverterSpec{
-		"policy": kubernetesHorizontalPodAutoscalerV2beta2_spec_behavior_scaleUp_policy,
-	},
-}
-
-var kubernetesHorizontalPodAutoscalerV2beta2_spec_behavior_scaleUp_policy = ConverterSpec{
-	ResourceName: "kubernetes_horizontal_pod_autoscaler_v2beta2",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"period_seconds": toInt,
-		"type":           toString,
-		"value":          toInt,
-	},
-	Blocks: map[string]ConverterSpec{},
-}
-
-var kubernetesHorizontalPodAutoscalerV2beta2_metadata = ConverterSpec{
-	ResourceName: "kubernetes_horizontal_pod_autoscaler_v2beta2",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"annotations":   toStringMap,
-		"generate_name": toString,
-		"labels":        toStringMap,
-		"name":          toString,
-		"namespace":     toString,
+	Schema: map[string]FieldSchema{
+		"metadata": {Required: true, MaxItems: 1, Description: "Standard horizontal pod autoscaler's metadata. More info: https://github.com/kubernetes/community/blob/master/contributors/devel/sig-architecture/api-conventions.md#metadata"},
+		"spec":     {Required: true, MaxItems: 1, Description: "Behaviour of the autoscaler. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#spec-and-status"},
 	},
-	Blocks: map[string]ConverterSpec{},
 }
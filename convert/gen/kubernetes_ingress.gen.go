@@ -5,15 +5,9 @@ package gen
 // DO NOT EDIT.
 
 import (
-	"fmt"
-
 	"github.com/zclconf/go-cty/cty"
 )
 
-// Make sure resources with no list attributes don't cause build errors because
-// of an unused import.
-var _ = fmt.Sprintf
-
 func init() {
 	register("kubernetes_ingress", kubernetesIngress)
 }
@@ -24,13 +18,17 @@ var kubernetesIngress = ConverterSpec{
 		"wait_for_load_balancer": toBool,
 	},
 	Blocks: map[string]ConverterSpec{
-		"metadata": kubernetesIngress_metadata,
+		"metadata": kubernetesIngressV1_metadata,
 		"spec":     kubernetesIngress_spec,
 	},
+	Schema: map[string]FieldSchema{
+		"metadata":               {Required: true, MaxItems: 1, Description: "Standard ingress's metadata. More info: https://github.com/kubernetes/community/blob/master/contributors/devel/sig-architecture/api-conventions.md#metadata"},
+		"spec":                   {Required: true, MaxItems: 1, Description: "spec is the desired state of the Ingress. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#spec-and-status"},
+		"wait_for_load_balancer": {Optional: true, Description: "Terraform will wait for the load balancer to have at least 1 endpoint before considering the resource created."},
+	},
 }
 
 var kubernetesIngress_spec = ConverterSpec{
-	ResourceName: "kubernetes_ingress",
 	Attributes: map[string]func(any) (cty.Value, error){
 		"ingress_class_name": toString,
 	},
@@ -39,85 +37,68 @@ var kubernetesIngress_spec = ConverterSpec{
 		"rule":    kubernetesIngress_spec_rule,
 		"tls":     kubernetesIngress_spec_tls,
 	},
+	Schema: map[string]FieldSchema{
+		"backend":            {Optional: true, MaxItems: 1, Description: "A default backend capable of servicing requests that don't match any rule. At least one of 'backend' or 'rules' must be specified. This field is optional to allow the loadbalancer controller or defaulting logic to specify a global default."},
+		"ingress_class_name": {Optional: true, Description: "ingressClassName is the name of the IngressClass cluster resource. The associated IngressClass defines which controller will implement the resource. This replaces the deprecated `kubernetes.io/ingress.class` annotation. For backwards compatibility, when that annotation is set, it must be given precedence over this field. The controller may emit a warning if the field and annotation have different values. Implementations of this API should ignore Ingresses without a class specified. An IngressClass resource may be marked as default, which can be used to set a default value for this field. For more information, refer to the IngressClass documentation."},
+		"rule":               {Optional: true},
+		"tls":                {Optional: true, Description: "tls represents the TLS configuration. Currently the Ingress only supports a single TLS port, 443. If multiple members of this list specify different hosts, they will be multiplexed on the same port according to the hostname specified through the SNI TLS extension, if the ingress controller fulfilling the ingress supports SNI."},
+	},
 }
 
 var kubernetesIngress_spec_backend = ConverterSpec{
-	ResourceName: "kubernetes_ingress",
 	Attributes: map[string]func(any) (cty.Value, error){
 		"service_name": toString,
 		"service_port": toString,
 	},
-	Blocks: map[string]ConverterSpec{},
-}
-
-var kubernetesIngress_spec_tls = ConverterSpec{
-	ResourceName: "kubernetes_ingress",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"hosts": func(a any) (cty.Value, error) {
-			l, ok := a.([]any)
-			if !ok {
-				return cty.Value{}, fmt.Errorf("expected slice, got: %T", a)
-			}
-			vl := make([]cty.Value, 0, len(l))
-			for _, val := range l {
-				v, err := toString(val)
-				if err != nil {
-					return cty.Value{}, err
-				}
-				vl = append(vl, v)
-			}
-			return cty.ListVal(vl), nil
-		},
-		"secret_name": toString,
+	Schema: map[string]FieldSchema{
+		"service_name": {Optional: true, Description: "Specifies the name of the referenced service."},
+		"service_port": {Optional: true, Description: "Specifies the port of the referenced service."},
 	},
-	Blocks: map[string]ConverterSpec{},
 }
 
 var kubernetesIngress_spec_rule = ConverterSpec{
-	ResourceName: "kubernetes_ingress",
 	Attributes: map[string]func(any) (cty.Value, error){
 		"host": toString,
 	},
 	Blocks: map[string]ConverterSpec{
 		"http": kubernetesIngress_spec_rule_http,
 	},
+	Schema: map[string]FieldSchema{
+		"host": {Optional: true, Description: "host is the fully qualified domain name of a network host, as defined by RFC 3986. Note the following deviations from the \"host\" part of the URI as defined in RFC 3986: 1. IPs are not allowed. Currently an IngressRuleValue can only apply to\n   the IP in the Spec of the parent Ingress.\n2. The `:` delimiter is not respected because ports are not allowed.\n\t  Currently the port of an Ingress is implicitly :80 for http and\n\t  :443 for https.\nBoth these may change in the future. Incoming requests are matched against the host before the IngressRuleValue. If the host is unspecified, the Ingress routes all traffic based on the specified IngressRuleValue.\n\nhost can be \"precise\" which is a domain name without the terminating dot of a network host (e.g. \"foo.bar.com\") or \"wildcard\", which is a domain name prefixed with a single wildcard label (e.g. \"*.foo.com\"). The wildcard character '*' must appear by itself as the first DNS label and matches only a single label. You cannot have a wildcard label by itself (e.g. Host == \"*\"). Requests will be matched against the Host field in the following way: 1. If Host is precise, the request matches this rule if the http host header is equal to Host. 2. If Host is a wildcard, then the request matches this rule if the http host header is to equal to the suffix (removing the first label) of the wildcard rule."},
+		"http": {Required: true, MaxItems: 1, Description: "http is a list of http selectors pointing to backends. In the example: http:///? -> backend where where parts of the url correspond to RFC 3986, this resource will be used to match against everything after the last '/' and before the first '?' or '#'."},
+	},
 }
 
 var kubernetesIngress_spec_rule_http = ConverterSpec{
-	ResourceName: "kubernetes_ingress",
-	Attributes:   map[string]func(any) (cty.Value, error){},
+	Attributes: map[string]func(any) (cty.Value, error){},
 	Blocks: map[string]ConverterSpec{
 		"path": kubernetesIngress_spec_rule_http_path,
 	},
+	Schema: map[string]FieldSchema{
+		"path": {Required: true},
+	},
 }
 
 var kubernetesIngress_spec_rule_http_path = ConverterSpec{
-	ResourceName: "kubernetes_ingress",
 	Attributes: map[string]func(any) (cty.Value, error){
 		"path": toString,
 	},
 	Blocks: map[string]ConverterSpec{
-		"backend": kubernetesIngress_spec_rule_http_path_backend,
+		"backend": kubernetesIngress_spec_backend,
 	},
-}
-
-var kubernetesIngress_spec_rule_http_path_backend = ConverterSpec{
-	ResourceName: "kubernetes_ingress",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"service_name": toString,
-		"service_port": toString,
+	Schema: map[string]FieldSchema{
+		"backend": {Optional: true, MaxItems: 1, Description: "Backend defines the referenced service endpoint to which the traffic will be forwarded to."},
+		"path":    {Optional: true, Description: "path is matched against the path of an incoming request. Currently it can contain characters disallowed from the conventional \"path\" part of a URL as defined by RFC 3986. Paths must begin with a '/' and must be present when using PathType with value \"Exact\" or \"Prefix\"."},
 	},
-	Blocks: map[string]ConverterSpec{},
 }
 
-var kubernetesIngress_metadata = ConverterSpec{
-	ResourceName: "kubernetes_ingress",
+var kubernetesIngress_spec_tls = ConverterSpec{
 	Attributes: map[string]func(any) (cty.Value, error){
-		"annotations":   toStringMap,
-		"generate_name": toString,
-		"labels":        toStringMap,
-		"name":          toString,
-		"namespace":     toString,
+		"hosts":       toList(toString),
+		"secret_name": toString,
+	},
+	Schema: map[string]FieldSchema{
+		"hosts":       {Optional: true, Description: "hosts is a list of hosts included in the TLS certificate. The values in this list must match the name/s used in the tlsSecret. Defaults to the wildcard host setting for the loadbalancer controller fulfilling this Ingress, if left unspecified."},
+		"secret_name": {Optional: true, Description: "secretName is the name of the secret used to terminate TLS traffic on port 443. Field is left optional to allow TLS routing based on SNI hostname alone. If the SNI host in a listener conflicts with the \"Host\" header field used by an IngressRule, the SNI host is used for termination and value of the Host header is used for routing."},
 	},
-	Blocks: map[string]ConverterSpec{},
 }
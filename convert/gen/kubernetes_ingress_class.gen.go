@@ -5,15 +5,9 @@ package gen
 // DO NOT EDIT.
 
 import (
-	"fmt"
-
 	"github.com/zclconf/go-cty/cty"
 )
 
-// Make sure resources with no list attributes don't cause build errors because
-// of an unused import.
-var _ = fmt.Sprintf
-
 func init() {
 	register("kubernetes_ingress_class", kubernetesIngressClass)
 }
@@ -22,40 +16,11 @@ var kubernetesIngressClass = ConverterSpec{
 	ResourceName: "kubernetes_ingress_class",
 	Attributes:   map[string]func(any) (cty.Value, error){},
 	Blocks: map[string]ConverterSpec{
-		"metadata": kubernetesIngressClass_metadata,
-		"spec":     kubernetesIngressClass_spec,
-	},
-}
-
-var kubernetesIngressClass_metadata = ConverterSpec{
-	ResourceName: "kubernetes_ingress_class",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"annotations":   toStringMap,
-		"generate_name": toString,
-		"labels":        toStringMap,
-		"name":          toString,
+		"metadata": kubernetesIngressClassV1_metadata,
+		"spec":     kubernetesIngressClassV1_spec,
 	},
-	Blocks: map[string]ConverterSpec{},
-}
-
-var kubernetesIngressClass_spec = ConverterSpec{
-	ResourceName: "kubernetes_ingress_class",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"controller": toString,
-	},
-	Blocks: map[string]ConverterSpec{
-		"parameters": kubernetesIngressClass_spec_parameters,
-	},
-}
-
-var kubernetesIngressClass_spec_parameters = ConverterSpec{
-	ResourceName: "kubernetes_ingress_class",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"api_group": toString,
-		"kind":      toString,
-		"name":      toString,
-		"namespace": toString,
-		"scope":     toString,
+	Schema: map[string]FieldSchema{
+		"metadata": {Required: true, MaxItems: 1, Description: "Standard ingress_class_v1's metadata. More info: https://github.com/kubernetes/community/blob/master/contributors/devel/sig-architecture/api-conventions.md#metadata"},
+		"spec":     {Required: true, MaxItems: 1, Description: "spec is the desired state of the IngressClass. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#spec-and-status"},
 	},
-	Blocks: map[string]ConverterSpec{},
 }
@@ -5,15 +5,9 @@ package gen
 // DO NOT EDIT.
 
 import (
-	"fmt"
-
 	"github.com/zclconf/go-cty/cty"
 )
 
-// Make sure resources with no list attributes don't cause build errors because
-// of an unused import.
-var _ = fmt.Sprintf
-
 func init() {
 	register("kubernetes_ingress_class_v1", kubernetesIngressClassV1)
 }
@@ -25,20 +19,41 @@ var kubernetesIngressClassV1 = ConverterSpec{
 		"metadata": kubernetesIngressClassV1_metadata,
 		"spec":     kubernetesIngressClassV1_spec,
 	},
+	Schema: map[string]FieldSchema{
+		"metadata": {Required: true, MaxItems: 1, Description: "Standard ingress_class_v1's metadata. More info: https://github.com/kubernetes/community/blob/master/contributors/devel/sig-architecture/api-conventions.md#metadata"},
+		"spec":     {Required: true, MaxItems: 1, Description: "spec is the desired state of the IngressClass. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#spec-and-status"},
+	},
+}
+
+var kubernetesIngressClassV1_metadata = ConverterSpec{
+	Attributes: map[string]func(any) (cty.Value, error){
+		"annotations":   toStringMap,
+		"generate_name": toString,
+		"labels":        toStringMap,
+		"name":          toString,
+	},
+	Schema: map[string]FieldSchema{
+		"annotations":   {Optional: true, Description: "An unstructured key value map stored with the ingress_class_v1 that may be used to store arbitrary metadata. More info: https://kubernetes.io/docs/concepts/overview/working-with-objects/annotations/"},
+		"generate_name": {Optional: true, ForceNew: true, ConflictsWith: []string{"metadata.0.name"}, Description: "Prefix, used by the server, to generate a unique name ONLY IF the `name` field has not been provided. This value will also be combined with a unique suffix. More info: https://github.com/kubernetes/community/blob/master/contributors/devel/sig-architecture/api-conventions.md#idempotency"},
+		"labels":        {Optional: true, Description: "Map of string keys and values that can be used to organize and categorize (scope and select) the ingress_class_v1. May match selectors of replication controllers and services. More info: https://kubernetes.io/docs/concepts/overview/working-with-objects/labels/"},
+		"name":          {Optional: true, ForceNew: true, ConflictsWith: []string{"metadata.0.generate_name"}, Description: "Name of the ingress_class_v1, must be unique. Cannot be updated. More info: https://kubernetes.io/docs/concepts/overview/working-with-objects/names/#names"},
+	},
 }
 
 var kubernetesIngressClassV1_spec = ConverterSpec{
-	ResourceName: "kubernetes_ingress_class_v1",
 	Attributes: map[string]func(any) (cty.Value, error){
 		"controller": toString,
 	},
 	Blocks: map[string]ConverterSpec{
 		"parameters": kubernetesIngressClassV1_spec_parameters,
 	},
+	Schema: map[string]FieldSchema{
+		"controller": {Optional: true, Description: "controller refers to the name of the controller that should handle this class. This allows for different \"flavors\" that are controlled by the same controller. For example, you may have different parameters for the same implementing controller. This should be specified as a domain-prefixed path no more than 250 characters in length, e.g. \"acme.io/ingress-controller\". This field is immutable."},
+		"parameters": {Optional: true},
+	},
 }
 
 var kubernetesIngressClassV1_spec_parameters = ConverterSpec{
-	ResourceName: "kubernetes_ingress_class_v1",
 	Attributes: map[string]func(any) (cty.Value, error){
 		"api_group": toString,
 		"kind":      toString,
@@ -46,16 +61,11 @@ var kubernetesIngressClassV1_spec_parameters = ConverterSpec{
 		"namespace": toString,
 		"scope":     toString,
 	},
-	Blocks: map[string]ConverterSpec{},
-}
-
-var kubernetesIngressClassV1_metadata = ConverterSpec{
-	ResourceName: "kubernetes_ingress_class_v1",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"annotations":   toStringMap,
-		"generate_name": toString,
-		"labels":        toStringMap,
-		"name":          toString,
+	Schema: map[string]FieldSchema{
+		"api_group": {Optional: true, Description: "APIGroup is the group for the resource being referenced. If APIGroup is not specified, the specified Kind must be in the core API group. For any other third-party types, APIGroup is required."},
+		"kind":      {Required: true, Description: "Kind is the type of resource being referenced"},
+		"name":      {Required: true, Description: "Name is the name of resource being referenced"},
+		"namespace": {Optional: true},
+		"scope":     {Optional: true},
 	},
-	Blocks: map[string]ConverterSpec{},
 }
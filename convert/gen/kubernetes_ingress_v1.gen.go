@@ -5,15 +5,9 @@ package gen
 // DO NOT EDIT.
 
 import (
-	"fmt"
-
 	"github.com/zclconf/go-cty/cty"
 )
 
-// Make sure resources with no list attributes don't cause build errors because
-// of an unused import.
-var _ = fmt.Sprintf
-
 func init() {
 	register("kubernetes_ingress_v1", kubernetesIngressV1)
 }
@@ -27,10 +21,31 @@ var kubernetesIngressV1 = ConverterSpec{
 		"metadata": kubernetesIngressV1_metadata,
 		"spec":     kubernetesIngressV1_spec,
 	},
+	Schema: map[string]FieldSchema{
+		"metadata":               {Required: true, MaxItems: 1, Description: "Standard ingress's metadata. More info: https://github.com/kubernetes/community/blob/master/contributors/devel/sig-architecture/api-conventions.md#metadata"},
+		"spec":                   {Required: true, MaxItems: 1, Description: "spec is the desired state of the Ingress. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#spec-and-status"},
+		"wait_for_load_balancer": {Optional: true, Description: "Terraform will wait for the load balancer to have at least 1 endpoint before considering the resource created."},
+	},
+}
+
+var kubernetesIngressV1_metadata = ConverterSpec{
+	Attributes: map[string]func(any) (cty.Value, error){
+		"annotations":   toStringMap,
+		"generate_name": toString,
+		"labels":        toStringMap,
+		"name":          toString,
+		"namespace":     toString,
+	},
+	Schema: map[string]FieldSchema{
+		"annotations":   {Optional: true, Description: "An unstructured key value map stored with the ingress that may be used to store arbitrary metadata. More info: https://kubernetes.io/docs/concepts/overview/working-with-objects/annotations/"},
+		"generate_name": {Optional: true, ForceNew: true, ConflictsWith: []string{"metadata.name"}, Description: "Prefix, used by the server, to generate a unique name ONLY IF the `name` field has not been provided. This value will also be combined with a unique suffix. More info: https://github.com/kubernetes/community/blob/master/contributors/devel/sig-architecture/api-conventions.md#idempotency"},
+		"labels":        {Optional: true, Description: "Map of string keys and values that can be used to organize and categorize (scope and select) the ingress. May match selectors of replication controllers and services. More info: https://kubernetes.io/docs/concepts/overview/working-with-objects/labels/"},
+		"name":          {Optional: true, ForceNew: true, ConflictsWith: []string{"metadata.generate_name"}, Description: "Name of the ingress, must be unique. Cannot be updated. More info: https://kubernetes.io/docs/concepts/overview/working-with-objects/names/#names"},
+		"namespace":     {Optional: true, ForceNew: true, Description: "Namespace defines the space within which name of the ingress must be unique.", Default: cty.StringVal("default")},
+	},
 }
 
 var kubernetesIngressV1_spec = ConverterSpec{
-	ResourceName: "kubernetes_ingress_v1",
 	Attributes: map[string]func(any) (cty.Value, error){
 		"ingress_class_name": toString,
 	},
@@ -39,144 +54,108 @@ var kubernetesIngressV1_spec = ConverterSpec{
 		"rule":            kubernetesIngressV1_spec_rule,
 		"tls":             kubernetesIngressV1_spec_tls,
 	},
+	Schema: map[string]FieldSchema{
+		"default_backend":    {Optional: true, MaxItems: 1, Description: "A default backend capable of servicing requests that don't match any rule. At least one of 'backend' or 'rules' must be specified. This field is optional to allow the loadbalancer controller or defaulting logic to specify a global default."},
+		"ingress_class_name": {Optional: true, Description: "ingressClassName is the name of an IngressClass cluster resource. Ingress controller implementations use this field to know whether they should be serving this Ingress resource, by a transitive connection (controller -> IngressClass -> Ingress resource). Although the `kubernetes.io/ingress.class` annotation (simple constant name) was never formally defined, it was widely supported by Ingress controllers to create a direct binding between Ingress controller and Ingress resources. Newly created Ingress resources should prefer using the field. However, even though the annotation is officially deprecated, for backwards compatibility reasons, ingress controllers should still honor that annotation if present."},
+		"rule":               {Optional: true},
+		"tls":                {Optional: true, Description: "tls represents the TLS configuration. Currently the Ingress only supports a single TLS port, 443. If multiple members of this list specify different hosts, they will be multiplexed on the same port according to the hostname specified through the SNI TLS extension, if the ingress controller fulfilling the ingress supports SNI."},
+	},
 }
 
 var kubernetesIngressV1_spec_defaultBackend = ConverterSpec{
-	ResourceName: "kubernetes_ingress_v1",
-	Attributes:   map[string]func(any) (cty.Value, error){},
+	Attributes: map[string]func(any) (cty.Value, error){},
 	Blocks: map[string]ConverterSpec{
 		"resource": kubernetesIngressV1_spec_defaultBackend_resource,
 		"service":  kubernetesIngressV1_spec_defaultBackend_service,
 	},
+	Schema: map[string]FieldSchema{
+		"resource": {Optional: true, MaxItems: 1, Description: "Resource is an ObjectRef to another Kubernetes resource in the namespace of the Ingress object. If resource is specified, a service.Name and service.Port must not be specified."},
+		"service":  {Optional: true, MaxItems: 1},
+	},
+}
+
+var kubernetesIngressV1_spec_defaultBackend_resource = ConverterSpec{
+	Attributes: map[string]func(any) (cty.Value, error){
+		"api_group": toString,
+		"kind":      toString,
+		"name":      toString,
+	},
+	Schema: map[string]FieldSchema{
+		"api_group": {Required: true, Description: "APIGroup is the group for the resource being referenced. If APIGroup is not specified, the specified Kind must be in the core API group. For any other third-party types, APIGroup is required."},
+		"kind":      {Required: true, Description: "The kind of resource."},
+		"name":      {Required: true, Description: "The name of the User to bind to."},
+	},
 }
 
 var kubernetesIngressV1_spec_defaultBackend_service = ConverterSpec{
-	ResourceName: "kubernetes_ingress_v1",
 	Attributes: map[string]func(any) (cty.Value, error){
 		"name": toString,
 	},
 	Blocks: map[string]ConverterSpec{
 		"port": kubernetesIngressV1_spec_defaultBackend_service_port,
 	},
+	Schema: map[string]FieldSchema{
+		"name": {Required: true, Description: "Specifies the name of the referenced service."},
+		"port": {Required: true, MaxItems: 1, Description: "Specifies the port of the referenced service."},
+	},
 }
 
 var kubernetesIngressV1_spec_defaultBackend_service_port = ConverterSpec{
-	ResourceName: "kubernetes_ingress_v1",
 	Attributes: map[string]func(any) (cty.Value, error){
 		"name":   toString,
 		"number": toInt,
 	},
-	Blocks: map[string]ConverterSpec{},
-}
-
-var kubernetesIngressV1_spec_defaultBackend_resource = ConverterSpec{
-	ResourceName: "kubernetes_ingress_v1",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"api_group": toString,
-		"kind":      toString,
-		"name":      toString,
+	Schema: map[string]FieldSchema{
+		"name":   {Optional: true, Description: "Specifies the name of the port of the referenced service."},
+		"number": {Optional: true, Description: "Specifies the numerical port of the referenced service."},
 	},
-	Blocks: map[string]ConverterSpec{},
-}
-
-var kubernetesIngressV1_spec_tls = ConverterSpec{
-	ResourceName: "kubernetes_ingress_v1",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"hosts": func(a any) (cty.Value, error) {
-			l, ok := a.([]any)
-			if !ok {
-				return cty.Value{}, fmt.Errorf("expected slice, got: %T", a)
-			}
-			vl := make([]cty.Value, 0, len(l))
-			for _, val := range l {
-				v, err := toString(val)
-				if err != nil {
-					return cty.Value{}, err
-				}
-				vl = append(vl, v)
-			}
-			return cty.ListVal(vl), nil
-		},
-		"secret_name": toString,
-	},
-	Blocks: map[string]ConverterSpec{},
 }
 
 var kubernetesIngressV1_spec_rule = ConverterSpec{
-	ResourceName: "kubernetes_ingress_v1",
 	Attributes: map[string]func(any) (cty.Value, error){
 		"host": toString,
 	},
 	Blocks: map[string]ConverterSpec{
 		"http": kubernetesIngressV1_spec_rule_http,
 	},
+	Schema: map[string]FieldSchema{
+		"host": {Optional: true, Description: "host is the fully qualified domain name of a network host, as defined by RFC 3986. Note the following deviations from the \"host\" part of the URI as defined in RFC 3986: 1. IPs are not allowed. Currently an IngressRuleValue can only apply to\n   the IP in the Spec of the parent Ingress.\n2. The `:` delimiter is not respected because ports are not allowed.\n\t  Currently the port of an Ingress is implicitly :80 for http and\n\t  :443 for https.\nBoth these may change in the future. Incoming requests are matched against the host before the IngressRuleValue. If the host is unspecified, the Ingress routes all traffic based on the specified IngressRuleValue.\n\nhost can be \"precise\" which is a domain name without the terminating dot of a network host (e.g. \"foo.bar.com\") or \"wildcard\", which is a domain name prefixed with a single wildcard label (e.g. \"*.foo.com\"). The wildcard character '*' must appear by itself as the first DNS label and matches only a single label. You cannot have a wildcard label by itself (e.g. Host == \"*\"). Requests will be matched against the Host field in the following way: 1. If host is precise, the request matches this rule if the http host header is equal to Host. 2. If host is a wildcard, then the request matches this rule if the http host header is to equal to the suffix (removing the first label) of the wildcard rule."},
+		"http": {Optional: true, MaxItems: 1, Description: "http is a list of http selectors pointing to backends. In the example: http:///? -> backend where where parts of the url correspond to RFC 3986, this resource will be used to match against everything after the last '/' and before the first '?' or '#'."},
+	},
 }
 
 var kubernetesIngressV1_spec_rule_http = ConverterSpec{
-	ResourceName: "kubernetes_ingress_v1",
-	Attributes:   map[string]func(any) (cty.Value, error){},
+	Attributes: map[string]func(any) (cty.Value, error){},
 	Blocks: map[string]ConverterSpec{
 		"path": kubernetesIngressV1_spec_rule_http_path,
 	},
+	Schema: map[string]FieldSchema{
+		"path": {Required: true},
+	},
 }
 
 var kubernetesIngressV1_spec_rule_http_path = ConverterSpec{
-	ResourceName: "kubernetes_ingress_v1",
 	Attributes: map[string]func(any) (cty.Value, error){
 		"path":      toString,
 		"path_type": toString,
 	},
 	Blocks: map[string]ConverterSpec{
-		"backend": kubernetesIngressV1_spec_rule_http_path_backend,
+		"backend": kubernetesIngressV1_spec_defaultBackend,
 	},
-}
-
-var kubernetesIngressV1_spec_rule_http_path_backend = ConverterSpec{
-	ResourceName: "kubernetes_ingress_v1",
-	Attributes:   map[string]func(any) (cty.Value, error){},
-	Blocks: map[string]ConverterSpec{
-		"resource": kubernetesIngressV1_spec_rule_http_path_backend_resource,
-		"service":  kubernetesIngressV1_spec_rule_http_path_backend_service,
-	},
-}
-
-var kubernetesIngressV1_spec_rule_http_path_backend_service = ConverterSpec{
-	ResourceName: "kubernetes_ingress_v1",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"name": toString,
-	},
-	Blocks: map[string]ConverterSpec{
-		"port": kubernetesIngressV1_spec_rule_http_path_backend_service_port,
-	},
-}
-
-var kubernetesIngressV1_spec_rule_http_path_backend_service_port = ConverterSpec{
-	ResourceName: "kubernetes_ingress_v1",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"name":   toString,
-		"number": toInt,
+	Schema: map[string]FieldSchema{
+		"backend":   {Optional: true, MaxItems: 1, Description: "Backend defines the referenced service endpoint to which the traffic will be forwarded to."},
+		"path":      {Optional: true, Description: "path is matched against the path of an incoming request. Currently it can contain characters disallowed from the conventional \"path\" part of a URL as defined by RFC 3986. Paths must begin with a '/' and must be present when using PathType with value \"Exact\" or \"Prefix\"."},
+		"path_type": {Optional: true, Description: "pathType determines the interpretation of the path matching. PathType can be one of the following values: * Exact: Matches the URL path exactly. * Prefix: Matches based on a URL path prefix split by '/'. Matching is\n  done on a path element by element basis. A path element refers is the\n  list of labels in the path split by the '/' separator. A request is a\n  match for path p if every p is an element-wise prefix of p of the\n  request path. Note that if the last element of the path is a substring\n  of the last element in request path, it is not a match (e.g. /foo/bar\n  matches /foo/bar/baz, but does not match /foo/barbaz).\n* ImplementationSpecific: Interpretation of the Path matching is up to\n  the IngressClass. Implementations can treat this as a separate PathType\n  or treat it identically to Prefix or Exact path types.\nImplementations are required to support all path types.", Default: cty.StringVal("ImplementationSpecific")},
 	},
-	Blocks: map[string]ConverterSpec{},
 }
 
-var kubernetesIngressV1_spec_rule_http_path_backend_resource = ConverterSpec{
-	ResourceName: "kubernetes_ingress_v1",
+var kubernetesIngressV1_spec_tls = ConverterSpec{
 	Attributes: map[string]func(any) (cty.Value, error){
-		"api_group": toString,
-		"kind":      toString,
-		"name":      toString,
+		"hosts":       toList(toString),
+		"secret_name": toString,
 	},
-	Blocks: map[string]ConverterSpec{},
-}
-
-var kubernetesIngressV1_metadata = ConverterSpec{
-	ResourceName: "kubernetes_ingress_v1",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"annotations":   toStringMap,
-		"generate_name": toString,
-		"labels":        toStringMap,
-		"name":          toString,
-		"namespace":     toString,
+	Schema: map[string]FieldSchema{
+		"hosts":       {Optional: true, Description: "hosts is a list of hosts included in the TLS certificate. The values in this list must match the name/s used in the tlsSecret. Defaults to the wildcard host setting for the loadbalancer controller fulfilling this Ingress, if left unspecified."},
+		"secret_name": {Optional: true, Description: "secretName is the name of the secret used to terminate TLS traffic on port 443. Field is left optional to allow TLS routing based on SNI hostname alone. If the SNI host in a listener conflicts with the \"Host\" header field used by an IngressRule, the SNI host is used for termination and value of the \"Host\" header is used for routing."},
 	},
-	Blocks: map[string]ConverterSpec{},
 }
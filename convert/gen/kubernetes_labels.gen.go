@@ -5,15 +5,9 @@ package gen
 // DO NOT EDIT.
 
 import (
-	"fmt"
-
 	"github.com/zclconf/go-cty/cty"
 )
 
-// Make sure resources with no list attributes don't cause build errors because
-// of an unused import.
-var _ = fmt.Sprintf
-
 func init() {
 	register("kubernetes_labels", kubernetesLabels)
 }
@@ -28,15 +22,14 @@ var kubernetesLabels = ConverterSpec{
 		"labels":        toStringMap,
 	},
 	Blocks: map[string]ConverterSpec{
-		"metadata": kubernetesLabels_metadata,
+		"metadata": kubernetesAnnotations_metadata,
 	},
-}
-
-var kubernetesLabels_metadata = ConverterSpec{
-	ResourceName: "kubernetes_labels",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"name":      toString,
-		"namespace": toString,
+	Schema: map[string]FieldSchema{
+		"api_version":   {Required: true, ForceNew: true, Description: "The apiVersion of the resource to label."},
+		"field_manager": {Optional: true, Description: "Set the name of the field manager for the specified labels.", Default: cty.StringVal("Terraform")},
+		"force":         {Optional: true, Description: "Force overwriting labels that were created or edited outside of Terraform."},
+		"kind":          {Required: true, ForceNew: true, Description: "The kind of the resource to label."},
+		"labels":        {Required: true, Description: "A map of labels to apply to the resource."},
+		"metadata":      {Required: true, MaxItems: 1},
 	},
-	Blocks: map[string]ConverterSpec{},
 }
@@ -5,15 +5,9 @@ package gen
 // DO NOT EDIT.
 
 import (
-	"fmt"
-
 	"github.com/zclconf/go-cty/cty"
 )
 
-// Make sure resources with no list attributes don't cause build errors because
-// of an unused import.
-var _ = fmt.Sprintf
-
 func init() {
 	register("kubernetes_limit_range", kubernetesLimitRange)
 }
@@ -22,40 +16,11 @@ var kubernetesLimitRange = ConverterSpec{
 	ResourceName: "kubernetes_limit_range",
 	Attributes:   map[string]func(any) (cty.Value, error){},
 	Blocks: map[string]ConverterSpec{
-		"metadata": kubernetesLimitRange_metadata,
-		"spec":     kubernetesLimitRange_spec,
-	},
-}
-
-var kubernetesLimitRange_spec = ConverterSpec{
-	ResourceName: "kubernetes_limit_range",
-	Attributes:   map[string]func(any) (cty.Value, error){},
-	Blocks: map[string]ConverterSpec{
-		"limit": kubernetesLimitRange_spec_limit,
+		"metadata": kubernetesLimitRangeV1_metadata,
+		"spec":     kubernetesLimitRangeV1_spec,
 	},
-}
-
-var kubernetesLimitRange_spec_limit = ConverterSpec{
-	ResourceName: "kubernetes_limit_range",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"default":                 toStringMap,
-		"default_request":         toStringMap,
-		"max":                     toStringMap,
-		"max_limit_request_ratio": toStringMap,
-		"min":                     toStringMap,
-		"type":                    toString,
-	},
-	Blocks: map[string]ConverterSpec{},
-}
-
-var kubernetesLimitRange_metadata = ConverterSpec{
-	ResourceName: "kubernetes_limit_range",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"annotations":   toStringMap,
-		"generate_name": toString,
-		"labels":        toStringMap,
-		"name":          toString,
-		"namespace":     toString,
+	Schema: map[string]FieldSchema{
+		"metadata": {Required: true, MaxItems: 1, Description: "Standard limit range's metadata. More info: https://github.com/kubernetes/community/blob/master/contributors/devel/sig-architecture/api-conventions.md#metadata"},
+		"spec":     {Optional: true, MaxItems: 1, Description: "Spec defines the limits enforced. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#spec-and-status"},
 	},
-	Blocks: map[string]ConverterSpec{},
 }
@@ -5,15 +5,9 @@ package gen
 // DO NOT EDIT.
 
 import (
-	"fmt"
-
 	"github.com/zclconf/go-cty/cty"
 )
 
-// Make sure resources with no list attributes don't cause build errors because
-// of an unused import.
-var _ = fmt.Sprintf
-
 func init() {
 	register("kubernetes_limit_range_v1", kubernetesLimitRangeV1)
 }
@@ -25,10 +19,13 @@ var kubernetesLimitRangeV1 = ConverterSpec{
 		"metadata": kubernetesLimitRangeV1_metadata,
 		"spec":     kubernetesLimitRangeV1_spec,
 	},
+	Schema: map[string]FieldSchema{
+		"metadata": {Required: true, MaxItems: 1, Description: "Standard limit range's metadata. More info: https://github.com/kubernetes/community/blob/master/contributors/devel/sig-architecture/api-conventions.md#metadata"},
+		"spec":     {Optional: true, MaxItems: 1, Description: "Spec defines the limits enforced. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#spec-and-status"},
+	},
 }
 
 var kubernetesLimitRangeV1_metadata = ConverterSpec{
-	ResourceName: "kubernetes_limit_range_v1",
 	Attributes: map[string]func(any) (cty.Value, error){
 		"annotations":   toStringMap,
 		"generate_name": toString,
@@ -36,26 +33,47 @@ var kubernetesLimitRangeV1_metadata = ConverterSpec{
 		"name":          toString,
 		"namespace":     toString,
 	},
-	Blocks: map[string]ConverterSpec{},
+	Schema: map[string]FieldSchema{
+		"annotations":   {Optional: true, Description: "An unstructured key value map stored with the limit range that may be used to store arbitrary metadata. More info: https://kubernetes.io/docs/concepts/overview/working-with-objects/annotations/"},
+		"generate_name": {Optional: true, ForceNew: true, ConflictsWith: []string{"metadata.name"}, Description: "Prefix, used by the server, to generate a unique name ONLY IF the `name` field has not been provided. This value will also be combined with a unique suffix. More info: https://github.com/kubernetes/community/blob/master/contributors/devel/sig-architecture/api-conventions.md#idempotency"},
+		"labels":        {Optional: true, Description: "Map of string keys and values that can be used to organize and categorize (scope and select) the limit range. May match selectors of replication controllers and services. More info: https://kubernetes.io/docs/concepts/overview/working-with-objects/labels/"},
+		"name":          {Optional: true, ForceNew: true, ConflictsWith: []string{"metadata.generate_name"}, Description: "Name of the limit range, must be unique. Cannot be updated. More info: https://kubernetes.io/docs/concepts/overview/working-with-objects/names/#names"},
+		"namespace":     {Optional: true, ForceNew: true, Description: "Namespace defines the space within which name of the limit range must be unique.", Default: cty.StringVal("default")},
+	},
 }
 
 var kubernetesLimitRangeV1_spec = ConverterSpec{
-	ResourceName: "kubernetes_limit_range_v1",
-	Attributes:   map[string]func(any) (cty.Value, error){},
+	Attributes: map[string]func(any) (cty.Value, error){},
 	Blocks: map[string]ConverterSpec{
 		"limit": kubernetesLimitRangeV1_spec_limit,
 	},
+	Schema: map[string]FieldSchema{
+		"limit": {Optional: true, Description: "Limits is the list of objects that are enforced."},
+	},
 }
 
 var kubernetesLimitRangeV1_spec_limit = ConverterSpec{
-	ResourceName: "kubernetes_limit_range_v1",
 	Attributes: map[string]func(any) (cty.Value, error){
-		"default":                 toStringMap,
-		"default_request":         toStringMap,
-		"max":                     toStringMap,
-		"max_limit_request_ratio": toStringMap,
-		"min":                     toStringMap,
+		"default":                 toQuantityMap,
+		"default_request":         toQuantityMap,
+		"max":                     toQuantityMap,
+		"max_limit_request_ratio": toQuantityMap,
+		"min":                     toQuantityMap,
 		"type":                    toString,
 	},
-	Blocks: map[string]ConverterSpec{},
+	Quantities: map[string]bool{
+		"default":                 true,
+		"default_request":         true,
+		"max":                     true,
+		"max_limit_request_ratio": true,
+		"min":                     true,
+	},
+	Schema: map[string]FieldSchema{
+		"default":                 {Optional: true, Description: "Default resource requirement limit value by resource name if resource limit is omitted."},
+		"default_request":         {Optional: true, Description: "The default resource requirement request value by resource name if resource request is omitted."},
+		"max":                     {Optional: true, Description: "Max usage constraints on this kind by resource name."},
+		"max_limit_request_ratio": {Optional: true, Description: "The named resource must have a request and limit that are both non-zero where limit divided by request is less than or equal to the enumerated value; this represents the max burst for the named resource."},
+		"min":                     {Optional: true, Description: "Min usage constraints on this kind by resource name."},
+		"type":                    {Optional: true, Description: "Type of resource that this limit applies to."},
+	},
 }
@@ -5,15 +5,9 @@ package gen
 // DO NOT EDIT.
 
 import (
-	"fmt"
-
 	"github.com/zclconf/go-cty/cty"
 )
 
-// Make sure resources with no list attributes don't cause build errors because
-// of an unused import.
-var _ = fmt.Sprintf
-
 func init() {
 	register("kubernetes_mutating_webhook_configuration_v1", kubernetesMutatingWebhookConfigurationV1)
 }
@@ -25,77 +19,59 @@ var kubernetesMutatingWebhookConfigurationV1 = ConverterSpec{
 		"metadata": kubernetesMutatingWebhookConfigurationV1_metadata,
 		"webhook":  kubernetesMutatingWebhookConfigurationV1_webhook,
 	},
+	Schema: map[string]FieldSchema{
+		"metadata": {Required: true, MaxItems: 1, Description: "Standard mutating webhook configuration's metadata. More info: https://github.com/kubernetes/community/blob/master/contributors/devel/sig-architecture/api-conventions.md#metadata"},
+		"webhook":  {Required: true, MinItems: 1, Description: "Webhooks is a list of webhooks and the affected resources and operations."},
+	},
 }
 
-var kubernetesMutatingWebhookConfigurationV1_webhook = ConverterSpec{
-	ResourceName: "kubernetes_mutating_webhook_configuration_v1",
+var kubernetesMutatingWebhookConfigurationV1_metadata = ConverterSpec{
 	Attributes: map[string]func(any) (cty.Value, error){
-		"admission_review_versions": func(a any) (cty.Value, error) {
-			l, ok := a.([]any)
-			if !ok {
-				return cty.Value{}, fmt.Errorf("expected slice, got: %T", a)
-			}
-			vl := make([]cty.Value, 0, len(l))
-			for _, val := range l {
-				v, err := toString(val)
-				if err != nil {
-					return cty.Value{}, err
-				}
-				vl = append(vl, v)
-			}
-			return cty.ListVal(vl), nil
-		},
-		"failure_policy":      toString,
-		"match_policy":        toString,
-		"name":                toString,
-		"reinvocation_policy": toString,
-		"side_effects":        toString,
-		"timeout_seconds":     toInt,
+		"annotations":   toStringMap,
+		"generate_name": toString,
+		"labels":        toStringMap,
+		"name":          toString,
 	},
-	Blocks: map[string]ConverterSpec{
-		"client_config":      kubernetesMutatingWebhookConfigurationV1_webhook_clientConfig,
-		"namespace_selector": kubernetesMutatingWebhookConfigurationV1_webhook_namespaceSelector,
-		"object_selector":    kubernetesMutatingWebhookConfigurationV1_webhook_objectSelector,
-		"rule":               kubernetesMutatingWebhookConfigurationV1_webhook_rule,
+	Schema: map[string]FieldSchema{
+		"annotations":   {Optional: true, Description: "An unstructured key value map stored with the mutating webhook configuration that may be used to store arbitrary metadata. More info: https://kubernetes.io/docs/concepts/overview/working-with-objects/annotations/"},
+		"generate_name": {Optional: true, ForceNew: true, ConflictsWith: []string{"metadata.0.name"}, Description: "Prefix, used by the server, to generate a unique name ONLY IF the `name` field has not been provided. This value will also be combined with a unique suffix. More info: https://github.com/kubernetes/community/blob/master/contributors/devel/sig-architecture/api-conventions.md#idempotency"},
+		"labels":        {Optional: true, Description: "Map of string keys and values that can be used to organize and categorize (scope and select) the mutating webhook configuration. May match selectors of replication controllers and services. More info: https://kubernetes.io/docs/concepts/overview/working-with-objects/labels/"},
+		"name":          {Optional: true, ForceNew: true, ConflictsWith: []string{"metadata.0.generate_name"}, Description: "Name of the mutating webhook configuration, must be unique. Cannot be updated. More info: https://kubernetes.io/docs/concepts/overview/working-with-objects/names/#names"},
 	},
 }
 
-var kubernetesMutatingWebhookConfigurationV1_webhook_objectSelector = ConverterSpec{
-	ResourceName: "kubernetes_mutating_webhook_configuration_v1",
+var kubernetesMutatingWebhookConfigurationV1_webhook = ConverterSpec{
 	Attributes: map[string]func(any) (cty.Value, error){
-		"match_labels": toStringMap,
+		"admission_review_versions": toList(toString),
+		"failure_policy":            toString,
+		"match_policy":              toString,
+		"name":                      toString,
+		"reinvocation_policy":       toString,
+		"side_effects":              toString,
+		"timeout_seconds":           toInt,
 	},
 	Blocks: map[string]ConverterSpec{
-		"match_expressions": kubernetesMutatingWebhookConfigurationV1_webhook_objectSelector_matchExpressions,
+		"client_config":      kubernetesMutatingWebhookConfigurationV1_webhook_clientConfig,
+		"namespace_selector": kubernetesClusterRoleV1_aggregationRule_clusterRoleSelectors,
+		"object_selector":    kubernetesClusterRoleV1_aggregationRule_clusterRoleSelectors,
+		"rule":               kubernetesMutatingWebhookConfigurationV1_webhook_rule,
 	},
-}
-
-var kubernetesMutatingWebhookConfigurationV1_webhook_objectSelector_matchExpressions = ConverterSpec{
-	ResourceName: "kubernetes_mutating_webhook_configuration_v1",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"key":      toString,
-		"operator": toString,
-		"values": func(a any) (cty.Value, error) {
-			l, ok := a.([]any)
-			if !ok {
-				return cty.Value{}, fmt.Errorf("expected slice, got: %T", a)
-			}
-			vl := make([]cty.Value, 0, len(l))
-			for _, val := range l {
-				v, err := toString(val)
-				if err != nil {
-					return cty.Value{}, err
-				}
-				vl = append(vl, v)
-			}
-			return cty.ListVal(vl), nil
-		},
+	Schema: map[string]FieldSchema{
+		"admission_review_versions": {Optional: true, Description: "AdmissionReviewVersions is an ordered list of preferred `AdmissionReview` versions the Webhook expects. API server will try to use first version in the list which it supports. If none of the versions specified in this list supported by API server, validation will fail for this object. If a persisted webhook configuration specifies allowed versions and does not include any versions known to the API Server, calls to the webhook will fail and be subject to the failure policy."},
+		"client_config":             {Required: true, MaxItems: 1, Description: "ClientConfig defines how to communicate with the hook. Required"},
+		"failure_policy":            {Optional: true, Description: "FailurePolicy defines how unrecognized errors from the admission endpoint are handled - allowed values are Ignore or Fail. Defaults to Fail.", Default: cty.StringVal("Fail")},
+		"match_policy":              {Optional: true, Description: "matchPolicy defines how the \"rules\" list is used to match incoming requests. Allowed values are \"Exact\" or \"Equivalent\".\n\n- Exact: match a request only if it exactly matches a specified rule. For example, if deployments can be modified via apps/v1, apps/v1beta1, and extensions/v1beta1, but \"rules\" only included `apiGroups:[\"apps\"], apiVersions:[\"v1\"], resources: [\"deployments\"]`, a request to apps/v1beta1 or extensions/v1beta1 would not be sent to the webhook.\n\n- Equivalent: match a request if modifies a resource listed in rules, even via another API group or version. For example, if deployments can be modified via apps/v1, apps/v1beta1, and extensions/v1beta1, and \"rules\" only included `apiGroups:[\"apps\"], apiVersions:[\"v1\"], resources: [\"deployments\"]`, a request to apps/v1beta1 or extensions/v1beta1 would be converted to apps/v1 and sent to the webhook.\n\nDefaults to \"Equivalent\"", Default: cty.StringVal("Equivalent")},
+		"name":                      {Required: true, Description: "The name of the admission webhook. Name should be fully qualified, e.g., imagepolicy.kubernetes.io, where \"imagepolicy\" is the name of the webhook, and kubernetes.io is the name of the organization. Required."},
+		"namespace_selector":        {Optional: true, MaxItems: 1, Description: "NamespaceSelector decides whether to run the webhook on an object based on whether the namespace for that object matches the selector. If the object itself is a namespace, the matching is performed on object.metadata.labels. If the object is another cluster scoped resource, it never skips the webhook.\n\nFor example, to run the webhook on any objects whose namespace is not associated with \"runlevel\" of \"0\" or \"1\";  you will set the selector as follows: \"namespaceSelector\": {\n  \"matchExpressions\": [\n    {\n      \"key\": \"runlevel\",\n      \"operator\": \"NotIn\",\n      \"values\": [\n        \"0\",\n        \"1\"\n      ]\n    }\n  ]\n}\n\nIf instead you want to only run the webhook on any objects whose namespace is associated with the \"environment\" of \"prod\" or \"staging\"; you will set the selector as follows: \"namespaceSelector\": {\n  \"matchExpressions\": [\n    {\n      \"key\": \"environment\",\n      \"operator\": \"In\",\n      \"values\": [\n        \"prod\",\n        \"staging\"\n      ]\n    }\n  ]\n}\n\nSee https://kubernetes.io/docs/concepts/overview/working-with-objects/labels/ for more examples of label selectors.\n\nDefault to the empty LabelSelector, which matches everything."},
+		"object_selector":           {Optional: true, MaxItems: 1, Description: "ObjectSelector decides whether to run the webhook based on if the object has matching labels. objectSelector is evaluated against both the oldObject and newObject that would be sent to the webhook, and is considered to match if either object matches the selector. A null object (oldObject in the case of create, or newObject in the case of delete) or an object that cannot have labels (like a DeploymentRollback or a PodProxyOptions object) is not considered to match. Use the object selector only if the webhook is opt-in, because end users may skip the admission webhook by setting the labels. Default to the empty LabelSelector, which matches everything."},
+		"reinvocation_policy":       {Optional: true, Description: "reinvocationPolicy indicates whether this webhook should be called multiple times as part of a single admission evaluation. Allowed values are \"Never\" and \"IfNeeded\".\n\nNever: the webhook will not be called more than once in a single admission evaluation.\n\nIfNeeded: the webhook will be called at least one additional time as part of the admission evaluation if the object being admitted is modified by other admission plugins after the initial webhook call. Webhooks that specify this option *must* be idempotent, able to process objects they previously admitted. Note: * the number of additional invocations is not guaranteed to be exactly one. * if additional invocations result in further modifications to the object, webhooks are not guaranteed to be invoked again. * webhooks that use this option may be reordered to minimize the number of additional invocations. * to validate an object after all mutations are guaranteed complete, use a validating admission webhook instead.\n\nDefaults to \"Never\".", Default: cty.StringVal("Never")},
+		"rule":                      {Optional: true, Description: "Rules describes what operations on what resources/subresources the webhook cares about. The webhook cares about an operation if it matches _any_ Rule. However, in order to prevent ValidatingAdmissionWebhooks and MutatingAdmissionWebhooks from putting the cluster in a state which cannot be recovered from without completely disabling the plugin, ValidatingAdmissionWebhooks and MutatingAdmissionWebhooks are never called on admission requests for ValidatingWebhookConfiguration and MutatingWebhookConfiguration objects."},
+		"side_effects":              {Optional: true, Description: "SideEffects states whether this webhook has side effects. Acceptable values are: None, NoneOnDryRun (webhooks created via v1beta1 may also specify Some or Unknown). Webhooks with side effects MUST implement a reconciliation system, since a request may be rejected by a future step in the admission chain and the side effects therefore need to be undone. Requests with the dryRun attribute will be auto-rejected if they match a webhook with sideEffects == Unknown or Some."},
+		"timeout_seconds":           {Optional: true, Description: "TimeoutSeconds specifies the timeout for this webhook. After the timeout passes, the webhook call will be ignored or the API call will fail based on the failure policy. The timeout value must be between 1 and 30 seconds. Default to 10 seconds.", Default: cty.NumberIntVal(10)},
 	},
-	Blocks: map[string]ConverterSpec{},
 }
 
 var kubernetesMutatingWebhookConfigurationV1_webhook_clientConfig = ConverterSpec{
-	ResourceName: "kubernetes_mutating_webhook_configuration_v1",
 	Attributes: map[string]func(any) (cty.Value, error){
 		"ca_bundle": toString,
 		"url":       toString,
@@ -103,128 +79,41 @@ var kubernetesMutatingWebhookConfigurationV1_webhook_clientConfig = ConverterSpe
 	Blocks: map[string]ConverterSpec{
 		"service": kubernetesMutatingWebhookConfigurationV1_webhook_clientConfig_service,
 	},
+	Schema: map[string]FieldSchema{
+		"ca_bundle": {Optional: true, Description: "`caBundle` is a PEM encoded CA bundle which will be used to validate the webhook's server certificate. If unspecified, system trust roots on the apiserver are used."},
+		"service":   {Optional: true, MaxItems: 1, Description: "`service` is a reference to the service for this webhook. Either `service` or `url` must be specified.\n\nIf the webhook is running within the cluster, then you should use `service`."},
+		"url":       {Optional: true, Description: "`url` gives the location of the webhook, in standard URL form (`scheme://host:port/path`). Exactly one of `url` or `service` must be specified.\n\nThe `host` should not refer to a service running in the cluster; use the `service` field instead. The host might be resolved via external DNS in some apiservers (e.g., `kube-apiserver` cannot resolve in-cluster DNS as that would be a layering violation). `host` may also be an IP address.\n\nPlease note that using `localhost` or `127.0.0.1` as a `host` is risky unless you take great care to run this webhook on all hosts which run an apiserver which might need to make calls to this webhook. Such installs are likely to be non-portable, i.e., not easy to turn up in a new cluster.\n\nThe scheme must be \"https\"; the URL must begin with \"https://\".\n\nA path is optional, and if present may be any string permissible in a URL. You may use the path to pass an arbitrary string to the webhook, for example, a cluster identifier.\n\nAttempting to use a user or basic auth e.g. \"user:password@\" is not allowed. Fragments (\"#...\") and query parameters (\"?...\") are not allowed, either."},
+	},
 }
 
 var kubernetesMutatingWebhookConfigurationV1_webhook_clientConfig_service = ConverterSpec{
-	ResourceName: "kubernetes_mutating_webhook_configuration_v1",
 	Attributes: map[string]func(any) (cty.Value, error){
 		"name":      toString,
 		"namespace": toString,
 		"path":      toString,
 		"port":      toInt,
 	},
-	Blocks: map[string]ConverterSpec{},
-}
-
-var kubernetesMutatingWebhookConfigurationV1_webhook_rule = ConverterSpec{
-	ResourceName: "kubernetes_mutating_webhook_configuration_v1",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"api_groups": func(a any) (cty.Value, error) {
-			l, ok := a.([]any)
-			if !ok {
-				return cty.Value{}, fmt.Errorf("expected slice, got: %T", a)
-			}
-			vl := make([]cty.Value, 0, len(l))
-			for _, val := range l {
-				v, err := toString(val)
-				if err != nil {
-					return cty.Value{}, err
-				}
-				vl = append(vl, v)
-			}
-			return cty.ListVal(vl), nil
-		},
-		"api_versions": func(a any) (cty.Value, error) {
-			l, ok := a.([]any)
-			if !ok {
-				return cty.Value{}, fmt.Errorf("expected slice, got: %T", a)
-			}
-			vl := make([]cty.Value, 0, len(l))
-			for _, val := range l {
-				v, err := toString(val)
-				if err != nil {
-					return cty.Value{}, err
-				}
-				vl = append(vl, v)
-			}
-			return cty.ListVal(vl), nil
-		},
-		"operations": func(a any) (cty.Value, error) {
-			l, ok := a.([]any)
-			if !ok {
-				return cty.Value{}, fmt.Errorf("expected slice, got: %T", a)
-			}
-			vl := make([]cty.Value, 0, len(l))
-			for _, val := range l {
-				v, err := toString(val)
-				if err != nil {
-					return cty.Value{}, err
-				}
-				vl = append(vl, v)
-			}
-			return cty.ListVal(vl), nil
-		},
-		"resources": func(a any) (cty.Value, error) {
-			l, ok := a.([]any)
-			if !ok {
-				return cty.Value{}, fmt.Errorf("expected slice, got: %T", a)
-			}
-			vl := make([]cty.Value, 0, len(l))
-			for _, val := range l {
-				v, err := toString(val)
-				if err != nil {
-					return cty.Value{}, err
-				}
-				vl = append(vl, v)
-			}
-			return cty.ListVal(vl), nil
-		},
-		"scope": toString,
-	},
-	Blocks: map[string]ConverterSpec{},
-}
-
-var kubernetesMutatingWebhookConfigurationV1_webhook_namespaceSelector = ConverterSpec{
-	ResourceName: "kubernetes_mutating_webhook_configuration_v1",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"match_labels": toStringMap,
-	},
-	Blocks: map[string]ConverterSpec{
-		"match_expressions": kubernetesMutatingWebhookConfigurationV1_webhook_namespaceSelector_matchExpressions,
+	Schema: map[string]FieldSchema{
+		"name":      {Required: true, Description: "`name` is the name of the service. Required"},
+		"namespace": {Required: true, Description: "`namespace` is the namespace of the service. Required"},
+		"path":      {Optional: true, Description: "`path` is an optional URL path which will be sent in any request to this service."},
+		"port":      {Optional: true, Description: "If specified, the port on the service that hosting webhook. Default to 443 for backward compatibility. `port` should be a valid port number (1-65535, inclusive).", Default: cty.NumberIntVal(443)},
 	},
 }
 
-var kubernetesMutatingWebhookConfigurationV1_webhook_namespaceSelector_matchExpressions = ConverterSpec{
-	ResourceName: "kubernetes_mutating_webhook_configuration_v1",
+var kubernetesMutatingWebhookConfigurationV1_webhook_rule = ConverterSpec{
 	Attributes: map[string]func(any) (cty.Value, error){
-		"key":      toString,
-		"operator": toString,
-		"values": func(a any) (cty.Value, error) {
-			l, ok := a.([]any)
-			if !ok {
-				return cty.Value{}, fmt.Errorf("expected slice, got: %T", a)
-			}
-			vl := make([]cty.Value, 0, len(l))
-			for _, val := range l {
-				v, err := toString(val)
-				if err != nil {
-					return cty.Value{}, err
-				}
-				vl = append(vl, v)
-			}
-			return cty.ListVal(vl), nil
-		},
+		"api_groups":   toList(toString),
+		"api_versions": toList(toString),
+		"operations":   toList(toString),
+		"resources":    toList(toString),
+		"scope":        toString,
 	},
-	Blocks: map[string]ConverterSpec{},
-}
-
-var kubernetesMutatingWebhookConfigurationV1_metadata = ConverterSpec{
-	ResourceName: "kubernetes_mutating_webhook_configuration_v1",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"annotations":   toStringMap,
-		"generate_name": toString,
-		"labels":        toStringMap,
-		"name":          toString,
+	Schema: map[string]FieldSchema{
+		"api_groups":   {Required: true, MinItems: 1},
+		"api_versions": {Required: true, MinItems: 1},
+		"operations":   {Required: true, MinItems: 1, Description: "Operations is the operations the admission hook cares about - CREATE, UPDATE, DELETE, CONNECT or * for all of those operations and any future admission operations that are added. If '*' is present, the length of the slice must be one. Required."},
+		"resources":    {Required: true, MinItems: 1},
+		"scope":        {Optional: true, Default: cty.StringVal("*")},
 	},
-	Blocks: map[string]ConverterSpec{},
 }
@@ -5,15 +5,9 @@ package gen
 // DO NOT EDIT.
 
 import (
-	"fmt"
-
 	"github.com/zclconf/go-cty/cty"
 )
 
-// Make sure resources with no list attributes don't cause build errors because
-// of an unused import.
-var _ = fmt.Sprintf
-
 func init() {
 	register("kubernetes_namespace", kubernetesNamespace)
 }
@@ -24,17 +18,10 @@ var kubernetesNamespace = ConverterSpec{
 		"wait_for_default_service_account": toBool,
 	},
 	Blocks: map[string]ConverterSpec{
-		"metadata": kubernetesNamespace_metadata,
+		"metadata": kubernetesNamespaceV1_metadata,
 	},
-}
-
-var kubernetesNamespace_metadata = ConverterSpec{
-	ResourceName: "kubernetes_namespace",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"annotations":   toStringMap,
-		"generate_name": toString,
-		"labels":        toStringMap,
-		"name":          toString,
+	Schema: map[string]FieldSchema{
+		"metadata":                         {Required: true, MaxItems: 1, Description: "Standard namespace's metadata. More info: https://github.com/kubernetes/community/blob/master/contributors/devel/sig-architecture/api-conventions.md#metadata"},
+		"wait_for_default_service_account": {Optional: true, Description: "Terraform will wait for the default service account to be created.", Default: cty.BoolVal(false)},
 	},
-	Blocks: map[string]ConverterSpec{},
 }
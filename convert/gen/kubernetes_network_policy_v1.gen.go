@@ -5,15 +5,9 @@ package gen
 // DO NOT EDIT.
 
 import (
-	"fmt"
-
 	"github.com/zclconf/go-cty/cty"
 )
 
-// Make sure resources with no list attributes don't cause build errors because
-// of an unused import.
-var _ = fmt.Sprintf
-
 func init() {
 	register("kubernetes_network_policy_v1", kubernetesNetworkPolicyV1)
 }
@@ -25,316 +19,104 @@ var kubernetesNetworkPolicyV1 = ConverterSpec{
 		"metadata": kubernetesNetworkPolicyV1_metadata,
 		"spec":     kubernetesNetworkPolicyV1_spec,
 	},
-}
-
-var kubernetesNetworkPolicyV1_spec = ConverterSpec{
-	ResourceName: "kubernetes_network_policy_v1",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"policy_types": func(a any) (cty.Value, error) {
-			l, ok := a.([]any)
-			if !ok {
-				return cty.Value{}, fmt.Errorf("expected slice, got: %T", a)
-			}
-			vl := make([]cty.Value, 0, len(l))
-			for _, val := range l {
-				v, err := toString(val)
-				if err != nil {
-					return cty.Value{}, err
-				}
-				vl = append(vl, v)
-			}
-			return cty.ListVal(vl), nil
-		},
-	},
-	Blocks: map[string]ConverterSpec{
-		"egress":       kubernetesNetworkPolicyV1_spec_egress,
-		"ingress":      kubernetesNetworkPolicyV1_spec_ingress,
-		"pod_selector": kubernetesNetworkPolicyV1_spec_podSelector,
+	Schema: map[string]FieldSchema{
+		"metadata": {Required: true, MaxItems: 1, Description: "Standard network policy's metadata. More info: https://github.com/kubernetes/community/blob/master/contributors/devel/sig-architecture/api-conventions.md#metadata"},
+		"spec":     {Required: true, MaxItems: 1, Description: "spec represents the specification of the desired behavior for this NetworkPolicy."},
 	},
 }
 
-var kubernetesNetworkPolicyV1_spec_ingress = ConverterSpec{
-	ResourceName: "kubernetes_network_policy_v1",
-	Attributes:   map[string]func(any) (cty.Value, error){},
-	Blocks: map[string]ConverterSpec{
-		"from":  kubernetesNetworkPolicyV1_spec_ingress_from,
-		"ports": kubernetesNetworkPolicyV1_spec_ingress_ports,
+var kubernetesNetworkPolicyV1_metadata = ConverterSpec{
+	Attributes: map[string]func(any) (cty.Value, error){
+		"annotations":   toStringMap,
+		"generate_name": toString,
+		"labels":        toStringMap,
+		"name":          toString,
+		"namespace":     toString,
 	},
-}
-
-var kubernetesNetworkPolicyV1_spec_ingress_from = ConverterSpec{
-	ResourceName: "kubernetes_network_policy_v1",
-	Attributes:   map[string]func(any) (cty.Value, error){},
-	Blocks: map[string]ConverterSpec{
-		"ip_block":           kubernetesNetworkPolicyV1_spec_ingress_from_ipBlock,
-		"namespace_selector": kubernetesNetworkPolicyV1_spec_ingress_from_namespaceSelector,
-		"pod_selector":       kubernetesNetworkPolicyV1_spec_ingress_from_podSelector,
+	Schema: map[string]FieldSchema{
+		"annotations":   {Optional: true, Description: "An unstructured key value map stored with the network policy that may be used to store arbitrary metadata. More info: https://kubernetes.io/docs/concepts/overview/working-with-objects/annotations/"},
+		"generate_name": {Optional: true, ForceNew: true, ConflictsWith: []string{"metadata.name"}, Description: "Prefix, used by the server, to generate a unique name ONLY IF the `name` field has not been provided. This value will also be combined with a unique suffix. More info: https://github.com/kubernetes/community/blob/master/contributors/devel/sig-architecture/api-conventions.md#idempotency"},
+		"labels":        {Optional: true, Description: "Map of string keys and values that can be used to organize and categorize (scope and select) the network policy. May match selectors of replication controllers and services. More info: https://kubernetes.io/docs/concepts/overview/working-with-objects/labels/"},
+		"name":          {Optional: true, ForceNew: true, ConflictsWith: []string{"metadata.generate_name"}, Description: "Name of the network policy, must be unique. Cannot be updated. More info: https://kubernetes.io/docs/concepts/overview/working-with-objects/names/#names"},
+		"namespace":     {Optional: true, ForceNew: true, Description: "Namespace defines the space within which name of the network policy must be unique.", Default: cty.StringVal("default")},
 	},
 }
 
-var kubernetesNetworkPolicyV1_spec_ingress_from_podSelector = ConverterSpec{
-	ResourceName: "kubernetes_network_policy_v1",
+var kubernetesNetworkPolicyV1_spec = ConverterSpec{
 	Attributes: map[string]func(any) (cty.Value, error){
-		"match_labels": toStringMap,
+		"policy_types": toList(toString),
 	},
 	Blocks: map[string]ConverterSpec{
-		"match_expressions": kubernetesNetworkPolicyV1_spec_ingress_from_podSelector_matchExpressions,
+		"egress":       kubernetesNetworkPolicyV1_spec_egress,
+		"ingress":      kubernetesNetworkPolicyV1_spec_ingress,
+		"pod_selector": kubernetesClusterRoleV1_aggregationRule_clusterRoleSelectors,
 	},
-}
-
-var kubernetesNetworkPolicyV1_spec_ingress_from_podSelector_matchExpressions = ConverterSpec{
-	ResourceName: "kubernetes_network_policy_v1",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"key":      toString,
-		"operator": toString,
-		"values": func(a any) (cty.Value, error) {
-			l, ok := a.([]any)
-			if !ok {
-				return cty.Value{}, fmt.Errorf("expected slice, got: %T", a)
-			}
-			vl := make([]cty.Value, 0, len(l))
-			for _, val := range l {
-				v, err := toString(val)
-				if err != nil {
-					return cty.Value{}, err
-				}
-				vl = append(vl, v)
-			}
-			return cty.ListVal(vl), nil
-		},
+	Schema: map[string]FieldSchema{
+		"egress":       {Optional: true, Description: "egress is a list of egress rules to be applied to the selected pods. Outgoing traffic is allowed if there are no NetworkPolicies selecting the pod (and cluster policy otherwise allows the traffic), OR if the traffic matches at least one egress rule across all of the NetworkPolicy objects whose podSelector matches the pod. If this field is empty then this NetworkPolicy limits all outgoing traffic (and serves solely to ensure that the pods it selects are isolated by default). This field is beta-level in 1.8"},
+		"ingress":      {Optional: true, Description: "ingress is a list of ingress rules to be applied to the selected pods. Traffic is allowed to a pod if there are no NetworkPolicies selecting the pod (and cluster policy otherwise allows the traffic), OR if the traffic source is the pod's local node, OR if the traffic matches at least one ingress rule across all of the NetworkPolicy objects whose podSelector matches the pod. If this field is empty then this NetworkPolicy does not allow any traffic (and serves solely to ensure that the pods it selects are isolated by default)"},
+		"pod_selector": {Required: true, MaxItems: 1, Description: "podSelector selects the pods to which this NetworkPolicy object applies. The array of ingress rules is applied to any pods selected by this field. Multiple network policies can select the same set of pods. In this case, the ingress rules for each are combined additively. This field is NOT optional and follows standard label selector semantics. An empty podSelector matches all pods in this namespace."},
+		"policy_types": {Required: true, MinItems: 1, MaxItems: 2, Description: "policyTypes is a list of rule types that the NetworkPolicy relates to. Valid options are [\"Ingress\"], [\"Egress\"], or [\"Ingress\", \"Egress\"]. If this field is not specified, it will default based on the existence of ingress or egress rules; policies that contain an egress section are assumed to affect egress, and all policies (whether or not they contain an ingress section) are assumed to affect ingress. If you want to write an egress-only policy, you must explicitly specify policyTypes [ \"Egress\" ]. Likewise, if you want to write a policy that specifies that no egress is allowed, you must specify a policyTypes value that include \"Egress\" (since such a policy would not include an egress section and would otherwise default to just [ \"Ingress\" ]). This field is beta-level in 1.8"},
 	},
-	Blocks: map[string]ConverterSpec{},
 }
 
-var kubernetesNetworkPolicyV1_spec_ingress_from_namespaceSelector = ConverterSpec{
-	ResourceName: "kubernetes_network_policy_v1",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"match_labels": toStringMap,
-	},
+var kubernetesNetworkPolicyV1_spec_egress = ConverterSpec{
+	Attributes: map[string]func(any) (cty.Value, error){},
 	Blocks: map[string]ConverterSpec{
-		"match_expressions": kubernetesNetworkPolicyV1_spec_ingress_from_namespaceSelector_matchExpressions,
-	},
-}
-
-var kubernetesNetworkPolicyV1_spec_ingress_from_namespaceSelector_matchExpressions = ConverterSpec{
-	ResourceName: "kubernetes_network_policy_v1",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"key":      toString,
-		"operator": toString,
-		"values": func(a any) (cty.Value, error) {
-			l, ok := a.([]any)
-			if !ok {
-				return cty.Value{}, fmt.Errorf("expected slice, got: %T", a)
-			}
-			vl := make([]cty.Value, 0, len(l))
-			for _, val := range l {
-				v, err := toString(val)
-				if err != nil {
-					return cty.Value{}, err
-				}
-				vl = append(vl, v)
-			}
-			return cty.ListVal(vl), nil
-		},
+		"ports": kubernetesNetworkPolicyV1_spec_egress_ports,
+		"to":    kubernetesNetworkPolicyV1_spec_egress_to,
 	},
-	Blocks: map[string]ConverterSpec{},
-}
-
-var kubernetesNetworkPolicyV1_spec_ingress_from_ipBlock = ConverterSpec{
-	ResourceName: "kubernetes_network_policy_v1",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"cidr": toString,
-		"except": func(a any) (cty.Value, error) {
-			l, ok := a.([]any)
-			if !ok {
-				return cty.Value{}, fmt.Errorf("expected slice, got: %T", a)
-			}
-			vl := make([]cty.Value, 0, len(l))
-			for _, val := range l {
-				v, err := toString(val)
-				if err != nil {
-					return cty.Value{}, err
-				}
-				vl = append(vl, v)
-			}
-			return cty.ListVal(vl), nil
-		},
+	Schema: map[string]FieldSchema{
+		"ports": {Optional: true, Description: "ports is a list of destination ports for outgoing traffic. Each item in this list is combined using a logical OR. If this field is empty or missing, this rule matches all ports (traffic not restricted by port). If this field is present and contains at least one item, then this rule allows traffic only if the traffic matches at least one port in the list."},
+		"to":    {Optional: true, Description: "to is a list of destinations for outgoing traffic of pods selected for this rule. Items in this list are combined using a logical OR operation. If this field is empty or missing, this rule matches all destinations (traffic not restricted by destination). If this field is present and contains at least one item, this rule allows traffic only if the traffic matches at least one item in the to list."},
 	},
-	Blocks: map[string]ConverterSpec{},
 }
 
-var kubernetesNetworkPolicyV1_spec_ingress_ports = ConverterSpec{
-	ResourceName: "kubernetes_network_policy_v1",
+var kubernetesNetworkPolicyV1_spec_egress_ports = ConverterSpec{
 	Attributes: map[string]func(any) (cty.Value, error){
 		"end_port": toInt,
 		"port":     toString,
 		"protocol": toString,
 	},
-	Blocks: map[string]ConverterSpec{},
-}
-
-var kubernetesNetworkPolicyV1_spec_podSelector = ConverterSpec{
-	ResourceName: "kubernetes_network_policy_v1",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"match_labels": toStringMap,
-	},
-	Blocks: map[string]ConverterSpec{
-		"match_expressions": kubernetesNetworkPolicyV1_spec_podSelector_matchExpressions,
-	},
-}
-
-var kubernetesNetworkPolicyV1_spec_podSelector_matchExpressions = ConverterSpec{
-	ResourceName: "kubernetes_network_policy_v1",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"key":      toString,
-		"operator": toString,
-		"values": func(a any) (cty.Value, error) {
-			l, ok := a.([]any)
-			if !ok {
-				return cty.Value{}, fmt.Errorf("expected slice, got: %T", a)
-			}
-			vl := make([]cty.Value, 0, len(l))
-			for _, val := range l {
-				v, err := toString(val)
-				if err != nil {
-					return cty.Value{}, err
-				}
-				vl = append(vl, v)
-			}
-			return cty.ListVal(vl), nil
-		},
-	},
-	Blocks: map[string]ConverterSpec{},
-}
-
-var kubernetesNetworkPolicyV1_spec_egress = ConverterSpec{
-	ResourceName: "kubernetes_network_policy_v1",
-	Attributes:   map[string]func(any) (cty.Value, error){},
-	Blocks: map[string]ConverterSpec{
-		"ports": kubernetesNetworkPolicyV1_spec_egress_ports,
-		"to":    kubernetesNetworkPolicyV1_spec_egress_to,
+	Schema: map[string]FieldSchema{
+		"end_port": {Optional: true, Description: "endPort indicates that the range of ports from port to endPort if set, inclusive, should be allowed by the policy. This field cannot be defined if the port field is not defined or if the port field is defined as a named (string) port. The endPort must be equal or greater than port."},
+		"port":     {Optional: true, Description: "port represents the port on the given protocol. This can either be a numerical or named port on a pod. If this field is not provided, this matches all port names and numbers. If present, only traffic on the specified protocol AND port will be matched."},
+		"protocol": {Optional: true, Description: "protocol represents the protocol (TCP, UDP, or SCTP) which traffic must match. If not specified, this field defaults to TCP.", Default: cty.StringVal("TCP")},
 	},
 }
 
 var kubernetesNetworkPolicyV1_spec_egress_to = ConverterSpec{
-	ResourceName: "kubernetes_network_policy_v1",
-	Attributes:   map[string]func(any) (cty.Value, error){},
+	Attributes: map[string]func(any) (cty.Value, error){},
 	Blocks: map[string]ConverterSpec{
 		"ip_block":           kubernetesNetworkPolicyV1_spec_egress_to_ipBlock,
-		"namespace_selector": kubernetesNetworkPolicyV1_spec_egress_to_namespaceSelector,
-		"pod_selector":       kubernetesNetworkPolicyV1_spec_egress_to_podSelector,
+		"namespace_selector": kubernetesClusterRoleV1_aggregationRule_clusterRoleSelectors,
+		"pod_selector":       kubernetesClusterRoleV1_aggregationRule_clusterRoleSelectors,
 	},
-}
-
-var kubernetesNetworkPolicyV1_spec_egress_to_podSelector = ConverterSpec{
-	ResourceName: "kubernetes_network_policy_v1",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"match_labels": toStringMap,
-	},
-	Blocks: map[string]ConverterSpec{
-		"match_expressions": kubernetesNetworkPolicyV1_spec_egress_to_podSelector_matchExpressions,
+	Schema: map[string]FieldSchema{
+		"ip_block":           {Optional: true, MaxItems: 1, Description: "ipBlock defines policy on a particular IPBlock. If this field is set then neither of the other fields can be."},
+		"namespace_selector": {Optional: true, MaxItems: 1, Description: "namespaceSelector selects namespaces using cluster-scoped labels. This field follows standard label selector semantics; if present but empty, it selects all namespaces.\n\nIf podSelector is also set, then the NetworkPolicyPeer as a whole selects the pods matching podSelector in the namespaces selected by namespaceSelector. Otherwise it selects all pods in the namespaces selected by namespaceSelector."},
+		"pod_selector":       {Optional: true, MaxItems: 1, Description: "podSelector is a label selector which selects pods. This field follows standard label selector semantics; if present but empty, it selects all pods.\n\nIf namespaceSelector is also set, then the NetworkPolicyPeer as a whole selects the pods matching podSelector in the Namespaces selected by NamespaceSelector. Otherwise it selects the pods matching podSelector in the policy's own namespace."},
 	},
 }
 
-var kubernetesNetworkPolicyV1_spec_egress_to_podSelector_matchExpressions = ConverterSpec{
-	ResourceName: "kubernetes_network_policy_v1",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"key":      toString,
-		"operator": toString,
-		"values": func(a any) (cty.Value, error) {
-			l, ok := a.([]any)
-			if !ok {
-				return cty.Value{}, fmt.Errorf("expected slice, got: %T", a)
-			}
-			vl := make([]cty.Value, 0, len(l))
-			for _, val := range l {
-				v, err := toString(val)
-				if err != nil {
-					return cty.Value{}, err
-				}
-				vl = append(vl, v)
-			}
-			return cty.ListVal(vl), nil
-		},
-	},
-	Blocks: map[string]ConverterSpec{},
-}
-
-var kubernetesNetworkPolicyV1_spec_egress_to_namespaceSelector = ConverterSpec{
-	ResourceName: "kubernetes_network_policy_v1",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"match_labels": toStringMap,
-	},
-	Blocks: map[string]ConverterSpec{
-		"match_expressions": kubernetesNetworkPolicyV1_spec_egress_to_namespaceSelector_matchExpressions,
-	},
-}
-
-var kubernetesNetworkPolicyV1_spec_egress_to_namespaceSelector_matchExpressions = ConverterSpec{
-	ResourceName: "kubernetes_network_policy_v1",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"key":      toString,
-		"operator": toString,
-		"values": func(a any) (cty.Value, error) {
-			l, ok := a.([]any)
-			if !ok {
-				return cty.Value{}, fmt.Errorf("expected slice, got: %T", a)
-			}
-			vl := make([]cty.Value, 0, len(l))
-			for _, val := range l {
-				v, err := toString(val)
-				if err != nil {
-					return cty.Value{}, err
-				}
-				vl = append(vl, v)
-			}
-			return cty.ListVal(vl), nil
-		},
-	},
-	Blocks: map[string]ConverterSpec{},
-}
-
 var kubernetesNetworkPolicyV1_spec_egress_to_ipBlock = ConverterSpec{
-	ResourceName: "kubernetes_network_policy_v1",
 	Attributes: map[string]func(any) (cty.Value, error){
-		"cidr": toString,
-		"except": func(a any) (cty.Value, error) {
-			l, ok := a.([]any)
-			if !ok {
-				return cty.Value{}, fmt.Errorf("expected slice, got: %T", a)
-			}
-			vl := make([]cty.Value, 0, len(l))
-			for _, val := range l {
-				v, err := toString(val)
-				if err != nil {
-					return cty.Value{}, err
-				}
-				vl = append(vl, v)
-			}
-			return cty.ListVal(vl), nil
-		},
+		"cidr":   toString,
+		"except": toList(toString),
 	},
-	Blocks: map[string]ConverterSpec{},
-}
-
-var kubernetesNetworkPolicyV1_spec_egress_ports = ConverterSpec{
-	ResourceName: "kubernetes_network_policy_v1",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"end_port": toInt,
-		"port":     toString,
-		"protocol": toString,
+	Schema: map[string]FieldSchema{
+		"cidr":   {Optional: true, Description: "cidr is a string representing the IPBlock Valid examples are \"192.168.1.0/24\" or \"2001:db8::/64\""},
+		"except": {Optional: true, Description: "except is a slice of CIDRs that should not be included within an IPBlock Valid examples are \"192.168.1.0/24\" or \"2001:db8::/64\" Except values will be rejected if they are outside the cidr range"},
 	},
-	Blocks: map[string]ConverterSpec{},
 }
 
-var kubernetesNetworkPolicyV1_metadata = ConverterSpec{
-	ResourceName: "kubernetes_network_policy_v1",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"annotations":   toStringMap,
-		"generate_name": toString,
-		"labels":        toStringMap,
-		"name":          toString,
-		"namespace":     toString,
+var kubernetesNetworkPolicyV1_spec_ingress = ConverterSpec{
+	Attributes: map[string]func(any) (cty.Value, error){},
+	Blocks: map[string]ConverterSpec{
+		"from":  kubernetesNetworkPolicyV1_spec_egress_to,
+		"ports": kubernetesNetworkPolicyV1_spec_egress_ports,
+	},
+	Schema: map[string]FieldSchema{
+		"from":  {Optional: true, Description: "from is a list of sources which should be able to access the pods selected for this rule. Items in this list are combined using a logical OR operation. If this field is empty or missing, this rule matches all sources (traffic not restricted by source). If this field is present and contains at least one item, this rule allows traffic only if the traffic matches at least one item in the from list."},
+		"ports": {Optional: true, Description: "ports is a list of ports which should be made accessible on the pods selected for this rule. Each item in this list is combined using a logical OR. If this field is empty or missing, this rule matches all ports (traffic not restricted by port). If this field is present and contains at least one item, then this rule allows traffic only if the traffic matches at least one port in the list."},
 	},
-	Blocks: map[string]ConverterSpec{},
 }
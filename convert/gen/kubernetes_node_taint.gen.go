@@ -5,15 +5,9 @@ package gen
 // DO NOT EDIT.
 
 import (
-	"fmt"
-
 	"github.com/zclconf/go-cty/cty"
 )
 
-// Make sure resources with no list attributes don't cause build errors because
-// of an unused import.
-var _ = fmt.Sprintf
-
 func init() {
 	register("kubernetes_node_taint", kubernetesNodeTaint)
 }
@@ -28,22 +22,32 @@ var kubernetesNodeTaint = ConverterSpec{
 		"metadata": kubernetesNodeTaint_metadata,
 		"taint":    kubernetesNodeTaint_taint,
 	},
+	Schema: map[string]FieldSchema{
+		"field_manager": {Optional: true, Description: "Set the name of the field manager for the node taint", Default: cty.StringVal("Terraform")},
+		"force":         {Optional: true, Description: "Force overwriting annotations that were created or edited outside of Terraform."},
+		"metadata":      {Required: true, MaxItems: 1},
+		"taint":         {Required: true},
+	},
+}
+
+var kubernetesNodeTaint_metadata = ConverterSpec{
+	Attributes: map[string]func(any) (cty.Value, error){
+		"name": toString,
+	},
+	Schema: map[string]FieldSchema{
+		"name": {Required: true, ForceNew: true, Description: "The name of the node"},
+	},
 }
 
 var kubernetesNodeTaint_taint = ConverterSpec{
-	ResourceName: "kubernetes_node_taint",
 	Attributes: map[string]func(any) (cty.Value, error){
 		"effect": toString,
 		"key":    toString,
 		"value":  toString,
 	},
-	Blocks: map[string]ConverterSpec{},
-}
-
-var kubernetesNodeTaint_metadata = ConverterSpec{
-	ResourceName: "kubernetes_node_taint",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"name": toString,
+	Schema: map[string]FieldSchema{
+		"effect": {Required: true, Description: "The taint effect"},
+		"key":    {Required: true, Description: "The taint key"},
+		"value":  {Required: true, Description: "The taint value"},
 	},
-	Blocks: map[string]ConverterSpec{},
 }
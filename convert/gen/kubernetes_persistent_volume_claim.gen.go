@@ -5,15 +5,9 @@ package gen
 // DO NOT EDIT.
 
 import (
-	"fmt"
-
 	"github.com/zclconf/go-cty/cty"
 )
 
-// Make sure resources with no list attributes don't cause build errors because
-// of an unused import.
-var _ = fmt.Sprintf
-
 func init() {
 	register("kubernetes_persistent_volume_claim", kubernetesPersistentVolumeClaim)
 }
@@ -24,90 +18,12 @@ var kubernetesPersistentVolumeClaim = ConverterSpec{
 		"wait_until_bound": toBool,
 	},
 	Blocks: map[string]ConverterSpec{
-		"metadata": kubernetesPersistentVolumeClaim_metadata,
-		"spec":     kubernetesPersistentVolumeClaim_spec,
-	},
-}
-
-var kubernetesPersistentVolumeClaim_spec = ConverterSpec{
-	ResourceName: "kubernetes_persistent_volume_claim",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"access_modes": func(a any) (cty.Value, error) {
-			l, ok := a.([]any)
-			if !ok {
-				return cty.Value{}, fmt.Errorf("expected slice, got: %T", a)
-			}
-			vl := make([]cty.Value, 0, len(l))
-			for _, val := range l {
-				v, err := toString(val)
-				if err != nil {
-					return cty.Value{}, err
-				}
-				vl = append(vl, v)
-			}
-			return cty.ListVal(vl), nil
-		},
-		"storage_class_name": toString,
-		"volume_mode":        toString,
-		"volume_name":        toString,
-	},
-	Blocks: map[string]ConverterSpec{
-		"resources": kubernetesPersistentVolumeClaim_spec_resources,
-		"selector":  kubernetesPersistentVolumeClaim_spec_selector,
-	},
-}
-
-var kubernetesPersistentVolumeClaim_spec_selector = ConverterSpec{
-	ResourceName: "kubernetes_persistent_volume_claim",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"match_labels": toStringMap,
-	},
-	Blocks: map[string]ConverterSpec{
-		"match_expressions": kubernetesPersistentVolumeClaim_spec_selector_matchExpressions,
-	},
-}
-
-var kubernetesPersistentVolumeClaim_spec_selector_matchExpressions = ConverterSpec{
-	ResourceName: "kubernetes_persistent_volume_claim",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"key":      toString,
-		"operator": toString,
-		"values": func(a any) (cty.Value, error) {
-			l, ok := a.([]any)
-			if !ok {
-				return cty.Value{}, fmt.Errorf("expected slice, got: %T", a)
-			}
-			vl := make([]cty.Value, 0, len(l))
-			for _, val := range l {
-				v, err := toString(val)
-				if err != nil {
-					return cty.Value{}, err
-				}
-				vl = append(vl, v)
-			}
-			return cty.ListVal(vl), nil
-		},
+		"metadata": kubernetesPersistentVolumeClaimV1_metadata,
+		"spec":     kubernetesPersistentVolumeClaimV1_spec,
 	},
-	Blocks: map[string]ConverterSpec{},
-}
-
-var kubernetesPersistentVolumeClaim_spec_resources = ConverterSpec{
-	ResourceName: "kubernetes_persistent_volume_claim",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"limits":   toStringMap,
-		"requests": toStringMap,
-	},
-	Blocks: map[string]ConverterSpec{},
-}
-
-var kubernetesPersistentVolumeClaim_metadata = ConverterSpec{
-	ResourceName: "kubernetes_persistent_volume_claim",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"annotations":   toStringMap,
-		"generate_name": toString,
-		"labels":        toStringMap,
-		"name":          toString,
-		"namespace":     toString,
+	Schema: map[string]FieldSchema{
+		"metadata":         {Required: true, MaxItems: 1, Description: "Standard persistent volume claim's metadata. More info: https://github.com/kubernetes/community/blob/master/contributors/devel/sig-architecture/api-conventions.md#metadata"},
+		"spec":             {Required: true, MaxItems: 1, Description: "Spec defines the desired characteristics of a volume requested by a pod author. More info: https://kubernetes.io/docs/concepts/storage/persistent-volumes/#persistentvolumeclaims"},
+		"wait_until_bound": {Optional: true, Description: "Whether to wait for the claim to reach `Bound` state (to find volume in which to claim the space)", Default: cty.BoolVal(true)},
 	},
-	Blocks: map[string]ConverterSpec{},
 }
@@ -5,15 +5,9 @@ package gen
 // DO NOT EDIT.
 
 import (
-	"fmt"
-
 	"github.com/zclconf/go-cty/cty"
 )
 
-// Make sure resources with no list attributes don't cause build errors because
-// of an unused import.
-var _ = fmt.Sprintf
-
 func init() {
 	register("kubernetes_persistent_volume_claim_v1", kubernetesPersistentVolumeClaimV1)
 }
@@ -27,87 +21,62 @@ var kubernetesPersistentVolumeClaimV1 = ConverterSpec{
 		"metadata": kubernetesPersistentVolumeClaimV1_metadata,
 		"spec":     kubernetesPersistentVolumeClaimV1_spec,
 	},
+	Schema: map[string]FieldSchema{
+		"metadata":         {Required: true, MaxItems: 1, Description: "Standard persistent volume claim's metadata. More info: https://github.com/kubernetes/community/blob/master/contributors/devel/sig-architecture/api-conventions.md#metadata"},
+		"spec":             {Required: true, MaxItems: 1, Description: "Spec defines the desired characteristics of a volume requested by a pod author. More info: https://kubernetes.io/docs/concepts/storage/persistent-volumes/#persistentvolumeclaims"},
+		"wait_until_bound": {Optional: true, Description: "Whether to wait for the claim to reach `Bound` state (to find volume in which to claim the space)", Default: cty.BoolVal(true)},
+	},
 }
 
-var kubernetesPersistentVolumeClaimV1_spec = ConverterSpec{
-	ResourceName: "kubernetes_persistent_volume_claim_v1",
+var kubernetesPersistentVolumeClaimV1_metadata = ConverterSpec{
 	Attributes: map[string]func(any) (cty.Value, error){
-		"access_modes": func(a any) (cty.Value, error) {
-			l, ok := a.([]any)
-			if !ok {
-				return cty.Value{}, fmt.Errorf("expected slice, got: %T", a)
-			}
-			vl := make([]cty.Value, 0, len(l))
-			for _, val := range l {
-				v, err := toString(val)
-				if err != nil {
-					return cty.Value{}, err
-				}
-				vl = append(vl, v)
-			}
-			return cty.ListVal(vl), nil
-		},
-		"storage_class_name": toString,
-		"volume_mode":        toString,
-		"volume_name":        toString,
+		"annotations":   toStringMap,
+		"generate_name": toString,
+		"labels":        toStringMap,
+		"name":          toString,
+		"namespace":     toString,
 	},
-	Blocks: map[string]ConverterSpec{
-		"resources": kubernetesPersistentVolumeClaimV1_spec_resources,
-		"selector":  kubernetesPersistentVolumeClaimV1_spec_selector,
+	Schema: map[string]FieldSchema{
+		"annotations":   {Optional: true, Description: "An unstructured key value map stored with the persistent volume claim that may be used to store arbitrary metadata. More info: https://kubernetes.io/docs/concepts/overview/working-with-objects/annotations/"},
+		"generate_name": {Optional: true, ForceNew: true, ConflictsWith: []string{"metadata.name"}, Description: "Prefix, used by the server, to generate a unique name ONLY IF the `name` field has not been provided. This value will also be combined with a unique suffix. More info: https://github.com/kubernetes/community/blob/master/contributors/devel/sig-architecture/api-conventions.md#idempotency"},
+		"labels":        {Optional: true, Description: "Map of string keys and values that can be used to organize and categorize (scope and select) the persistent volume claim. May match selectors of replication controllers and services. More info: https://kubernetes.io/docs/concepts/overview/working-with-objects/labels/"},
+		"name":          {Optional: true, ForceNew: true, ConflictsWith: []string{"metadata.generate_name"}, Description: "Name of the persistent volume claim, must be unique. Cannot be updated. More info: https://kubernetes.io/docs/concepts/overview/working-with-objects/names/#names"},
+		"namespace":     {Optional: true, ForceNew: true, Description: "Namespace defines the space within which name of the persistent volume claim must be unique.", Default: cty.StringVal("default")},
 	},
 }
 
-var kubernetesPersistentVolumeClaimV1_spec_selector = ConverterSpec{
-	ResourceName: "kubernetes_persistent_volume_claim_v1",
+var kubernetesPersistentVolumeClaimV1_spec = ConverterSpec{
 	Attributes: map[string]func(any) (cty.Value, error){
-		"match_labels": toStringMap,
+		"access_modes":       toList(toString),
+		"storage_class_name": toString,
+		"volume_mode":        toString,
+		"volume_name":        toString,
 	},
 	Blocks: map[string]ConverterSpec{
-		"match_expressions": kubernetesPersistentVolumeClaimV1_spec_selector_matchExpressions,
+		"resources": kubernetesPersistentVolumeClaimV1_spec_resources,
+		"selector":  kubernetesDeploymentV1_spec_selector,
 	},
-}
-
-var kubernetesPersistentVolumeClaimV1_spec_selector_matchExpressions = ConverterSpec{
-	ResourceName: "kubernetes_persistent_volume_claim_v1",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"key":      toString,
-		"operator": toString,
-		"values": func(a any) (cty.Value, error) {
-			l, ok := a.([]any)
-			if !ok {
-				return cty.Value{}, fmt.Errorf("expected slice, got: %T", a)
-			}
-			vl := make([]cty.Value, 0, len(l))
-			for _, val := range l {
-				v, err := toString(val)
-				if err != nil {
-					return cty.Value{}, err
-				}
-				vl = append(vl, v)
-			}
-			return cty.ListVal(vl), nil
-		},
+	Schema: map[string]FieldSchema{
+		"access_modes":       {Required: true, ForceNew: true, Description: "A set of the desired access modes the volume should have. More info: https://kubernetes.io/docs/concepts/storage/persistent-volumes#access-modes"},
+		"resources":          {Required: true, MaxItems: 1, Description: "A list of the minimum resources the volume should have. More info: https://kubernetes.io/docs/concepts/storage/persistent-volumes#resources"},
+		"selector":           {Optional: true, ForceNew: true, MaxItems: 1, Description: "A label query over volumes to consider for binding."},
+		"storage_class_name": {Optional: true, ForceNew: true, Description: "Name of the storage class requested by the claim"},
+		"volume_mode":        {Optional: true, ForceNew: true, Description: "Defines what type of volume is required by the claim."},
+		"volume_name":        {Optional: true, ForceNew: true, Description: "The binding reference to the PersistentVolume backing this claim."},
 	},
-	Blocks: map[string]ConverterSpec{},
 }
 
 var kubernetesPersistentVolumeClaimV1_spec_resources = ConverterSpec{
-	ResourceName: "kubernetes_persistent_volume_claim_v1",
 	Attributes: map[string]func(any) (cty.Value, error){
-		"limits":   toStringMap,
-		"requests": toStringMap,
+		"limits":   toQuantityMap,
+		"requests": toQuantityMap,
 	},
-	Blocks: map[string]ConverterSpec{},
-}
-
-var kubernetesPersistentVolumeClaimV1_metadata = ConverterSpec{
-	ResourceName: "kubernetes_persistent_volume_claim_v1",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"annotations":   toStringMap,
-		"generate_name": toString,
-		"labels":        toStringMap,
-		"name":          toString,
-		"namespace":     toString,
+	Quantities: map[string]bool{
+		"limits":   true,
+		"requests": true,
+	},
+	Schema: map[string]FieldSchema{
+		"limits":   {Optional: true, ForceNew: true, Description: "Map describing the maximum amount of compute resources allowed. More info: https://kubernetes.io/docs/concepts/configuration/manage-resources-containers/"},
+		"requests": {Optional: true, Description: "Map describing the minimum amount of compute resources required. If this is omitted for a container, it defaults to `limits` if that is explicitly specified, otherwise to an implementation-defined value. More info: https://kubernetes.io/docs/concepts/configuration/manage-resources-containers/"},
 	},
-	Blocks: map[string]ConverterSpec{},
 }
@@ -5,15 +5,9 @@ package gen
 // DO NOT EDIT.
 
 import (
-	"fmt"
-
 	"github.com/zclconf/go-cty/cty"
 )
 
-// Make sure resources with no list attributes don't cause build errors because
-// of an unused import.
-var _ = fmt.Sprintf
-
 func init() {
 	register("kubernetes_persistent_volume_v1", kubernetesPersistentVolumeV1)
 }
@@ -25,42 +19,30 @@ var kubernetesPersistentVolumeV1 = ConverterSpec{
 		"metadata": kubernetesPersistentVolumeV1_metadata,
 		"spec":     kubernetesPersistentVolumeV1_spec,
 	},
+	Schema: map[string]FieldSchema{
+		"metadata": {Required: true, MaxItems: 1, Description: "Standard persistent volume's metadata. More info: https://github.com/kubernetes/community/blob/master/contributors/devel/sig-architecture/api-conventions.md#metadata"},
+		"spec":     {Required: true, Description: "Spec of the persistent volume owned by the cluster"},
+	},
+}
+
+var kubernetesPersistentVolumeV1_metadata = ConverterSpec{
+	Attributes: map[string]func(any) (cty.Value, error){
+		"annotations": toStringMap,
+		"labels":      toStringMap,
+		"name":        toString,
+	},
+	Schema: map[string]FieldSchema{
+		"annotations": {Optional: true, Description: "An unstructured key value map stored with the persistent volume that may be used to store arbitrary metadata. More info: https://kubernetes.io/docs/concepts/overview/working-with-objects/annotations/"},
+		"labels":      {Optional: true, Description: "Map of string keys and values that can be used to organize and categorize (scope and select) the persistent volume. May match selectors of replication controllers and services. More info: https://kubernetes.io/docs/concepts/overview/working-with-objects/labels/"},
+		"name":        {Optional: true, ForceNew: true, Description: "Name of the persistent volume, must be unique. Cannot be updated. More info: https://kubernetes.io/docs/concepts/overview/working-with-objects/names/#names"},
+	},
 }
 
 var kubernetesPersistentVolumeV1_spec = ConverterSpec{
-	ResourceName: "kubernetes_persistent_volume_v1",
 	Attributes: map[string]func(any) (cty.Value, error){
-		"access_modes": func(a any) (cty.Value, error) {
-			l, ok := a.([]any)
-			if !ok {
-				return cty.Value{}, fmt.Errorf("expected slice, got: %T", a)
-			}
-			vl := make([]cty.Value, 0, len(l))
-			for _, val := range l {
-				v, err := toString(val)
-				if err != nil {
-					return cty.Value{}, err
-				}
-				vl = append(vl, v)
-			}
-			return cty.ListVal(vl), nil
-		},
-		"capacity": toStringMap,
-		"mount_options": func(a any) (cty.Value, error) {
-			l, ok := a.([]any)
-			if !ok {
-				return cty.Value{}, fmt.Errorf("expected slice, got: %T", a)
-			}
-			vl := make([]cty.Value, 0, len(l))
-			for _, val := range l {
-				v, err := toString(val)
-				if err != nil {
-					return cty.Value{}, err
-				}
-				vl = append(vl, v)
-			}
-			return cty.ListVal(vl), nil
-		},
+		"access_modes":                     toList(toString),
+		"capacity":                         toQuantityMap,
+		"mount_options":                    toList(toString),
 		"persistent_volume_reclaim_policy": toString,
 		"storage_class_name":               toString,
 		"volume_mode":                      toString,
@@ -70,93 +52,80 @@ var kubernetesPersistentVolumeV1_spec = ConverterSpec{
 		"node_affinity":            kubernetesPersistentVolumeV1_spec_nodeAffinity,
 		"persistent_volume_source": kubernetesPersistentVolumeV1_spec_persistentVolumeSource,
 	},
+	Quantities: map[string]bool{
+		"capacity": true,
+	},
+	Schema: map[string]FieldSchema{
+		"access_modes":                     {Required: true, Description: "Contains all ways the volume can be mounted. More info: https://kubernetes.io/docs/concepts/storage/persistent-volumes#access-modes"},
+		"capacity":                         {Required: true, Description: "A description of the persistent volume's resources and capacity. More info: https://kubernetes.io/docs/concepts/storage/persistent-volumes#capacity"},
+		"claim_ref":                        {Optional: true, MaxItems: 1, Description: "A reference to the persistent volume claim details for statically managed PVs. More Info: https://kubernetes.io/docs/concepts/storage/persistent-volumes/#binding"},
+		"mount_options":                    {Optional: true, Description: "A list of mount options, e.g. [\"ro\", \"soft\"]. Not validated - mount will simply fail if one is invalid."},
+		"node_affinity":                    {Optional: true, MaxItems: 1, Description: "A description of the persistent volume's node affinity. More info: https://kubernetes.io/docs/concepts/storage/volumes/#local"},
+		"persistent_volume_reclaim_policy": {Optional: true, Description: "What happens to a persistent volume when released from its claim. Valid options are Retain (default) and Recycle. Recycling must be supported by the volume plugin underlying this persistent volume. More info: https://kubernetes.io/docs/concepts/storage/persistent-volumes#reclaiming", Default: cty.StringVal("Retain")},
+		"persistent_volume_source":         {Required: true, MaxItems: 1, Description: "The specification of a persistent volume."},
+		"storage_class_name":               {Optional: true, Description: "A description of the persistent volume's class. More info: https://kubernetes.io/docs/concepts/storage/persistent-volumes/#class"},
+		"volume_mode":                      {Optional: true, ForceNew: true, Description: "Defines if a volume is intended to be used with a formatted filesystem. or to remain in raw block state.", Default: cty.StringVal("Filesystem")},
+	},
 }
 
 var kubernetesPersistentVolumeV1_spec_claimRef = ConverterSpec{
-	ResourceName: "kubernetes_persistent_volume_v1",
 	Attributes: map[string]func(any) (cty.Value, error){
 		"name":      toString,
 		"namespace": toString,
 	},
-	Blocks: map[string]ConverterSpec{},
+	Schema: map[string]FieldSchema{
+		"name":      {Required: true, Description: "The name of the PersistentVolumeClaim"},
+		"namespace": {Optional: true, Description: "The namespace of the PersistentVolumeClaim. Uses 'default' namespace if none is specified.", Default: cty.StringVal("default")},
+	},
 }
 
 var kubernetesPersistentVolumeV1_spec_nodeAffinity = ConverterSpec{
-	ResourceName: "kubernetes_persistent_volume_v1",
-	Attributes:   map[string]func(any) (cty.Value, error){},
+	Attributes: map[string]func(any) (cty.Value, error){},
 	Blocks: map[string]ConverterSpec{
 		"required": kubernetesPersistentVolumeV1_spec_nodeAffinity_required,
 	},
+	Schema: map[string]FieldSchema{
+		"required": {Optional: true, MaxItems: 1},
+	},
 }
 
 var kubernetesPersistentVolumeV1_spec_nodeAffinity_required = ConverterSpec{
-	ResourceName: "kubernetes_persistent_volume_v1",
-	Attributes:   map[string]func(any) (cty.Value, error){},
+	Attributes: map[string]func(any) (cty.Value, error){},
 	Blocks: map[string]ConverterSpec{
 		"node_selector_term": kubernetesPersistentVolumeV1_spec_nodeAffinity_required_nodeSelectorTerm,
 	},
+	Schema: map[string]FieldSchema{
+		"node_selector_term": {Required: true},
+	},
 }
 
 var kubernetesPersistentVolumeV1_spec_nodeAffinity_required_nodeSelectorTerm = ConverterSpec{
-	ResourceName: "kubernetes_persistent_volume_v1",
-	Attributes:   map[string]func(any) (cty.Value, error){},
+	Attributes: map[string]func(any) (cty.Value, error){},
 	Blocks: map[string]ConverterSpec{
 		"match_expressions": kubernetesPersistentVolumeV1_spec_nodeAffinity_required_nodeSelectorTerm_matchExpressions,
-		"match_fields":      kubernetesPersistentVolumeV1_spec_nodeAffinity_required_nodeSelectorTerm_matchFields,
+		"match_fields":      kubernetesPersistentVolumeV1_spec_nodeAffinity_required_nodeSelectorTerm_matchExpressions,
+	},
+	Schema: map[string]FieldSchema{
+		"match_expressions": {Optional: true, ForceNew: true, Description: "A list of node selector requirements by node's labels. The requirements are ANDed."},
+		"match_fields":      {Optional: true, ForceNew: true, Description: "A list of node selector requirements by node's fields. The requirements are ANDed."},
 	},
-}
-
-var kubernetesPersistentVolumeV1_spec_nodeAffinity_required_nodeSelectorTerm_matchFields = ConverterSpec{
-	ResourceName: "kubernetes_persistent_volume_v1",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"key":      toString,
-		"operator": toString,
-		"values": func(a any) (cty.Value, error) {
-			l, ok := a.([]any)
-			if !ok {
-				return cty.Value{}, fmt.Errorf("expected slice, got: %T", a)
-			}
-			vl := make([]cty.Value, 0, len(l))
-			for _, val := range l {
-				v, err := toString(val)
-				if err != nil {
-					return cty.Value{}, err
-				}
-				vl = append(vl, v)
-			}
-			return cty.ListVal(vl), nil
-		},
-	},
-	Blocks: map[string]ConverterSpec{},
 }
 
 var kubernetesPersistentVolumeV1_spec_nodeAffinity_required_nodeSelectorTerm_matchExpressions = ConverterSpec{
-	ResourceName: "kubernetes_persistent_volume_v1",
 	Attributes: map[string]func(any) (cty.Value, error){
 		"key":      toString,
 		"operator": toString,
-		"values": func(a any) (cty.Value, error) {
-			l, ok := a.([]any)
-			if !ok {
-				return cty.Value{}, fmt.Errorf("expected slice, got: %T", a)
-			}
-			vl := make([]cty.Value, 0, len(l))
-			for _, val := range l {
-				v, err := toString(val)
-				if err != nil {
-					return cty.Value{}, err
-				}
-				vl = append(vl, v)
-			}
-			return cty.ListVal(vl), nil
-		},
-	},
-	Blocks: map[string]ConverterSpec{},
+		"values":   toList(toString),
+	},
+	Schema: map[string]FieldSchema{
+		"key":      {Required: true, ForceNew: true, Description: "The label key that the selector applies to."},
+		"operator": {Required: true, ForceNew: true, Description: "A key's relationship to a set of values. Valid operators ard `In`, `NotIn`, `Exists`, `DoesNotExist`, `Gt`, and `Lt`."},
+		"values":   {Optional: true, ForceNew: true, Description: "An array of string values. If the operator is `In` or `NotIn`, the values array must be non-empty. If the operator is `Exists` or `DoesNotExist`, the values array must be empty. This array is replaced during a strategic merge patch."},
+	},
 }
 
 var kubernetesPersistentVolumeV1_spec_persistentVolumeSource = ConverterSpec{
-	ResourceName: "kubernetes_persistent_volume_v1",
-	Attributes:   map[string]func(any) (cty.Value, error){},
+	Attributes: map[string]func(any) (cty.Value, error){},
 	Blocks: map[string]ConverterSpec{
 		"aws_elastic_block_store": kubernetesPersistentVolumeV1_spec_persistentVolumeSource_awsElasticBlockStore,
 		"azure_disk":              kubernetesPersistentVolumeV1_spec_persistentVolumeSource_azureDisk,
@@ -178,80 +147,124 @@ var kubernetesPersistentVolumeV1_spec_persistentVolumeSource = ConverterSpec{
 		"rbd":                     kubernetesPersistentVolumeV1_spec_persistentVolumeSource_rbd,
 		"vsphere_volume":          kubernetesPersistentVolumeV1_spec_persistentVolumeSource_vsphereVolume,
 	},
+	Schema: map[string]FieldSchema{
+		"aws_elastic_block_store": {Optional: true, MaxItems: 1, Description: "Represents an AWS Disk resource that is attached to a kubelet's host machine and then exposed to the pod. More info: https://kubernetes.io/docs/concepts/storage/volumes#awselasticblockstore"},
+		"azure_disk":              {Optional: true, MaxItems: 1, Description: "Represents an Azure Data Disk mount on the host and bind mount to the pod."},
+		"azure_file":              {Optional: true, MaxItems: 1, Description: "Represents an Azure File Service mount on the host and bind mount to the pod."},
+		"ceph_fs":                 {Optional: true, MaxItems: 1, Description: "Represents a Ceph FS mount on the host that shares a pod's lifetime"},
+		"cinder":                  {Optional: true, MaxItems: 1, Description: "Represents a cinder volume attached and mounted on kubelets host machine. More info: https://examples.k8s.io/mysql-cinder-pd/README.md"},
+		"csi":                     {Optional: true, MaxItems: 1, Description: "Represents a CSI Volume. More info: https://kubernetes.io/docs/concepts/storage/volumes#csi"},
+		"fc":                      {Optional: true, MaxItems: 1, Description: "Represents a Fibre Channel resource that is attached to a kubelet's host machine and then exposed to the pod."},
+		"flex_volume":             {Optional: true, MaxItems: 1, Description: "Represents a generic volume resource that is provisioned/attached using an exec based plugin. This is an alpha feature and may change in future."},
+		"flocker":                 {Optional: true, MaxItems: 1, Description: "Represents a Flocker volume attached to a kubelet's host machine and exposed to the pod for its usage. This depends on the Flocker control service being running"},
+		"gce_persistent_disk":     {Optional: true, MaxItems: 1, Description: "Represents a GCE Disk resource that is attached to a kubelet's host machine and then exposed to the pod. Provisioned by an admin. More info: https://kubernetes.io/docs/concepts/storage/volumes#gcepersistentdisk"},
+		"glusterfs":               {Optional: true, MaxItems: 1, Description: "Represents a Glusterfs volume that is attached to a host and exposed to the pod. Provisioned by an admin. More info: https://examples.k8s.io/volumes/glusterfs/README.md"},
+		"host_path":               {Optional: true, MaxItems: 1, Description: "Represents a directory on the host. Provisioned by a developer or tester. This is useful for single-node development and testing only! On-host storage is not supported in any way and WILL NOT WORK in a multi-node cluster. More info: https://kubernetes.io/docs/concepts/storage/volumes#hostpath"},
+		"iscsi":                   {Optional: true, MaxItems: 1, Description: "Represents an ISCSI Disk resource that is attached to a kubelet's host machine and then exposed to the pod. Provisioned by an admin."},
+		"local":                   {Optional: true, MaxItems: 1, Description: "Represents a mounted local storage device such as a disk, partition or directory. Local volumes can only be used as a statically created PersistentVolume. Dynamic provisioning is not supported yet. More info: https://kubernetes.io/docs/concepts/storage/volumes#local"},
+		"nfs":                     {Optional: true, MaxItems: 1, Description: "Represents an NFS mount on the host. Provisioned by an admin. More info: https://kubernetes.io/docs/concepts/storage/volumes#nfs"},
+		"photon_persistent_disk":  {Optional: true, MaxItems: 1, Description: "Represents a PhotonController persistent disk attached and mounted on kubelets host machine"},
+		"quobyte":                 {Optional: true, MaxItems: 1, Description: "Quobyte represents a Quobyte mount on the host that shares a pod's lifetime"},
+		"rbd":                     {Optional: true, MaxItems: 1, Description: "Represents a Rados Block Device mount on the host that shares a pod's lifetime. More info: https://examples.k8s.io/volumes/rbd/README.md"},
+		"vsphere_volume":          {Optional: true, MaxItems: 1, Description: "Represents a vSphere volume attached and mounted on kubelets host machine"},
+	},
 }
 
-var kubernetesPersistentVolumeV1_spec_persistentVolumeSource_quobyte = ConverterSpec{
-	ResourceName: "kubernetes_persistent_volume_v1",
+var kubernetesPersistentVolumeV1_spec_persistentVolumeSource_awsElasticBlockStore = ConverterSpec{
 	Attributes: map[string]func(any) (cty.Value, error){
-		"group":     toString,
+		"fs_type":   toString,
+		"partition": toInt,
 		"read_only": toBool,
-		"registry":  toString,
-		"user":      toString,
-		"volume":    toString,
+		"volume_id": toString,
+	},
+	Schema: map[string]FieldSchema{
+		"fs_type":   {Optional: true, Description: "Filesystem type of the volume that you want to mount. Tip: Ensure that the filesystem type is supported by the host operating system. Examples: \"ext4\", \"xfs\", \"ntfs\". Implicitly inferred to be \"ext4\" if unspecified. More info: https://kubernetes.io/docs/concepts/storage/volumes#awselasticblockstore"},
+		"partition": {Optional: true, Description: "The partition in the volume that you want to mount. If omitted, the default is to mount by volume name. Examples: For volume /dev/sda1, you specify the partition as \"1\". Similarly, the volume partition for /dev/sda is \"0\" (or you can leave the property empty)."},
+		"read_only": {Optional: true, Description: "Whether to set the read-only property in VolumeMounts to \"true\". If omitted, the default is \"false\". More info: https://kubernetes.io/docs/concepts/storage/volumes#awselasticblockstore"},
+		"volume_id": {Required: true, Description: "Unique ID of the persistent disk resource in AWS (Amazon EBS volume). More info: https://kubernetes.io/docs/concepts/storage/volumes#awselasticblockstore"},
 	},
-	Blocks: map[string]ConverterSpec{},
 }
 
-var kubernetesPersistentVolumeV1_spec_persistentVolumeSource_iscsi = ConverterSpec{
-	ResourceName: "kubernetes_persistent_volume_v1",
+var kubernetesPersistentVolumeV1_spec_persistentVolumeSource_azureDisk = ConverterSpec{
 	Attributes: map[string]func(any) (cty.Value, error){
-		"fs_type":         toString,
-		"iqn":             toString,
-		"iscsi_interface": toString,
-		"lun":             toInt,
-		"read_only":       toBool,
-		"target_portal":   toString,
+		"caching_mode":  toString,
+		"data_disk_uri": toString,
+		"disk_name":     toString,
+		"fs_type":       toString,
+		"kind":          toString,
+		"read_only":     toBool,
+	},
+	Schema: map[string]FieldSchema{
+		"caching_mode":  {Required: true, Description: "Host Caching mode: None, Read Only, Read Write."},
+		"data_disk_uri": {Required: true, Description: "The URI the data disk in the blob storage"},
+		"disk_name":     {Required: true, Description: "The Name of the data disk in the blob storage"},
+		"fs_type":       {Optional: true, Description: "Filesystem type to mount. Must be a filesystem type supported by the host operating system. Ex. \"ext4\", \"xfs\", \"ntfs\". Implicitly inferred to be \"ext4\" if unspecified."},
+		"kind":          {Optional: true, Description: "The type for the data disk. Expected values: Shared, Dedicated, Managed. Defaults to Shared"},
+		"read_only":     {Optional: true, Description: "Whether to force the read-only setting in VolumeMounts. Defaults to false (read/write).", Default: cty.BoolVal(false)},
 	},
-	Blocks: map[string]ConverterSpec{},
 }
 
-var kubernetesPersistentVolumeV1_spec_persistentVolumeSource_glusterfs = ConverterSpec{
-	ResourceName: "kubernetes_persistent_volume_v1",
+var kubernetesPersistentVolumeV1_spec_persistentVolumeSource_azureFile = ConverterSpec{
 	Attributes: map[string]func(any) (cty.Value, error){
-		"endpoints_name": toString,
-		"path":           toString,
-		"read_only":      toBool,
+		"read_only":        toBool,
+		"secret_name":      toString,
+		"secret_namespace": toString,
+		"share_name":       toString,
+	},
+	Schema: map[string]FieldSchema{
+		"read_only":        {Optional: true, Description: "Whether to force the read-only setting in VolumeMounts. Defaults to false (read/write)."},
+		"secret_name":      {Required: true, Description: "The name of secret that contains Azure Storage Account Name and Key"},
+		"secret_namespace": {Optional: true, ForceNew: true, Description: "The namespace of the secret that contains Azure Storage Account Name and Key. For Kubernetes up to 1.18.x the default is the same as the Pod. For Kubernetes 1.19.x and later the default is \"default\" namespace."},
+		"share_name":       {Required: true, Description: "Share Name"},
 	},
-	Blocks: map[string]ConverterSpec{},
 }
 
-var kubernetesPersistentVolumeV1_spec_persistentVolumeSource_flexVolume = ConverterSpec{
-	ResourceName: "kubernetes_persistent_volume_v1",
+var kubernetesPersistentVolumeV1_spec_persistentVolumeSource_cephFs = ConverterSpec{
 	Attributes: map[string]func(any) (cty.Value, error){
-		"driver":    toString,
-		"fs_type":   toString,
-		"options":   toStringMap,
-		"read_only": toBool,
+		"monitors":    toList(toString),
+		"path":        toString,
+		"read_only":   toBool,
+		"secret_file": toString,
+		"user":        toString,
 	},
 	Blocks: map[string]ConverterSpec{
-		"secret_ref": kubernetesPersistentVolumeV1_spec_persistentVolumeSource_flexVolume_secretRef,
+		"secret_ref": kubernetesPersistentVolumeV1_spec_persistentVolumeSource_cephFs_secretRef,
+	},
+	Schema: map[string]FieldSchema{
+		"monitors":    {Required: true, Description: "Monitors is a collection of Ceph monitors. More info: https://examples.k8s.io/volumes/cephfs/README.md#how-to-use-it"},
+		"path":        {Optional: true, Description: "Used as the mounted root, rather than the full Ceph tree, default is /"},
+		"read_only":   {Optional: true, Description: "Whether to force the read-only setting in VolumeMounts. Defaults to `false` (read/write). More info: https://examples.k8s.io/volumes/cephfs/README.md#how-to-use-it"},
+		"secret_file": {Optional: true, Description: "The path to key ring for User, default is `/etc/ceph/user.secret`. More info: https://examples.k8s.io/volumes/cephfs/README.md#how-to-use-it"},
+		"secret_ref":  {Optional: true, MaxItems: 1, Description: "Reference to the authentication secret for User, default is empty. More info: https://examples.k8s.io/volumes/cephfs/README.md#how-to-use-it"},
+		"user":        {Optional: true, Description: "User is the rados user name, default is admin. More info: https://examples.k8s.io/volumes/cephfs/README.md#how-to-use-it"},
 	},
 }
 
-var kubernetesPersistentVolumeV1_spec_persistentVolumeSource_flexVolume_secretRef = ConverterSpec{
-	ResourceName: "kubernetes_persistent_volume_v1",
+var kubernetesPersistentVolumeV1_spec_persistentVolumeSource_cephFs_secretRef = ConverterSpec{
 	Attributes: map[string]func(any) (cty.Value, error){
 		"name":      toString,
 		"namespace": toString,
 	},
-	Blocks: map[string]ConverterSpec{},
+	Schema: map[string]FieldSchema{
+		"name":      {Optional: true, Description: "Name of the referent. More info: https://kubernetes.io/docs/concepts/overview/working-with-objects/names/#names"},
+		"namespace": {Optional: true, Description: "Name of the referent. More info: https://kubernetes.io/docs/concepts/overview/working-with-objects/names/#names"},
+	},
 }
 
-var kubernetesPersistentVolumeV1_spec_persistentVolumeSource_azureDisk = ConverterSpec{
-	ResourceName: "kubernetes_persistent_volume_v1",
+var kubernetesPersistentVolumeV1_spec_persistentVolumeSource_cinder = ConverterSpec{
 	Attributes: map[string]func(any) (cty.Value, error){
-		"caching_mode":  toString,
-		"data_disk_uri": toString,
-		"disk_name":     toString,
-		"fs_type":       toString,
-		"kind":          toString,
-		"read_only":     toBool,
+		"fs_type":   toString,
+		"read_only": toBool,
+		"volume_id": toString,
+	},
+	Schema: map[string]FieldSchema{
+		"fs_type":   {Optional: true, Description: "Filesystem type to mount. Must be a filesystem type supported by the host operating system. Examples: \"ext4\", \"xfs\", \"ntfs\". Implicitly inferred to be \"ext4\" if unspecified. More info: https://examples.k8s.io/mysql-cinder-pd/README.md"},
+		"read_only": {Optional: true, Description: "Whether to force the read-only setting in VolumeMounts. Defaults to false (read/write). More info: https://examples.k8s.io/mysql-cinder-pd/README.md"},
+		"volume_id": {Required: true, Description: "Volume ID used to identify the volume in Cinder. More info: https://examples.k8s.io/mysql-cinder-pd/README.md"},
 	},
-	Blocks: map[string]ConverterSpec{},
 }
 
 var kubernetesPersistentVolumeV1_spec_persistentVolumeSource_csi = ConverterSpec{
-	ResourceName: "kubernetes_persistent_volume_v1",
 	Attributes: map[string]func(any) (cty.Value, error){
 		"driver":            toString,
 		"fs_type":           toString,
@@ -260,253 +273,209 @@ var kubernetesPersistentVolumeV1_spec_persistentVolumeSource_csi = ConverterSpec
 		"volume_handle":     toString,
 	},
 	Blocks: map[string]ConverterSpec{
-		"controller_expand_secret_ref":  kubernetesPersistentVolumeV1_spec_persistentVolumeSource_csi_controllerExpandSecretRef,
-		"controller_publish_secret_ref": kubernetesPersistentVolumeV1_spec_persistentVolumeSource_csi_controllerPublishSecretRef,
-		"node_publish_secret_ref":       kubernetesPersistentVolumeV1_spec_persistentVolumeSource_csi_nodePublishSecretRef,
-		"node_stage_secret_ref":         kubernetesPersistentVolumeV1_spec_persistentVolumeSource_csi_nodeStageSecretRef,
-	},
-}
-
-var kubernetesPersistentVolumeV1_spec_persistentVolumeSource_csi_nodeStageSecretRef = ConverterSpec{
-	ResourceName: "kubernetes_persistent_volume_v1",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"name":      toString,
-		"namespace": toString,
-	},
-	Blocks: map[string]ConverterSpec{},
-}
-
-var kubernetesPersistentVolumeV1_spec_persistentVolumeSource_csi_controllerPublishSecretRef = ConverterSpec{
-	ResourceName: "kubernetes_persistent_volume_v1",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"name":      toString,
-		"namespace": toString,
+		"controller_expand_secret_ref":  kubernetesPersistentVolumeV1_spec_persistentVolumeSource_cephFs_secretRef,
+		"controller_publish_secret_ref": kubernetesPersistentVolumeV1_spec_persistentVolumeSource_cephFs_secretRef,
+		"node_publish_secret_ref":       kubernetesPersistentVolumeV1_spec_persistentVolumeSource_cephFs_secretRef,
+		"node_stage_secret_ref":         kubernetesPersistentVolumeV1_spec_persistentVolumeSource_cephFs_secretRef,
 	},
-	Blocks: map[string]ConverterSpec{},
-}
-
-var kubernetesPersistentVolumeV1_spec_persistentVolumeSource_csi_controllerExpandSecretRef = ConverterSpec{
-	ResourceName: "kubernetes_persistent_volume_v1",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"name":      toString,
-		"namespace": toString,
+	Schema: map[string]FieldSchema{
+		"controller_expand_secret_ref":  {Optional: true, MaxItems: 1, Description: "A reference to the secret object containing sensitive information to pass to the CSI driver to complete the CSI ControllerExpandVolume call."},
+		"controller_publish_secret_ref": {Optional: true, MaxItems: 1, Description: "A reference to the secret object containing sensitive information to pass to the CSI driver to complete the CSI ControllerPublishVolume and ControllerUnpublishVolume calls."},
+		"driver":                        {Required: true, Description: "the name of the volume driver to use. More info: https://kubernetes.io/docs/concepts/storage/volumes/#csi"},
+		"fs_type":                       {Optional: true, Description: "Filesystem type to mount. Must be a filesystem type supported by the host operating system. Ex. \"ext4\", \"xfs\", \"ntfs\". Implicitly inferred to be \"ext4\" if unspecified."},
+		"node_publish_secret_ref":       {Optional: true, MaxItems: 1, Description: "A reference to the secret object containing sensitive information to pass to the CSI driver to complete the CSI NodePublishVolume and NodeUnpublishVolume calls."},
+		"node_stage_secret_ref":         {Optional: true, MaxItems: 1, Description: "A reference to the secret object containing sensitive information to pass to the CSI driver to complete the CSI NodeStageVolume and NodeStageVolume and NodeUnstageVolume calls."},
+		"read_only":                     {Optional: true, Description: "Whether to set the read-only property in VolumeMounts to \"true\". If omitted, the default is \"false\". More info: https://kubernetes.io/docs/concepts/storage/volumes#csi"},
+		"volume_attributes":             {Optional: true, Description: "Attributes of the volume to publish."},
+		"volume_handle":                 {Required: true, Description: "A string value that uniquely identifies the volume. More info: https://kubernetes.io/docs/concepts/storage/volumes/#csi"},
 	},
-	Blocks: map[string]ConverterSpec{},
 }
 
-var kubernetesPersistentVolumeV1_spec_persistentVolumeSource_csi_nodePublishSecretRef = ConverterSpec{
-	ResourceName: "kubernetes_persistent_volume_v1",
+var kubernetesPersistentVolumeV1_spec_persistentVolumeSource_fc = ConverterSpec{
 	Attributes: map[string]func(any) (cty.Value, error){
-		"name":      toString,
-		"namespace": toString,
+		"fs_type":      toString,
+		"lun":          toInt,
+		"read_only":    toBool,
+		"target_ww_ns": toList(toString),
 	},
-	Blocks: map[string]ConverterSpec{},
-}
-
-var kubernetesPersistentVolumeV1_spec_persistentVolumeSource_flocker = ConverterSpec{
-	ResourceName: "kubernetes_persistent_volume_v1",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"dataset_name": toString,
-		"dataset_uuid": toString,
+	Schema: map[string]FieldSchema{
+		"fs_type":      {Optional: true, Description: "Filesystem type to mount. Must be a filesystem type supported by the host operating system. Ex. \"ext4\", \"xfs\", \"ntfs\". Implicitly inferred to be \"ext4\" if unspecified."},
+		"lun":          {Required: true, Description: "FC target lun number"},
+		"read_only":    {Optional: true, Description: "Whether to force the read-only setting in VolumeMounts. Defaults to false (read/write)."},
+		"target_ww_ns": {Required: true, Description: "FC target worldwide names (WWNs)"},
 	},
-	Blocks: map[string]ConverterSpec{},
 }
 
-var kubernetesPersistentVolumeV1_spec_persistentVolumeSource_cephFs = ConverterSpec{
-	ResourceName: "kubernetes_persistent_volume_v1",
+var kubernetesPersistentVolumeV1_spec_persistentVolumeSource_flexVolume = ConverterSpec{
 	Attributes: map[string]func(any) (cty.Value, error){
-		"monitors": func(a any) (cty.Value, error) {
-			l, ok := a.([]any)
-			if !ok {
-				return cty.Value{}, fmt.Errorf("expected slice, got: %T", a)
-			}
-			vl := make([]cty.Value, 0, len(l))
-			for _, val := range l {
-				v, err := toString(val)
-				if err != nil {
-					return cty.Value{}, err
-				}
-				vl = append(vl, v)
-			}
-			return cty.ListVal(vl), nil
-		},
-		"path":        toString,
-		"read_only":   toBool,
-		"secret_file": toString,
-		"user":        toString,
+		"driver":    toString,
+		"fs_type":   toString,
+		"options":   toStringMap,
+		"read_only": toBool,
 	},
 	Blocks: map[string]ConverterSpec{
 		"secret_ref": kubernetesPersistentVolumeV1_spec_persistentVolumeSource_cephFs_secretRef,
 	},
-}
-
-var kubernetesPersistentVolumeV1_spec_persistentVolumeSource_cephFs_secretRef = ConverterSpec{
-	ResourceName: "kubernetes_persistent_volume_v1",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"name":      toString,
-		"namespace": toString,
-	},
-	Blocks: map[string]ConverterSpec{},
-}
-
-var kubernetesPersistentVolumeV1_spec_persistentVolumeSource_vsphereVolume = ConverterSpec{
-	ResourceName: "kubernetes_persistent_volume_v1",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"fs_type":     toString,
-		"volume_path": toString,
+	Schema: map[string]FieldSchema{
+		"driver":     {Required: true, Description: "Driver is the name of the driver to use for this volume."},
+		"fs_type":    {Optional: true, Description: "Filesystem type to mount. Must be a filesystem type supported by the host operating system. Ex. \"ext4\", \"xfs\", \"ntfs\". The default filesystem depends on FlexVolume script."},
+		"options":    {Optional: true, Description: "Extra command options if any."},
+		"read_only":  {Optional: true, Description: "Whether to force the ReadOnly setting in VolumeMounts. Defaults to false (read/write)."},
+		"secret_ref": {Optional: true, MaxItems: 1, Description: "Reference to the secret object containing sensitive information to pass to the plugin scripts. This may be empty if no secret object is specified. If the secret object contains more than one secret, all secrets are passed to the plugin scripts."},
 	},
-	Blocks: map[string]ConverterSpec{},
 }
 
-var kubernetesPersistentVolumeV1_spec_persistentVolumeSource_photonPersistentDisk = ConverterSpec{
-	ResourceName: "kubernetes_persistent_volume_v1",
+var kubernetesPersistentVolumeV1_spec_persistentVolumeSource_flocker = ConverterSpec{
 	Attributes: map[string]func(any) (cty.Value, error){
-		"fs_type": toString,
-		"pd_id":   toString,
+		"dataset_name": toString,
+		"dataset_uuid": toString,
 	},
-	Blocks: map[string]ConverterSpec{},
-}
-
-var kubernetesPersistentVolumeV1_spec_persistentVolumeSource_nfs = ConverterSpec{
-	ResourceName: "kubernetes_persistent_volume_v1",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"path":      toString,
-		"read_only": toBool,
-		"server":    toString,
+	Schema: map[string]FieldSchema{
+		"dataset_name": {Optional: true, Description: "Name of the dataset stored as metadata -> name on the dataset for Flocker should be considered as deprecated"},
+		"dataset_uuid": {Optional: true, Description: "UUID of the dataset. This is unique identifier of a Flocker dataset"},
 	},
-	Blocks: map[string]ConverterSpec{},
 }
 
 var kubernetesPersistentVolumeV1_spec_persistentVolumeSource_gcePersistentDisk = ConverterSpec{
-	ResourceName: "kubernetes_persistent_volume_v1",
 	Attributes: map[string]func(any) (cty.Value, error){
 		"fs_type":   toString,
 		"partition": toInt,
 		"pd_name":   toString,
 		"read_only": toBool,
 	},
-	Blocks: map[string]ConverterSpec{},
-}
-
-var kubernetesPersistentVolumeV1_spec_persistentVolumeSource_awsElasticBlockStore = ConverterSpec{
-	ResourceName: "kubernetes_persistent_volume_v1",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"fs_type":   toString,
-		"partition": toInt,
-		"read_only": toBool,
-		"volume_id": toString,
+	Schema: map[string]FieldSchema{
+		"fs_type":   {Optional: true, Description: "Filesystem type of the volume that you want to mount. Tip: Ensure that the filesystem type is supported by the host operating system. Examples: \"ext4\", \"xfs\", \"ntfs\". Implicitly inferred to be \"ext4\" if unspecified. More info: https://kubernetes.io/docs/concepts/storage/volumes#gcepersistentdisk"},
+		"partition": {Optional: true, Description: "The partition in the volume that you want to mount. If omitted, the default is to mount by volume name. Examples: For volume /dev/sda1, you specify the partition as \"1\". Similarly, the volume partition for /dev/sda is \"0\" (or you can leave the property empty). More info: https://kubernetes.io/docs/concepts/storage/volumes#gcepersistentdisk"},
+		"pd_name":   {Required: true, Description: "Unique name of the PD resource in GCE. Used to identify the disk in GCE. More info: https://kubernetes.io/docs/concepts/storage/volumes#gcepersistentdisk"},
+		"read_only": {Optional: true, Description: "Whether to force the ReadOnly setting in VolumeMounts. Defaults to false. More info: https://kubernetes.io/docs/concepts/storage/volumes#gcepersistentdisk"},
 	},
-	Blocks: map[string]ConverterSpec{},
 }
 
-var kubernetesPersistentVolumeV1_spec_persistentVolumeSource_local = ConverterSpec{
-	ResourceName: "kubernetes_persistent_volume_v1",
+var kubernetesPersistentVolumeV1_spec_persistentVolumeSource_glusterfs = ConverterSpec{
 	Attributes: map[string]func(any) (cty.Value, error){
-		"path": toString,
+		"endpoints_name": toString,
+		"path":           toString,
+		"read_only":      toBool,
+	},
+	Schema: map[string]FieldSchema{
+		"endpoints_name": {Required: true, Description: "The endpoint name that details Glusterfs topology. More info: https://examples.k8s.io/volumes/glusterfs/README.md#create-a-pod"},
+		"path":           {Required: true, Description: "The Glusterfs volume path. More info: https://examples.k8s.io/volumes/glusterfs/README.md#create-a-pod"},
+		"read_only":      {Optional: true, Description: "Whether to force the Glusterfs volume to be mounted with read-only permissions. Defaults to false. More info: https://examples.k8s.io/volumes/glusterfs/README.md#create-a-pod"},
 	},
-	Blocks: map[string]ConverterSpec{},
 }
 
 var kubernetesPersistentVolumeV1_spec_persistentVolumeSource_hostPath = ConverterSpec{
-	ResourceName: "kubernetes_persistent_volume_v1",
 	Attributes: map[string]func(any) (cty.Value, error){
 		"path": toString,
 		"type": toString,
 	},
-	Blocks: map[string]ConverterSpec{},
+	Schema: map[string]FieldSchema{
+		"path": {Optional: true, Description: "Path of the directory on the host. More info: https://kubernetes.io/docs/concepts/storage/volumes#hostpath"},
+		"type": {Optional: true, Description: "Type for HostPath volume. Allowed values are \"\" (default), DirectoryOrCreate, Directory, FileOrCreate, File, Socket, CharDevice and BlockDevice"},
+	},
 }
 
-var kubernetesPersistentVolumeV1_spec_persistentVolumeSource_rbd = ConverterSpec{
-	ResourceName: "kubernetes_persistent_volume_v1",
+var kubernetesPersistentVolumeV1_spec_persistentVolumeSource_iscsi = ConverterSpec{
 	Attributes: map[string]func(any) (cty.Value, error){
-		"ceph_monitors": func(a any) (cty.Value, error) {
-			l, ok := a.([]any)
-			if !ok {
-				return cty.Value{}, fmt.Errorf("expected slice, got: %T", a)
-			}
-			vl := make([]cty.Value, 0, len(l))
-			for _, val := range l {
-				v, err := toString(val)
-				if err != nil {
-					return cty.Value{}, err
-				}
-				vl = append(vl, v)
-			}
-			return cty.ListVal(vl), nil
-		},
-		"fs_type":    toString,
-		"keyring":    toString,
-		"rados_user": toString,
-		"rbd_image":  toString,
-		"rbd_pool":   toString,
-		"read_only":  toBool,
+		"fs_type":         toString,
+		"iqn":             toString,
+		"iscsi_interface": toString,
+		"lun":             toInt,
+		"read_only":       toBool,
+		"target_portal":   toString,
 	},
-	Blocks: map[string]ConverterSpec{
-		"secret_ref": kubernetesPersistentVolumeV1_spec_persistentVolumeSource_rbd_secretRef,
+	Schema: map[string]FieldSchema{
+		"fs_type":         {Optional: true, Description: "Filesystem type of the volume that you want to mount. Tip: Ensure that the filesystem type is supported by the host operating system. Examples: \"ext4\", \"xfs\", \"ntfs\". Implicitly inferred to be \"ext4\" if unspecified. More info: https://kubernetes.io/docs/concepts/storage/volumes#iscsi"},
+		"iqn":             {Required: true, Description: "Target iSCSI Qualified Name."},
+		"iscsi_interface": {Optional: true, Description: "iSCSI interface name that uses an iSCSI transport. Defaults to 'default' (tcp).", Default: cty.StringVal("default")},
+		"lun":             {Optional: true, Description: "iSCSI target lun number."},
+		"read_only":       {Optional: true, Description: "Whether to force the read-only setting in VolumeMounts. Defaults to false."},
+		"target_portal":   {Required: true, Description: "iSCSI target portal. The portal is either an IP or ip_addr:port if the port is other than default (typically TCP ports 860 and 3260)."},
 	},
 }
 
-var kubernetesPersistentVolumeV1_spec_persistentVolumeSource_rbd_secretRef = ConverterSpec{
-	ResourceName: "kubernetes_persistent_volume_v1",
+var kubernetesPersistentVolumeV1_spec_persistentVolumeSource_local = ConverterSpec{
 	Attributes: map[string]func(any) (cty.Value, error){
-		"name":      toString,
-		"namespace": toString,
+		"path": toString,
+	},
+	Schema: map[string]FieldSchema{
+		"path": {Optional: true, Description: "Path of the directory on the host. More info: https://kubernetes.io/docs/concepts/storage/volumes#local"},
 	},
-	Blocks: map[string]ConverterSpec{},
 }
 
-var kubernetesPersistentVolumeV1_spec_persistentVolumeSource_fc = ConverterSpec{
-	ResourceName: "kubernetes_persistent_volume_v1",
+var kubernetesPersistentVolumeV1_spec_persistentVolumeSource_nfs = ConverterSpec{
 	Attributes: map[string]func(any) (cty.Value, error){
-		"fs_type":   toString,
-		"lun":       toInt,
+		"path":      toString,
 		"read_only": toBool,
-		"target_ww_ns": func(a any) (cty.Value, error) {
-			l, ok := a.([]any)
-			if !ok {
-				return cty.Value{}, fmt.Errorf("expected slice, got: %T", a)
-			}
-			vl := make([]cty.Value, 0, len(l))
-			for _, val := range l {
-				v, err := toString(val)
-				if err != nil {
-					return cty.Value{}, err
-				}
-				vl = append(vl, v)
-			}
-			return cty.ListVal(vl), nil
-		},
-	},
-	Blocks: map[string]ConverterSpec{},
+		"server":    toString,
+	},
+	Schema: map[string]FieldSchema{
+		"path":      {Required: true, Description: "Path that is exported by the NFS server. More info: https://kubernetes.io/docs/concepts/storage/volumes#nfs"},
+		"read_only": {Optional: true, Description: "Whether to force the NFS export to be mounted with read-only permissions. Defaults to false. More info: https://kubernetes.io/docs/concepts/storage/volumes#nfs"},
+		"server":    {Required: true, Description: "Server is the hostname or IP address of the NFS server. More info: https://kubernetes.io/docs/concepts/storage/volumes#nfs"},
+	},
 }
 
-var kubernetesPersistentVolumeV1_spec_persistentVolumeSource_cinder = ConverterSpec{
-	ResourceName: "kubernetes_persistent_volume_v1",
+var kubernetesPersistentVolumeV1_spec_persistentVolumeSource_photonPersistentDisk = ConverterSpec{
 	Attributes: map[string]func(any) (cty.Value, error){
-		"fs_type":   toString,
+		"fs_type": toString,
+		"pd_id":   toString,
+	},
+	Schema: map[string]FieldSchema{
+		"fs_type": {Optional: true, Description: "Filesystem type to mount. Must be a filesystem type supported by the host operating system. Ex. \"ext4\", \"xfs\", \"ntfs\". Implicitly inferred to be \"ext4\" if unspecified."},
+		"pd_id":   {Required: true, Description: "ID that identifies Photon Controller persistent disk"},
+	},
+}
+
+var kubernetesPersistentVolumeV1_spec_persistentVolumeSource_quobyte = ConverterSpec{
+	Attributes: map[string]func(any) (cty.Value, error){
+		"group":     toString,
 		"read_only": toBool,
-		"volume_id": toString,
+		"registry":  toString,
+		"user":      toString,
+		"volume":    toString,
+	},
+	Schema: map[string]FieldSchema{
+		"group":     {Optional: true, Description: "Group to map volume access to Default is no group"},
+		"read_only": {Optional: true, Description: "Whether to force the Quobyte volume to be mounted with read-only permissions. Defaults to false."},
+		"registry":  {Required: true, Description: "Registry represents a single or multiple Quobyte Registry services specified as a string as host:port pair (multiple entries are separated with commas) which acts as the central registry for volumes"},
+		"user":      {Optional: true, Description: "User to map volume access to Defaults to serivceaccount user"},
+		"volume":    {Required: true, Description: "Volume is a string that references an already created Quobyte volume by name."},
 	},
-	Blocks: map[string]ConverterSpec{},
 }
 
-var kubernetesPersistentVolumeV1_spec_persistentVolumeSource_azureFile = ConverterSpec{
-	ResourceName: "kubernetes_persistent_volume_v1",
+var kubernetesPersistentVolumeV1_spec_persistentVolumeSource_rbd = ConverterSpec{
 	Attributes: map[string]func(any) (cty.Value, error){
-		"read_only":        toBool,
-		"secret_name":      toString,
-		"secret_namespace": toString,
-		"share_name":       toString,
+		"ceph_monitors": toList(toString),
+		"fs_type":       toString,
+		"keyring":       toString,
+		"rados_user":    toString,
+		"rbd_image":     toString,
+		"rbd_pool":      toString,
+		"read_only":     toBool,
+	},
+	Blocks: map[string]ConverterSpec{
+		"secret_ref": kubernetesPersistentVolumeV1_spec_persistentVolumeSource_cephFs_secretRef,
+	},
+	Schema: map[string]FieldSchema{
+		"ceph_monitors": {Required: true, Description: "A collection of Ceph monitors. More info: https://examples.k8s.io/volumes/rbd/README.md#how-to-use-it"},
+		"fs_type":       {Optional: true, Description: "Filesystem type of the volume that you want to mount. Tip: Ensure that the filesystem type is supported by the host operating system. Examples: \"ext4\", \"xfs\", \"ntfs\". Implicitly inferred to be \"ext4\" if unspecified. More info: https://kubernetes.io/docs/concepts/storage/volumes#rbd"},
+		"keyring":       {Optional: true, Description: "Keyring is the path to key ring for RBDUser. Default is /etc/ceph/keyring. More info: https://examples.k8s.io/volumes/rbd/README.md#how-to-use-it"},
+		"rados_user":    {Optional: true, Description: "The rados user name. Default is admin. More info: https://examples.k8s.io/volumes/rbd/README.md#how-to-use-it", Default: cty.StringVal("admin")},
+		"rbd_image":     {Required: true, Description: "The rados image name. More info: https://examples.k8s.io/volumes/rbd/README.md#how-to-use-it"},
+		"rbd_pool":      {Optional: true, Description: "The rados pool name. Default is rbd. More info: https://examples.k8s.io/volumes/rbd/README.md#how-to-use-it.", Default: cty.StringVal("rbd")},
+		"read_only":     {Optional: true, Description: "Whether to force the read-only setting in VolumeMounts. Defaults to false. More info: https://examples.k8s.io/volumes/rbd/README.md#how-to-use-it", Default: cty.BoolVal(false)},
+		"secret_ref":    {Optional: true, MaxItems: 1, Description: "Name of the authentication secret for RBDUser. If provided overrides keyring. Default is nil. More info: https://examples.k8s.io/volumes/rbd/README.md#how-to-use-it"},
 	},
-	Blocks: map[string]ConverterSpec{},
 }
 
-var kubernetesPersistentVolumeV1_metadata = ConverterSpec{
-	ResourceName: "kubernetes_persistent_volume_v1",
+var kubernetesPersistentVolumeV1_spec_persistentVolumeSource_vsphereVolume = ConverterSpec{
 	Attributes: map[string]func(any) (cty.Value, error){
-		"annotations": toStringMap,
-		"labels":      toStringMap,
-		"name":        toString,
+		"fs_type":     toString,
+		"volume_path": toString,
+	},
+	Schema: map[string]FieldSchema{
+		"fs_type":     {Optional: true, Description: "Filesystem type to mount. Must be a filesystem type supported by the host operating system. Ex. \"ext4\", \"xfs\", \"ntfs\". Implicitly inferred to be \"ext4\" if unspecified."},
+		"volume_path": {Required: true, Description: "Path that identifies vSphere volume vmdk"},
 	},
-	Blocks: map[string]ConverterSpec{},
 }
@@ -5,15 +5,9 @@ package gen
 // DO NOT EDIT.
 
 import (
-	"fmt"
-
 	"github.com/zclconf/go-cty/cty"
 )
 
-// Make sure resources with no list attributes don't cause build errors because
-// of an unused import.
-var _ = fmt.Sprintf
-
 func init() {
 	register("kubernetes_pod_disruption_budget", kubernetesPodDisruptionBudget)
 }
@@ -22,64 +16,11 @@ var kubernetesPodDisruptionBudget = ConverterSpec{
 	ResourceName: "kubernetes_pod_disruption_budget",
 	Attributes:   map[string]func(any) (cty.Value, error){},
 	Blocks: map[string]ConverterSpec{
-		"metadata": kubernetesPodDisruptionBudget_metadata,
-		"spec":     kubernetesPodDisruptionBudget_spec,
-	},
-}
-
-var kubernetesPodDisruptionBudget_spec = ConverterSpec{
-	ResourceName: "kubernetes_pod_disruption_budget",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"max_unavailable": toString,
-		"min_available":   toString,
+		"metadata": kubernetesPodDisruptionBudgetV1_metadata,
+		"spec":     kubernetesPodDisruptionBudgetV1_spec,
 	},
-	Blocks: map[string]ConverterSpec{
-		"selector": kubernetesPodDisruptionBudget_spec_selector,
-	},
-}
-
-var kubernetesPodDisruptionBudget_spec_selector = ConverterSpec{
-	ResourceName: "kubernetes_pod_disruption_budget",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"match_labels": toStringMap,
-	},
-	Blocks: map[string]ConverterSpec{
-		"match_expressions": kubernetesPodDisruptionBudget_spec_selector_matchExpressions,
-	},
-}
-
-var kubernetesPodDisruptionBudget_spec_selector_matchExpressions = ConverterSpec{
-	ResourceName: "kubernetes_pod_disruption_budget",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"key":      toString,
-		"operator": toString,
-		"values": func(a any) (cty.Value, error) {
-			l, ok := a.([]any)
-			if !ok {
-				return cty.Value{}, fmt.Errorf("expected slice, got: %T", a)
-			}
-			vl := make([]cty.Value, 0, len(l))
-			for _, val := range l {
-				v, err := toString(val)
-				if err != nil {
-					return cty.Value{}, err
-				}
-				vl = append(vl, v)
-			}
-			return cty.ListVal(vl), nil
-		},
-	},
-	Blocks: map[string]ConverterSpec{},
-}
-
-var kubernetesPodDisruptionBudget_metadata = ConverterSpec{
-	ResourceName: "kubernetes_pod_disruption_budget",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"annotations":   toStringMap,
-		"generate_name": toString,
-		"labels":        toStringMap,
-		"name":          toString,
-		"namespace":     toString,
+	Schema: map[string]FieldSchema{
+		"metadata": {Required: true, MaxItems: 1, Description: "Standard pod disruption budget's metadata. More info: https://github.com/kubernetes/community/blob/master/contributors/devel/sig-architecture/api-conventions.md#metadata"},
+		"spec":     {Required: true, ForceNew: true, MaxItems: 1, Description: "Specification of the desired behavior of the PodDisruptionBudget."},
 	},
-	Blocks: map[string]ConverterSpec{},
 }
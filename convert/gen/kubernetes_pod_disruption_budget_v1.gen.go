@@ -5,15 +5,9 @@ package gen
 // DO NOT EDIT.
 
 import (
-	"fmt"
-
 	"github.com/zclconf/go-cty/cty"
 )
 
-// Make sure resources with no list attributes don't cause build errors because
-// of an unused import.
-var _ = fmt.Sprintf
-
 func init() {
 	register("kubernetes_pod_disruption_budget_v1", kubernetesPodDisruptionBudgetV1)
 }
@@ -25,61 +19,40 @@ var kubernetesPodDisruptionBudgetV1 = ConverterSpec{
 		"metadata": kubernetesPodDisruptionBudgetV1_metadata,
 		"spec":     kubernetesPodDisruptionBudgetV1_spec,
 	},
+	Schema: map[string]FieldSchema{
+		"metadata": {Required: true, MaxItems: 1, Description: "Standard pod disruption budget's metadata. More info: https://github.com/kubernetes/community/blob/master/contributors/devel/sig-architecture/api-conventions.md#metadata"},
+		"spec":     {Required: true, ForceNew: true, MaxItems: 1, Description: "Specification of the desired behavior of the PodDisruptionBudget."},
+	},
 }
 
-var kubernetesPodDisruptionBudgetV1_spec = ConverterSpec{
-	ResourceName: "kubernetes_pod_disruption_budget_v1",
+var kubernetesPodDisruptionBudgetV1_metadata = ConverterSpec{
 	Attributes: map[string]func(any) (cty.Value, error){
-		"max_unavailable": toString,
-		"min_available":   toString,
+		"annotations":   toStringMap,
+		"generate_name": toString,
+		"labels":        toStringMap,
+		"name":          toString,
+		"namespace":     toString,
 	},
-	Blocks: map[string]ConverterSpec{
-		"selector": kubernetesPodDisruptionBudgetV1_spec_selector,
+	Schema: map[string]FieldSchema{
+		"annotations":   {Optional: true, Description: "An unstructured key value map stored with the pod disruption budget that may be used to store arbitrary metadata. More info: https://kubernetes.io/docs/concepts/overview/working-with-objects/annotations/"},
+		"generate_name": {Optional: true, ForceNew: true, ConflictsWith: []string{"metadata.name"}, Description: "Prefix, used by the server, to generate a unique name ONLY IF the `name` field has not been provided. This value will also be combined with a unique suffix. More info: https://github.com/kubernetes/community/blob/master/contributors/devel/sig-architecture/api-conventions.md#idempotency"},
+		"labels":        {Optional: true, Description: "Map of string keys and values that can be used to organize and categorize (scope and select) the pod disruption budget. May match selectors of replication controllers and services. More info: https://kubernetes.io/docs/concepts/overview/working-with-objects/labels/"},
+		"name":          {Optional: true, ForceNew: true, ConflictsWith: []string{"metadata.generate_name"}, Description: "Name of the pod disruption budget, must be unique. Cannot be updated. More info: https://kubernetes.io/docs/concepts/overview/working-with-objects/names/#names"},
+		"namespace":     {Optional: true, ForceNew: true, Description: "Namespace defines the space within which name of the pod disruption budget must be unique.", Default: cty.StringVal("default")},
 	},
 }
 
-var kubernetesPodDisruptionBudgetV1_spec_selector = ConverterSpec{
-	ResourceName: "kubernetes_pod_disruption_budget_v1",
+var kubernetesPodDisruptionBudgetV1_spec = ConverterSpec{
 	Attributes: map[string]func(any) (cty.Value, error){
-		"match_labels": toStringMap,
+		"max_unavailable": toString,
+		"min_available":   toString,
 	},
 	Blocks: map[string]ConverterSpec{
-		"match_expressions": kubernetesPodDisruptionBudgetV1_spec_selector_matchExpressions,
-	},
-}
-
-var kubernetesPodDisruptionBudgetV1_spec_selector_matchExpressions = ConverterSpec{
-	ResourceName: "kubernetes_pod_disruption_budget_v1",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"key":      toString,
-		"operator": toString,
-		"values": func(a any) (cty.Value, error) {
-			l, ok := a.([]any)
-			if !ok {
-				return cty.Value{}, fmt.Errorf("expected slice, got: %T", a)
-			}
-			vl := make([]cty.Value, 0, len(l))
-			for _, val := range l {
-				v, err := toString(val)
-				if err != nil {
-					return cty.Value{}, err
-				}
-				vl = append(vl, v)
-			}
-			return cty.ListVal(vl), nil
-		},
+		"selector": kubernetesDeploymentV1_spec_selector,
 	},
-	Blocks: map[string]ConverterSpec{},
-}
-
-var kubernetesPodDisruptionBudgetV1_metadata = ConverterSpec{
-	ResourceName: "kubernetes_pod_disruption_budget_v1",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"annotations":   toStringMap,
-		"generate_name": toString,
-		"labels":        toStringMap,
-		"name":          toString,
-		"namespace":     toString,
+	Schema: map[string]FieldSchema{
+		"max_unavailable": {Optional: true, ForceNew: true},
+		"min_available":   {Optional: true, ForceNew: true},
+		"selector":        {Required: true, ForceNew: true, MaxItems: 1},
 	},
-	Blocks: map[string]ConverterSpec{},
 }
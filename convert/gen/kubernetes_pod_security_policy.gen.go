@@ -5,15 +5,9 @@ package gen
 // DO NOT EDIT.
 
 import (
-	"fmt"
-
 	"github.com/zclconf/go-cty/cty"
 )
 
-// Make sure resources with no list attributes don't cause build errors because
-// of an unused import.
-var _ = fmt.Sprintf
-
 func init() {
 	register("kubernetes_pod_security_policy", kubernetesPodSecurityPolicy)
 }
@@ -22,268 +16,12 @@ var kubernetesPodSecurityPolicy = ConverterSpec{
 	ResourceName: "kubernetes_pod_security_policy",
 	Attributes:   map[string]func(any) (cty.Value, error){},
 	Blocks: map[string]ConverterSpec{
-		"metadata": kubernetesPodSecurityPolicy_metadata,
-		"spec":     kubernetesPodSecurityPolicy_spec,
-	},
-}
-
-var kubernetesPodSecurityPolicy_spec = ConverterSpec{
-	ResourceName: "kubernetes_pod_security_policy",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"allow_privilege_escalation": toBool,
-		"allowed_capabilities": func(a any) (cty.Value, error) {
-			l, ok := a.([]any)
-			if !ok {
-				return cty.Value{}, fmt.Errorf("expected slice, got: %T", a)
-			}
-			vl := make([]cty.Value, 0, len(l))
-			for _, val := range l {
-				v, err := toString(val)
-				if err != nil {
-					return cty.Value{}, err
-				}
-				vl = append(vl, v)
-			}
-			return cty.ListVal(vl), nil
-		},
-		"allowed_proc_mount_types": func(a any) (cty.Value, error) {
-			l, ok := a.([]any)
-			if !ok {
-				return cty.Value{}, fmt.Errorf("expected slice, got: %T", a)
-			}
-			vl := make([]cty.Value, 0, len(l))
-			for _, val := range l {
-				v, err := toString(val)
-				if err != nil {
-					return cty.Value{}, err
-				}
-				vl = append(vl, v)
-			}
-			return cty.ListVal(vl), nil
-		},
-		"allowed_unsafe_sysctls": func(a any) (cty.Value, error) {
-			l, ok := a.([]any)
-			if !ok {
-				return cty.Value{}, fmt.Errorf("expected slice, got: %T", a)
-			}
-			vl := make([]cty.Value, 0, len(l))
-			for _, val := range l {
-				v, err := toString(val)
-				if err != nil {
-					return cty.Value{}, err
-				}
-				vl = append(vl, v)
-			}
-			return cty.ListVal(vl), nil
-		},
-		"default_add_capabilities": func(a any) (cty.Value, error) {
-			l, ok := a.([]any)
-			if !ok {
-				return cty.Value{}, fmt.Errorf("expected slice, got: %T", a)
-			}
-			vl := make([]cty.Value, 0, len(l))
-			for _, val := range l {
-				v, err := toString(val)
-				if err != nil {
-					return cty.Value{}, err
-				}
-				vl = append(vl, v)
-			}
-			return cty.ListVal(vl), nil
-		},
-		"default_allow_privilege_escalation": toBool,
-		"forbidden_sysctls": func(a any) (cty.Value, error) {
-			l, ok := a.([]any)
-			if !ok {
-				return cty.Value{}, fmt.Errorf("expected slice, got: %T", a)
-			}
-			vl := make([]cty.Value, 0, len(l))
-			for _, val := range l {
-				v, err := toString(val)
-				if err != nil {
-					return cty.Value{}, err
-				}
-				vl = append(vl, v)
-			}
-			return cty.ListVal(vl), nil
-		},
-		"host_ipc":                  toBool,
-		"host_network":              toBool,
-		"host_pid":                  toBool,
-		"privileged":                toBool,
-		"read_only_root_filesystem": toBool,
-		"required_drop_capabilities": func(a any) (cty.Value, error) {
-			l, ok := a.([]any)
-			if !ok {
-				return cty.Value{}, fmt.Errorf("expected slice, got: %T", a)
-			}
-			vl := make([]cty.Value, 0, len(l))
-			for _, val := range l {
-				v, err := toString(val)
-				if err != nil {
-					return cty.Value{}, err
-				}
-				vl = append(vl, v)
-			}
-			return cty.ListVal(vl), nil
-		},
-		"volumes": func(a any) (cty.Value, error) {
-			l, ok := a.([]any)
-			if !ok {
-				return cty.Value{}, fmt.Errorf("expected slice, got: %T", a)
-			}
-			vl := make([]cty.Value, 0, len(l))
-			for _, val := range l {
-				v, err := toString(val)
-				if err != nil {
-					return cty.Value{}, err
-				}
-				vl = append(vl, v)
-			}
-			return cty.ListVal(vl), nil
-		},
-	},
-	Blocks: map[string]ConverterSpec{
-		"allowed_flex_volumes": kubernetesPodSecurityPolicy_spec_allowedFlexVolumes,
-		"allowed_host_paths":   kubernetesPodSecurityPolicy_spec_allowedHostPaths,
-		"fs_group":             kubernetesPodSecurityPolicy_spec_fsGroup,
-		"host_ports":           kubernetesPodSecurityPolicy_spec_hostPorts,
-		"run_as_group":         kubernetesPodSecurityPolicy_spec_runAsGroup,
-		"run_as_user":          kubernetesPodSecurityPolicy_spec_runAsUser,
-		"se_linux":             kubernetesPodSecurityPolicy_spec_seLinux,
-		"supplemental_groups":  kubernetesPodSecurityPolicy_spec_supplementalGroups,
+		"metadata": kubernetesPodSecurityPolicyV1beta1_metadata,
+		"spec":     kubernetesPodSecurityPolicyV1beta1_spec,
 	},
-}
-
-var kubernetesPodSecurityPolicy_spec_supplementalGroups = ConverterSpec{
-	ResourceName: "kubernetes_pod_security_policy",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"rule": toString,
-	},
-	Blocks: map[string]ConverterSpec{
-		"range": kubernetesPodSecurityPolicy_spec_supplementalGroups_range,
-	},
-}
-
-var kubernetesPodSecurityPolicy_spec_supplementalGroups_range = ConverterSpec{
-	ResourceName: "kubernetes_pod_security_policy",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"max": toInt,
-		"min": toInt,
-	},
-	Blocks: map[string]ConverterSpec{},
-}
-
-var kubernetesPodSecurityPolicy_spec_runAsGroup = ConverterSpec{
-	ResourceName: "kubernetes_pod_security_policy",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"rule": toString,
-	},
-	Blocks: map[string]ConverterSpec{
-		"range": kubernetesPodSecurityPolicy_spec_runAsGroup_range,
-	},
-}
-
-var kubernetesPodSecurityPolicy_spec_runAsGroup_range = ConverterSpec{
-	ResourceName: "kubernetes_pod_security_policy",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"max": toInt,
-		"min": toInt,
-	},
-	Blocks: map[string]ConverterSpec{},
-}
-
-var kubernetesPodSecurityPolicy_spec_fsGroup = ConverterSpec{
-	ResourceName: "kubernetes_pod_security_policy",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"rule": toString,
-	},
-	Blocks: map[string]ConverterSpec{
-		"range": kubernetesPodSecurityPolicy_spec_fsGroup_range,
-	},
-}
-
-var kubernetesPodSecurityPolicy_spec_fsGroup_range = ConverterSpec{
-	ResourceName: "kubernetes_pod_security_policy",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"max": toInt,
-		"min": toInt,
-	},
-	Blocks: map[string]ConverterSpec{},
-}
-
-var kubernetesPodSecurityPolicy_spec_seLinux = ConverterSpec{
-	ResourceName: "kubernetes_pod_security_policy",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"rule": toString,
-	},
-	Blocks: map[string]ConverterSpec{
-		"se_linux_options": kubernetesPodSecurityPolicy_spec_seLinux_seLinuxOptions,
-	},
-}
-
-var kubernetesPodSecurityPolicy_spec_seLinux_seLinuxOptions = ConverterSpec{
-	ResourceName: "kubernetes_pod_security_policy",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"level": toString,
-		"role":  toString,
-		"type":  toString,
-		"user":  toString,
-	},
-	Blocks: map[string]ConverterSpec{},
-}
-
-var kubernetesPodSecurityPolicy_spec_hostPorts = ConverterSpec{
-	ResourceName: "kubernetes_pod_security_policy",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"max": toInt,
-		"min": toInt,
-	},
-	Blocks: map[string]ConverterSpec{},
-}
-
-var kubernetesPodSecurityPolicy_spec_allowedHostPaths = ConverterSpec{
-	ResourceName: "kubernetes_pod_security_policy",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"path_prefix": toString,
-		"read_only":   toBool,
-	},
-	Blocks: map[string]ConverterSpec{},
-}
-
-var kubernetesPodSecurityPolicy_spec_allowedFlexVolumes = ConverterSpec{
-	ResourceName: "kubernetes_pod_security_policy",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"driver": toString,
-	},
-	Blocks: map[string]ConverterSpec{},
-}
-
-var kubernetesPodSecurityPolicy_spec_runAsUser = ConverterSpec{
-	ResourceName: "kubernetes_pod_security_policy",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"rule": toString,
-	},
-	Blocks: map[string]ConverterSpec{
-		"range": kubernetesPodSecurityPolicy_spec_runAsUser_range,
-	},
-}
-
-var kubernetesPodSecurityPolicy_spec_runAsUser_range = ConverterSpec{
-	ResourceName: "kubernetes_pod_security_policy",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"max": toInt,
-		"min": toInt,
-	},
-	Blocks: map[string]ConverterSpec{},
-}
-
-var kubernetesPodSecurityPolicy_metadata = ConverterSpec{
-	ResourceName: "kubernetes_pod_security_policy",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"annotations": toStringMap,
-		"labels":      toStringMap,
-		"name":        toString,
+	Schema: map[string]FieldSchema{
+		"metadata": {Required: true, MaxItems: 1, Description: "Standard podsecuritypolicy's metadata. More info: https://github.com/kubernetes/community/blob/master/contributors/devel/sig-architecture/api-conventions.md#metadata"},
+		"spec":     {Required: true, MaxItems: 1, Description: "spec defines the policy enforced."},
 	},
-	Blocks: map[string]ConverterSpec{},
+	Deprecated: "\"PodSecurityPolicy\" was deprecated in Kubernetes v1.21.0; Starting from version 1.21.0 Kubernetes has deprecated PodSecurityPolicy and has been removed entirely in v1.25.0",
 }
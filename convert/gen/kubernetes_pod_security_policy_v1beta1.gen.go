@@ -5,15 +5,9 @@ package gen
 // DO NOT EDIT.
 
 import (
-	"fmt"
-
 	"github.com/zclconf/go-cty/cty"
 )
 
-// Make sure resources with no list attributes don't cause build errors because
-// of an unused import.
-var _ = fmt.Sprintf
-
 func init() {
 	register("kubernetes_pod_security_policy_v1beta1", kubernetesPodSecurityPolicyV1beta1)
 }
@@ -25,123 +19,42 @@ var kubernetesPodSecurityPolicyV1beta1 = ConverterSpec{
 		"metadata": kubernetesPodSecurityPolicyV1beta1_metadata,
 		"spec":     kubernetesPodSecurityPolicyV1beta1_spec,
 	},
+	Schema: map[string]FieldSchema{
+		"metadata": {Required: true, MaxItems: 1, Description: "Standard podsecuritypolicy's metadata. More info: https://github.com/kubernetes/community/blob/master/contributors/devel/sig-architecture/api-conventions.md#metadata"},
+		"spec":     {Required: true, MaxItems: 1, Description: "spec defines the policy enforced."},
+	},
+	Deprecated: "\"PodSecurityPolicy\" was deprecated in Kubernetes v1.21.0; Starting from version 1.21.0 Kubernetes has deprecated PodSecurityPolicy and has been removed entirely in v1.25.0",
+}
+
+var kubernetesPodSecurityPolicyV1beta1_metadata = ConverterSpec{
+	Attributes: map[string]func(any) (cty.Value, error){
+		"annotations": toStringMap,
+		"labels":      toStringMap,
+		"name":        toString,
+	},
+	Schema: map[string]FieldSchema{
+		"annotations": {Optional: true, Description: "An unstructured key value map stored with the podsecuritypolicy that may be used to store arbitrary metadata. More info: https://kubernetes.io/docs/concepts/overview/working-with-objects/annotations/"},
+		"labels":      {Optional: true, Description: "Map of string keys and values that can be used to organize and categorize (scope and select) the podsecuritypolicy. May match selectors of replication controllers and services. More info: https://kubernetes.io/docs/concepts/overview/working-with-objects/labels/"},
+		"name":        {Optional: true, ForceNew: true, Description: "Name of the podsecuritypolicy, must be unique. Cannot be updated. More info: https://kubernetes.io/docs/concepts/overview/working-with-objects/names/#names"},
+	},
 }
 
 var kubernetesPodSecurityPolicyV1beta1_spec = ConverterSpec{
-	ResourceName: "kubernetes_pod_security_policy_v1beta1",
 	Attributes: map[string]func(any) (cty.Value, error){
-		"allow_privilege_escalation": toBool,
-		"allowed_capabilities": func(a any) (cty.Value, error) {
-			l, ok := a.([]any)
-			if !ok {
-				return cty.Value{}, fmt.Errorf("expected slice, got: %T", a)
-			}
-			vl := make([]cty.Value, 0, len(l))
-			for _, val := range l {
-				v, err := toString(val)
-				if err != nil {
-					return cty.Value{}, err
-				}
-				vl = append(vl, v)
-			}
-			return cty.ListVal(vl), nil
-		},
-		"allowed_proc_mount_types": func(a any) (cty.Value, error) {
-			l, ok := a.([]any)
-			if !ok {
-				return cty.Value{}, fmt.Errorf("expected slice, got: %T", a)
-			}
-			vl := make([]cty.Value, 0, len(l))
-			for _, val := range l {
-				v, err := toString(val)
-				if err != nil {
-					return cty.Value{}, err
-				}
-				vl = append(vl, v)
-			}
-			return cty.ListVal(vl), nil
-		},
-		"allowed_unsafe_sysctls": func(a any) (cty.Value, error) {
-			l, ok := a.([]any)
-			if !ok {
-				return cty.Value{}, fmt.Errorf("expected slice, got: %T", a)
-			}
-			vl := make([]cty.Value, 0, len(l))
-			for _, val := range l {
-				v, err := toString(val)
-				if err != nil {
-					return cty.Value{}, err
-				}
-				vl = append(vl, v)
-			}
-			return cty.ListVal(vl), nil
-		},
-		"default_add_capabilities": func(a any) (cty.Value, error) {
-			l, ok := a.([]any)
-			if !ok {
-				return cty.Value{}, fmt.Errorf("expected slice, got: %T", a)
-			}
-			vl := make([]cty.Value, 0, len(l))
-			for _, val := range l {
-				v, err := toString(val)
-				if err != nil {
-					return cty.Value{}, err
-				}
-				vl = append(vl, v)
-			}
-			return cty.ListVal(vl), nil
-		},
+		"allow_privilege_escalation":         toBool,
+		"allowed_capabilities":               toList(toString),
+		"allowed_proc_mount_types":           toList(toString),
+		"allowed_unsafe_sysctls":             toList(toString),
+		"default_add_capabilities":           toList(toString),
 		"default_allow_privilege_escalation": toBool,
-		"forbidden_sysctls": func(a any) (cty.Value, error) {
-			l, ok := a.([]any)
-			if !ok {
-				return cty.Value{}, fmt.Errorf("expected slice, got: %T", a)
-			}
-			vl := make([]cty.Value, 0, len(l))
-			for _, val := range l {
-				v, err := toString(val)
-				if err != nil {
-					return cty.Value{}, err
-				}
-				vl = append(vl, v)
-			}
-			return cty.ListVal(vl), nil
-		},
-		"host_ipc":                  toBool,
-		"host_network":              toBool,
-		"host_pid":                  toBool,
-		"privileged":                toBool,
-		"read_only_root_filesystem": toBool,
-		"required_drop_capabilities": func(a any) (cty.Value, error) {
-			l, ok := a.([]any)
-			if !ok {
-				return cty.Value{}, fmt.Errorf("expected slice, got: %T", a)
-			}
-			vl := make([]cty.Value, 0, len(l))
-			for _, val := range l {
-				v, err := toString(val)
-				if err != nil {
-					return cty.Value{}, err
-				}
-				vl = append(vl, v)
-			}
-			return cty.ListVal(vl), nil
-		},
-		"volumes": func(a any) (cty.Value, error) {
-			l, ok := a.([]any)
-			if !ok {
-				return cty.Value{}, fmt.Errorf("expected slice, got: %T", a)
-			}
-			vl := make([]cty.Value, 0, len(l))
-			for _, val := range l {
-				v, err := toString(val)
-				if err != nil {
-					return cty.Value{}, err
-				}
-				vl = append(vl, v)
-			}
-			return cty.ListVal(vl), nil
-		},
+		"forbidden_sysctls":                  toList(toString),
+		"host_ipc":                           toBool,
+		"host_network":                       toBool,
+		"host_pid":                           toBool,
+		"privileged":                         toBool,
+		"read_only_root_filesystem":          toBool,
+		"required_drop_capabilities":         toList(toString),
+		"volumes":                            toList(toString),
 	},
 	Blocks: map[string]ConverterSpec{
 		"allowed_flex_volumes": kubernetesPodSecurityPolicyV1beta1_spec_allowedFlexVolumes,
@@ -153,137 +66,139 @@ var kubernetesPodSecurityPolicyV1beta1_spec = ConverterSpec{
 		"se_linux":             kubernetesPodSecurityPolicyV1beta1_spec_seLinux,
 		"supplemental_groups":  kubernetesPodSecurityPolicyV1beta1_spec_supplementalGroups,
 	},
+	Schema: map[string]FieldSchema{
+		"allow_privilege_escalation":         {Optional: true, Description: "allowPrivilegeEscalation determines if a pod can request to allow privilege escalation. If unspecified, defaults to true."},
+		"allowed_capabilities":               {Optional: true, Description: "allowedCapabilities is a list of capabilities that can be requested to add to the container. Capabilities in this field may be added at the pod author's discretion. You must not list a capability in both allowedCapabilities and requiredDropCapabilities."},
+		"allowed_flex_volumes":               {Optional: true, Description: "allowedFlexVolumes is an allowlist of Flexvolumes.  Empty or nil indicates that all Flexvolumes may be used.  This parameter is effective only when the usage of the Flexvolumes is allowed in the \"volumes\" field."},
+		"allowed_host_paths":                 {Optional: true, Description: "allowedHostPaths is an allowlist of host paths. Empty indicates that all host paths may be used."},
+		"allowed_proc_mount_types":           {Optional: true, Description: "AllowedProcMountTypes is an allowlist of allowed ProcMountTypes. Empty or nil indicates that only the DefaultProcMountType may be used. This requires the ProcMountType feature flag to be enabled."},
+		"allowed_unsafe_sysctls":             {Optional: true, Description: "allowedUnsafeSysctls is a list of explicitly allowed unsafe sysctls, defaults to none. Each entry is either a plain sysctl name or ends in \"*\" in which case it is considered as a prefix of allowed sysctls. Single * means all unsafe sysctls are allowed. Kubelet has to allowlist all allowed unsafe sysctls explicitly to avoid rejection.\n\nExamples: e.g. \"foo/*\" allows \"foo/bar\", \"foo/baz\", etc. e.g. \"foo.*\" allows \"foo.bar\", \"foo.baz\", etc."},
+		"default_add_capabilities":           {Optional: true, Description: "defaultAddCapabilities is the default set of capabilities that will be added to the container unless the pod spec specifically drops the capability.  You may not list a capability in both defaultAddCapabilities and requiredDropCapabilities. Capabilities added here are implicitly allowed, and need not be included in the allowedCapabilities list."},
+		"default_allow_privilege_escalation": {Optional: true, Description: "defaultAllowPrivilegeEscalation controls the default setting for whether a process can gain more privileges than its parent process."},
+		"forbidden_sysctls":                  {Optional: true, Description: "forbiddenSysctls is a list of explicitly forbidden sysctls, defaults to none. Each entry is either a plain sysctl name or ends in \"*\" in which case it is considered as a prefix of forbidden sysctls. Single * means all sysctls are forbidden.\n\nExamples: e.g. \"foo/*\" forbids \"foo/bar\", \"foo/baz\", etc. e.g. \"foo.*\" forbids \"foo.bar\", \"foo.baz\", etc."},
+		"fs_group":                           {Required: true, MaxItems: 1, Description: "fsGroup is the strategy that will dictate what fs group is used by the SecurityContext."},
+		"host_ipc":                           {Optional: true, Description: "hostIPC determines if the policy allows the use of HostIPC in the pod spec."},
+		"host_network":                       {Optional: true, Description: "hostNetwork determines if the policy allows the use of HostNetwork in the pod spec."},
+		"host_pid":                           {Optional: true, Description: "hostPID determines if the policy allows the use of HostPID in the pod spec."},
+		"host_ports":                         {Optional: true, Description: "hostPorts determines which host port ranges are allowed to be exposed."},
+		"privileged":                         {Optional: true, Description: "privileged determines if a pod can request to be run as privileged."},
+		"read_only_root_filesystem":          {Optional: true, Description: "readOnlyRootFilesystem when set to true will force containers to run with a read only root file system.  If the container specifically requests to run with a non-read only root file system the PSP should deny the pod. If set to false the container may run with a read only root file system if it wishes but it will not be forced to."},
+		"required_drop_capabilities":         {Optional: true, Description: "requiredDropCapabilities are the capabilities that will be dropped from the container.  These are required to be dropped and cannot be added."},
+		"run_as_group":                       {Optional: true, MaxItems: 1, Description: "RunAsGroup is the strategy that will dictate the allowable RunAsGroup values that may be set. If this field is omitted, the pod's RunAsGroup can take any value. This field requires the RunAsGroup feature gate to be enabled."},
+		"run_as_user":                        {Required: true, MaxItems: 1, Description: "runAsUser is the strategy that will dictate the allowable RunAsUser values that may be set."},
+		"se_linux":                           {Optional: true, MaxItems: 1, Description: "seLinux is the strategy that will dictate the allowable labels that may be set."},
+		"supplemental_groups":                {Required: true, MaxItems: 1, Description: "supplementalGroups is the strategy that will dictate what supplemental groups are used by the SecurityContext."},
+		"volumes":                            {Optional: true, Description: "volumes is an allowlist of volume plugins. Empty indicates that no volumes may be used. To allow all volumes you may use '*'."},
+	},
 }
 
-var kubernetesPodSecurityPolicyV1beta1_spec_seLinux = ConverterSpec{
-	ResourceName: "kubernetes_pod_security_policy_v1beta1",
+var kubernetesPodSecurityPolicyV1beta1_spec_allowedFlexVolumes = ConverterSpec{
 	Attributes: map[string]func(any) (cty.Value, error){
-		"rule": toString,
+		"driver": toString,
 	},
-	Blocks: map[string]ConverterSpec{
-		"se_linux_options": kubernetesPodSecurityPolicyV1beta1_spec_seLinux_seLinuxOptions,
+	Schema: map[string]FieldSchema{
+		"driver": {Required: true, Description: "driver is the name of the Flexvolume driver."},
 	},
 }
 
-var kubernetesPodSecurityPolicyV1beta1_spec_seLinux_seLinuxOptions = ConverterSpec{
-	ResourceName: "kubernetes_pod_security_policy_v1beta1",
+var kubernetesPodSecurityPolicyV1beta1_spec_allowedHostPaths = ConverterSpec{
 	Attributes: map[string]func(any) (cty.Value, error){
-		"level": toString,
-		"role":  toString,
-		"type":  toString,
-		"user":  toString,
+		"path_prefix": toString,
+		"read_only":   toBool,
+	},
+	Schema: map[string]FieldSchema{
+		"path_prefix": {Required: true, Description: "pathPrefix is the path prefix that the host volume must match. It does not support `*`. Trailing slashes are trimmed when validating the path prefix with a host path.\n\nExamples: `/foo` would allow `/foo`, `/foo/` and `/foo/bar` `/foo` would not allow `/food` or `/etc/foo`"},
+		"read_only":   {Optional: true, Description: "when set to true, will allow host volumes matching the pathPrefix only if all volume mounts are readOnly."},
 	},
-	Blocks: map[string]ConverterSpec{},
 }
 
-var kubernetesPodSecurityPolicyV1beta1_spec_supplementalGroups = ConverterSpec{
-	ResourceName: "kubernetes_pod_security_policy_v1beta1",
+var kubernetesPodSecurityPolicyV1beta1_spec_fsGroup = ConverterSpec{
 	Attributes: map[string]func(any) (cty.Value, error){
 		"rule": toString,
 	},
 	Blocks: map[string]ConverterSpec{
-		"range": kubernetesPodSecurityPolicyV1beta1_spec_supplementalGroups_range,
+		"range": kubernetesPodSecurityPolicyV1beta1_spec_hostPorts,
+	},
+	Schema: map[string]FieldSchema{
+		"range": {Optional: true, Description: "ranges are the allowed ranges of fs groups.  If you would like to force a single fs group then supply a single range with the same start and end. Required for MustRunAs."},
+		"rule":  {Required: true, Description: "rule is the strategy that will dictate what FSGroup is used in the SecurityContext."},
 	},
 }
 
-var kubernetesPodSecurityPolicyV1beta1_spec_supplementalGroups_range = ConverterSpec{
-	ResourceName: "kubernetes_pod_security_policy_v1beta1",
+var kubernetesPodSecurityPolicyV1beta1_spec_hostPorts = ConverterSpec{
 	Attributes: map[string]func(any) (cty.Value, error){
 		"max": toInt,
 		"min": toInt,
 	},
-	Blocks: map[string]ConverterSpec{},
+	Schema: map[string]FieldSchema{
+		"max": {Required: true, Description: "max is the end of the range, inclusive."},
+		"min": {Required: true, Description: "min is the start of the range, inclusive."},
+	},
 }
 
 var kubernetesPodSecurityPolicyV1beta1_spec_runAsGroup = ConverterSpec{
-	ResourceName: "kubernetes_pod_security_policy_v1beta1",
 	Attributes: map[string]func(any) (cty.Value, error){
 		"rule": toString,
 	},
 	Blocks: map[string]ConverterSpec{
-		"range": kubernetesPodSecurityPolicyV1beta1_spec_runAsGroup_range,
+		"range": kubernetesPodSecurityPolicyV1beta1_spec_hostPorts,
 	},
-}
-
-var kubernetesPodSecurityPolicyV1beta1_spec_runAsGroup_range = ConverterSpec{
-	ResourceName: "kubernetes_pod_security_policy_v1beta1",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"max": toInt,
-		"min": toInt,
+	Schema: map[string]FieldSchema{
+		"range": {Optional: true, Description: "ranges are the allowed ranges of gids that may be used. If you would like to force a single gid then supply a single range with the same start and end. Required for MustRunAs."},
+		"rule":  {Required: true, Description: "rule is the strategy that will dictate the allowable RunAsGroup values that may be set."},
 	},
-	Blocks: map[string]ConverterSpec{},
-}
-
-var kubernetesPodSecurityPolicyV1beta1_spec_allowedFlexVolumes = ConverterSpec{
-	ResourceName: "kubernetes_pod_security_policy_v1beta1",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"driver": toString,
-	},
-	Blocks: map[string]ConverterSpec{},
 }
 
 var kubernetesPodSecurityPolicyV1beta1_spec_runAsUser = ConverterSpec{
-	ResourceName: "kubernetes_pod_security_policy_v1beta1",
 	Attributes: map[string]func(any) (cty.Value, error){
 		"rule": toString,
 	},
 	Blocks: map[string]ConverterSpec{
-		"range": kubernetesPodSecurityPolicyV1beta1_spec_runAsUser_range,
+		"range": kubernetesPodSecurityPolicyV1beta1_spec_hostPorts,
 	},
-}
-
-var kubernetesPodSecurityPolicyV1beta1_spec_runAsUser_range = ConverterSpec{
-	ResourceName: "kubernetes_pod_security_policy_v1beta1",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"max": toInt,
-		"min": toInt,
+	Schema: map[string]FieldSchema{
+		"range": {Optional: true, Description: "ranges are the allowed ranges of uids that may be used. If you would like to force a single uid then supply a single range with the same start and end. Required for MustRunAs."},
+		"rule":  {Required: true, Description: "rule is the strategy that will dictate the allowable RunAsUser values that may be set."},
 	},
-	Blocks: map[string]ConverterSpec{},
 }
 
-var kubernetesPodSecurityPolicyV1beta1_spec_fsGroup = ConverterSpec{
-	ResourceName: "kubernetes_pod_security_policy_v1beta1",
+var kubernetesPodSecurityPolicyV1beta1_spec_seLinux = ConverterSpec{
 	Attributes: map[string]func(any) (cty.Value, error){
 		"rule": toString,
 	},
 	Blocks: map[string]ConverterSpec{
-		"range": kubernetesPodSecurityPolicyV1beta1_spec_fsGroup_range,
+		"se_linux_options": kubernetesPodSecurityPolicyV1beta1_spec_seLinux_seLinuxOptions,
 	},
-}
-
-var kubernetesPodSecurityPolicyV1beta1_spec_fsGroup_range = ConverterSpec{
-	ResourceName: "kubernetes_pod_security_policy_v1beta1",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"max": toInt,
-		"min": toInt,
+	Schema: map[string]FieldSchema{
+		"rule":             {Required: true, Description: "rule is the strategy that will dictate the allowable labels that may be set."},
+		"se_linux_options": {Optional: true, Description: "seLinuxOptions required to run as; required for MustRunAs More info: https://kubernetes.io/docs/tasks/configure-pod-container/security-context/"},
 	},
-	Blocks: map[string]ConverterSpec{},
 }
 
-var kubernetesPodSecurityPolicyV1beta1_spec_allowedHostPaths = ConverterSpec{
-	ResourceName: "kubernetes_pod_security_policy_v1beta1",
+var kubernetesPodSecurityPolicyV1beta1_spec_seLinux_seLinuxOptions = ConverterSpec{
 	Attributes: map[string]func(any) (cty.Value, error){
-		"path_prefix": toString,
-		"read_only":   toBool,
+		"level": toString,
+		"role":  toString,
+		"type":  toString,
+		"user":  toString,
 	},
-	Blocks: map[string]ConverterSpec{},
-}
-
-var kubernetesPodSecurityPolicyV1beta1_spec_hostPorts = ConverterSpec{
-	ResourceName: "kubernetes_pod_security_policy_v1beta1",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"max": toInt,
-		"min": toInt,
+	Schema: map[string]FieldSchema{
+		"level": {Required: true},
+		"role":  {Required: true},
+		"type":  {Required: true},
+		"user":  {Required: true},
 	},
-	Blocks: map[string]ConverterSpec{},
 }
 
-var kubernetesPodSecurityPolicyV1beta1_metadata = ConverterSpec{
-	ResourceName: "kubernetes_pod_security_policy_v1beta1",
+var kubernetesPodSecurityPolicyV1beta1_spec_supplementalGroups = ConverterSpec{
 	Attributes: map[string]func(any) (cty.Value, error){
-		"annotations": toStringMap,
-		"labels":      toStringMap,
-		"name":        toString,
+		"rule": toString,
+	},
+	Blocks: map[string]ConverterSpec{
+		"range": kubernetesPodSecurityPolicyV1beta1_spec_hostPorts,
+	},
+	Schema: map[string]FieldSchema{
+		"range": {Optional: true, Description: "ranges are the allowed ranges of supplemental groups.  If you would like to force a single supplemental group then supply a single range with the same start and end. Required for MustRunAs."},
+		"rule":  {Required: true, Description: "rule is the strategy that will dictate what supplemental groups is used in the SecurityContext."},
 	},
-	Blocks: map[string]ConverterSpec{},
 }
@@ -5,15 +5,9 @@ package gen
 // DO NOT EDIT.
 
 import (
-	"fmt"
-
 	"github.com/zclconf/go-cty/cty"
 )
 
-// Make sure resources with no list attributes don't cause build errors because
-// of an unused import.
-var _ = fmt.Sprintf
-
 func init() {
 	register("kubernetes_pod_v1", kubernetesPodV1)
 }
@@ -21,30 +15,37 @@ func init() {
 var kubernetesPodV1 = ConverterSpec{
 	ResourceName: "kubernetes_pod_v1",
 	Attributes: map[string]func(any) (cty.Value, error){
-		"target_state": func(a any) (cty.Value, error) {
-			l, ok := a.([]any)
-			if !ok {
-				return cty.Value{}, fmt.Errorf("expected slice, got: %T", a)
-			}
-			vl := make([]cty.Value, 0, len(l))
-			for _, val := range l {
-				v, err := toString(val)
-				if err != nil {
-					return cty.Value{}, err
-				}
-				vl = append(vl, v)
-			}
-			return cty.ListVal(vl), nil
-		},
+		"target_state": toList(toString),
 	},
 	Blocks: map[string]ConverterSpec{
 		"metadata": kubernetesPodV1_metadata,
 		"spec":     kubernetesPodV1_spec,
 	},
+	Schema: map[string]FieldSchema{
+		"metadata":     {Required: true, MaxItems: 1, Description: "Standard pod's metadata. More info: https://github.com/kubernetes/community/blob/master/contributors/devel/sig-architecture/api-conventions.md#metadata"},
+		"spec":         {Required: true, MaxItems: 1, Description: "Specification of the desired behavior of the pod."},
+		"target_state": {Optional: true, MinItems: 1, Description: "A list of the pod phases that indicate whether it was successfully created. Options: \"Pending\", \"Running\", \"Succeeded\", \"Failed\", \"Unknown\". Default: \"Running\". More info: https://kubernetes.io/docs/concepts/workloads/pods/pod-lifecycle/#pod-phase"},
+	},
+}
+
+var kubernetesPodV1_metadata = ConverterSpec{
+	Attributes: map[string]func(any) (cty.Value, error){
+		"annotations":   toStringMap,
+		"generate_name": toString,
+		"labels":        toStringMap,
+		"name":          toString,
+		"namespace":     toString,
+	},
+	Schema: map[string]FieldSchema{
+		"annotations":   {Optional: true, Description: "An unstructured key value map stored with the pod that may be used to store arbitrary metadata. More info: https://kubernetes.io/docs/concepts/overview/working-with-objects/annotations/"},
+		"generate_name": {Optional: true, ForceNew: true, ConflictsWith: []string{"metadata.name"}, Description: "Prefix, used by the server, to generate a unique name ONLY IF the `name` field has not been provided. This value will also be combined with a unique suffix. More info: https://github.com/kubernetes/community/blob/master/contributors/devel/sig-architecture/api-conventions.md#idempotency"},
+		"labels":        {Optional: true, Description: "Map of string keys and values that can be used to organize and categorize (scope and select) the pod. May match selectors of replication controllers and services. More info: https://kubernetes.io/docs/concepts/overview/working-with-objects/labels/"},
+		"name":          {Optional: true, ForceNew: true, ConflictsWith: []string{"metadata.generate_name"}, Description: "Name of the pod, must be unique. Cannot be updated. More info: https://kubernetes.io/docs/concepts/overview/working-with-objects/names/#names"},
+		"namespace":     {Optional: true, ForceNew: true, Description: "Namespace defines the space within which name of the pod must be unique.", Default: cty.StringVal("default")},
+	},
 }
 
 var kubernetesPodV1_spec = ConverterSpec{
-	ResourceName: "kubernetes_pod_v1",
 	Attributes: map[string]func(any) (cty.Value, error){
 		"active_deadline_seconds":          toInt,
 		"automount_service_account_token":  toBool,
@@ -71,7 +72,7 @@ var kubernetesPodV1_spec = ConverterSpec{
 		"dns_config":                 kubernetesPodV1_spec_dnsConfig,
 		"host_aliases":               kubernetesPodV1_spec_hostAliases,
 		"image_pull_secrets":         kubernetesPodV1_spec_imagePullSecrets,
-		"init_container":             kubernetesPodV1_spec_initContainer,
+		"init_container":             kubernetesPodV1_spec_container,
 		"os":                         kubernetesPodV1_spec_os,
 		"readiness_gate":             kubernetesPodV1_spec_readinessGate,
 		"security_context":           kubernetesPodV1_spec_securityContext,
@@ -79,366 +80,354 @@ var kubernetesPodV1_spec = ConverterSpec{
 		"topology_spread_constraint": kubernetesPodV1_spec_topologySpreadConstraint,
 		"volume":                     kubernetesPodV1_spec_volume,
 	},
+	Schema: map[string]FieldSchema{
+		"active_deadline_seconds":          {Optional: true, Description: "Optional duration in seconds the pod may be active on the node relative to StartTime before the system will actively try to mark it failed and kill associated containers. Value must be a positive integer."},
+		"affinity":                         {Optional: true, ForceNew: true, MaxItems: 1, Description: "Optional pod scheduling constraints."},
+		"automount_service_account_token":  {Optional: true, ForceNew: true, Description: "AutomountServiceAccountToken indicates whether a service account token should be automatically mounted.", Default: cty.BoolVal(true)},
+		"container":                        {Optional: true, Description: "List of containers belonging to the pod. Containers cannot currently be added or removed. There must be at least one container in a Pod. Cannot be updated. More info: https://kubernetes.io/docs/concepts/containers/"},
+		"dns_config":                       {Optional: true, MaxItems: 1, Description: "Specifies the DNS parameters of a pod. Parameters specified here will be merged to the generated DNS configuration based on DNSPolicy. Optional: Defaults to empty"},
+		"dns_policy":                       {Optional: true, ForceNew: true, Description: "Set DNS policy for containers within the pod. Valid values are 'ClusterFirstWithHostNet', 'ClusterFirst', 'Default' or 'None'. DNS parameters given in DNSConfig will be merged with the policy selected with DNSPolicy. To have DNS options set along with hostNetwork, you have to specify DNS policy explicitly to 'ClusterFirstWithHostNet'. Defaults to 'ClusterFirst'. More info: https://kubernetes.io/docs/concepts/services-networking/dns-pod-service/#pod-s-dns-policy", Default: cty.StringVal("ClusterFirst")},
+		"enable_service_links":             {Optional: true, ForceNew: true, Description: "Enables generating environment variables for service discovery. Defaults to true.", Default: cty.BoolVal(true)},
+		"host_aliases":                     {Optional: true, ForceNew: true, Description: "List of hosts and IPs that will be injected into the pod's hosts file if specified. Optional: Defaults to empty."},
+		"host_ipc":                         {Optional: true, ForceNew: true, Description: "Use the host's ipc namespace. Optional: Defaults to false.", Default: cty.BoolVal(false)},
+		"host_network":                     {Optional: true, ForceNew: true, Description: "Host networking requested for this pod. Use the host's network namespace. If this option is set, the ports that will be used must be specified.", Default: cty.BoolVal(false)},
+		"host_pid":                         {Optional: true, ForceNew: true, Description: "Use the host's pid namespace.", Default: cty.BoolVal(false)},
+		"hostname":                         {Optional: true, ForceNew: true, Description: "Specifies the hostname of the Pod If not specified, the pod's hostname will be set to a system-defined value."},
+		"image_pull_secrets":               {Optional: true, Description: "ImagePullSecrets is an optional list of references to secrets in the same namespace to use for pulling any of the images used by this PodSpec. If specified, these secrets will be passed to individual puller implementations for them to use. For example, in the case of docker, only DockerConfig type secrets are honored. More info: https://kubernetes.io/docs/concepts/containers/images/#specifying-imagepullsecrets-on-a-pod"},
+		"init_container":                   {Optional: true, ForceNew: true, Description: "List of init containers belonging to the pod. Init containers always run to completion and each must complete successfully before the next is started. More info: https://kubernetes.io/docs/concepts/workloads/pods/init-containers/"},
+		"node_name":                        {Optional: true, ForceNew: true, Description: "NodeName is a request to schedule this pod onto a specific node. If it is non-empty, the scheduler simply schedules this pod onto that node, assuming that it fits resource requirements."},
+		"node_selector":                    {Optional: true, ForceNew: true, Description: "NodeSelector is a selector which must be true for the pod to fit on a node. Selector which must match a node's labels for the pod to be scheduled on that node. More info: https://kubernetes.io/docs/concepts/configuration/assign-pod-node/."},
+		"os":                               {Optional: true, MaxItems: 1, Description: "Specifies the OS of the containers in the pod."},
+		"priority_class_name":              {Optional: true, ForceNew: true, Description: "If specified, indicates the pod's priority. \"system-node-critical\" and \"system-cluster-critical\" are two special keywords which indicate the highest priorities with the former being the highest priority. Any other name must be defined by creating a PriorityClass object with that name. If not specified, the pod priority will be default or zero if there is no default."},
+		"readiness_gate":                   {Optional: true, Description: "If specified, all readiness gates will be evaluated for pod readiness. A pod is ready when all its containers are ready AND all conditions specified in the readiness gates have status equal to \"True\" More info: https://git.k8s.io/enhancements/keps/sig-network/0007-pod-ready%2B%2B.md"},
+		"restart_policy":                   {Optional: true, ForceNew: true, Description: "Restart policy for all containers within the pod. One of Always, OnFailure, Never. More info: https://kubernetes.io/docs/concepts/workloads/pods/pod-lifecycle/#restart-policy.", Default: cty.StringVal("Always")},
+		"runtime_class_name":               {Optional: true, ForceNew: true, Description: "RuntimeClassName is a feature for selecting the container runtime configuration. The container runtime configuration is used to run a Pod's containers. More info: https://kubernetes.io/docs/concepts/containers/runtime-class"},
+		"scheduler_name":                   {Optional: true, ForceNew: true, Description: "If specified, the pod will be dispatched by specified scheduler. If not specified, the pod will be dispatched by default scheduler."},
+		"security_context":                 {Optional: true, MaxItems: 1, Description: "SecurityContext holds pod-level security attributes and common container settings. Optional: Defaults to empty"},
+		"service_account_name":             {Optional: true, ForceNew: true, Description: "ServiceAccountName is the name of the ServiceAccount to use to run this pod. More info: http://releases.k8s.io/HEAD/docs/design/service_accounts.md."},
+		"share_process_namespace":          {Optional: true, ForceNew: true, Description: "Share a single process namespace between all of the containers in a pod. When this is set containers will be able to view and signal processes from other containers in the same pod, and the first process in each container will not be assigned PID 1. HostPID and ShareProcessNamespace cannot both be set. Optional: Defaults to false.", Default: cty.BoolVal(false)},
+		"subdomain":                        {Optional: true, ForceNew: true, Description: "If specified, the fully qualified Pod hostname will be \"...svc.\". If not specified, the pod will not have a domainname at all.."},
+		"termination_grace_period_seconds": {Optional: true, ForceNew: true, Description: "Optional duration in seconds the pod needs to terminate gracefully. May be decreased in delete request. Value must be non-negative integer. The value zero indicates delete immediately. If this value is nil, the default grace period will be used instead. The grace period is the duration in seconds after the processes running in the pod are sent a termination signal and the time when the processes are forcibly halted with a kill signal. Set this value longer than the expected cleanup time for your process.", Default: cty.NumberIntVal(30)},
+		"toleration":                       {Optional: true, Description: "If specified, the pod's toleration. Optional: Defaults to empty"},
+		"topology_spread_constraint":       {Optional: true, Description: "describes how a group of pods ought to spread across topology domains. Scheduler will schedule pods in a way which abides by the constraints."},
+		"volume":                           {Optional: true, Description: "List of volumes that can be mounted by containers belonging to the pod. More info: https://kubernetes.io/docs/concepts/storage/volumes"},
+	},
 }
 
-var kubernetesPodV1_spec_initContainer = ConverterSpec{
-	ResourceName: "kubernetes_pod_v1",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"args": func(a any) (cty.Value, error) {
-			l, ok := a.([]any)
-			if !ok {
-				return cty.Value{}, fmt.Errorf("expected slice, got: %T", a)
-			}
-			vl := make([]cty.Value, 0, len(l))
-			for _, val := range l {
-				v, err := toString(val)
-				if err != nil {
-					return cty.Value{}, err
-				}
-				vl = append(vl, v)
-			}
-			return cty.ListVal(vl), nil
-		},
-		"command": func(a any) (cty.Value, error) {
-			l, ok := a.([]any)
-			if !ok {
-				return cty.Value{}, fmt.Errorf("expected slice, got: %T", a)
-			}
-			vl := make([]cty.Value, 0, len(l))
-			for _, val := range l {
-				v, err := toString(val)
-				if err != nil {
-					return cty.Value{}, err
-				}
-				vl = append(vl, v)
-			}
-			return cty.ListVal(vl), nil
-		},
-		"image":                      toString,
-		"image_pull_policy":          toString,
-		"name":                       toString,
-		"stdin":                      toBool,
-		"stdin_once":                 toBool,
-		"termination_message_path":   toString,
-		"termination_message_policy": toString,
-		"tty":                        toBool,
-		"working_dir":                toString,
-	},
+var kubernetesPodV1_spec_affinity = ConverterSpec{
+	Attributes: map[string]func(any) (cty.Value, error){},
 	Blocks: map[string]ConverterSpec{
-		"env":              kubernetesPodV1_spec_initContainer_env,
-		"env_from":         kubernetesPodV1_spec_initContainer_envFrom,
-		"lifecycle":        kubernetesPodV1_spec_initContainer_lifecycle,
-		"liveness_probe":   kubernetesPodV1_spec_initContainer_livenessProbe,
-		"port":             kubernetesPodV1_spec_initContainer_port,
-		"readiness_probe":  kubernetesPodV1_spec_initContainer_readinessProbe,
-		"resources":        kubernetesPodV1_spec_initContainer_resources,
-		"security_context": kubernetesPodV1_spec_initContainer_securityContext,
-		"startup_probe":    kubernetesPodV1_spec_initContainer_startupProbe,
-		"volume_device":    kubernetesPodV1_spec_initContainer_volumeDevice,
-		"volume_mount":     kubernetesPodV1_spec_initContainer_volumeMount,
+		"node_affinity":     kubernetesPodV1_spec_affinity_nodeAffinity,
+		"pod_affinity":      kubernetesPodV1_spec_affinity_podAffinity,
+		"pod_anti_affinity": kubernetesPodV1_spec_affinity_podAffinity,
+	},
+	Schema: map[string]FieldSchema{
+		"node_affinity":     {Optional: true, MaxItems: 1, Description: "Node affinity scheduling rules for the pod."},
+		"pod_affinity":      {Optional: true, MaxItems: 1, Description: "Inter-pod topological affinity. rules that specify that certain pods should be placed in the same topological domain (e.g. same node, same rack, same zone, same power domain, etc.)"},
+		"pod_anti_affinity": {Optional: true, MaxItems: 1, Description: "Inter-pod topological affinity. rules that specify that certain pods should be placed in the same topological domain (e.g. same node, same rack, same zone, same power domain, etc.)"},
 	},
 }
 
-var kubernetesPodV1_spec_initContainer_volumeMount = ConverterSpec{
-	ResourceName: "kubernetes_pod_v1",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"mount_path":        toString,
-		"mount_propagation": toString,
-		"name":              toString,
-		"read_only":         toBool,
-		"sub_path":          toString,
-		"sub_path_expr":     toString,
+var kubernetesPodV1_spec_affinity_nodeAffinity = ConverterSpec{
+	Attributes: map[string]func(any) (cty.Value, error){},
+	Blocks: map[string]ConverterSpec{
+		"preferred_during_scheduling_ignored_during_execution": kubernetesPodV1_spec_affinity_nodeAffinity_preferredDuringSchedulingIgnoredDuringExecution,
+		"required_during_scheduling_ignored_during_execution":  kubernetesPodV1_spec_affinity_nodeAffinity_requiredDuringSchedulingIgnoredDuringExecution,
+	},
+	Schema: map[string]FieldSchema{
+		"preferred_during_scheduling_ignored_during_execution": {Optional: true, Description: "The scheduler will prefer to schedule pods to nodes that satisfy the affinity expressions specified by this field, but it may choose a node that violates one or more of the expressions. The node that is most preferred is the one with the greatest sum of weights, i.e. for each node that meets all of the scheduling requirements (resource request, RequiredDuringScheduling affinity expressions, etc.), compute a sum by iterating through the elements of this field and adding 'weight' to the sum if the node matches the corresponding MatchExpressions; the node(s) with the highest sum are the most preferred."},
+		"required_during_scheduling_ignored_during_execution":  {Optional: true, MaxItems: 1, Description: "If the affinity requirements specified by this field are not met at scheduling time, the pod will not be scheduled onto the node. If the affinity requirements specified by this field cease to be met at some point during pod execution (e.g. due to a node label update), the system may or may not try to eventually evict the pod from its node."},
 	},
-	Blocks: map[string]ConverterSpec{},
 }
 
-var kubernetesPodV1_spec_initContainer_securityContext = ConverterSpec{
-	ResourceName: "kubernetes_pod_v1",
+var kubernetesPodV1_spec_affinity_nodeAffinity_preferredDuringSchedulingIgnoredDuringExecution = ConverterSpec{
 	Attributes: map[string]func(any) (cty.Value, error){
-		"allow_privilege_escalation": toBool,
-		"privileged":                 toBool,
-		"read_only_root_filesystem":  toBool,
-		"run_as_group":               toString,
-		"run_as_non_root":            toBool,
-		"run_as_user":                toString,
+		"weight": toInt,
 	},
 	Blocks: map[string]ConverterSpec{
-		"capabilities":     kubernetesPodV1_spec_initContainer_securityContext_capabilities,
-		"se_linux_options": kubernetesPodV1_spec_initContainer_securityContext_seLinuxOptions,
-		"seccomp_profile":  kubernetesPodV1_spec_initContainer_securityContext_seccompProfile,
+		"preference": kubernetesPodV1_spec_affinity_nodeAffinity_preferredDuringSchedulingIgnoredDuringExecution_preference,
+	},
+	Schema: map[string]FieldSchema{
+		"preference": {Required: true, MaxItems: 1, Description: "A node selector term, associated with the corresponding weight."},
+		"weight":     {Required: true, Description: "weight is in the range 1-100"},
 	},
 }
 
-var kubernetesPodV1_spec_initContainer_securityContext_seccompProfile = ConverterSpec{
-	ResourceName: "kubernetes_pod_v1",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"localhost_profile": toString,
-		"type":              toString,
+var kubernetesPodV1_spec_affinity_nodeAffinity_preferredDuringSchedulingIgnoredDuringExecution_preference = ConverterSpec{
+	Attributes: map[string]func(any) (cty.Value, error){},
+	Blocks: map[string]ConverterSpec{
+		"match_expressions": kubernetesPodV1_spec_affinity_nodeAffinity_preferredDuringSchedulingIgnoredDuringExecution_preference_matchExpressions,
+		"match_fields":      kubernetesPersistentVolumeV1_spec_nodeAffinity_required_nodeSelectorTerm_matchExpressions,
+	},
+	Schema: map[string]FieldSchema{
+		"match_expressions": {Optional: true, Description: "List of node selector requirements. The requirements are ANDed."},
+		"match_fields":      {Optional: true, ForceNew: true, Description: "A list of node selector requirements by node's fields. The requirements are ANDed."},
 	},
-	Blocks: map[string]ConverterSpec{},
 }
 
-var kubernetesPodV1_spec_initContainer_securityContext_capabilities = ConverterSpec{
-	ResourceName: "kubernetes_pod_v1",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"add": func(a any) (cty.Value, error) {
-			l, ok := a.([]any)
-			if !ok {
-				return cty.Value{}, fmt.Errorf("expected slice, got: %T", a)
-			}
-			vl := make([]cty.Value, 0, len(l))
-			for _, val := range l {
-				v, err := toString(val)
-				if err != nil {
-					return cty.Value{}, err
-				}
-				vl = append(vl, v)
-			}
-			return cty.ListVal(vl), nil
-		},
-		"drop": func(a any) (cty.Value, error) {
-			l, ok := a.([]any)
-			if !ok {
-				return cty.Value{}, fmt.Errorf("expected slice, got: %T", a)
-			}
-			vl := make([]cty.Value, 0, len(l))
-			for _, val := range l {
-				v, err := toString(val)
-				if err != nil {
-					return cty.Value{}, err
-				}
-				vl = append(vl, v)
-			}
-			return cty.ListVal(vl), nil
-		},
-	},
-	Blocks: map[string]ConverterSpec{},
-}
-
-var kubernetesPodV1_spec_initContainer_securityContext_seLinuxOptions = ConverterSpec{
-	ResourceName: "kubernetes_pod_v1",
+var kubernetesPodV1_spec_affinity_nodeAffinity_preferredDuringSchedulingIgnoredDuringExecution_preference_matchExpressions = ConverterSpec{
 	Attributes: map[string]func(any) (cty.Value, error){
-		"level": toString,
-		"role":  toString,
-		"type":  toString,
-		"user":  toString,
+		"key":      toString,
+		"operator": toString,
+		"values":   toList(toString),
+	},
+	Schema: map[string]FieldSchema{
+		"key":      {Optional: true, Description: "The label key that the selector applies to."},
+		"operator": {Optional: true, Description: "Operator represents a key's relationship to a set of values. Valid operators are In, NotIn, Exists, DoesNotExist. Gt, and Lt."},
+		"values":   {Optional: true, Description: "Values is an array of string values. If the operator is In or NotIn, the values array must be non-empty. If the operator is Exists or DoesNotExist, the values array must be empty. If the operator is Gt or Lt, the values array must have a single element, which will be interpreted as an integer. This array is replaced during a strategic merge patch."},
 	},
-	Blocks: map[string]ConverterSpec{},
 }
 
-var kubernetesPodV1_spec_initContainer_lifecycle = ConverterSpec{
-	ResourceName: "kubernetes_pod_v1",
-	Attributes:   map[string]func(any) (cty.Value, error){},
+var kubernetesPodV1_spec_affinity_nodeAffinity_requiredDuringSchedulingIgnoredDuringExecution = ConverterSpec{
+	Attributes: map[string]func(any) (cty.Value, error){},
 	Blocks: map[string]ConverterSpec{
-		"post_start": kubernetesPodV1_spec_initContainer_lifecycle_postStart,
-		"pre_stop":   kubernetesPodV1_spec_initContainer_lifecycle_preStop,
+		"node_selector_term": kubernetesPodV1_spec_affinity_nodeAffinity_preferredDuringSchedulingIgnoredDuringExecution_preference,
+	},
+	Schema: map[string]FieldSchema{
+		"node_selector_term": {Optional: true, Description: "List of node selector terms. The terms are ORed."},
 	},
 }
 
-var kubernetesPodV1_spec_initContainer_lifecycle_preStop = ConverterSpec{
-	ResourceName: "kubernetes_pod_v1",
-	Attributes:   map[string]func(any) (cty.Value, error){},
+var kubernetesPodV1_spec_affinity_podAffinity = ConverterSpec{
+	Attributes: map[string]func(any) (cty.Value, error){},
 	Blocks: map[string]ConverterSpec{
-		"exec":       kubernetesPodV1_spec_initContainer_lifecycle_preStop_exec,
-		"http_get":   kubernetesPodV1_spec_initContainer_lifecycle_preStop_httpGet,
-		"tcp_socket": kubernetesPodV1_spec_initContainer_lifecycle_preStop_tcpSocket,
+		"preferred_during_scheduling_ignored_during_execution": kubernetesPodV1_spec_affinity_podAffinity_preferredDuringSchedulingIgnoredDuringExecution,
+		"required_during_scheduling_ignored_during_execution":  kubernetesPodV1_spec_affinity_podAffinity_requiredDuringSchedulingIgnoredDuringExecution,
+	},
+	Schema: map[string]FieldSchema{
+		"preferred_during_scheduling_ignored_during_execution": {Optional: true, Description: "The scheduler will prefer to schedule pods to nodes that satisfy the anti-affinity expressions specified by this field, but it may choose a node that violates one or more of the expressions. The node that is most preferred is the one with the greatest sum of weights, i.e. for each node that meets all of the scheduling requirements (resource request, RequiredDuringScheduling anti-affinity expressions, etc.), compute a sum by iterating through the elements of this field and adding 'weight' to the sum if the node matches the corresponding MatchExpressions; the node(s) with the highest sum are the most preferred."},
+		"required_during_scheduling_ignored_during_execution":  {Optional: true, Description: "If the affinity requirements specified by this field are not met at scheduling time, the pod will not be scheduled onto the node. If the affinity requirements specified by this field cease to be met at some point during pod execution (e.g. due to a pod label update), the system may or may not try to eventually evict the pod from its node. When there are multiple elements, the lists of nodes corresponding to each PodAffinityTerm are intersected, i.e. all terms must be satisfied."},
 	},
 }
 
-var kubernetesPodV1_spec_initContainer_lifecycle_preStop_tcpSocket = ConverterSpec{
-	ResourceName: "kubernetes_pod_v1",
+var kubernetesPodV1_spec_affinity_podAffinity_preferredDuringSchedulingIgnoredDuringExecution = ConverterSpec{
 	Attributes: map[string]func(any) (cty.Value, error){
-		"port": toString,
+		"weight": toInt,
+	},
+	Blocks: map[string]ConverterSpec{
+		"pod_affinity_term": kubernetesPodV1_spec_affinity_podAffinity_requiredDuringSchedulingIgnoredDuringExecution,
+	},
+	Schema: map[string]FieldSchema{
+		"pod_affinity_term": {Required: true, MaxItems: 1, Description: "A pod affinity term, associated with the corresponding weight"},
+		"weight":            {Required: true, Description: "weight associated with matching the corresponding podAffinityTerm, in the range 1-100"},
 	},
-	Blocks: map[string]ConverterSpec{},
 }
 
-var kubernetesPodV1_spec_initContainer_lifecycle_preStop_httpGet = ConverterSpec{
-	ResourceName: "kubernetes_pod_v1",
+var kubernetesPodV1_spec_affinity_podAffinity_requiredDuringSchedulingIgnoredDuringExecution = ConverterSpec{
 	Attributes: map[string]func(any) (cty.Value, error){
-		"host":   toString,
-		"path":   toString,
-		"port":   toString,
-		"scheme": toString,
+		"namespaces":   toList(toString),
+		"topology_key": toString,
 	},
 	Blocks: map[string]ConverterSpec{
-		"http_header": kubernetesPodV1_spec_initContainer_lifecycle_preStop_httpGet_httpHeader,
+		"label_selector":     kubernetesClusterRoleV1_aggregationRule_clusterRoleSelectors,
+		"namespace_selector": kubernetesClusterRoleV1_aggregationRule_clusterRoleSelectors,
+	},
+	Schema: map[string]FieldSchema{
+		"label_selector":     {Optional: true, Description: "A label query over a set of resources, in this case pods."},
+		"namespace_selector": {Optional: true, Description: "A label query over a set of namespaces that matches the namespaceSelector in Kubernetes."},
+		"namespaces":         {Optional: true, Description: "namespaces specifies which namespaces the labelSelector applies to (matches against); null or empty list means 'this pod's namespace'"},
+		"topology_key":       {Required: true, Description: "empty topology key is interpreted by the scheduler as 'all topologies'"},
 	},
 }
 
-var kubernetesPodV1_spec_initContainer_lifecycle_preStop_httpGet_httpHeader = ConverterSpec{
-	ResourceName: "kubernetes_pod_v1",
+var kubernetesPodV1_spec_container = ConverterSpec{
 	Attributes: map[string]func(any) (cty.Value, error){
-		"name":  toString,
-		"value": toString,
+		"args":                       toList(toString),
+		"command":                    toList(toString),
+		"image":                      toString,
+		"image_pull_policy":          toString,
+		"name":                       toString,
+		"stdin":                      toBool,
+		"stdin_once":                 toBool,
+		"termination_message_path":   toString,
+		"termination_message_policy": toString,
+		"tty":                        toBool,
+		"working_dir":                toString,
+	},
+	Blocks: map[string]ConverterSpec{
+		"env":              kubernetesPodV1_spec_container_env,
+		"env_from":         kubernetesPodV1_spec_container_envFrom,
+		"lifecycle":        kubernetesPodV1_spec_container_lifecycle,
+		"liveness_probe":   kubernetesPodV1_spec_container_livenessProbe,
+		"port":             kubernetesPodV1_spec_container_port,
+		"readiness_probe":  kubernetesPodV1_spec_container_livenessProbe,
+		"resources":        kubernetesPodV1_spec_container_resources,
+		"security_context": kubernetesPodV1_spec_container_securityContext,
+		"startup_probe":    kubernetesPodV1_spec_container_livenessProbe,
+		"volume_device":    kubernetesPodV1_spec_container_volumeDevice,
+		"volume_mount":     kubernetesPodV1_spec_container_volumeMount,
+	},
+	Schema: map[string]FieldSchema{
+		"args":                       {Optional: true, ForceNew: true, Description: "Arguments to the entrypoint. The docker image's CMD is used if this is not provided. Variable references $(VAR_NAME) are expanded using the container's environment. If a variable cannot be resolved, the reference in the input string will be unchanged. The $(VAR_NAME) syntax can be escaped with a double $$, ie: $$(VAR_NAME). Escaped references will never be expanded, regardless of whether the variable exists or not. Cannot be updated. More info: https://kubernetes.io/docs/tasks/inject-data-application/define-command-argument-container/#running-a-command-in-a-shell"},
+		"command":                    {Optional: true, ForceNew: true, Description: "Entrypoint array. Not executed within a shell. The docker image's ENTRYPOINT is used if this is not provided. Variable references $(VAR_NAME) are expanded using the container's environment. If a variable cannot be resolved, the reference in the input string will be unchanged. The $(VAR_NAME) syntax can be escaped with a double $$, ie: $$(VAR_NAME). Escaped references will never be expanded, regardless of whether the variable exists or not. Cannot be updated. More info: https://kubernetes.io/docs/tasks/inject-data-application/define-command-argument-container/#running-a-command-in-a-shell"},
+		"env":                        {Optional: true, Description: "List of environment variables to set in the container. Cannot be updated."},
+		"env_from":                   {Optional: true, Description: "List of sources to populate environment variables in the container. The keys defined within a source must be a C_IDENTIFIER. All invalid keys will be reported as an event when the container is starting. When a key exists in multiple sources, the value associated with the last source will take precedence. Values defined by an Env with a duplicate key will take precedence. Cannot be updated."},
+		"image":                      {Optional: true, ForceNew: true, Description: "Docker image name. More info: https://kubernetes.io/docs/concepts/containers/images/"},
+		"image_pull_policy":          {Optional: true, ForceNew: true, Description: "Image pull policy. One of Always, Never, IfNotPresent. Defaults to Always if :latest tag is specified, or IfNotPresent otherwise. Cannot be updated. More info: https://kubernetes.io/docs/concepts/containers/images/#updating-images"},
+		"lifecycle":                  {Optional: true, MaxItems: 1, Description: "Actions that the management system should take in response to container lifecycle events"},
+		"liveness_probe":             {Optional: true, ForceNew: true, MaxItems: 1, Description: "Periodic probe of container liveness. Container will be restarted if the probe fails. Cannot be updated. More info: https://kubernetes.io/docs/concepts/workloads/pods/pod-lifecycle/#container-probes"},
+		"name":                       {Required: true, ForceNew: true, Description: "Name of the container specified as a DNS_LABEL. Each container in a pod must have a unique name (DNS_LABEL). Cannot be updated."},
+		"port":                       {Optional: true, Description: "List of ports to expose from the container. Exposing a port here gives the system additional information about the network connections a container uses, but is primarily informational. Not specifying a port here DOES NOT prevent that port from being exposed. Any port which is listening on the default \"0.0.0.0\" address inside a container will be accessible from the network. Cannot be updated."},
+		"readiness_probe":            {Optional: true, ForceNew: true, MaxItems: 1, Description: "Periodic probe of container service readiness. Container will be removed from service endpoints if the probe fails. Cannot be updated. More info: https://kubernetes.io/docs/concepts/workloads/pods/pod-lifecycle/#container-probes"},
+		"resources":                  {Optional: true, ForceNew: true, MaxItems: 1, Description: "Compute Resources required by this container. Cannot be updated. More info: https://kubernetes.io/docs/concepts/storage/persistent-volumes#resources"},
+		"security_context":           {Optional: true, ForceNew: true, MaxItems: 1, Description: "Security options the pod should run with. More info: https://kubernetes.io/docs/tasks/configure-pod-container/security-context/"},
+		"startup_probe":              {Optional: true, ForceNew: true, MaxItems: 1, Description: "StartupProbe indicates that the Pod has successfully initialized. If specified, no other probes are executed until this completes successfully. If this probe fails, the Pod will be restarted, just as if the livenessProbe failed. This can be used to provide different probe parameters at the beginning of a Pod's lifecycle, when it might take a long time to load data or warm a cache, than during steady-state operation. This cannot be updated. This is an alpha feature enabled by the StartupProbe feature flag. More info: https://kubernetes.io/docs/concepts/workloads/pods/pod-lifecycle#container-probes"},
+		"stdin":                      {Optional: true, ForceNew: true, Description: "Whether this container should allocate a buffer for stdin in the container runtime. If this is not set, reads from stdin in the container will always result in EOF. ", Default: cty.BoolVal(false)},
+		"stdin_once":                 {Optional: true, ForceNew: true, Description: "Whether the container runtime should close the stdin channel after it has been opened by a single attach. When stdin is true the stdin stream will remain open across multiple attach sessions. If stdinOnce is set to true, stdin is opened on container start, is empty until the first client attaches to stdin, and then remains open and accepts data until the client disconnects, at which time stdin is closed and remains closed until the container is restarted. If this flag is false, a container processes that reads from stdin will never receive an EOF.", Default: cty.BoolVal(false)},
+		"termination_message_path":   {Optional: true, ForceNew: true, Description: "Optional: Path at which the file to which the container's termination message will be written is mounted into the container's filesystem. Message written is intended to be brief final status, such as an assertion failure message. Defaults to /dev/termination-log. Cannot be updated.", Default: cty.StringVal("/dev/termination-log")},
+		"termination_message_policy": {Optional: true, ForceNew: true, Description: "Optional: Indicate how the termination message should be populated. File will use the contents of terminationMessagePath to populate the container status message on both success and failure. FallbackToLogsOnError will use the last chunk of container log output if the termination message file is empty and the container exited with an error. The log output is limited to 2048 bytes or 80 lines, whichever is smaller. Defaults to File. Cannot be updated."},
+		"tty":                        {Optional: true, ForceNew: true, Description: "Whether this container should allocate a TTY for itself", Default: cty.BoolVal(false)},
+		"volume_device":              {Optional: true, ForceNew: true, Description: "Raw volume devices to attach into the container's filesystem as raw block devices. Cannot be updated."},
+		"volume_mount":               {Optional: true, ForceNew: true, Description: "Pod volumes to mount into the container's filesystem. Cannot be updated."},
+		"working_dir":                {Optional: true, ForceNew: true, Description: "Container's working directory. If not specified, the container runtime's default will be used, which might be configured in the container image. Cannot be updated."},
 	},
-	Blocks: map[string]ConverterSpec{},
 }
 
-var kubernetesPodV1_spec_initContainer_lifecycle_preStop_exec = ConverterSpec{
-	ResourceName: "kubernetes_pod_v1",
+var kubernetesPodV1_spec_container_env = ConverterSpec{
 	Attributes: map[string]func(any) (cty.Value, error){
-		"command": func(a any) (cty.Value, error) {
-			l, ok := a.([]any)
-			if !ok {
-				return cty.Value{}, fmt.Errorf("expected slice, got: %T", a)
-			}
-			vl := make([]cty.Value, 0, len(l))
-			for _, val := range l {
-				v, err := toString(val)
-				if err != nil {
-					return cty.Value{}, err
-				}
-				vl = append(vl, v)
-			}
-			return cty.ListVal(vl), nil
-		},
-	},
-	Blocks: map[string]ConverterSpec{},
-}
-
-var kubernetesPodV1_spec_initContainer_lifecycle_postStart = ConverterSpec{
-	ResourceName: "kubernetes_pod_v1",
-	Attributes:   map[string]func(any) (cty.Value, error){},
+		"name":  toString,
+		"value": toString,
+	},
 	Blocks: map[string]ConverterSpec{
-		"exec":       kubernetesPodV1_spec_initContainer_lifecycle_postStart_exec,
-		"http_get":   kubernetesPodV1_spec_initContainer_lifecycle_postStart_httpGet,
-		"tcp_socket": kubernetesPodV1_spec_initContainer_lifecycle_postStart_tcpSocket,
+		"value_from": kubernetesPodV1_spec_container_env_valueFrom,
+	},
+	Schema: map[string]FieldSchema{
+		"name":       {Required: true, ForceNew: true, Description: "Name of the environment variable. Must be a C_IDENTIFIER"},
+		"value":      {Optional: true, ForceNew: true, Description: "Variable references $(VAR_NAME) are expanded using the previous defined environment variables in the container and any service environment variables. If a variable cannot be resolved, the reference in the input string will be unchanged. The $(VAR_NAME) syntax can be escaped with a double $$, ie: $$(VAR_NAME). Escaped references will never be expanded, regardless of whether the variable exists or not. Defaults to \"\"."},
+		"value_from": {Optional: true, MaxItems: 1, Description: "Source for the environment variable's value"},
 	},
 }
 
-var kubernetesPodV1_spec_initContainer_lifecycle_postStart_httpGet = ConverterSpec{
-	ResourceName: "kubernetes_pod_v1",
+var kubernetesPodV1_spec_container_envFrom = ConverterSpec{
 	Attributes: map[string]func(any) (cty.Value, error){
-		"host":   toString,
-		"path":   toString,
-		"port":   toString,
-		"scheme": toString,
+		"prefix": toString,
 	},
 	Blocks: map[string]ConverterSpec{
-		"http_header": kubernetesPodV1_spec_initContainer_lifecycle_postStart_httpGet_httpHeader,
+		"config_map_ref": kubernetesPodV1_spec_container_envFrom_configMapRef,
+		"secret_ref":     kubernetesPodV1_spec_container_envFrom_secretRef,
+	},
+	Schema: map[string]FieldSchema{
+		"config_map_ref": {Optional: true, MaxItems: 1, Description: "The ConfigMap to select from"},
+		"prefix":         {Optional: true, ForceNew: true, Description: "An optional identifer to prepend to each key in the ConfigMap. Must be a C_IDENTIFIER."},
+		"secret_ref":     {Optional: true, MaxItems: 1, Description: "The Secret to select from"},
 	},
 }
 
-var kubernetesPodV1_spec_initContainer_lifecycle_postStart_httpGet_httpHeader = ConverterSpec{
-	ResourceName: "kubernetes_pod_v1",
+var kubernetesPodV1_spec_container_envFrom_configMapRef = ConverterSpec{
 	Attributes: map[string]func(any) (cty.Value, error){
-		"name":  toString,
-		"value": toString,
+		"name":     toString,
+		"optional": toBool,
+	},
+	Schema: map[string]FieldSchema{
+		"name":     {Required: true, ForceNew: true, Description: "Name of the referent. More info: https://kubernetes.io/docs/concepts/overview/working-with-objects/names/#names"},
+		"optional": {Optional: true, ForceNew: true, Description: "Specify whether the ConfigMap must be defined"},
 	},
-	Blocks: map[string]ConverterSpec{},
 }
 
-var kubernetesPodV1_spec_initContainer_lifecycle_postStart_exec = ConverterSpec{
-	ResourceName: "kubernetes_pod_v1",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"command": func(a any) (cty.Value, error) {
-			l, ok := a.([]any)
-			if !ok {
-				return cty.Value{}, fmt.Errorf("expected slice, got: %T", a)
-			}
-			vl := make([]cty.Value, 0, len(l))
-			for _, val := range l {
-				v, err := toString(val)
-				if err != nil {
-					return cty.Value{}, err
-				}
-				vl = append(vl, v)
-			}
-			return cty.ListVal(vl), nil
-		},
-	},
-	Blocks: map[string]ConverterSpec{},
-}
-
-var kubernetesPodV1_spec_initContainer_lifecycle_postStart_tcpSocket = ConverterSpec{
-	ResourceName: "kubernetes_pod_v1",
+var kubernetesPodV1_spec_container_envFrom_secretRef = ConverterSpec{
 	Attributes: map[string]func(any) (cty.Value, error){
-		"port": toString,
+		"name":     toString,
+		"optional": toBool,
+	},
+	Schema: map[string]FieldSchema{
+		"name":     {Required: true, ForceNew: true, Description: "Name of the referent. More info: https://kubernetes.io/docs/concepts/overview/working-with-objects/names/#names"},
+		"optional": {Optional: true, ForceNew: true, Description: "Specify whether the Secret must be defined"},
 	},
-	Blocks: map[string]ConverterSpec{},
 }
 
-var kubernetesPodV1_spec_initContainer_startupProbe = ConverterSpec{
-	ResourceName: "kubernetes_pod_v1",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"failure_threshold":     toInt,
-		"initial_delay_seconds": toInt,
-		"period_seconds":        toInt,
-		"success_threshold":     toInt,
-		"timeout_seconds":       toInt,
-	},
+var kubernetesPodV1_spec_container_env_valueFrom = ConverterSpec{
+	Attributes: map[string]func(any) (cty.Value, error){},
 	Blocks: map[string]ConverterSpec{
-		"exec":       kubernetesPodV1_spec_initContainer_startupProbe_exec,
-		"grpc":       kubernetesPodV1_spec_initContainer_startupProbe_grpc,
-		"http_get":   kubernetesPodV1_spec_initContainer_startupProbe_httpGet,
-		"tcp_socket": kubernetesPodV1_spec_initContainer_startupProbe_tcpSocket,
+		"config_map_key_ref": kubernetesPodV1_spec_container_env_valueFrom_configMapKeyRef,
+		"field_ref":          kubernetesPodV1_spec_container_env_valueFrom_fieldRef,
+		"resource_field_ref": kubernetesPodV1_spec_container_env_valueFrom_resourceFieldRef,
+		"secret_key_ref":     kubernetesPodV1_spec_container_env_valueFrom_secretKeyRef,
+	},
+	Schema: map[string]FieldSchema{
+		"config_map_key_ref": {Optional: true, MaxItems: 1, Description: "Selects a key of a ConfigMap."},
+		"field_ref":          {Optional: true, MaxItems: 1, Description: "Selects a field of the pod: supports metadata.name, metadata.namespace, metadata.labels, metadata.annotations, spec.nodeName, spec.serviceAccountName, status.podIP."},
+		"resource_field_ref": {Optional: true, MaxItems: 1, Description: "Selects a resource of the container: only resources limits and requests (limits.cpu, limits.memory, limits.ephemeral-storage, requests.cpu, requests.memory and requests.ephemeral-storage) are currently supported."},
+		"secret_key_ref":     {Optional: true, MaxItems: 1, Description: "Selects a key of a secret in the pod's namespace."},
 	},
 }
 
-var kubernetesPodV1_spec_initContainer_startupProbe_httpGet = ConverterSpec{
-	ResourceName: "kubernetes_pod_v1",
+var kubernetesPodV1_spec_container_env_valueFrom_configMapKeyRef = ConverterSpec{
 	Attributes: map[string]func(any) (cty.Value, error){
-		"host":   toString,
-		"path":   toString,
-		"port":   toString,
-		"scheme": toString,
+		"key":      toString,
+		"name":     toString,
+		"optional": toBool,
 	},
-	Blocks: map[string]ConverterSpec{
-		"http_header": kubernetesPodV1_spec_initContainer_startupProbe_httpGet_httpHeader,
+	Schema: map[string]FieldSchema{
+		"key":      {Optional: true, ForceNew: true, Description: "The key to select."},
+		"name":     {Optional: true, ForceNew: true, Description: "Name of the referent. More info: https://kubernetes.io/docs/concepts/overview/working-with-objects/names/#names"},
+		"optional": {Optional: true, ForceNew: true, Description: "Specify whether the ConfigMap or its key must be defined."},
 	},
 }
 
-var kubernetesPodV1_spec_initContainer_startupProbe_httpGet_httpHeader = ConverterSpec{
-	ResourceName: "kubernetes_pod_v1",
+var kubernetesPodV1_spec_container_env_valueFrom_fieldRef = ConverterSpec{
 	Attributes: map[string]func(any) (cty.Value, error){
-		"name":  toString,
-		"value": toString,
+		"api_version": toString,
+		"field_path":  toString,
+	},
+	Schema: map[string]FieldSchema{
+		"api_version": {Optional: true, ForceNew: true, Description: "Version of the schema the FieldPath is written in terms of, defaults to \"v1\".", Default: cty.StringVal("v1")},
+		"field_path":  {Optional: true, ForceNew: true, Description: "Path of the field to select in the specified API version"},
 	},
-	Blocks: map[string]ConverterSpec{},
 }
 
-var kubernetesPodV1_spec_initContainer_startupProbe_grpc = ConverterSpec{
-	ResourceName: "kubernetes_pod_v1",
+var kubernetesPodV1_spec_container_env_valueFrom_resourceFieldRef = ConverterSpec{
 	Attributes: map[string]func(any) (cty.Value, error){
-		"port":    toInt,
-		"service": toString,
+		"container_name": toString,
+		"divisor":        toString,
+		"resource":       toString,
+	},
+	Schema: map[string]FieldSchema{
+		"container_name": {Optional: true, ForceNew: true},
+		"divisor":        {Optional: true, Default: cty.StringVal("1")},
+		"resource":       {Required: true, ForceNew: true, Description: "Resource to select"},
 	},
-	Blocks: map[string]ConverterSpec{},
 }
 
-var kubernetesPodV1_spec_initContainer_startupProbe_tcpSocket = ConverterSpec{
-	ResourceName: "kubernetes_pod_v1",
+var kubernetesPodV1_spec_container_env_valueFrom_secretKeyRef = ConverterSpec{
 	Attributes: map[string]func(any) (cty.Value, error){
-		"port": toString,
+		"key":      toString,
+		"name":     toString,
+		"optional": toBool,
+	},
+	Schema: map[string]FieldSchema{
+		"key":      {Optional: true, ForceNew: true, Description: "The key of the secret to select from. Must be a valid secret key."},
+		"name":     {Optional: true, ForceNew: true, Description: "Name of the referent. More info: https://kubernetes.io/docs/concepts/overview/working-with-objects/names/#names"},
+		"optional": {Optional: true, ForceNew: true, Description: "Specify whether the Secret or its key must be defined."},
 	},
-	Blocks: map[string]ConverterSpec{},
 }
 
-var kubernetesPodV1_spec_initContainer_startupProbe_exec = ConverterSpec{
-	ResourceName: "kubernetes_pod_v1",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"command": func(a any) (cty.Value, error) {
-			l, ok := a.([]any)
-			if !ok {
-				return cty.Value{}, fmt.Errorf("expected slice, got: %T", a)
-			}
-			vl := make([]cty.Value, 0, len(l))
-			for _, val := range l {
-				v, err := toString(val)
-				if err != nil {
-					return cty.Value{}, err
-				}
-				vl = append(vl, v)
-			}
-			return cty.ListVal(vl), nil
-		},
-	},
-	Blocks: map[string]ConverterSpec{},
-}
-
-var kubernetesPodV1_spec_initContainer_livenessProbe = ConverterSpec{
-	ResourceName: "kubernetes_pod_v1",
+var kubernetesPodV1_spec_container_lifecycle = ConverterSpec{
+	Attributes: map[string]func(any) (cty.Value, error){},
+	Blocks: map[string]ConverterSpec{
+		"post_start": kubernetesPodV1_spec_container_lifecycle_postStart,
+		"pre_stop":   kubernetesPodV1_spec_container_lifecycle_postStart,
+	},
+	Schema: map[string]FieldSchema{
+		"post_start": {Optional: true, ForceNew: true, Description: "post_start is called immediately after a container is created. If the handler fails, the container is terminated and restarted according to its restart policy. Other management of the container blocks until the hook completes. More info: https://kubernetes.io/docs/concepts/containers/container-lifecycle-hooks/#container-hooks"},
+		"pre_stop":   {Optional: true, ForceNew: true, Description: "pre_stop is called immediately before a container is terminated. The container is terminated after the handler completes. The reason for termination is passed to the handler. Regardless of the outcome of the handler, the container is eventually terminated. Other management of the container blocks until the hook completes. More info: https://kubernetes.io/docs/concepts/containers/container-lifecycle-hooks/#container-hooks"},
+	},
+}
+
+var kubernetesPodV1_spec_container_lifecycle_postStart = ConverterSpec{
+	Attributes: map[string]func(any) (cty.Value, error){},
+	Blocks: map[string]ConverterSpec{
+		"exec":       kubernetesPodV1_spec_container_livenessProbe_exec,
+		"http_get":   kubernetesPodV1_spec_container_livenessProbe_httpGet,
+		"tcp_socket": kubernetesPodV1_spec_container_livenessProbe_tcpSocket,
+	},
+	Schema: map[string]FieldSchema{
+		"exec":       {Optional: true, MaxItems: 1, Description: "exec specifies the action to take."},
+		"http_get":   {Optional: true, MaxItems: 1, Description: "Specifies the http request to perform."},
+		"tcp_socket": {Optional: true, Description: "TCPSocket specifies an action involving a TCP port. TCP hooks not yet supported"},
+	},
+}
+
+var kubernetesPodV1_spec_container_livenessProbe = ConverterSpec{
 	Attributes: map[string]func(any) (cty.Value, error){
 		"failure_threshold":     toInt,
 		"initial_delay_seconds": toInt,
@@ -447,32 +436,45 @@ var kubernetesPodV1_spec_initContainer_livenessProbe = ConverterSpec{
 		"timeout_seconds":       toInt,
 	},
 	Blocks: map[string]ConverterSpec{
-		"exec":       kubernetesPodV1_spec_initContainer_livenessProbe_exec,
-		"grpc":       kubernetesPodV1_spec_initContainer_livenessProbe_grpc,
-		"http_get":   kubernetesPodV1_spec_initContainer_livenessProbe_httpGet,
-		"tcp_socket": kubernetesPodV1_spec_initContainer_livenessProbe_tcpSocket,
+		"exec":       kubernetesPodV1_spec_container_livenessProbe_exec,
+		"grpc":       kubernetesPodV1_spec_container_livenessProbe_grpc,
+		"http_get":   kubernetesPodV1_spec_container_livenessProbe_httpGet,
+		"tcp_socket": kubernetesPodV1_spec_container_livenessProbe_tcpSocket,
+	},
+	Schema: map[string]FieldSchema{
+		"exec":                  {Optional: true, MaxItems: 1, Description: "exec specifies the action to take."},
+		"failure_threshold":     {Optional: true, Description: "Minimum consecutive failures for the probe to be considered failed after having succeeded.", Default: cty.NumberIntVal(3)},
+		"grpc":                  {Optional: true, Description: "GRPC specifies an action involving a GRPC port."},
+		"http_get":              {Optional: true, MaxItems: 1, Description: "Specifies the http request to perform."},
+		"initial_delay_seconds": {Optional: true, Description: "Number of seconds after the container has started before liveness probes are initiated. More info: https://kubernetes.io/docs/concepts/workloads/pods/pod-lifecycle/#container-probes"},
+		"period_seconds":        {Optional: true, Description: "How often (in seconds) to perform the probe", Default: cty.NumberIntVal(10)},
+		"success_threshold":     {Optional: true, Description: "Minimum consecutive successes for the probe to be considered successful after having failed.", Default: cty.NumberIntVal(1)},
+		"tcp_socket":            {Optional: true, Description: "TCPSocket specifies an action involving a TCP port. TCP hooks not yet supported"},
+		"timeout_seconds":       {Optional: true, Description: "Number of seconds after which the probe times out. More info: https://kubernetes.io/docs/concepts/workloads/pods/pod-lifecycle/#container-probes", Default: cty.NumberIntVal(1)},
 	},
 }
 
-var kubernetesPodV1_spec_initContainer_livenessProbe_tcpSocket = ConverterSpec{
-	ResourceName: "kubernetes_pod_v1",
+var kubernetesPodV1_spec_container_livenessProbe_exec = ConverterSpec{
 	Attributes: map[string]func(any) (cty.Value, error){
-		"port": toString,
+		"command": toList(toString),
+	},
+	Schema: map[string]FieldSchema{
+		"command": {Optional: true, Description: "Command is the command line to execute inside the container, the working directory for the command is root ('/') in the container's filesystem. The command is simply exec'd, it is not run inside a shell, so traditional shell instructions. To use a shell, you need to explicitly call out to that shell. Exit status of 0 is treated as live/healthy and non-zero is unhealthy."},
 	},
-	Blocks: map[string]ConverterSpec{},
 }
 
-var kubernetesPodV1_spec_initContainer_livenessProbe_grpc = ConverterSpec{
-	ResourceName: "kubernetes_pod_v1",
+var kubernetesPodV1_spec_container_livenessProbe_grpc = ConverterSpec{
 	Attributes: map[string]func(any) (cty.Value, error){
 		"port":    toInt,
 		"service": toString,
 	},
-	Blocks: map[string]ConverterSpec{},
+	Schema: map[string]FieldSchema{
+		"port":    {Required: true, Description: "Number of the port to access on the container. Number must be in the range 1 to 65535."},
+		"service": {Optional: true, Description: "Name of the service to place in the gRPC HealthCheckRequest (see https://github.com/grpc/grpc/blob/master/doc/health-checking.md). If this is not specified, the default behavior is defined by gRPC."},
+	},
 }
 
-var kubernetesPodV1_spec_initContainer_livenessProbe_httpGet = ConverterSpec{
-	ResourceName: "kubernetes_pod_v1",
+var kubernetesPodV1_spec_container_livenessProbe_httpGet = ConverterSpec{
 	Attributes: map[string]func(any) (cty.Value, error){
 		"host":   toString,
 		"path":   toString,
@@ -480,52 +482,38 @@ var kubernetesPodV1_spec_initContainer_livenessProbe_httpGet = ConverterSpec{
 		"scheme": toString,
 	},
 	Blocks: map[string]ConverterSpec{
-		"http_header": kubernetesPodV1_spec_initContainer_livenessProbe_httpGet_httpHeader,
+		"http_header": kubernetesPodV1_spec_container_livenessProbe_httpGet_httpHeader,
+	},
+	Schema: map[string]FieldSchema{
+		"host":        {Optional: true, Description: "Host name to connect to, defaults to the pod IP. You probably want to set \"Host\" in httpHeaders instead."},
+		"http_header": {Optional: true, Description: "Scheme to use for connecting to the host."},
+		"path":        {Optional: true, Description: "Path to access on the HTTP server."},
+		"port":        {Optional: true, Description: "Name or number of the port to access on the container. Number must be in the range 1 to 65535. Name must be an IANA_SVC_NAME."},
+		"scheme":      {Optional: true, Description: "Scheme to use for connecting to the host.", Default: cty.StringVal("HTTP")},
 	},
 }
 
-var kubernetesPodV1_spec_initContainer_livenessProbe_httpGet_httpHeader = ConverterSpec{
-	ResourceName: "kubernetes_pod_v1",
+var kubernetesPodV1_spec_container_livenessProbe_httpGet_httpHeader = ConverterSpec{
 	Attributes: map[string]func(any) (cty.Value, error){
 		"name":  toString,
 		"value": toString,
 	},
-	Blocks: map[string]ConverterSpec{},
+	Schema: map[string]FieldSchema{
+		"name":  {Optional: true, Description: "The header field name"},
+		"value": {Optional: true, Description: "The header field value"},
+	},
 }
 
-var kubernetesPodV1_spec_initContainer_livenessProbe_exec = ConverterSpec{
-	ResourceName: "kubernetes_pod_v1",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"command": func(a any) (cty.Value, error) {
-			l, ok := a.([]any)
-			if !ok {
-				return cty.Value{}, fmt.Errorf("expected slice, got: %T", a)
-			}
-			vl := make([]cty.Value, 0, len(l))
-			for _, val := range l {
-				v, err := toString(val)
-				if err != nil {
-					return cty.Value{}, err
-				}
-				vl = append(vl, v)
-			}
-			return cty.ListVal(vl), nil
-		},
-	},
-	Blocks: map[string]ConverterSpec{},
-}
-
-var kubernetesPodV1_spec_initContainer_volumeDevice = ConverterSpec{
-	ResourceName: "kubernetes_pod_v1",
+var kubernetesPodV1_spec_container_livenessProbe_tcpSocket = ConverterSpec{
 	Attributes: map[string]func(any) (cty.Value, error){
-		"device_path": toString,
-		"name":        toString,
+		"port": toString,
+	},
+	Schema: map[string]FieldSchema{
+		"port": {Required: true, Description: "Number or name of the port to access on the container. Number must be in the range 1 to 65535. Name must be an IANA_SVC_NAME."},
 	},
-	Blocks: map[string]ConverterSpec{},
 }
 
-var kubernetesPodV1_spec_initContainer_port = ConverterSpec{
-	ResourceName: "kubernetes_pod_v1",
+var kubernetesPodV1_spec_container_port = ConverterSpec{
 	Attributes: map[string]func(any) (cty.Value, error){
 		"container_port": toInt,
 		"host_ip":        toString,
@@ -533,2281 +521,633 @@ var kubernetesPodV1_spec_initContainer_port = ConverterSpec{
 		"name":           toString,
 		"protocol":       toString,
 	},
-	Blocks: map[string]ConverterSpec{},
+	Schema: map[string]FieldSchema{
+		"container_port": {Required: true, ForceNew: true, Description: "Number of port to expose on the pod's IP address. This must be a valid port number, 0 < x < 65536."},
+		"host_ip":        {Optional: true, ForceNew: true, Description: "What host IP to bind the external port to."},
+		"host_port":      {Optional: true, ForceNew: true, Description: "Number of port to expose on the host. If specified, this must be a valid port number, 0 < x < 65536. If HostNetwork is specified, this must match ContainerPort. Most containers do not need this."},
+		"name":           {Optional: true, ForceNew: true, Description: "If specified, this must be an IANA_SVC_NAME and unique within the pod. Each named port in a pod must have a unique name. Name for the port that can be referred to by services"},
+		"protocol":       {Optional: true, ForceNew: true, Description: "Protocol for port. Must be UDP or TCP. Defaults to \"TCP\".", Default: cty.StringVal("TCP")},
+	},
 }
 
-var kubernetesPodV1_spec_initContainer_envFrom = ConverterSpec{
-	ResourceName: "kubernetes_pod_v1",
+var kubernetesPodV1_spec_container_resources = ConverterSpec{
 	Attributes: map[string]func(any) (cty.Value, error){
-		"prefix": toString,
+		"limits":   toQuantityMap,
+		"requests": toQuantityMap,
 	},
-	Blocks: map[string]ConverterSpec{
-		"config_map_ref": kubernetesPodV1_spec_initContainer_envFrom_configMapRef,
-		"secret_ref":     kubernetesPodV1_spec_initContainer_envFrom_secretRef,
+	Quantities: map[string]bool{
+		"limits":   true,
+		"requests": true,
+	},
+	Schema: map[string]FieldSchema{
+		"limits":   {Optional: true, ForceNew: true, Description: "Describes the maximum amount of compute resources allowed. More info: https://kubernetes.io/docs/concepts/configuration/manage-resources-containers/"},
+		"requests": {Optional: true, ForceNew: true, Description: "Requests describes the minimum amount of compute resources required. If Requests is omitted for a container, it defaults to Limits if that is explicitly specified, otherwise to an implementation-defined value. More info: https://kubernetes.io/docs/concepts/configuration/manage-compute-resources-container/"},
 	},
 }
 
-var kubernetesPodV1_spec_initContainer_envFrom_secretRef = ConverterSpec{
-	ResourceName: "kubernetes_pod_v1",
+var kubernetesPodV1_spec_container_securityContext = ConverterSpec{
 	Attributes: map[string]func(any) (cty.Value, error){
-		"name":     toString,
-		"optional": toBool,
+		"allow_privilege_escalation": toBool,
+		"privileged":                 toBool,
+		"read_only_root_filesystem":  toBool,
+		"run_as_group":               toString,
+		"run_as_non_root":            toBool,
+		"run_as_user":                toString,
+	},
+	Blocks: map[string]ConverterSpec{
+		"capabilities":     kubernetesPodV1_spec_container_securityContext_capabilities,
+		"se_linux_options": kubernetesPodV1_spec_securityContext_seLinuxOptions,
+		"seccomp_profile":  kubernetesPodV1_spec_securityContext_seccompProfile,
+	},
+	Schema: map[string]FieldSchema{
+		"allow_privilege_escalation": {Optional: true, ForceNew: true, Description: "AllowPrivilegeEscalation controls whether a process can gain more privileges than its parent process. This bool directly controls if the no_new_privs flag will be set on the container process. AllowPrivilegeEscalation is true always when the container is: 1) run as Privileged 2) has CAP_SYS_ADMIN", Default: cty.BoolVal(true)},
+		"capabilities":               {Optional: true, MaxItems: 1, Description: "The capabilities to add/drop when running containers. Defaults to the default set of capabilities granted by the container runtime."},
+		"privileged":                 {Optional: true, ForceNew: true, Description: "Run container in privileged mode. Processes in privileged containers are essentially equivalent to root on the host. Defaults to false.", Default: cty.BoolVal(false)},
+		"read_only_root_filesystem":  {Optional: true, ForceNew: true, Description: "Whether this container has a read-only root filesystem. Default is false.", Default: cty.BoolVal(false)},
+		"run_as_group":               {Optional: true, ForceNew: true, Description: "The GID to run the entrypoint of the container process. Uses runtime default if unset. May also be set in PodSecurityContext. If set in both SecurityContext and PodSecurityContext, the value specified in SecurityContext takes precedence."},
+		"run_as_non_root":            {Optional: true, ForceNew: true, Description: "Indicates that the container must run as a non-root user. If true, the Kubelet will validate the image at runtime to ensure that it does not run as UID 0 (root) and fail to start the container if it does. If unset or false, no such validation will be performed. May also be set in PodSecurityContext. If set in both SecurityContext and PodSecurityContext, the value specified in SecurityContext takes precedence."},
+		"run_as_user":                {Optional: true, ForceNew: true, Description: "The UID to run the entrypoint of the container process. Defaults to user specified in image metadata if unspecified. May also be set in PodSecurityContext. If set in both SecurityContext and PodSecurityContext, the value specified in SecurityContext takes precedence."},
+		"se_linux_options":           {Optional: true, MaxItems: 1, Description: "The SELinux context to be applied to the container. If unspecified, the container runtime will allocate a random SELinux context for each container. May also be set in PodSecurityContext. If set in both SecurityContext and PodSecurityContext, the value specified in SecurityContext takes precedence."},
+		"seccomp_profile":            {Optional: true, MaxItems: 1, Description: "The seccomp options to use by the containers in this pod. Note that this field cannot be set when spec.os.name is windows."},
 	},
-	Blocks: map[string]ConverterSpec{},
 }
 
-var kubernetesPodV1_spec_initContainer_envFrom_configMapRef = ConverterSpec{
-	ResourceName: "kubernetes_pod_v1",
+var kubernetesPodV1_spec_container_securityContext_capabilities = ConverterSpec{
 	Attributes: map[string]func(any) (cty.Value, error){
-		"name":     toString,
-		"optional": toBool,
+		"add":  toList(toString),
+		"drop": toList(toString),
+	},
+	Schema: map[string]FieldSchema{
+		"add":  {Optional: true, ForceNew: true, Description: "Added capabilities"},
+		"drop": {Optional: true, ForceNew: true, Description: "Removed capabilities"},
 	},
-	Blocks: map[string]ConverterSpec{},
 }
 
-var kubernetesPodV1_spec_initContainer_env = ConverterSpec{
-	ResourceName: "kubernetes_pod_v1",
+var kubernetesPodV1_spec_container_volumeDevice = ConverterSpec{
 	Attributes: map[string]func(any) (cty.Value, error){
-		"name":  toString,
-		"value": toString,
+		"device_path": toString,
+		"name":        toString,
 	},
-	Blocks: map[string]ConverterSpec{
-		"value_from": kubernetesPodV1_spec_initContainer_env_valueFrom,
+	Schema: map[string]FieldSchema{
+		"device_path": {Required: true, Description: "Path within the container at which the volume device should be attached. For example '/dev/xvda'."},
+		"name":        {Required: true, Description: "This must match the Name of a PersistentVolumeClaim."},
 	},
 }
 
-var kubernetesPodV1_spec_initContainer_env_valueFrom = ConverterSpec{
-	ResourceName: "kubernetes_pod_v1",
-	Attributes:   map[string]func(any) (cty.Value, error){},
-	Blocks: map[string]ConverterSpec{
-		"config_map_key_ref": kubernetesPodV1_spec_initContainer_env_valueFrom_configMapKeyRef,
-		"field_ref":          kubernetesPodV1_spec_initContainer_env_valueFrom_fieldRef,
-		"resource_field_ref": kubernetesPodV1_spec_initContainer_env_valueFrom_resourceFieldRef,
-		"secret_key_ref":     kubernetesPodV1_spec_initContainer_env_valueFrom_secretKeyRef,
+var kubernetesPodV1_spec_container_volumeMount = ConverterSpec{
+	Attributes: map[string]func(any) (cty.Value, error){
+		"mount_path":        toString,
+		"mount_propagation": toString,
+		"name":              toString,
+		"read_only":         toBool,
+		"sub_path":          toString,
+		"sub_path_expr":     toString,
+	},
+	Schema: map[string]FieldSchema{
+		"mount_path":        {Required: true, Description: "Path within the container at which the volume should be mounted. Must not contain ':'."},
+		"mount_propagation": {Optional: true, Description: "Mount propagation mode. mount_propagation determines how mounts are propagated from the host to container and the other way around. Valid values are None (default), HostToContainer and Bidirectional.", Default: cty.StringVal("None")},
+		"name":              {Required: true, Description: "This must match the Name of a Volume."},
+		"read_only":         {Optional: true, Description: "Mounted read-only if true, read-write otherwise (false or unspecified). Defaults to false.", Default: cty.BoolVal(false)},
+		"sub_path":          {Optional: true, Description: "Path within the volume from which the container's volume should be mounted. Defaults to \"\" (volume's root)."},
+		"sub_path_expr":     {Optional: true, Description: "Dynamic path within the volume from which the container's volume should be mounted. Defaults to \"\" (volume's root)."},
 	},
 }
 
-var kubernetesPodV1_spec_initContainer_env_valueFrom_secretKeyRef = ConverterSpec{
-	ResourceName: "kubernetes_pod_v1",
+var kubernetesPodV1_spec_dnsConfig = ConverterSpec{
 	Attributes: map[string]func(any) (cty.Value, error){
-		"key":      toString,
-		"name":     toString,
-		"optional": toBool,
+		"nameservers": toList(toString),
+		"searches":    toList(toString),
+	},
+	Blocks: map[string]ConverterSpec{
+		"option": kubernetesPodV1_spec_dnsConfig_option,
+	},
+	Schema: map[string]FieldSchema{
+		"nameservers": {Optional: true, ForceNew: true, Description: "A list of DNS name server IP addresses. This will be appended to the base nameservers generated from DNSPolicy. Duplicated nameservers will be removed."},
+		"option":      {Optional: true, Description: "A list of DNS resolver options. This will be merged with the base options generated from DNSPolicy. Duplicated entries will be removed. Resolution options given in Options will override those that appear in the base DNSPolicy."},
+		"searches":    {Optional: true, Description: "A list of DNS search domains for host-name lookup. This will be appended to the base search paths generated from DNSPolicy. Duplicated search paths will be removed."},
 	},
-	Blocks: map[string]ConverterSpec{},
 }
 
-var kubernetesPodV1_spec_initContainer_env_valueFrom_resourceFieldRef = ConverterSpec{
-	ResourceName: "kubernetes_pod_v1",
+var kubernetesPodV1_spec_dnsConfig_option = ConverterSpec{
 	Attributes: map[string]func(any) (cty.Value, error){
-		"container_name": toString,
-		"divisor":        toString,
-		"resource":       toString,
+		"name":  toString,
+		"value": toString,
+	},
+	Schema: map[string]FieldSchema{
+		"name":  {Required: true, ForceNew: true, Description: "Name of the option."},
+		"value": {Optional: true, ForceNew: true, Description: "Value of the option. Optional: Defaults to empty."},
 	},
-	Blocks: map[string]ConverterSpec{},
 }
 
-var kubernetesPodV1_spec_initContainer_env_valueFrom_fieldRef = ConverterSpec{
-	ResourceName: "kubernetes_pod_v1",
+var kubernetesPodV1_spec_hostAliases = ConverterSpec{
 	Attributes: map[string]func(any) (cty.Value, error){
-		"api_version": toString,
-		"field_path":  toString,
+		"hostnames": toList(toString),
+		"ip":        toString,
+	},
+	Schema: map[string]FieldSchema{
+		"hostnames": {Required: true, ForceNew: true, Description: "Hostnames for the IP address."},
+		"ip":        {Required: true, ForceNew: true, Description: "IP address of the host file entry."},
 	},
-	Blocks: map[string]ConverterSpec{},
 }
 
-var kubernetesPodV1_spec_initContainer_env_valueFrom_configMapKeyRef = ConverterSpec{
-	ResourceName: "kubernetes_pod_v1",
+var kubernetesPodV1_spec_imagePullSecrets = ConverterSpec{
 	Attributes: map[string]func(any) (cty.Value, error){
-		"key":      toString,
-		"name":     toString,
-		"optional": toBool,
+		"name": toString,
+	},
+	Schema: map[string]FieldSchema{
+		"name": {Required: true, ForceNew: true, Description: "Name of the referent. More info: https://kubernetes.io/docs/concepts/overview/working-with-objects/names/#names"},
 	},
-	Blocks: map[string]ConverterSpec{},
 }
 
-var kubernetesPodV1_spec_initContainer_resources = ConverterSpec{
-	ResourceName: "kubernetes_pod_v1",
+var kubernetesPodV1_spec_os = ConverterSpec{
 	Attributes: map[string]func(any) (cty.Value, error){
-		"limits":   toStringMap,
-		"requests": toStringMap,
+		"name": toString,
+	},
+	Schema: map[string]FieldSchema{
+		"name": {Required: true, Description: "Name is the name of the operating system. The currently supported values are linux and windows."},
 	},
-	Blocks: map[string]ConverterSpec{},
 }
 
-var kubernetesPodV1_spec_initContainer_readinessProbe = ConverterSpec{
-	ResourceName: "kubernetes_pod_v1",
+var kubernetesPodV1_spec_readinessGate = ConverterSpec{
 	Attributes: map[string]func(any) (cty.Value, error){
-		"failure_threshold":     toInt,
-		"initial_delay_seconds": toInt,
-		"period_seconds":        toInt,
-		"success_threshold":     toInt,
-		"timeout_seconds":       toInt,
+		"condition_type": toString,
 	},
-	Blocks: map[string]ConverterSpec{
-		"exec":       kubernetesPodV1_spec_initContainer_readinessProbe_exec,
-		"grpc":       kubernetesPodV1_spec_initContainer_readinessProbe_grpc,
-		"http_get":   kubernetesPodV1_spec_initContainer_readinessProbe_httpGet,
-		"tcp_socket": kubernetesPodV1_spec_initContainer_readinessProbe_tcpSocket,
+	Schema: map[string]FieldSchema{
+		"condition_type": {Required: true, ForceNew: true, Description: "refers to a condition in the pod's condition list with matching type."},
 	},
 }
 
-var kubernetesPodV1_spec_initContainer_readinessProbe_grpc = ConverterSpec{
-	ResourceName: "kubernetes_pod_v1",
+var kubernetesPodV1_spec_securityContext = ConverterSpec{
 	Attributes: map[string]func(any) (cty.Value, error){
-		"port":    toInt,
-		"service": toString,
+		"fs_group":               toString,
+		"fs_group_change_policy": toString,
+		"run_as_group":           toString,
+		"run_as_non_root":        toBool,
+		"run_as_user":            toString,
+		"supplemental_groups":    toList(toInt),
+	},
+	Blocks: map[string]ConverterSpec{
+		"se_linux_options": kubernetesPodV1_spec_securityContext_seLinuxOptions,
+		"seccomp_profile":  kubernetesPodV1_spec_securityContext_seccompProfile,
+		"sysctl":           kubernetesPodV1_spec_securityContext_sysctl,
+		"windows_options":  kubernetesPodV1_spec_securityContext_windowsOptions,
+	},
+	Schema: map[string]FieldSchema{
+		"fs_group":               {Optional: true, ForceNew: true, Description: "A special supplemental group that applies to all containers in a pod. Some volume types allow the Kubelet to change the ownership of that volume to be owned by the pod: 1. The owning GID will be the FSGroup 2. The setgid bit is set (new files created in the volume will be owned by FSGroup) 3. The permission bits are OR'd with rw-rw---- If unset, the Kubelet will not modify the ownership and permissions of any volume."},
+		"fs_group_change_policy": {Optional: true, ForceNew: true, Description: "fsGroupChangePolicy defines behavior of changing ownership and permission of the volume before being exposed inside Pod. This field will only apply to volume types which support fsGroup based ownership(and permissions). It will have no effect on ephemeral volume types such as: secret, configmaps and emptydir."},
+		"run_as_group":           {Optional: true, ForceNew: true, Description: "The GID to run the entrypoint of the container process. Uses runtime default if unset. May also be set in SecurityContext. If set in both SecurityContext and PodSecurityContext, the value specified in SecurityContext takes precedence for that container."},
+		"run_as_non_root":        {Optional: true, ForceNew: true, Description: "Indicates that the container must run as a non-root user. If true, the Kubelet will validate the image at runtime to ensure that it does not run as UID 0 (root) and fail to start the container if it does. If unset or false, no such validation will be performed. May also be set in SecurityContext. If set in both SecurityContext and PodSecurityContext, the value specified in SecurityContext takes precedence."},
+		"run_as_user":            {Optional: true, ForceNew: true, Description: "The UID to run the entrypoint of the container process. Defaults to user specified in image metadata if unspecified. May also be set in SecurityContext. If set in both SecurityContext and PodSecurityContext, the value specified in SecurityContext takes precedence for that container."},
+		"se_linux_options":       {Optional: true, MaxItems: 1, Description: "The SELinux context to be applied to all containers. If unspecified, the container runtime will allocate a random SELinux context for each container. May also be set in SecurityContext. If set in both SecurityContext and PodSecurityContext, the value specified in SecurityContext takes precedence for that container."},
+		"seccomp_profile":        {Optional: true, MaxItems: 1, Description: "The seccomp options to use by the containers in this pod. Note that this field cannot be set when spec.os.name is windows."},
+		"supplemental_groups":    {Optional: true, ForceNew: true, Description: "A list of groups applied to the first process run in each container, in addition to the container's primary GID. If unspecified, no groups will be added to any container."},
+		"sysctl":                 {Optional: true, Description: "holds a list of namespaced sysctls used for the pod."},
+		"windows_options":        {Optional: true, MaxItems: 1, Description: "The Windows specific settings applied to all containers. If unspecified, the options within a container's SecurityContext will be used. If set in both SecurityContext and PodSecurityContext, the value specified in SecurityContext takes precedence. Note that this field cannot be set when spec.os.name is linux."},
 	},
-	Blocks: map[string]ConverterSpec{},
 }
 
-var kubernetesPodV1_spec_initContainer_readinessProbe_tcpSocket = ConverterSpec{
-	ResourceName: "kubernetes_pod_v1",
+var kubernetesPodV1_spec_securityContext_seLinuxOptions = ConverterSpec{
 	Attributes: map[string]func(any) (cty.Value, error){
-		"port": toString,
+		"level": toString,
+		"role":  toString,
+		"type":  toString,
+		"user":  toString,
+	},
+	Schema: map[string]FieldSchema{
+		"level": {Optional: true, ForceNew: true, Description: "Level is SELinux level label that applies to the container."},
+		"role":  {Optional: true, ForceNew: true, Description: "Role is a SELinux role label that applies to the container."},
+		"type":  {Optional: true, ForceNew: true, Description: "Type is a SELinux type label that applies to the container."},
+		"user":  {Optional: true, ForceNew: true, Description: "User is a SELinux user label that applies to the container."},
 	},
-	Blocks: map[string]ConverterSpec{},
 }
 
-var kubernetesPodV1_spec_initContainer_readinessProbe_httpGet = ConverterSpec{
-	ResourceName: "kubernetes_pod_v1",
+var kubernetesPodV1_spec_securityContext_seccompProfile = ConverterSpec{
 	Attributes: map[string]func(any) (cty.Value, error){
-		"host":   toString,
-		"path":   toString,
-		"port":   toString,
-		"scheme": toString,
+		"localhost_profile": toString,
+		"type":              toString,
 	},
-	Blocks: map[string]ConverterSpec{
-		"http_header": kubernetesPodV1_spec_initContainer_readinessProbe_httpGet_httpHeader,
+	Schema: map[string]FieldSchema{
+		"localhost_profile": {Optional: true, ForceNew: true, Description: "Localhost Profile indicates a profile defined in a file on the node should be used. The profile must be preconfigured on the node to work.", Default: cty.StringVal("")},
+		"type":              {Optional: true, ForceNew: true, Description: "Type indicates which kind of seccomp profile will be applied. Valid options are: Localhost, RuntimeDefault, Unconfined.", Default: cty.StringVal("Unconfined")},
 	},
 }
 
-var kubernetesPodV1_spec_initContainer_readinessProbe_httpGet_httpHeader = ConverterSpec{
-	ResourceName: "kubernetes_pod_v1",
+var kubernetesPodV1_spec_securityContext_sysctl = ConverterSpec{
 	Attributes: map[string]func(any) (cty.Value, error){
 		"name":  toString,
 		"value": toString,
 	},
-	Blocks: map[string]ConverterSpec{},
+	Schema: map[string]FieldSchema{
+		"name":  {Required: true, ForceNew: true, Description: "Name of a property to set."},
+		"value": {Required: true, ForceNew: true, Description: "Value of a property to set."},
+	},
 }
 
-var kubernetesPodV1_spec_initContainer_readinessProbe_exec = ConverterSpec{
-	ResourceName: "kubernetes_pod_v1",
+var kubernetesPodV1_spec_securityContext_windowsOptions = ConverterSpec{
+	Attributes: map[string]func(any) (cty.Value, error){
+		"gmsa_credential_spec":      toString,
+		"gmsa_credential_spec_name": toString,
+		"host_process":              toBool,
+		"run_as_username":           toString,
+	},
+	Schema: map[string]FieldSchema{
+		"gmsa_credential_spec":      {Optional: true, Description: "GMSACredentialSpec is where the GMSA admission webhook inlines the contents of the GMSA credential spec named by the GMSACredentialSpecName field"},
+		"gmsa_credential_spec_name": {Optional: true, Description: "GMSACredentialSpecName is the name of the GMSA credential spec to use."},
+		"host_process":              {Optional: true, Description: "HostProcess determines if a container should be run as a 'Host Process' container. Default value is false.", Default: cty.BoolVal(false)},
+		"run_as_username":           {Optional: true, Description: "The UserName in Windows to run the entrypoint of the container process. Defaults to the user specified in image metadata if unspecified. May also be set in PodSecurityContext. If set in both SecurityContext and PodSecurityContext, the value specified in SecurityContext takes precedence."},
+	},
+}
+
+var kubernetesPodV1_spec_toleration = ConverterSpec{
+	Attributes: map[string]func(any) (cty.Value, error){
+		"effect":             toString,
+		"key":                toString,
+		"operator":           toString,
+		"toleration_seconds": toString,
+		"value":              toString,
+	},
+	Schema: map[string]FieldSchema{
+		"effect":             {Optional: true, ForceNew: true, Description: "Effect indicates the taint effect to match. Empty means match all taint effects. When specified, allowed values are NoSchedule, PreferNoSchedule and NoExecute."},
+		"key":                {Optional: true, ForceNew: true, Description: "Key is the taint key that the toleration applies to. Empty means match all taint keys. If the key is empty, operator must be Exists; this combination means to match all values and all keys."},
+		"operator":           {Optional: true, ForceNew: true, Description: "Operator represents a key's relationship to the value. Valid operators are Exists and Equal. Defaults to Equal. Exists is equivalent to wildcard for value, so that a pod can tolerate all taints of a particular category.", Default: cty.StringVal("Equal")},
+		"toleration_seconds": {Optional: true, ForceNew: true, Description: "TolerationSeconds represents the period of time the toleration (which must be of effect NoExecute, otherwise this field is ignored) tolerates the taint. By default, it is not set, which means tolerate the taint forever (do not evict). Zero and negative values will be treated as 0 (evict immediately) by the system."},
+		"value":              {Optional: true, ForceNew: true, Description: "Value is the taint value the toleration matches to. If the operator is Exists, the value should be empty, otherwise just a regular string."},
+	},
+}
+
+var kubernetesPodV1_spec_topologySpreadConstraint = ConverterSpec{
 	Attributes: map[string]func(any) (cty.Value, error){
-		"command": func(a any) (cty.Value, error) {
-			l, ok := a.([]any)
-			if !ok {
-				return cty.Value{}, fmt.Errorf("expected slice, got: %T", a)
-			}
-			vl := make([]cty.Value, 0, len(l))
-			for _, val := range l {
-				v, err := toString(val)
-				if err != nil {
-					return cty.Value{}, err
-				}
-				vl = append(vl, v)
-			}
-			return cty.ListVal(vl), nil
-		},
-	},
-	Blocks: map[string]ConverterSpec{},
+		"match_label_keys":     toList(toString),
+		"max_skew":             toInt,
+		"min_domains":          toInt,
+		"node_affinity_policy": toString,
+		"node_taints_policy":   toString,
+		"topology_key":         toString,
+		"when_unsatisfiable":   toString,
+	},
+	Blocks: map[string]ConverterSpec{
+		"label_selector": kubernetesClusterRoleV1_aggregationRule_clusterRoleSelectors,
+	},
+	Schema: map[string]FieldSchema{
+		"label_selector":       {Optional: true, Description: "A label query over a set of resources, in this case pods."},
+		"match_label_keys":     {Optional: true, ForceNew: true, Description: "is a set of pod label keys to select the pods over which spreading will be calculated."},
+		"max_skew":             {Optional: true, Description: "describes the degree to which pods may be unevenly distributed.", Default: cty.NumberIntVal(1)},
+		"min_domains":          {Optional: true, ForceNew: true, Description: "indicates a minimum number of eligible domains."},
+		"node_affinity_policy": {Optional: true, ForceNew: true, Description: "indicates how we will treat Pod's nodeAffinity/nodeSelector when calculating pod topology spread skew."},
+		"node_taints_policy":   {Optional: true, ForceNew: true, Description: "indicates how we will treat node taints when calculating pod topology spread skew."},
+		"topology_key":         {Optional: true, Description: "the key of node labels. Nodes that have a label with this key and identical values are considered to be in the same topology."},
+		"when_unsatisfiable":   {Optional: true, Description: "indicates how to deal with a pod if it doesn't satisfy the spread constraint.", Default: cty.StringVal("DoNotSchedule")},
+	},
 }
 
 var kubernetesPodV1_spec_volume = ConverterSpec{
-	ResourceName: "kubernetes_pod_v1",
 	Attributes: map[string]func(any) (cty.Value, error){
 		"name": toString,
 	},
 	Blocks: map[string]ConverterSpec{
-		"aws_elastic_block_store": kubernetesPodV1_spec_volume_awsElasticBlockStore,
-		"azure_disk":              kubernetesPodV1_spec_volume_azureDisk,
-		"azure_file":              kubernetesPodV1_spec_volume_azureFile,
-		"ceph_fs":                 kubernetesPodV1_spec_volume_cephFs,
-		"cinder":                  kubernetesPodV1_spec_volume_cinder,
+		"aws_elastic_block_store": kubernetesPersistentVolumeV1_spec_persistentVolumeSource_awsElasticBlockStore,
+		"azure_disk":              kubernetesPersistentVolumeV1_spec_persistentVolumeSource_azureDisk,
+		"azure_file":              kubernetesPersistentVolumeV1_spec_persistentVolumeSource_azureFile,
+		"ceph_fs":                 kubernetesPersistentVolumeV1_spec_persistentVolumeSource_cephFs,
+		"cinder":                  kubernetesPersistentVolumeV1_spec_persistentVolumeSource_cinder,
 		"config_map":              kubernetesPodV1_spec_volume_configMap,
 		"csi":                     kubernetesPodV1_spec_volume_csi,
 		"downward_api":            kubernetesPodV1_spec_volume_downwardApi,
 		"empty_dir":               kubernetesPodV1_spec_volume_emptyDir,
 		"ephemeral":               kubernetesPodV1_spec_volume_ephemeral,
-		"fc":                      kubernetesPodV1_spec_volume_fc,
-		"flex_volume":             kubernetesPodV1_spec_volume_flexVolume,
-		"flocker":                 kubernetesPodV1_spec_volume_flocker,
-		"gce_persistent_disk":     kubernetesPodV1_spec_volume_gcePersistentDisk,
+		"fc":                      kubernetesPersistentVolumeV1_spec_persistentVolumeSource_fc,
+		"flex_volume":             kubernetesPersistentVolumeV1_spec_persistentVolumeSource_flexVolume,
+		"flocker":                 kubernetesPersistentVolumeV1_spec_persistentVolumeSource_flocker,
+		"gce_persistent_disk":     kubernetesPersistentVolumeV1_spec_persistentVolumeSource_gcePersistentDisk,
 		"git_repo":                kubernetesPodV1_spec_volume_gitRepo,
-		"glusterfs":               kubernetesPodV1_spec_volume_glusterfs,
-		"host_path":               kubernetesPodV1_spec_volume_hostPath,
-		"iscsi":                   kubernetesPodV1_spec_volume_iscsi,
-		"local":                   kubernetesPodV1_spec_volume_local,
-		"nfs":                     kubernetesPodV1_spec_volume_nfs,
+		"glusterfs":               kubernetesPersistentVolumeV1_spec_persistentVolumeSource_glusterfs,
+		"host_path":               kubernetesPersistentVolumeV1_spec_persistentVolumeSource_hostPath,
+		"iscsi":                   kubernetesPersistentVolumeV1_spec_persistentVolumeSource_iscsi,
+		"local":                   kubernetesPersistentVolumeV1_spec_persistentVolumeSource_local,
+		"nfs":                     kubernetesPersistentVolumeV1_spec_persistentVolumeSource_nfs,
 		"persistent_volume_claim": kubernetesPodV1_spec_volume_persistentVolumeClaim,
-		"photon_persistent_disk":  kubernetesPodV1_spec_volume_photonPersistentDisk,
+		"photon_persistent_disk":  kubernetesPersistentVolumeV1_spec_persistentVolumeSource_photonPersistentDisk,
 		"projected":               kubernetesPodV1_spec_volume_projected,
-		"quobyte":                 kubernetesPodV1_spec_volume_quobyte,
-		"rbd":                     kubernetesPodV1_spec_volume_rbd,
+		"quobyte":                 kubernetesPersistentVolumeV1_spec_persistentVolumeSource_quobyte,
+		"rbd":                     kubernetesPersistentVolumeV1_spec_persistentVolumeSource_rbd,
 		"secret":                  kubernetesPodV1_spec_volume_secret,
-		"vsphere_volume":          kubernetesPodV1_spec_volume_vsphereVolume,
+		"vsphere_volume":          kubernetesPersistentVolumeV1_spec_persistentVolumeSource_vsphereVolume,
+	},
+	Schema: map[string]FieldSchema{
+		"aws_elastic_block_store": {Optional: true, MaxItems: 1, Description: "Represents an AWS Disk resource that is attached to a kubelet's host machine and then exposed to the pod. More info: https://kubernetes.io/docs/concepts/storage/volumes#awselasticblockstore"},
+		"azure_disk":              {Optional: true, MaxItems: 1, Description: "Represents an Azure Data Disk mount on the host and bind mount to the pod."},
+		"azure_file":              {Optional: true, MaxItems: 1, Description: "Represents an Azure File Service mount on the host and bind mount to the pod."},
+		"ceph_fs":                 {Optional: true, MaxItems: 1, Description: "Represents a Ceph FS mount on the host that shares a pod's lifetime"},
+		"cinder":                  {Optional: true, MaxItems: 1, Description: "Represents a cinder volume attached and mounted on kubelets host machine. More info: https://examples.k8s.io/mysql-cinder-pd/README.md"},
+		"config_map":              {Optional: true, MaxItems: 1, Description: "ConfigMap represents a configMap that should populate this volume"},
+		"csi":                     {Optional: true, MaxItems: 1, Description: "Represents a CSI Volume. More info: https://kubernetes.io/docs/concepts/storage/volumes#csi"},
+		"downward_api":            {Optional: true, MaxItems: 1, Description: "DownwardAPI represents downward API about the pod that should populate this volume"},
+		"empty_dir":               {Optional: true, MaxItems: 1, Description: "EmptyDir represents a temporary directory that shares a pod's lifetime. More info: https://kubernetes.io/docs/concepts/storage/volumes#emptydir"},
+		"ephemeral":               {Optional: true, MaxItems: 1, Description: "Represents an ephemeral volume that is handled by a normal storage driver. More info: https://kubernetes.io/docs/concepts/storage/ephemeral-volumes/#generic-ephemeral-volumes"},
+		"fc":                      {Optional: true, MaxItems: 1, Description: "Represents a Fibre Channel resource that is attached to a kubelet's host machine and then exposed to the pod."},
+		"flex_volume":             {Optional: true, MaxItems: 1, Description: "Represents a generic volume resource that is provisioned/attached using an exec based plugin. This is an alpha feature and may change in future."},
+		"flocker":                 {Optional: true, MaxItems: 1, Description: "Represents a Flocker volume attached to a kubelet's host machine and exposed to the pod for its usage. This depends on the Flocker control service being running"},
+		"gce_persistent_disk":     {Optional: true, MaxItems: 1, Description: "Represents a GCE Disk resource that is attached to a kubelet's host machine and then exposed to the pod. Provisioned by an admin. More info: https://kubernetes.io/docs/concepts/storage/volumes#gcepersistentdisk"},
+		"git_repo":                {Optional: true, MaxItems: 1, Description: "GitRepo represents a git repository at a particular revision."},
+		"glusterfs":               {Optional: true, MaxItems: 1, Description: "Represents a Glusterfs volume that is attached to a host and exposed to the pod. Provisioned by an admin. More info: https://examples.k8s.io/volumes/glusterfs/README.md"},
+		"host_path":               {Optional: true, MaxItems: 1, Description: "Represents a directory on the host. Provisioned by a developer or tester. This is useful for single-node development and testing only! On-host storage is not supported in any way and WILL NOT WORK in a multi-node cluster. More info: https://kubernetes.io/docs/concepts/storage/volumes#hostpath"},
+		"iscsi":                   {Optional: true, MaxItems: 1, Description: "Represents an ISCSI Disk resource that is attached to a kubelet's host machine and then exposed to the pod. Provisioned by an admin."},
+		"local":                   {Optional: true, MaxItems: 1, Description: "Represents a mounted local storage device such as a disk, partition or directory. Local volumes can only be used as a statically created PersistentVolume. Dynamic provisioning is not supported yet. More info: https://kubernetes.io/docs/concepts/storage/volumes#local"},
+		"name":                    {Optional: true, Description: "Volume's name. Must be a DNS_LABEL and unique within the pod. More info: https://kubernetes.io/docs/concepts/overview/working-with-objects/names/#names"},
+		"nfs":                     {Optional: true, MaxItems: 1, Description: "Represents an NFS mount on the host. Provisioned by an admin. More info: https://kubernetes.io/docs/concepts/storage/volumes#nfs"},
+		"persistent_volume_claim": {Optional: true, MaxItems: 1, Description: "The specification of a persistent volume."},
+		"photon_persistent_disk":  {Optional: true, MaxItems: 1, Description: "Represents a PhotonController persistent disk attached and mounted on kubelets host machine"},
+		"projected":               {Optional: true, Description: "Projected represents a single volume that projects several volume sources into the same directory. More info: https://kubernetes.io/docs/concepts/storage/volumes/#projected"},
+		"quobyte":                 {Optional: true, MaxItems: 1, Description: "Quobyte represents a Quobyte mount on the host that shares a pod's lifetime"},
+		"rbd":                     {Optional: true, MaxItems: 1, Description: "Represents a Rados Block Device mount on the host that shares a pod's lifetime. More info: https://examples.k8s.io/volumes/rbd/README.md"},
+		"secret":                  {Optional: true, MaxItems: 1, Description: "Secret represents a secret that should populate this volume. More info: https://kubernetes.io/docs/concepts/storage/volumes#secrets"},
+		"vsphere_volume":          {Optional: true, MaxItems: 1, Description: "Represents a vSphere volume attached and mounted on kubelets host machine"},
 	},
 }
 
-var kubernetesPodV1_spec_volume_cephFs = ConverterSpec{
-	ResourceName: "kubernetes_pod_v1",
+var kubernetesPodV1_spec_volume_configMap = ConverterSpec{
 	Attributes: map[string]func(any) (cty.Value, error){
-		"monitors": func(a any) (cty.Value, error) {
-			l, ok := a.([]any)
-			if !ok {
-				return cty.Value{}, fmt.Errorf("expected slice, got: %T", a)
-			}
-			vl := make([]cty.Value, 0, len(l))
-			for _, val := range l {
-				v, err := toString(val)
-				if err != nil {
-					return cty.Value{}, err
-				}
-				vl = append(vl, v)
-			}
-			return cty.ListVal(vl), nil
-		},
-		"path":        toString,
-		"read_only":   toBool,
-		"secret_file": toString,
-		"user":        toString,
+		"default_mode": toString,
+		"name":         toString,
+		"optional":     toBool,
 	},
 	Blocks: map[string]ConverterSpec{
-		"secret_ref": kubernetesPodV1_spec_volume_cephFs_secretRef,
+		"items": kubernetesPodV1_spec_volume_configMap_items,
+	},
+	Schema: map[string]FieldSchema{
+		"default_mode": {Optional: true, Description: "Optional: mode bits to use on created files by default. Must be a value between 0 and 0777. Defaults to 0644. Directories within the path are not affected by this setting. This might be in conflict with other options that affect the file mode, like fsGroup, and the result can be other mode bits set.", Default: cty.StringVal("0644")},
+		"items":        {Optional: true, Description: "If unspecified, each key-value pair in the Data field of the referenced ConfigMap will be projected into the volume as a file whose name is the key and content is the value. If specified, the listed keys will be projected into the specified paths, and unlisted keys will not be present. If a key is specified which is not present in the ConfigMap, the volume setup will error unless it is marked optional. Paths must be relative and may not contain the '..' path or start with '..'."},
+		"name":         {Optional: true, Description: "Name of the referent. More info: https://kubernetes.io/docs/concepts/overview/working-with-objects/names/#names"},
+		"optional":     {Optional: true, Description: "Optional: Specify whether the ConfigMap or its keys must be defined."},
 	},
 }
 
-var kubernetesPodV1_spec_volume_cephFs_secretRef = ConverterSpec{
-	ResourceName: "kubernetes_pod_v1",
+var kubernetesPodV1_spec_volume_configMap_items = ConverterSpec{
 	Attributes: map[string]func(any) (cty.Value, error){
-		"name":      toString,
-		"namespace": toString,
+		"key":  toString,
+		"mode": toString,
+		"path": toString,
+	},
+	Schema: map[string]FieldSchema{
+		"key":  {Optional: true, Description: "The key to project."},
+		"mode": {Optional: true, Description: "Optional: mode bits to use on this file, must be a value between 0 and 0777. If not specified, the volume defaultMode will be used. This might be in conflict with other options that affect the file mode, like fsGroup, and the result can be other mode bits set."},
+		"path": {Optional: true, Description: "The relative path of the file to map the key to. May not be an absolute path. May not contain the path element '..'. May not start with the string '..'."},
 	},
-	Blocks: map[string]ConverterSpec{},
 }
 
-var kubernetesPodV1_spec_volume_azureFile = ConverterSpec{
-	ResourceName: "kubernetes_pod_v1",
+var kubernetesPodV1_spec_volume_csi = ConverterSpec{
 	Attributes: map[string]func(any) (cty.Value, error){
-		"read_only":        toBool,
-		"secret_name":      toString,
-		"secret_namespace": toString,
-		"share_name":       toString,
+		"driver":            toString,
+		"fs_type":           toString,
+		"read_only":         toBool,
+		"volume_attributes": toStringMap,
+	},
+	Blocks: map[string]ConverterSpec{
+		"node_publish_secret_ref": kubernetesDefaultServiceAccountV1_imagePullSecret,
+	},
+	Schema: map[string]FieldSchema{
+		"driver":                  {Required: true, Description: "the name of the volume driver to use. More info: https://kubernetes.io/docs/concepts/storage/volumes/#csi"},
+		"fs_type":                 {Optional: true, Description: "Filesystem type to mount. Must be a filesystem type supported by the host operating system. Ex. \"ext4\", \"xfs\", \"ntfs\". Implicitly inferred to be \"ext4\" if unspecified."},
+		"node_publish_secret_ref": {Optional: true, MaxItems: 1, Description: "A reference to the secret object containing sensitive information to pass to the CSI driver to complete the CSI NodePublishVolume and NodeUnpublishVolume calls."},
+		"read_only":               {Optional: true, Description: "Whether to set the read-only property in VolumeMounts to \"true\". If omitted, the default is \"false\". More info: https://kubernetes.io/docs/concepts/storage/volumes#csi"},
+		"volume_attributes":       {Optional: true, Description: "Attributes of the volume to publish."},
 	},
-	Blocks: map[string]ConverterSpec{},
 }
 
-var kubernetesPodV1_spec_volume_nfs = ConverterSpec{
-	ResourceName: "kubernetes_pod_v1",
+var kubernetesPodV1_spec_volume_downwardApi = ConverterSpec{
 	Attributes: map[string]func(any) (cty.Value, error){
-		"path":      toString,
-		"read_only": toBool,
-		"server":    toString,
+		"default_mode": toString,
+	},
+	Blocks: map[string]ConverterSpec{
+		"items": kubernetesPodV1_spec_volume_downwardApi_items,
+	},
+	Schema: map[string]FieldSchema{
+		"default_mode": {Optional: true, Description: "Optional: mode bits to use on created files by default. Must be a value between 0 and 0777. Defaults to 0644. Directories within the path are not affected by this setting. This might be in conflict with other options that affect the file mode, like fsGroup, and the result can be other mode bits set.", Default: cty.StringVal("0644")},
+		"items":        {Optional: true, Description: "If unspecified, each key-value pair in the Data field of the referenced ConfigMap will be projected into the volume as a file whose name is the key and content is the value. If specified, the listed keys will be projected into the specified paths, and unlisted keys will not be present. If a key is specified which is not present in the ConfigMap, the volume setup will error. Paths must be relative and may not contain the '..' path or start with '..'."},
 	},
-	Blocks: map[string]ConverterSpec{},
 }
 
-var kubernetesPodV1_spec_volume_flocker = ConverterSpec{
-	ResourceName: "kubernetes_pod_v1",
+var kubernetesPodV1_spec_volume_downwardApi_items = ConverterSpec{
 	Attributes: map[string]func(any) (cty.Value, error){
-		"dataset_name": toString,
-		"dataset_uuid": toString,
+		"mode": toString,
+		"path": toString,
+	},
+	Blocks: map[string]ConverterSpec{
+		"field_ref":          kubernetesEnv_env_valueFrom_fieldRef,
+		"resource_field_ref": kubernetesPodV1_spec_volume_downwardApi_items_resourceFieldRef,
+	},
+	Schema: map[string]FieldSchema{
+		"field_ref":          {Required: true, MaxItems: 1, Description: "Required: Selects a field of the pod: only annotations, labels, name and namespace are supported."},
+		"mode":               {Optional: true, Description: "Optional: mode bits to use on this file, must be a value between 0 and 0777. If not specified, the volume defaultMode will be used. This might be in conflict with other options that affect the file mode, like fsGroup, and the result can be other mode bits set."},
+		"path":               {Required: true, Description: "Path is the relative path name of the file to be created. Must not be absolute or contain the '..' path. Must be utf-8 encoded. The first item of the relative path must not start with '..'"},
+		"resource_field_ref": {Optional: true, MaxItems: 1, Description: "Selects a resource of the container: only resources limits and requests (limits.cpu, limits.memory, requests.cpu and requests.memory) are currently supported."},
 	},
-	Blocks: map[string]ConverterSpec{},
 }
 
-var kubernetesPodV1_spec_volume_local = ConverterSpec{
-	ResourceName: "kubernetes_pod_v1",
+var kubernetesPodV1_spec_volume_downwardApi_items_resourceFieldRef = ConverterSpec{
 	Attributes: map[string]func(any) (cty.Value, error){
-		"path": toString,
+		"container_name": toString,
+		"divisor":        toString,
+		"resource":       toString,
+	},
+	Schema: map[string]FieldSchema{
+		"container_name": {Required: true},
+		"divisor":        {Optional: true, Default: cty.StringVal("1")},
+		"resource":       {Required: true, Description: "Resource to select"},
 	},
-	Blocks: map[string]ConverterSpec{},
 }
 
-var kubernetesPodV1_spec_volume_csi = ConverterSpec{
-	ResourceName: "kubernetes_pod_v1",
+var kubernetesPodV1_spec_volume_emptyDir = ConverterSpec{
 	Attributes: map[string]func(any) (cty.Value, error){
-		"driver":            toString,
-		"fs_type":           toString,
-		"read_only":         toBool,
-		"volume_attributes": toStringMap,
+		"medium":     toString,
+		"size_limit": toString,
+	},
+	Schema: map[string]FieldSchema{
+		"medium":     {Optional: true, ForceNew: true, Description: "What type of storage medium should back this directory. The default is \"\" which means to use the node's default medium. Must be one of [\"\" \"Memory\" \"HugePages\" \"HugePages-2Mi\" \"HugePages-1Gi\"]. More info: https://kubernetes.io/docs/concepts/storage/volumes#emptydir", Default: cty.StringVal("")},
+		"size_limit": {Optional: true, ForceNew: true, Description: "Total amount of local storage required for this EmptyDir volume."},
+	},
+}
+
+var kubernetesPodV1_spec_volume_ephemeral = ConverterSpec{
+	Attributes: map[string]func(any) (cty.Value, error){},
+	Blocks: map[string]ConverterSpec{
+		"volume_claim_template": kubernetesPodV1_spec_volume_ephemeral_volumeClaimTemplate,
 	},
+	Schema: map[string]FieldSchema{
+		"volume_claim_template": {Required: true, MaxItems: 1, Description: "Will be used to create a stand-alone PVC to provision the volume. The pod in which this EphemeralVolumeSource is embedded will be the owner of the PVC."},
+	},
+}
+
+var kubernetesPodV1_spec_volume_ephemeral_volumeClaimTemplate = ConverterSpec{
+	Attributes: map[string]func(any) (cty.Value, error){},
 	Blocks: map[string]ConverterSpec{
-		"node_publish_secret_ref": kubernetesPodV1_spec_volume_csi_nodePublishSecretRef,
+		"metadata": kubernetesPodV1_spec_volume_ephemeral_volumeClaimTemplate_metadata,
+		"spec":     kubernetesPersistentVolumeClaimV1_spec,
+	},
+	Schema: map[string]FieldSchema{
+		"metadata": {Optional: true, MaxItems: 1, Description: "May contain labels and annotations that will be copied into the PVC when creating it. No other fields are allowed and will be rejected during validation."},
+		"spec":     {Required: true, MaxItems: 1, Description: "The specification for the PersistentVolumeClaim. The entire content is copied unchanged into the PVC that gets created from this template. The same fields as in a PersistentVolumeClaim are also valid here."},
 	},
 }
 
-var kubernetesPodV1_spec_volume_csi_nodePublishSecretRef = ConverterSpec{
-	ResourceName: "kubernetes_pod_v1",
+var kubernetesPodV1_spec_volume_ephemeral_volumeClaimTemplate_metadata = ConverterSpec{
 	Attributes: map[string]func(any) (cty.Value, error){
-		"name": toString,
+		"annotations": toStringMap,
+		"labels":      toStringMap,
+	},
+	Schema: map[string]FieldSchema{
+		"annotations": {Optional: true, Description: "An unstructured key value map stored with the persistent volume claim that may be used to store arbitrary metadata. More info: https://kubernetes.io/docs/concepts/overview/working-with-objects/annotations/"},
+		"labels":      {Optional: true, Description: "Map of string keys and values that can be used to organize and categorize (scope and select) the persistent volume claim. May match selectors of replication controllers and services. More info: https://kubernetes.io/docs/concepts/overview/working-with-objects/labels/"},
+	},
+}
+
+var kubernetesPodV1_spec_volume_gitRepo = ConverterSpec{
+	Attributes: map[string]func(any) (cty.Value, error){
+		"directory":  toString,
+		"repository": toString,
+		"revision":   toString,
+	},
+	Schema: map[string]FieldSchema{
+		"directory":  {Optional: true, Description: "Target directory name. Must not contain or start with '..'. If '.' is supplied, the volume directory will be the git repository. Otherwise, if specified, the volume will contain the git repository in the subdirectory with the given name."},
+		"repository": {Optional: true, Description: "Repository URL"},
+		"revision":   {Optional: true, Description: "Commit hash for the specified revision."},
+	},
+}
+
+var kubernetesPodV1_spec_volume_persistentVolumeClaim = ConverterSpec{
+	Attributes: map[string]func(any) (cty.Value, error){
+		"claim_name": toString,
+		"read_only":  toBool,
+	},
+	Schema: map[string]FieldSchema{
+		"claim_name": {Optional: true, Description: "ClaimName is the name of a PersistentVolumeClaim in the same "},
+		"read_only":  {Optional: true, Description: "Will force the ReadOnly setting in VolumeMounts.", Default: cty.BoolVal(false)},
 	},
-	Blocks: map[string]ConverterSpec{},
 }
 
 var kubernetesPodV1_spec_volume_projected = ConverterSpec{
-	ResourceName: "kubernetes_pod_v1",
 	Attributes: map[string]func(any) (cty.Value, error){
 		"default_mode": toString,
 	},
 	Blocks: map[string]ConverterSpec{
 		"sources": kubernetesPodV1_spec_volume_projected_sources,
 	},
+	Schema: map[string]FieldSchema{
+		"default_mode": {Optional: true, Description: "Optional: mode bits to use on created files by default. Must be a value between 0 and 0777. Defaults to 0644. Directories within the path are not affected by this setting. This might be in conflict with other options that affect the file mode, like fsGroup, and the result can be other mode bits set.", Default: cty.StringVal("0644")},
+		"sources":      {Required: true, MinItems: 1, Description: "Source of the volume to project in the directory."},
+	},
 }
 
 var kubernetesPodV1_spec_volume_projected_sources = ConverterSpec{
-	ResourceName: "kubernetes_pod_v1",
-	Attributes:   map[string]func(any) (cty.Value, error){},
+	Attributes: map[string]func(any) (cty.Value, error){},
 	Blocks: map[string]ConverterSpec{
 		"config_map":            kubernetesPodV1_spec_volume_projected_sources_configMap,
 		"downward_api":          kubernetesPodV1_spec_volume_projected_sources_downwardApi,
 		"secret":                kubernetesPodV1_spec_volume_projected_sources_secret,
 		"service_account_token": kubernetesPodV1_spec_volume_projected_sources_serviceAccountToken,
 	},
+	Schema: map[string]FieldSchema{
+		"config_map":            {Optional: true, Description: "ConfigMap represents a configMap that should populate this volume"},
+		"downward_api":          {Optional: true, MaxItems: 1, Description: "DownwardAPI represents downward API about the pod that should populate this volume"},
+		"secret":                {Optional: true, Description: "Secret represents a secret that should populate this volume. More info: https://kubernetes.io/docs/concepts/storage/volumes#secrets"},
+		"service_account_token": {Optional: true, MaxItems: 1, Description: "A projected service account token volume"},
+	},
 }
 
 var kubernetesPodV1_spec_volume_projected_sources_configMap = ConverterSpec{
-	ResourceName: "kubernetes_pod_v1",
 	Attributes: map[string]func(any) (cty.Value, error){
 		"name":     toString,
 		"optional": toBool,
 	},
 	Blocks: map[string]ConverterSpec{
-		"items": kubernetesPodV1_spec_volume_projected_sources_configMap_items,
+		"items": kubernetesPodV1_spec_volume_configMap_items,
 	},
-}
-
-var kubernetesPodV1_spec_volume_projected_sources_configMap_items = ConverterSpec{
-	ResourceName: "kubernetes_pod_v1",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"key":  toString,
-		"mode": toString,
-		"path": toString,
+	Schema: map[string]FieldSchema{
+		"items":    {Optional: true, Description: "If unspecified, each key-value pair in the Data field of the referenced ConfigMap will be projected into the volume as a file whose name is the key and content is the value. If specified, the listed keys will be projected into the specified paths, and unlisted keys will not be present. If a key is specified which is not present in the ConfigMap, the volume setup will error. Paths must be relative and may not contain the '..' path or start with '..'."},
+		"name":     {Optional: true, Description: "Name of the referent. More info: https://kubernetes.io/docs/concepts/overview/working-with-objects/names/#names"},
+		"optional": {Optional: true, Description: "Optional: Specify whether the ConfigMap or it's keys must be defined."},
 	},
-	Blocks: map[string]ConverterSpec{},
-}
-
-var kubernetesPodV1_spec_volume_projected_sources_secret = ConverterSpec{
-	ResourceName: "kubernetes_pod_v1",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"name":     toString,
-		"optional": toBool,
-	},
-	Blocks: map[string]ConverterSpec{
-		"items": kubernetesPodV1_spec_volume_projected_sources_secret_items,
-	},
-}
-
-var kubernetesPodV1_spec_volume_projected_sources_secret_items = ConverterSpec{
-	ResourceName: "kubernetes_pod_v1",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"key":  toString,
-		"mode": toString,
-		"path": toString,
-	},
-	Blocks: map[string]ConverterSpec{},
-}
-
-var kubernetesPodV1_spec_volume_projected_sources_serviceAccountToken = ConverterSpec{
-	ResourceName: "kubernetes_pod_v1",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"audience":           toString,
-		"expiration_seconds": toInt,
-		"path":               toString,
-	},
-	Blocks: map[string]ConverterSpec{},
 }
 
 var kubernetesPodV1_spec_volume_projected_sources_downwardApi = ConverterSpec{
-	ResourceName: "kubernetes_pod_v1",
-	Attributes:   map[string]func(any) (cty.Value, error){},
+	Attributes: map[string]func(any) (cty.Value, error){},
 	Blocks: map[string]ConverterSpec{
 		"items": kubernetesPodV1_spec_volume_projected_sources_downwardApi_items,
 	},
+	Schema: map[string]FieldSchema{
+		"items": {Optional: true, Description: "Represents a volume containing downward API info. Downward API volumes support ownership management and SELinux relabeling."},
+	},
 }
 
 var kubernetesPodV1_spec_volume_projected_sources_downwardApi_items = ConverterSpec{
-	ResourceName: "kubernetes_pod_v1",
 	Attributes: map[string]func(any) (cty.Value, error){
 		"mode": toString,
 		"path": toString,
 	},
 	Blocks: map[string]ConverterSpec{
 		"field_ref":          kubernetesPodV1_spec_volume_projected_sources_downwardApi_items_fieldRef,
-		"resource_field_ref": kubernetesPodV1_spec_volume_projected_sources_downwardApi_items_resourceFieldRef,
+		"resource_field_ref": kubernetesPodV1_spec_volume_downwardApi_items_resourceFieldRef,
+	},
+	Schema: map[string]FieldSchema{
+		"field_ref":          {Optional: true, MaxItems: 1, Description: "Selects a field of the pod: only annotations, labels, name and namespace are supported."},
+		"mode":               {Optional: true, Description: "Mode bits to use on this file, must be a value between 0 and 0777. If not specified, the volume defaultMode will be used. This might be in conflict with other options that affect the file mode, like fsGroup, and the result can be other mode bits set."},
+		"path":               {Required: true, Description: "Path is the relative path name of the file to be created. Must not be absolute or contain the '..' path. Must be utf-8 encoded. The first item of the relative path must not start with '..'"},
+		"resource_field_ref": {Optional: true, MaxItems: 1, Description: "Selects a resource of the container: only resources limits and requests (limits.cpu, limits.memory, requests.cpu and requests.memory) are currently supported."},
 	},
 }
 
 var kubernetesPodV1_spec_volume_projected_sources_downwardApi_items_fieldRef = ConverterSpec{
-	ResourceName: "kubernetes_pod_v1",
 	Attributes: map[string]func(any) (cty.Value, error){
 		"api_version": toString,
 		"field_path":  toString,
 	},
-	Blocks: map[string]ConverterSpec{},
-}
-
-var kubernetesPodV1_spec_volume_projected_sources_downwardApi_items_resourceFieldRef = ConverterSpec{
-	ResourceName: "kubernetes_pod_v1",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"container_name": toString,
-		"divisor":        toString,
-		"resource":       toString,
-	},
-	Blocks: map[string]ConverterSpec{},
-}
-
-var kubernetesPodV1_spec_volume_gitRepo = ConverterSpec{
-	ResourceName: "kubernetes_pod_v1",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"directory":  toString,
-		"repository": toString,
-		"revision":   toString,
+	Schema: map[string]FieldSchema{
+		"api_version": {Optional: true, Description: "Version of the schema the FieldPath is written in terms of, defaults to 'v1'.", Default: cty.StringVal("v1")},
+		"field_path":  {Optional: true, Description: "Path of the field to select in the specified API version"},
 	},
-	Blocks: map[string]ConverterSpec{},
 }
 
-var kubernetesPodV1_spec_volume_configMap = ConverterSpec{
-	ResourceName: "kubernetes_pod_v1",
+var kubernetesPodV1_spec_volume_projected_sources_secret = ConverterSpec{
 	Attributes: map[string]func(any) (cty.Value, error){
-		"default_mode": toString,
-		"name":         toString,
-		"optional":     toBool,
+		"name":     toString,
+		"optional": toBool,
 	},
 	Blocks: map[string]ConverterSpec{
 		"items": kubernetesPodV1_spec_volume_configMap_items,
 	},
-}
-
-var kubernetesPodV1_spec_volume_configMap_items = ConverterSpec{
-	ResourceName: "kubernetes_pod_v1",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"key":  toString,
-		"mode": toString,
-		"path": toString,
-	},
-	Blocks: map[string]ConverterSpec{},
-}
-
-var kubernetesPodV1_spec_volume_vsphereVolume = ConverterSpec{
-	ResourceName: "kubernetes_pod_v1",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"fs_type":     toString,
-		"volume_path": toString,
-	},
-	Blocks: map[string]ConverterSpec{},
-}
-
-var kubernetesPodV1_spec_volume_gcePersistentDisk = ConverterSpec{
-	ResourceName: "kubernetes_pod_v1",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"fs_type":   toString,
-		"partition": toInt,
-		"pd_name":   toString,
-		"read_only": toBool,
+	Schema: map[string]FieldSchema{
+		"items":    {Optional: true, Description: "If unspecified, each key-value pair in the Data field of the referenced Secret will be projected into the volume as a file whose name is the key and content is the value. If specified, the listed keys will be projected into the specified paths, and unlisted keys will not be present. If a key is specified which is not present in the Secret, the volume setup will error unless it is marked optional. Paths must be relative and may not contain the '..' path or start with '..'."},
+		"name":     {Optional: true, Description: "Name of the secret in the pod's namespace to use. More info: https://kubernetes.io/docs/concepts/storage/volumes#secrets"},
+		"optional": {Optional: true, Description: "Optional: Specify whether the Secret or it's keys must be defined."},
 	},
-	Blocks: map[string]ConverterSpec{},
 }
 
-var kubernetesPodV1_spec_volume_azureDisk = ConverterSpec{
-	ResourceName: "kubernetes_pod_v1",
+var kubernetesPodV1_spec_volume_projected_sources_serviceAccountToken = ConverterSpec{
 	Attributes: map[string]func(any) (cty.Value, error){
-		"caching_mode":  toString,
-		"data_disk_uri": toString,
-		"disk_name":     toString,
-		"fs_type":       toString,
-		"kind":          toString,
-		"read_only":     toBool,
+		"audience":           toString,
+		"expiration_seconds": toInt,
+		"path":               toString,
 	},
-	Blocks: map[string]ConverterSpec{},
-}
-
-var kubernetesPodV1_spec_volume_awsElasticBlockStore = ConverterSpec{
-	ResourceName: "kubernetes_pod_v1",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"fs_type":   toString,
-		"partition": toInt,
-		"read_only": toBool,
-		"volume_id": toString,
+	Schema: map[string]FieldSchema{
+		"audience":           {Optional: true, Description: "Audience is the intended audience of the token"},
+		"expiration_seconds": {Optional: true, Description: "ExpirationSeconds is the expected duration of validity of the service account token. It defaults to 1 hour and must be at least 10 minutes (600 seconds).", Default: cty.NumberIntVal(3600)},
+		"path":               {Required: true, Description: "Path specifies a relative path to the mount point of the projected volume."},
 	},
-	Blocks: map[string]ConverterSpec{},
 }
 
 var kubernetesPodV1_spec_volume_secret = ConverterSpec{
-	ResourceName: "kubernetes_pod_v1",
 	Attributes: map[string]func(any) (cty.Value, error){
 		"default_mode": toString,
 		"optional":     toBool,
 		"secret_name":  toString,
 	},
 	Blocks: map[string]ConverterSpec{
-		"items": kubernetesPodV1_spec_volume_secret_items,
-	},
-}
-
-var kubernetesPodV1_spec_volume_secret_items = ConverterSpec{
-	ResourceName: "kubernetes_pod_v1",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"key":  toString,
-		"mode": toString,
-		"path": toString,
-	},
-	Blocks: map[string]ConverterSpec{},
-}
-
-var kubernetesPodV1_spec_volume_persistentVolumeClaim = ConverterSpec{
-	ResourceName: "kubernetes_pod_v1",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"claim_name": toString,
-		"read_only":  toBool,
-	},
-	Blocks: map[string]ConverterSpec{},
-}
-
-var kubernetesPodV1_spec_volume_ephemeral = ConverterSpec{
-	ResourceName: "kubernetes_pod_v1",
-	Attributes:   map[string]func(any) (cty.Value, error){},
-	Blocks: map[string]ConverterSpec{
-		"volume_claim_template": kubernetesPodV1_spec_volume_ephemeral_volumeClaimTemplate,
-	},
-}
-
-var kubernetesPodV1_spec_volume_ephemeral_volumeClaimTemplate = ConverterSpec{
-	ResourceName: "kubernetes_pod_v1",
-	Attributes:   map[string]func(any) (cty.Value, error){},
-	Blocks: map[string]ConverterSpec{
-		"metadata": kubernetesPodV1_spec_volume_ephemeral_volumeClaimTemplate_metadata,
-		"spec":     kubernetesPodV1_spec_volume_ephemeral_volumeClaimTemplate_spec,
-	},
-}
-
-var kubernetesPodV1_spec_volume_ephemeral_volumeClaimTemplate_spec = ConverterSpec{
-	ResourceName: "kubernetes_pod_v1",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"access_modes": func(a any) (cty.Value, error) {
-			l, ok := a.([]any)
-			if !ok {
-				return cty.Value{}, fmt.Errorf("expected slice, got: %T", a)
-			}
-			vl := make([]cty.Value, 0, len(l))
-			for _, val := range l {
-				v, err := toString(val)
-				if err != nil {
-					return cty.Value{}, err
-				}
-				vl = append(vl, v)
-			}
-			return cty.ListVal(vl), nil
-		},
-		"storage_class_name": toString,
-		"volume_mode":        toString,
-		"volume_name":        toString,
-	},
-	Blocks: map[string]ConverterSpec{
-		"resources": kubernetesPodV1_spec_volume_ephemeral_volumeClaimTemplate_spec_resources,
-		"selector":  kubernetesPodV1_spec_volume_ephemeral_volumeClaimTemplate_spec_selector,
-	},
-}
-
-var kubernetesPodV1_spec_volume_ephemeral_volumeClaimTemplate_spec_selector = ConverterSpec{
-	ResourceName: "kubernetes_pod_v1",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"match_labels": toStringMap,
-	},
-	Blocks: map[string]ConverterSpec{
-		"match_expressions": kubernetesPodV1_spec_volume_ephemeral_volumeClaimTemplate_spec_selector_matchExpressions,
-	},
-}
-
-var kubernetesPodV1_spec_volume_ephemeral_volumeClaimTemplate_spec_selector_matchExpressions = ConverterSpec{
-	ResourceName: "kubernetes_pod_v1",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"key":      toString,
-		"operator": toString,
-		"values": func(a any) (cty.Value, error) {
-			l, ok := a.([]any)
-			if !ok {
-				return cty.Value{}, fmt.Errorf("expected slice, got: %T", a)
-			}
-			vl := make([]cty.Value, 0, len(l))
-			for _, val := range l {
-				v, err := toString(val)
-				if err != nil {
-					return cty.Value{}, err
-				}
-				vl = append(vl, v)
-			}
-			return cty.ListVal(vl), nil
-		},
-	},
-	Blocks: map[string]ConverterSpec{},
-}
-
-var kubernetesPodV1_spec_volume_ephemeral_volumeClaimTemplate_spec_resources = ConverterSpec{
-	ResourceName: "kubernetes_pod_v1",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"limits":   toStringMap,
-		"requests": toStringMap,
-	},
-	Blocks: map[string]ConverterSpec{},
-}
-
-var kubernetesPodV1_spec_volume_ephemeral_volumeClaimTemplate_metadata = ConverterSpec{
-	ResourceName: "kubernetes_pod_v1",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"annotations": toStringMap,
-		"labels":      toStringMap,
-	},
-	Blocks: map[string]ConverterSpec{},
-}
-
-var kubernetesPodV1_spec_volume_emptyDir = ConverterSpec{
-	ResourceName: "kubernetes_pod_v1",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"medium":     toString,
-		"size_limit": toString,
-	},
-	Blocks: map[string]ConverterSpec{},
-}
-
-var kubernetesPodV1_spec_volume_rbd = ConverterSpec{
-	ResourceName: "kubernetes_pod_v1",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"ceph_monitors": func(a any) (cty.Value, error) {
-			l, ok := a.([]any)
-			if !ok {
-				return cty.Value{}, fmt.Errorf("expected slice, got: %T", a)
-			}
-			vl := make([]cty.Value, 0, len(l))
-			for _, val := range l {
-				v, err := toString(val)
-				if err != nil {
-					return cty.Value{}, err
-				}
-				vl = append(vl, v)
-			}
-			return cty.ListVal(vl), nil
-		},
-		"fs_type":    toString,
-		"keyring":    toString,
-		"rados_user": toString,
-		"rbd_image":  toString,
-		"rbd_pool":   toString,
-		"read_only":  toBool,
-	},
-	Blocks: map[string]ConverterSpec{
-		"secret_ref": kubernetesPodV1_spec_volume_rbd_secretRef,
-	},
-}
-
-var kubernetesPodV1_spec_volume_rbd_secretRef = ConverterSpec{
-	ResourceName: "kubernetes_pod_v1",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"name":      toString,
-		"namespace": toString,
-	},
-	Blocks: map[string]ConverterSpec{},
-}
-
-var kubernetesPodV1_spec_volume_fc = ConverterSpec{
-	ResourceName: "kubernetes_pod_v1",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"fs_type":   toString,
-		"lun":       toInt,
-		"read_only": toBool,
-		"target_ww_ns": func(a any) (cty.Value, error) {
-			l, ok := a.([]any)
-			if !ok {
-				return cty.Value{}, fmt.Errorf("expected slice, got: %T", a)
-			}
-			vl := make([]cty.Value, 0, len(l))
-			for _, val := range l {
-				v, err := toString(val)
-				if err != nil {
-					return cty.Value{}, err
-				}
-				vl = append(vl, v)
-			}
-			return cty.ListVal(vl), nil
-		},
-	},
-	Blocks: map[string]ConverterSpec{},
-}
-
-var kubernetesPodV1_spec_volume_cinder = ConverterSpec{
-	ResourceName: "kubernetes_pod_v1",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"fs_type":   toString,
-		"read_only": toBool,
-		"volume_id": toString,
-	},
-	Blocks: map[string]ConverterSpec{},
-}
-
-var kubernetesPodV1_spec_volume_hostPath = ConverterSpec{
-	ResourceName: "kubernetes_pod_v1",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"path": toString,
-		"type": toString,
-	},
-	Blocks: map[string]ConverterSpec{},
-}
-
-var kubernetesPodV1_spec_volume_downwardApi = ConverterSpec{
-	ResourceName: "kubernetes_pod_v1",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"default_mode": toString,
-	},
-	Blocks: map[string]ConverterSpec{
-		"items": kubernetesPodV1_spec_volume_downwardApi_items,
-	},
-}
-
-var kubernetesPodV1_spec_volume_downwardApi_items = ConverterSpec{
-	ResourceName: "kubernetes_pod_v1",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"mode": toString,
-		"path": toString,
-	},
-	Blocks: map[string]ConverterSpec{
-		"field_ref":          kubernetesPodV1_spec_volume_downwardApi_items_fieldRef,
-		"resource_field_ref": kubernetesPodV1_spec_volume_downwardApi_items_resourceFieldRef,
-	},
-}
-
-var kubernetesPodV1_spec_volume_downwardApi_items_resourceFieldRef = ConverterSpec{
-	ResourceName: "kubernetes_pod_v1",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"container_name": toString,
-		"divisor":        toString,
-		"resource":       toString,
-	},
-	Blocks: map[string]ConverterSpec{},
-}
-
-var kubernetesPodV1_spec_volume_downwardApi_items_fieldRef = ConverterSpec{
-	ResourceName: "kubernetes_pod_v1",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"api_version": toString,
-		"field_path":  toString,
-	},
-	Blocks: map[string]ConverterSpec{},
-}
-
-var kubernetesPodV1_spec_volume_quobyte = ConverterSpec{
-	ResourceName: "kubernetes_pod_v1",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"group":     toString,
-		"read_only": toBool,
-		"registry":  toString,
-		"user":      toString,
-		"volume":    toString,
-	},
-	Blocks: map[string]ConverterSpec{},
-}
-
-var kubernetesPodV1_spec_volume_photonPersistentDisk = ConverterSpec{
-	ResourceName: "kubernetes_pod_v1",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"fs_type": toString,
-		"pd_id":   toString,
-	},
-	Blocks: map[string]ConverterSpec{},
-}
-
-var kubernetesPodV1_spec_volume_iscsi = ConverterSpec{
-	ResourceName: "kubernetes_pod_v1",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"fs_type":         toString,
-		"iqn":             toString,
-		"iscsi_interface": toString,
-		"lun":             toInt,
-		"read_only":       toBool,
-		"target_portal":   toString,
-	},
-	Blocks: map[string]ConverterSpec{},
-}
-
-var kubernetesPodV1_spec_volume_glusterfs = ConverterSpec{
-	ResourceName: "kubernetes_pod_v1",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"endpoints_name": toString,
-		"path":           toString,
-		"read_only":      toBool,
-	},
-	Blocks: map[string]ConverterSpec{},
-}
-
-var kubernetesPodV1_spec_volume_flexVolume = ConverterSpec{
-	ResourceName: "kubernetes_pod_v1",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"driver":    toString,
-		"fs_type":   toString,
-		"options":   toStringMap,
-		"read_only": toBool,
-	},
-	Blocks: map[string]ConverterSpec{
-		"secret_ref": kubernetesPodV1_spec_volume_flexVolume_secretRef,
-	},
-}
-
-var kubernetesPodV1_spec_volume_flexVolume_secretRef = ConverterSpec{
-	ResourceName: "kubernetes_pod_v1",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"name":      toString,
-		"namespace": toString,
-	},
-	Blocks: map[string]ConverterSpec{},
-}
-
-var kubernetesPodV1_spec_imagePullSecrets = ConverterSpec{
-	ResourceName: "kubernetes_pod_v1",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"name": toString,
+		"items": kubernetesPodV1_spec_volume_configMap_items,
 	},
-	Blocks: map[string]ConverterSpec{},
-}
-
-var kubernetesPodV1_spec_hostAliases = ConverterSpec{
-	ResourceName: "kubernetes_pod_v1",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"hostnames": func(a any) (cty.Value, error) {
-			l, ok := a.([]any)
-			if !ok {
-				return cty.Value{}, fmt.Errorf("expected slice, got: %T", a)
-			}
-			vl := make([]cty.Value, 0, len(l))
-			for _, val := range l {
-				v, err := toString(val)
-				if err != nil {
-					return cty.Value{}, err
-				}
-				vl = append(vl, v)
-			}
-			return cty.ListVal(vl), nil
-		},
-		"ip": toString,
-	},
-	Blocks: map[string]ConverterSpec{},
-}
-
-var kubernetesPodV1_spec_container = ConverterSpec{
-	ResourceName: "kubernetes_pod_v1",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"args": func(a any) (cty.Value, error) {
-			l, ok := a.([]any)
-			if !ok {
-				return cty.Value{}, fmt.Errorf("expected slice, got: %T", a)
-			}
-			vl := make([]cty.Value, 0, len(l))
-			for _, val := range l {
-				v, err := toString(val)
-				if err != nil {
-					return cty.Value{}, err
-				}
-				vl = append(vl, v)
-			}
-			return cty.ListVal(vl), nil
-		},
-		"command": func(a any) (cty.Value, error) {
-			l, ok := a.([]any)
-			if !ok {
-				return cty.Value{}, fmt.Errorf("expected slice, got: %T", a)
-			}
-			vl := make([]cty.Value, 0, len(l))
-			for _, val := range l {
-				v, err := toString(val)
-				if err != nil {
-					return cty.Value{}, err
-				}
-				vl = append(vl, v)
-			}
-			return cty.ListVal(vl), nil
-		},
-		"image":                      toString,
-		"image_pull_policy":          toString,
-		"name":                       toString,
-		"stdin":                      toBool,
-		"stdin_once":                 toBool,
-		"termination_message_path":   toString,
-		"termination_message_policy": toString,
-		"tty":                        toBool,
-		"working_dir":                toString,
-	},
-	Blocks: map[string]ConverterSpec{
-		"env":              kubernetesPodV1_spec_container_env,
-		"env_from":         kubernetesPodV1_spec_container_envFrom,
-		"lifecycle":        kubernetesPodV1_spec_container_lifecycle,
-		"liveness_probe":   kubernetesPodV1_spec_container_livenessProbe,
-		"port":             kubernetesPodV1_spec_container_port,
-		"readiness_probe":  kubernetesPodV1_spec_container_readinessProbe,
-		"resources":        kubernetesPodV1_spec_container_resources,
-		"security_context": kubernetesPodV1_spec_container_securityContext,
-		"startup_probe":    kubernetesPodV1_spec_container_startupProbe,
-		"volume_device":    kubernetesPodV1_spec_container_volumeDevice,
-		"volume_mount":     kubernetesPodV1_spec_container_volumeMount,
-	},
-}
-
-var kubernetesPodV1_spec_container_lifecycle = ConverterSpec{
-	ResourceName: "kubernetes_pod_v1",
-	Attributes:   map[string]func(any) (cty.Value, error){},
-	Blocks: map[string]ConverterSpec{
-		"post_start": kubernetesPodV1_spec_container_lifecycle_postStart,
-		"pre_stop":   kubernetesPodV1_spec_container_lifecycle_preStop,
-	},
-}
-
-var kubernetesPodV1_spec_container_lifecycle_preStop = ConverterSpec{
-	ResourceName: "kubernetes_pod_v1",
-	Attributes:   map[string]func(any) (cty.Value, error){},
-	Blocks: map[string]ConverterSpec{
-		"exec":       kubernetesPodV1_spec_container_lifecycle_preStop_exec,
-		"http_get":   kubernetesPodV1_spec_container_lifecycle_preStop_httpGet,
-		"tcp_socket": kubernetesPodV1_spec_container_lifecycle_preStop_tcpSocket,
-	},
-}
-
-var kubernetesPodV1_spec_container_lifecycle_preStop_tcpSocket = ConverterSpec{
-	ResourceName: "kubernetes_pod_v1",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"port": toString,
-	},
-	Blocks: map[string]ConverterSpec{},
-}
-
-var kubernetesPodV1_spec_container_lifecycle_preStop_httpGet = ConverterSpec{
-	ResourceName: "kubernetes_pod_v1",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"host":   toString,
-		"path":   toString,
-		"port":   toString,
-		"scheme": toString,
-	},
-	Blocks: map[string]ConverterSpec{
-		"http_header": kubernetesPodV1_spec_container_lifecycle_preStop_httpGet_httpHeader,
-	},
-}
-
-var kubernetesPodV1_spec_container_lifecycle_preStop_httpGet_httpHeader = ConverterSpec{
-	ResourceName: "kubernetes_pod_v1",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"name":  toString,
-		"value": toString,
-	},
-	Blocks: map[string]ConverterSpec{},
-}
-
-var kubernetesPodV1_spec_container_lifecycle_preStop_exec = ConverterSpec{
-	ResourceName: "kubernetes_pod_v1",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"command": func(a any) (cty.Value, error) {
-			l, ok := a.([]any)
-			if !ok {
-				return cty.Value{}, fmt.Errorf("expected slice, got: %T", a)
-			}
-			vl := make([]cty.Value, 0, len(l))
-			for _, val := range l {
-				v, err := toString(val)
-				if err != nil {
-					return cty.Value{}, err
-				}
-				vl = append(vl, v)
-			}
-			return cty.ListVal(vl), nil
-		},
-	},
-	Blocks: map[string]ConverterSpec{},
-}
-
-var kubernetesPodV1_spec_container_lifecycle_postStart = ConverterSpec{
-	ResourceName: "kubernetes_pod_v1",
-	Attributes:   map[string]func(any) (cty.Value, error){},
-	Blocks: map[string]ConverterSpec{
-		"exec":       kubernetesPodV1_spec_container_lifecycle_postStart_exec,
-		"http_get":   kubernetesPodV1_spec_container_lifecycle_postStart_httpGet,
-		"tcp_socket": kubernetesPodV1_spec_container_lifecycle_postStart_tcpSocket,
-	},
-}
-
-var kubernetesPodV1_spec_container_lifecycle_postStart_httpGet = ConverterSpec{
-	ResourceName: "kubernetes_pod_v1",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"host":   toString,
-		"path":   toString,
-		"port":   toString,
-		"scheme": toString,
-	},
-	Blocks: map[string]ConverterSpec{
-		"http_header": kubernetesPodV1_spec_container_lifecycle_postStart_httpGet_httpHeader,
-	},
-}
-
-var kubernetesPodV1_spec_container_lifecycle_postStart_httpGet_httpHeader = ConverterSpec{
-	ResourceName: "kubernetes_pod_v1",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"name":  toString,
-		"value": toString,
-	},
-	Blocks: map[string]ConverterSpec{},
-}
-
-var kubernetesPodV1_spec_container_lifecycle_postStart_exec = ConverterSpec{
-	ResourceName: "kubernetes_pod_v1",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"command": func(a any) (cty.Value, error) {
-			l, ok := a.([]any)
-			if !ok {
-				return cty.Value{}, fmt.Errorf("expected slice, got: %T", a)
-			}
-			vl := make([]cty.Value, 0, len(l))
-			for _, val := range l {
-				v, err := toString(val)
-				if err != nil {
-					return cty.Value{}, err
-				}
-				vl = append(vl, v)
-			}
-			return cty.ListVal(vl), nil
-		},
-	},
-	Blocks: map[string]ConverterSpec{},
-}
-
-var kubernetesPodV1_spec_container_lifecycle_postStart_tcpSocket = ConverterSpec{
-	ResourceName: "kubernetes_pod_v1",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"port": toString,
-	},
-	Blocks: map[string]ConverterSpec{},
-}
-
-var kubernetesPodV1_spec_container_env = ConverterSpec{
-	ResourceName: "kubernetes_pod_v1",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"name":  toString,
-		"value": toString,
-	},
-	Blocks: map[string]ConverterSpec{
-		"value_from": kubernetesPodV1_spec_container_env_valueFrom,
-	},
-}
-
-var kubernetesPodV1_spec_container_env_valueFrom = ConverterSpec{
-	ResourceName: "kubernetes_pod_v1",
-	Attributes:   map[string]func(any) (cty.Value, error){},
-	Blocks: map[string]ConverterSpec{
-		"config_map_key_ref": kubernetesPodV1_spec_container_env_valueFrom_configMapKeyRef,
-		"field_ref":          kubernetesPodV1_spec_container_env_valueFrom_fieldRef,
-		"resource_field_ref": kubernetesPodV1_spec_container_env_valueFrom_resourceFieldRef,
-		"secret_key_ref":     kubernetesPodV1_spec_container_env_valueFrom_secretKeyRef,
-	},
-}
-
-var kubernetesPodV1_spec_container_env_valueFrom_secretKeyRef = ConverterSpec{
-	ResourceName: "kubernetes_pod_v1",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"key":      toString,
-		"name":     toString,
-		"optional": toBool,
-	},
-	Blocks: map[string]ConverterSpec{},
-}
-
-var kubernetesPodV1_spec_container_env_valueFrom_resourceFieldRef = ConverterSpec{
-	ResourceName: "kubernetes_pod_v1",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"container_name": toString,
-		"divisor":        toString,
-		"resource":       toString,
-	},
-	Blocks: map[string]ConverterSpec{},
-}
-
-var kubernetesPodV1_spec_container_env_valueFrom_fieldRef = ConverterSpec{
-	ResourceName: "kubernetes_pod_v1",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"api_version": toString,
-		"field_path":  toString,
-	},
-	Blocks: map[string]ConverterSpec{},
-}
-
-var kubernetesPodV1_spec_container_env_valueFrom_configMapKeyRef = ConverterSpec{
-	ResourceName: "kubernetes_pod_v1",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"key":      toString,
-		"name":     toString,
-		"optional": toBool,
-	},
-	Blocks: map[string]ConverterSpec{},
-}
-
-var kubernetesPodV1_spec_container_volumeMount = ConverterSpec{
-	ResourceName: "kubernetes_pod_v1",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"mount_path":        toString,
-		"mount_propagation": toString,
-		"name":              toString,
-		"read_only":         toBool,
-		"sub_path":          toString,
-		"sub_path_expr":     toString,
-	},
-	Blocks: map[string]ConverterSpec{},
-}
-
-var kubernetesPodV1_spec_container_securityContext = ConverterSpec{
-	ResourceName: "kubernetes_pod_v1",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"allow_privilege_escalation": toBool,
-		"privileged":                 toBool,
-		"read_only_root_filesystem":  toBool,
-		"run_as_group":               toString,
-		"run_as_non_root":            toBool,
-		"run_as_user":                toString,
-	},
-	Blocks: map[string]ConverterSpec{
-		"capabilities":     kubernetesPodV1_spec_container_securityContext_capabilities,
-		"se_linux_options": kubernetesPodV1_spec_container_securityContext_seLinuxOptions,
-		"seccomp_profile":  kubernetesPodV1_spec_container_securityContext_seccompProfile,
-	},
-}
-
-var kubernetesPodV1_spec_container_securityContext_seccompProfile = ConverterSpec{
-	ResourceName: "kubernetes_pod_v1",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"localhost_profile": toString,
-		"type":              toString,
-	},
-	Blocks: map[string]ConverterSpec{},
-}
-
-var kubernetesPodV1_spec_container_securityContext_seLinuxOptions = ConverterSpec{
-	ResourceName: "kubernetes_pod_v1",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"level": toString,
-		"role":  toString,
-		"type":  toString,
-		"user":  toString,
-	},
-	Blocks: map[string]ConverterSpec{},
-}
-
-var kubernetesPodV1_spec_container_securityContext_capabilities = ConverterSpec{
-	ResourceName: "kubernetes_pod_v1",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"add": func(a any) (cty.Value, error) {
-			l, ok := a.([]any)
-			if !ok {
-				return cty.Value{}, fmt.Errorf("expected slice, got: %T", a)
-			}
-			vl := make([]cty.Value, 0, len(l))
-			for _, val := range l {
-				v, err := toString(val)
-				if err != nil {
-					return cty.Value{}, err
-				}
-				vl = append(vl, v)
-			}
-			return cty.ListVal(vl), nil
-		},
-		"drop": func(a any) (cty.Value, error) {
-			l, ok := a.([]any)
-			if !ok {
-				return cty.Value{}, fmt.Errorf("expected slice, got: %T", a)
-			}
-			vl := make([]cty.Value, 0, len(l))
-			for _, val := range l {
-				v, err := toString(val)
-				if err != nil {
-					return cty.Value{}, err
-				}
-				vl = append(vl, v)
-			}
-			return cty.ListVal(vl), nil
-		},
-	},
-	Blocks: map[string]ConverterSpec{},
-}
-
-var kubernetesPodV1_spec_container_resources = ConverterSpec{
-	ResourceName: "kubernetes_pod_v1",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"limits":   toStringMap,
-		"requests": toStringMap,
-	},
-	Blocks: map[string]ConverterSpec{},
-}
-
-var kubernetesPodV1_spec_container_startupProbe = ConverterSpec{
-	ResourceName: "kubernetes_pod_v1",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"failure_threshold":     toInt,
-		"initial_delay_seconds": toInt,
-		"period_seconds":        toInt,
-		"success_threshold":     toInt,
-		"timeout_seconds":       toInt,
-	},
-	Blocks: map[string]ConverterSpec{
-		"exec":       kubernetesPodV1_spec_container_startupProbe_exec,
-		"grpc":       kubernetesPodV1_spec_container_startupProbe_grpc,
-		"http_get":   kubernetesPodV1_spec_container_startupProbe_httpGet,
-		"tcp_socket": kubernetesPodV1_spec_container_startupProbe_tcpSocket,
-	},
-}
-
-var kubernetesPodV1_spec_container_startupProbe_grpc = ConverterSpec{
-	ResourceName: "kubernetes_pod_v1",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"port":    toInt,
-		"service": toString,
-	},
-	Blocks: map[string]ConverterSpec{},
-}
-
-var kubernetesPodV1_spec_container_startupProbe_tcpSocket = ConverterSpec{
-	ResourceName: "kubernetes_pod_v1",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"port": toString,
-	},
-	Blocks: map[string]ConverterSpec{},
-}
-
-var kubernetesPodV1_spec_container_startupProbe_httpGet = ConverterSpec{
-	ResourceName: "kubernetes_pod_v1",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"host":   toString,
-		"path":   toString,
-		"port":   toString,
-		"scheme": toString,
-	},
-	Blocks: map[string]ConverterSpec{
-		"http_header": kubernetesPodV1_spec_container_startupProbe_httpGet_httpHeader,
-	},
-}
-
-var kubernetesPodV1_spec_container_startupProbe_httpGet_httpHeader = ConverterSpec{
-	ResourceName: "kubernetes_pod_v1",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"name":  toString,
-		"value": toString,
-	},
-	Blocks: map[string]ConverterSpec{},
-}
-
-var kubernetesPodV1_spec_container_startupProbe_exec = ConverterSpec{
-	ResourceName: "kubernetes_pod_v1",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"command": func(a any) (cty.Value, error) {
-			l, ok := a.([]any)
-			if !ok {
-				return cty.Value{}, fmt.Errorf("expected slice, got: %T", a)
-			}
-			vl := make([]cty.Value, 0, len(l))
-			for _, val := range l {
-				v, err := toString(val)
-				if err != nil {
-					return cty.Value{}, err
-				}
-				vl = append(vl, v)
-			}
-			return cty.ListVal(vl), nil
-		},
-	},
-	Blocks: map[string]ConverterSpec{},
-}
-
-var kubernetesPodV1_spec_container_port = ConverterSpec{
-	ResourceName: "kubernetes_pod_v1",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"container_port": toInt,
-		"host_ip":        toString,
-		"host_port":      toInt,
-		"name":           toString,
-		"protocol":       toString,
-	},
-	Blocks: map[string]ConverterSpec{},
-}
-
-var kubernetesPodV1_spec_container_livenessProbe = ConverterSpec{
-	ResourceName: "kubernetes_pod_v1",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"failure_threshold":     toInt,
-		"initial_delay_seconds": toInt,
-		"period_seconds":        toInt,
-		"success_threshold":     toInt,
-		"timeout_seconds":       toInt,
-	},
-	Blocks: map[string]ConverterSpec{
-		"exec":       kubernetesPodV1_spec_container_livenessProbe_exec,
-		"grpc":       kubernetesPodV1_spec_container_livenessProbe_grpc,
-		"http_get":   kubernetesPodV1_spec_container_livenessProbe_httpGet,
-		"tcp_socket": kubernetesPodV1_spec_container_livenessProbe_tcpSocket,
-	},
-}
-
-var kubernetesPodV1_spec_container_livenessProbe_exec = ConverterSpec{
-	ResourceName: "kubernetes_pod_v1",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"command": func(a any) (cty.Value, error) {
-			l, ok := a.([]any)
-			if !ok {
-				return cty.Value{}, fmt.Errorf("expected slice, got: %T", a)
-			}
-			vl := make([]cty.Value, 0, len(l))
-			for _, val := range l {
-				v, err := toString(val)
-				if err != nil {
-					return cty.Value{}, err
-				}
-				vl = append(vl, v)
-			}
-			return cty.ListVal(vl), nil
-		},
-	},
-	Blocks: map[string]ConverterSpec{},
-}
-
-var kubernetesPodV1_spec_container_livenessProbe_grpc = ConverterSpec{
-	ResourceName: "kubernetes_pod_v1",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"port":    toInt,
-		"service": toString,
-	},
-	Blocks: map[string]ConverterSpec{},
-}
-
-var kubernetesPodV1_spec_container_livenessProbe_tcpSocket = ConverterSpec{
-	ResourceName: "kubernetes_pod_v1",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"port": toString,
-	},
-	Blocks: map[string]ConverterSpec{},
-}
-
-var kubernetesPodV1_spec_container_livenessProbe_httpGet = ConverterSpec{
-	ResourceName: "kubernetes_pod_v1",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"host":   toString,
-		"path":   toString,
-		"port":   toString,
-		"scheme": toString,
-	},
-	Blocks: map[string]ConverterSpec{
-		"http_header": kubernetesPodV1_spec_container_livenessProbe_httpGet_httpHeader,
-	},
-}
-
-var kubernetesPodV1_spec_container_livenessProbe_httpGet_httpHeader = ConverterSpec{
-	ResourceName: "kubernetes_pod_v1",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"name":  toString,
-		"value": toString,
-	},
-	Blocks: map[string]ConverterSpec{},
-}
-
-var kubernetesPodV1_spec_container_envFrom = ConverterSpec{
-	ResourceName: "kubernetes_pod_v1",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"prefix": toString,
-	},
-	Blocks: map[string]ConverterSpec{
-		"config_map_ref": kubernetesPodV1_spec_container_envFrom_configMapRef,
-		"secret_ref":     kubernetesPodV1_spec_container_envFrom_secretRef,
-	},
-}
-
-var kubernetesPodV1_spec_container_envFrom_configMapRef = ConverterSpec{
-	ResourceName: "kubernetes_pod_v1",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"name":     toString,
-		"optional": toBool,
-	},
-	Blocks: map[string]ConverterSpec{},
-}
-
-var kubernetesPodV1_spec_container_envFrom_secretRef = ConverterSpec{
-	ResourceName: "kubernetes_pod_v1",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"name":     toString,
-		"optional": toBool,
-	},
-	Blocks: map[string]ConverterSpec{},
-}
-
-var kubernetesPodV1_spec_container_volumeDevice = ConverterSpec{
-	ResourceName: "kubernetes_pod_v1",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"device_path": toString,
-		"name":        toString,
-	},
-	Blocks: map[string]ConverterSpec{},
-}
-
-var kubernetesPodV1_spec_container_readinessProbe = ConverterSpec{
-	ResourceName: "kubernetes_pod_v1",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"failure_threshold":     toInt,
-		"initial_delay_seconds": toInt,
-		"period_seconds":        toInt,
-		"success_threshold":     toInt,
-		"timeout_seconds":       toInt,
-	},
-	Blocks: map[string]ConverterSpec{
-		"exec":       kubernetesPodV1_spec_container_readinessProbe_exec,
-		"grpc":       kubernetesPodV1_spec_container_readinessProbe_grpc,
-		"http_get":   kubernetesPodV1_spec_container_readinessProbe_httpGet,
-		"tcp_socket": kubernetesPodV1_spec_container_readinessProbe_tcpSocket,
-	},
-}
-
-var kubernetesPodV1_spec_container_readinessProbe_grpc = ConverterSpec{
-	ResourceName: "kubernetes_pod_v1",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"port":    toInt,
-		"service": toString,
-	},
-	Blocks: map[string]ConverterSpec{},
-}
-
-var kubernetesPodV1_spec_container_readinessProbe_tcpSocket = ConverterSpec{
-	ResourceName: "kubernetes_pod_v1",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"port": toString,
-	},
-	Blocks: map[string]ConverterSpec{},
-}
-
-var kubernetesPodV1_spec_container_readinessProbe_exec = ConverterSpec{
-	ResourceName: "kubernetes_pod_v1",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"command": func(a any) (cty.Value, error) {
-			l, ok := a.([]any)
-			if !ok {
-				return cty.Value{}, fmt.Errorf("expected slice, got: %T", a)
-			}
-			vl := make([]cty.Value, 0, len(l))
-			for _, val := range l {
-				v, err := toString(val)
-				if err != nil {
-					return cty.Value{}, err
-				}
-				vl = append(vl, v)
-			}
-			return cty.ListVal(vl), nil
-		},
-	},
-	Blocks: map[string]ConverterSpec{},
-}
-
-var kubernetesPodV1_spec_container_readinessProbe_httpGet = ConverterSpec{
-	ResourceName: "kubernetes_pod_v1",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"host":   toString,
-		"path":   toString,
-		"port":   toString,
-		"scheme": toString,
-	},
-	Blocks: map[string]ConverterSpec{
-		"http_header": kubernetesPodV1_spec_container_readinessProbe_httpGet_httpHeader,
-	},
-}
-
-var kubernetesPodV1_spec_container_readinessProbe_httpGet_httpHeader = ConverterSpec{
-	ResourceName: "kubernetes_pod_v1",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"name":  toString,
-		"value": toString,
-	},
-	Blocks: map[string]ConverterSpec{},
-}
-
-var kubernetesPodV1_spec_affinity = ConverterSpec{
-	ResourceName: "kubernetes_pod_v1",
-	Attributes:   map[string]func(any) (cty.Value, error){},
-	Blocks: map[string]ConverterSpec{
-		"node_affinity":     kubernetesPodV1_spec_affinity_nodeAffinity,
-		"pod_affinity":      kubernetesPodV1_spec_affinity_podAffinity,
-		"pod_anti_affinity": kubernetesPodV1_spec_affinity_podAntiAffinity,
-	},
-}
-
-var kubernetesPodV1_spec_affinity_podAntiAffinity = ConverterSpec{
-	ResourceName: "kubernetes_pod_v1",
-	Attributes:   map[string]func(any) (cty.Value, error){},
-	Blocks: map[string]ConverterSpec{
-		"preferred_during_scheduling_ignored_during_execution": kubernetesPodV1_spec_affinity_podAntiAffinity_preferredDuringSchedulingIgnoredDuringExecution,
-		"required_during_scheduling_ignored_during_execution":  kubernetesPodV1_spec_affinity_podAntiAffinity_requiredDuringSchedulingIgnoredDuringExecution,
-	},
-}
-
-var kubernetesPodV1_spec_affinity_podAntiAffinity_preferredDuringSchedulingIgnoredDuringExecution = ConverterSpec{
-	ResourceName: "kubernetes_pod_v1",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"weight": toInt,
-	},
-	Blocks: map[string]ConverterSpec{
-		"pod_affinity_term": kubernetesPodV1_spec_affinity_podAntiAffinity_preferredDuringSchedulingIgnoredDuringExecution_podAffinityTerm,
-	},
-}
-
-var kubernetesPodV1_spec_affinity_podAntiAffinity_preferredDuringSchedulingIgnoredDuringExecution_podAffinityTerm = ConverterSpec{
-	ResourceName: "kubernetes_pod_v1",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"namespaces": func(a any) (cty.Value, error) {
-			l, ok := a.([]any)
-			if !ok {
-				return cty.Value{}, fmt.Errorf("expected slice, got: %T", a)
-			}
-			vl := make([]cty.Value, 0, len(l))
-			for _, val := range l {
-				v, err := toString(val)
-				if err != nil {
-					return cty.Value{}, err
-				}
-				vl = append(vl, v)
-			}
-			return cty.ListVal(vl), nil
-		},
-		"topology_key": toString,
-	},
-	Blocks: map[string]ConverterSpec{
-		"label_selector":     kubernetesPodV1_spec_affinity_podAntiAffinity_preferredDuringSchedulingIgnoredDuringExecution_podAffinityTerm_labelSelector,
-		"namespace_selector": kubernetesPodV1_spec_affinity_podAntiAffinity_preferredDuringSchedulingIgnoredDuringExecution_podAffinityTerm_namespaceSelector,
-	},
-}
-
-var kubernetesPodV1_spec_affinity_podAntiAffinity_preferredDuringSchedulingIgnoredDuringExecution_podAffinityTerm_namespaceSelector = ConverterSpec{
-	ResourceName: "kubernetes_pod_v1",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"match_labels": toStringMap,
-	},
-	Blocks: map[string]ConverterSpec{
-		"match_expressions": kubernetesPodV1_spec_affinity_podAntiAffinity_preferredDuringSchedulingIgnoredDuringExecution_podAffinityTerm_namespaceSelector_matchExpressions,
-	},
-}
-
-var kubernetesPodV1_spec_affinity_podAntiAffinity_preferredDuringSchedulingIgnoredDuringExecution_podAffinityTerm_namespaceSelector_matchExpressions = ConverterSpec{
-	ResourceName: "kubernetes_pod_v1",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"key":      toString,
-		"operator": toString,
-		"values": func(a any) (cty.Value, error) {
-			l, ok := a.([]any)
-			if !ok {
-				return cty.Value{}, fmt.Errorf("expected slice, got: %T", a)
-			}
-			vl := make([]cty.Value, 0, len(l))
-			for _, val := range l {
-				v, err := toString(val)
-				if err != nil {
-					return cty.Value{}, err
-				}
-				vl = append(vl, v)
-			}
-			return cty.ListVal(vl), nil
-		},
-	},
-	Blocks: map[string]ConverterSpec{},
-}
-
-var kubernetesPodV1_spec_affinity_podAntiAffinity_preferredDuringSchedulingIgnoredDuringExecution_podAffinityTerm_labelSelector = ConverterSpec{
-	ResourceName: "kubernetes_pod_v1",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"match_labels": toStringMap,
-	},
-	Blocks: map[string]ConverterSpec{
-		"match_expressions": kubernetesPodV1_spec_affinity_podAntiAffinity_preferredDuringSchedulingIgnoredDuringExecution_podAffinityTerm_labelSelector_matchExpressions,
-	},
-}
-
-var kubernetesPodV1_spec_affinity_podAntiAffinity_preferredDuringSchedulingIgnoredDuringExecution_podAffinityTerm_labelSelector_matchExpressions = ConverterSpec{
-	ResourceName: "kubernetes_pod_v1",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"key":      toString,
-		"operator": toString,
-		"values": func(a any) (cty.Value, error) {
-			l, ok := a.([]any)
-			if !ok {
-				return cty.Value{}, fmt.Errorf("expected slice, got: %T", a)
-			}
-			vl := make([]cty.Value, 0, len(l))
-			for _, val := range l {
-				v, err := toString(val)
-				if err != nil {
-					return cty.Value{}, err
-				}
-				vl = append(vl, v)
-			}
-			return cty.ListVal(vl), nil
-		},
-	},
-	Blocks: map[string]ConverterSpec{},
-}
-
-var kubernetesPodV1_spec_affinity_podAntiAffinity_requiredDuringSchedulingIgnoredDuringExecution = ConverterSpec{
-	ResourceName: "kubernetes_pod_v1",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"namespaces": func(a any) (cty.Value, error) {
-			l, ok := a.([]any)
-			if !ok {
-				return cty.Value{}, fmt.Errorf("expected slice, got: %T", a)
-			}
-			vl := make([]cty.Value, 0, len(l))
-			for _, val := range l {
-				v, err := toString(val)
-				if err != nil {
-					return cty.Value{}, err
-				}
-				vl = append(vl, v)
-			}
-			return cty.ListVal(vl), nil
-		},
-		"topology_key": toString,
-	},
-	Blocks: map[string]ConverterSpec{
-		"label_selector":     kubernetesPodV1_spec_affinity_podAntiAffinity_requiredDuringSchedulingIgnoredDuringExecution_labelSelector,
-		"namespace_selector": kubernetesPodV1_spec_affinity_podAntiAffinity_requiredDuringSchedulingIgnoredDuringExecution_namespaceSelector,
-	},
-}
-
-var kubernetesPodV1_spec_affinity_podAntiAffinity_requiredDuringSchedulingIgnoredDuringExecution_namespaceSelector = ConverterSpec{
-	ResourceName: "kubernetes_pod_v1",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"match_labels": toStringMap,
-	},
-	Blocks: map[string]ConverterSpec{
-		"match_expressions": kubernetesPodV1_spec_affinity_podAntiAffinity_requiredDuringSchedulingIgnoredDuringExecution_namespaceSelector_matchExpressions,
-	},
-}
-
-var kubernetesPodV1_spec_affinity_podAntiAffinity_requiredDuringSchedulingIgnoredDuringExecution_namespaceSelector_matchExpressions = ConverterSpec{
-	ResourceName: "kubernetes_pod_v1",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"key":      toString,
-		"operator": toString,
-		"values": func(a any) (cty.Value, error) {
-			l, ok := a.([]any)
-			if !ok {
-				return cty.Value{}, fmt.Errorf("expected slice, got: %T", a)
-			}
-			vl := make([]cty.Value, 0, len(l))
-			for _, val := range l {
-				v, err := toString(val)
-				if err != nil {
-					return cty.Value{}, err
-				}
-				vl = append(vl, v)
-			}
-			return cty.ListVal(vl), nil
-		},
-	},
-	Blocks: map[string]ConverterSpec{},
-}
-
-var kubernetesPodV1_spec_affinity_podAntiAffinity_requiredDuringSchedulingIgnoredDuringExecution_labelSelector = ConverterSpec{
-	ResourceName: "kubernetes_pod_v1",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"match_labels": toStringMap,
-	},
-	Blocks: map[string]ConverterSpec{
-		"match_expressions": kubernetesPodV1_spec_affinity_podAntiAffinity_requiredDuringSchedulingIgnoredDuringExecution_labelSelector_matchExpressions,
-	},
-}
-
-var kubernetesPodV1_spec_affinity_podAntiAffinity_requiredDuringSchedulingIgnoredDuringExecution_labelSelector_matchExpressions = ConverterSpec{
-	ResourceName: "kubernetes_pod_v1",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"key":      toString,
-		"operator": toString,
-		"values": func(a any) (cty.Value, error) {
-			l, ok := a.([]any)
-			if !ok {
-				return cty.Value{}, fmt.Errorf("expected slice, got: %T", a)
-			}
-			vl := make([]cty.Value, 0, len(l))
-			for _, val := range l {
-				v, err := toString(val)
-				if err != nil {
-					return cty.Value{}, err
-				}
-				vl = append(vl, v)
-			}
-			return cty.ListVal(vl), nil
-		},
-	},
-	Blocks: map[string]ConverterSpec{},
-}
-
-var kubernetesPodV1_spec_affinity_podAffinity = ConverterSpec{
-	ResourceName: "kubernetes_pod_v1",
-	Attributes:   map[string]func(any) (cty.Value, error){},
-	Blocks: map[string]ConverterSpec{
-		"preferred_during_scheduling_ignored_during_execution": kubernetesPodV1_spec_affinity_podAffinity_preferredDuringSchedulingIgnoredDuringExecution,
-		"required_during_scheduling_ignored_during_execution":  kubernetesPodV1_spec_affinity_podAffinity_requiredDuringSchedulingIgnoredDuringExecution,
-	},
-}
-
-var kubernetesPodV1_spec_affinity_podAffinity_preferredDuringSchedulingIgnoredDuringExecution = ConverterSpec{
-	ResourceName: "kubernetes_pod_v1",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"weight": toInt,
-	},
-	Blocks: map[string]ConverterSpec{
-		"pod_affinity_term": kubernetesPodV1_spec_affinity_podAffinity_preferredDuringSchedulingIgnoredDuringExecution_podAffinityTerm,
-	},
-}
-
-var kubernetesPodV1_spec_affinity_podAffinity_preferredDuringSchedulingIgnoredDuringExecution_podAffinityTerm = ConverterSpec{
-	ResourceName: "kubernetes_pod_v1",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"namespaces": func(a any) (cty.Value, error) {
-			l, ok := a.([]any)
-			if !ok {
-				return cty.Value{}, fmt.Errorf("expected slice, got: %T", a)
-			}
-			vl := make([]cty.Value, 0, len(l))
-			for _, val := range l {
-				v, err := toString(val)
-				if err != nil {
-					return cty.Value{}, err
-				}
-				vl = append(vl, v)
-			}
-			return cty.ListVal(vl), nil
-		},
-		"topology_key": toString,
-	},
-	Blocks: map[string]ConverterSpec{
-		"label_selector":     kubernetesPodV1_spec_affinity_podAffinity_preferredDuringSchedulingIgnoredDuringExecution_podAffinityTerm_labelSelector,
-		"namespace_selector": kubernetesPodV1_spec_affinity_podAffinity_preferredDuringSchedulingIgnoredDuringExecution_podAffinityTerm_namespaceSelector,
-	},
-}
-
-var kubernetesPodV1_spec_affinity_podAffinity_preferredDuringSchedulingIgnoredDuringExecution_podAffinityTerm_namespaceSelector = ConverterSpec{
-	ResourceName: "kubernetes_pod_v1",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"match_labels": toStringMap,
-	},
-	Blocks: map[string]ConverterSpec{
-		"match_expressions": kubernetesPodV1_spec_affinity_podAffinity_preferredDuringSchedulingIgnoredDuringExecution_podAffinityTerm_namespaceSelector_matchExpressions,
-	},
-}
-
-var kubernetesPodV1_spec_affinity_podAffinity_preferredDuringSchedulingIgnoredDuringExecution_podAffinityTerm_namespaceSelector_matchExpressions = ConverterSpec{
-	ResourceName: "kubernetes_pod_v1",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"key":      toString,
-		"operator": toString,
-		"values": func(a any) (cty.Value, error) {
-			l, ok := a.([]any)
-			if !ok {
-				return cty.Value{}, fmt.Errorf("expected slice, got: %T", a)
-			}
-			vl := make([]cty.Value, 0, len(l))
-			for _, val := range l {
-				v, err := toString(val)
-				if err != nil {
-					return cty.Value{}, err
-				}
-				vl = append(vl, v)
-			}
-			return cty.ListVal(vl), nil
-		},
-	},
-	Blocks: map[string]ConverterSpec{},
-}
-
-var kubernetesPodV1_spec_affinity_podAffinity_preferredDuringSchedulingIgnoredDuringExecution_podAffinityTerm_labelSelector = ConverterSpec{
-	ResourceName: "kubernetes_pod_v1",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"match_labels": toStringMap,
-	},
-	Blocks: map[string]ConverterSpec{
-		"match_expressions": kubernetesPodV1_spec_affinity_podAffinity_preferredDuringSchedulingIgnoredDuringExecution_podAffinityTerm_labelSelector_matchExpressions,
-	},
-}
-
-var kubernetesPodV1_spec_affinity_podAffinity_preferredDuringSchedulingIgnoredDuringExecution_podAffinityTerm_labelSelector_matchExpressions = ConverterSpec{
-	ResourceName: "kubernetes_pod_v1",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"key":      toString,
-		"operator": toString,
-		"values": func(a any) (cty.Value, error) {
-			l, ok := a.([]any)
-			if !ok {
-				return cty.Value{}, fmt.Errorf("expected slice, got: %T", a)
-			}
-			vl := make([]cty.Value, 0, len(l))
-			for _, val := range l {
-				v, err := toString(val)
-				if err != nil {
-					return cty.Value{}, err
-				}
-				vl = append(vl, v)
-			}
-			return cty.ListVal(vl), nil
-		},
-	},
-	Blocks: map[string]ConverterSpec{},
-}
-
-var kubernetesPodV1_spec_affinity_podAffinity_requiredDuringSchedulingIgnoredDuringExecution = ConverterSpec{
-	ResourceName: "kubernetes_pod_v1",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"namespaces": func(a any) (cty.Value, error) {
-			l, ok := a.([]any)
-			if !ok {
-				return cty.Value{}, fmt.Errorf("expected slice, got: %T", a)
-			}
-			vl := make([]cty.Value, 0, len(l))
-			for _, val := range l {
-				v, err := toString(val)
-				if err != nil {
-					return cty.Value{}, err
-				}
-				vl = append(vl, v)
-			}
-			return cty.ListVal(vl), nil
-		},
-		"topology_key": toString,
-	},
-	Blocks: map[string]ConverterSpec{
-		"label_selector":     kubernetesPodV1_spec_affinity_podAffinity_requiredDuringSchedulingIgnoredDuringExecution_labelSelector,
-		"namespace_selector": kubernetesPodV1_spec_affinity_podAffinity_requiredDuringSchedulingIgnoredDuringExecution_namespaceSelector,
-	},
-}
-
-var kubernetesPodV1_spec_affinity_podAffinity_requiredDuringSchedulingIgnoredDuringExecution_namespaceSelector = ConverterSpec{
-	ResourceName: "kubernetes_pod_v1",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"match_labels": toStringMap,
-	},
-	Blocks: map[string]ConverterSpec{
-		"match_expressions": kubernetesPodV1_spec_affinity_podAffinity_requiredDuringSchedulingIgnoredDuringExecution_namespaceSelector_matchExpressions,
-	},
-}
-
-var kubernetesPodV1_spec_affinity_podAffinity_requiredDuringSchedulingIgnoredDuringExecution_namespaceSelector_matchExpressions = ConverterSpec{
-	ResourceName: "kubernetes_pod_v1",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"key":      toString,
-		"operator": toString,
-		"values": func(a any) (cty.Value, error) {
-			l, ok := a.([]any)
-			if !ok {
-				return cty.Value{}, fmt.Errorf("expected slice, got: %T", a)
-			}
-			vl := make([]cty.Value, 0, len(l))
-			for _, val := range l {
-				v, err := toString(val)
-				if err != nil {
-					return cty.Value{}, err
-				}
-				vl = append(vl, v)
-			}
-			return cty.ListVal(vl), nil
-		},
-	},
-	Blocks: map[string]ConverterSpec{},
-}
-
-var kubernetesPodV1_spec_affinity_podAffinity_requiredDuringSchedulingIgnoredDuringExecution_labelSelector = ConverterSpec{
-	ResourceName: "kubernetes_pod_v1",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"match_labels": toStringMap,
-	},
-	Blocks: map[string]ConverterSpec{
-		"match_expressions": kubernetesPodV1_spec_affinity_podAffinity_requiredDuringSchedulingIgnoredDuringExecution_labelSelector_matchExpressions,
-	},
-}
-
-var kubernetesPodV1_spec_affinity_podAffinity_requiredDuringSchedulingIgnoredDuringExecution_labelSelector_matchExpressions = ConverterSpec{
-	ResourceName: "kubernetes_pod_v1",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"key":      toString,
-		"operator": toString,
-		"values": func(a any) (cty.Value, error) {
-			l, ok := a.([]any)
-			if !ok {
-				return cty.Value{}, fmt.Errorf("expected slice, got: %T", a)
-			}
-			vl := make([]cty.Value, 0, len(l))
-			for _, val := range l {
-				v, err := toString(val)
-				if err != nil {
-					return cty.Value{}, err
-				}
-				vl = append(vl, v)
-			}
-			return cty.ListVal(vl), nil
-		},
-	},
-	Blocks: map[string]ConverterSpec{},
-}
-
-var kubernetesPodV1_spec_affinity_nodeAffinity = ConverterSpec{
-	ResourceName: "kubernetes_pod_v1",
-	Attributes:   map[string]func(any) (cty.Value, error){},
-	Blocks: map[string]ConverterSpec{
-		"preferred_during_scheduling_ignored_during_execution": kubernetesPodV1_spec_affinity_nodeAffinity_preferredDuringSchedulingIgnoredDuringExecution,
-		"required_during_scheduling_ignored_during_execution":  kubernetesPodV1_spec_affinity_nodeAffinity_requiredDuringSchedulingIgnoredDuringExecution,
-	},
-}
-
-var kubernetesPodV1_spec_affinity_nodeAffinity_preferredDuringSchedulingIgnoredDuringExecution = ConverterSpec{
-	ResourceName: "kubernetes_pod_v1",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"weight": toInt,
-	},
-	Blocks: map[string]ConverterSpec{
-		"preference": kubernetesPodV1_spec_affinity_nodeAffinity_preferredDuringSchedulingIgnoredDuringExecution_preference,
-	},
-}
-
-var kubernetesPodV1_spec_affinity_nodeAffinity_preferredDuringSchedulingIgnoredDuringExecution_preference = ConverterSpec{
-	ResourceName: "kubernetes_pod_v1",
-	Attributes:   map[string]func(any) (cty.Value, error){},
-	Blocks: map[string]ConverterSpec{
-		"match_expressions": kubernetesPodV1_spec_affinity_nodeAffinity_preferredDuringSchedulingIgnoredDuringExecution_preference_matchExpressions,
-		"match_fields":      kubernetesPodV1_spec_affinity_nodeAffinity_preferredDuringSchedulingIgnoredDuringExecution_preference_matchFields,
-	},
-}
-
-var kubernetesPodV1_spec_affinity_nodeAffinity_preferredDuringSchedulingIgnoredDuringExecution_preference_matchExpressions = ConverterSpec{
-	ResourceName: "kubernetes_pod_v1",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"key":      toString,
-		"operator": toString,
-		"values": func(a any) (cty.Value, error) {
-			l, ok := a.([]any)
-			if !ok {
-				return cty.Value{}, fmt.Errorf("expected slice, got: %T", a)
-			}
-			vl := make([]cty.Value, 0, len(l))
-			for _, val := range l {
-				v, err := toString(val)
-				if err != nil {
-					return cty.Value{}, err
-				}
-				vl = append(vl, v)
-			}
-			return cty.ListVal(vl), nil
-		},
-	},
-	Blocks: map[string]ConverterSpec{},
-}
-
-var kubernetesPodV1_spec_affinity_nodeAffinity_preferredDuringSchedulingIgnoredDuringExecution_preference_matchFields = ConverterSpec{
-	ResourceName: "kubernetes_pod_v1",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"key":      toString,
-		"operator": toString,
-		"values": func(a any) (cty.Value, error) {
-			l, ok := a.([]any)
-			if !ok {
-				return cty.Value{}, fmt.Errorf("expected slice, got: %T", a)
-			}
-			vl := make([]cty.Value, 0, len(l))
-			for _, val := range l {
-				v, err := toString(val)
-				if err != nil {
-					return cty.Value{}, err
-				}
-				vl = append(vl, v)
-			}
-			return cty.ListVal(vl), nil
-		},
-	},
-	Blocks: map[string]ConverterSpec{},
-}
-
-var kubernetesPodV1_spec_affinity_nodeAffinity_requiredDuringSchedulingIgnoredDuringExecution = ConverterSpec{
-	ResourceName: "kubernetes_pod_v1",
-	Attributes:   map[string]func(any) (cty.Value, error){},
-	Blocks: map[string]ConverterSpec{
-		"node_selector_term": kubernetesPodV1_spec_affinity_nodeAffinity_requiredDuringSchedulingIgnoredDuringExecution_nodeSelectorTerm,
-	},
-}
-
-var kubernetesPodV1_spec_affinity_nodeAffinity_requiredDuringSchedulingIgnoredDuringExecution_nodeSelectorTerm = ConverterSpec{
-	ResourceName: "kubernetes_pod_v1",
-	Attributes:   map[string]func(any) (cty.Value, error){},
-	Blocks: map[string]ConverterSpec{
-		"match_expressions": kubernetesPodV1_spec_affinity_nodeAffinity_requiredDuringSchedulingIgnoredDuringExecution_nodeSelectorTerm_matchExpressions,
-		"match_fields":      kubernetesPodV1_spec_affinity_nodeAffinity_requiredDuringSchedulingIgnoredDuringExecution_nodeSelectorTerm_matchFields,
-	},
-}
-
-var kubernetesPodV1_spec_affinity_nodeAffinity_requiredDuringSchedulingIgnoredDuringExecution_nodeSelectorTerm_matchFields = ConverterSpec{
-	ResourceName: "kubernetes_pod_v1",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"key":      toString,
-		"operator": toString,
-		"values": func(a any) (cty.Value, error) {
-			l, ok := a.([]any)
-			if !ok {
-				return cty.Value{}, fmt.Errorf("expected slice, got: %T", a)
-			}
-			vl := make([]cty.Value, 0, len(l))
-			for _, val := range l {
-				v, err := toString(val)
-				if err != nil {
-					return cty.Value{}, err
-				}
-				vl = append(vl, v)
-			}
-			return cty.ListVal(vl), nil
-		},
-	},
-	Blocks: map[string]ConverterSpec{},
-}
-
-var kubernetesPodV1_spec_affinity_nodeAffinity_requiredDuringSchedulingIgnoredDuringExecution_nodeSelectorTerm_matchExpressions = ConverterSpec{
-	ResourceName: "kubernetes_pod_v1",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"key":      toString,
-		"operator": toString,
-		"values": func(a any) (cty.Value, error) {
-			l, ok := a.([]any)
-			if !ok {
-				return cty.Value{}, fmt.Errorf("expected slice, got: %T", a)
-			}
-			vl := make([]cty.Value, 0, len(l))
-			for _, val := range l {
-				v, err := toString(val)
-				if err != nil {
-					return cty.Value{}, err
-				}
-				vl = append(vl, v)
-			}
-			return cty.ListVal(vl), nil
-		},
-	},
-	Blocks: map[string]ConverterSpec{},
-}
-
-var kubernetesPodV1_spec_topologySpreadConstraint = ConverterSpec{
-	ResourceName: "kubernetes_pod_v1",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"match_label_keys": func(a any) (cty.Value, error) {
-			l, ok := a.([]any)
-			if !ok {
-				return cty.Value{}, fmt.Errorf("expected slice, got: %T", a)
-			}
-			vl := make([]cty.Value, 0, len(l))
-			for _, val := range l {
-				v, err := toString(val)
-				if err != nil {
-					return cty.Value{}, err
-				}
-				vl = append(vl, v)
-			}
-			return cty.ListVal(vl), nil
-		},
-		"max_skew":             toInt,
-		"min_domains":          toInt,
-		"node_affinity_policy": toString,
-		"node_taints_policy":   toString,
-		"topology_key":         toString,
-		"when_unsatisfiable":   toString,
-	},
-	Blocks: map[string]ConverterSpec{
-		"label_selector": kubernetesPodV1_spec_topologySpreadConstraint_labelSelector,
-	},
-}
-
-var kubernetesPodV1_spec_topologySpreadConstraint_labelSelector = ConverterSpec{
-	ResourceName: "kubernetes_pod_v1",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"match_labels": toStringMap,
-	},
-	Blocks: map[string]ConverterSpec{
-		"match_expressions": kubernetesPodV1_spec_topologySpreadConstraint_labelSelector_matchExpressions,
-	},
-}
-
-var kubernetesPodV1_spec_topologySpreadConstraint_labelSelector_matchExpressions = ConverterSpec{
-	ResourceName: "kubernetes_pod_v1",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"key":      toString,
-		"operator": toString,
-		"values": func(a any) (cty.Value, error) {
-			l, ok := a.([]any)
-			if !ok {
-				return cty.Value{}, fmt.Errorf("expected slice, got: %T", a)
-			}
-			vl := make([]cty.Value, 0, len(l))
-			for _, val := range l {
-				v, err := toString(val)
-				if err != nil {
-					return cty.Value{}, err
-				}
-				vl = append(vl, v)
-			}
-			return cty.ListVal(vl), nil
-		},
-	},
-	Blocks: map[string]ConverterSpec{},
-}
-
-var kubernetesPodV1_spec_securityContext = ConverterSpec{
-	ResourceName: "kubernetes_pod_v1",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"fs_group":               toString,
-		"fs_group_change_policy": toString,
-		"run_as_group":           toString,
-		"run_as_non_root":        toBool,
-		"run_as_user":            toString,
-		"supplemental_groups": func(a any) (cty.Value, error) {
-			l, ok := a.([]any)
-			if !ok {
-				return cty.Value{}, fmt.Errorf("expected slice, got: %T", a)
-			}
-			vl := make([]cty.Value, 0, len(l))
-			for _, val := range l {
-				v, err := toInt(val)
-				if err != nil {
-					return cty.Value{}, err
-				}
-				vl = append(vl, v)
-			}
-			return cty.ListVal(vl), nil
-		},
-	},
-	Blocks: map[string]ConverterSpec{
-		"se_linux_options": kubernetesPodV1_spec_securityContext_seLinuxOptions,
-		"seccomp_profile":  kubernetesPodV1_spec_securityContext_seccompProfile,
-		"sysctl":           kubernetesPodV1_spec_securityContext_sysctl,
-		"windows_options":  kubernetesPodV1_spec_securityContext_windowsOptions,
-	},
-}
-
-var kubernetesPodV1_spec_securityContext_seccompProfile = ConverterSpec{
-	ResourceName: "kubernetes_pod_v1",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"localhost_profile": toString,
-		"type":              toString,
-	},
-	Blocks: map[string]ConverterSpec{},
-}
-
-var kubernetesPodV1_spec_securityContext_sysctl = ConverterSpec{
-	ResourceName: "kubernetes_pod_v1",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"name":  toString,
-		"value": toString,
-	},
-	Blocks: map[string]ConverterSpec{},
-}
-
-var kubernetesPodV1_spec_securityContext_windowsOptions = ConverterSpec{
-	ResourceName: "kubernetes_pod_v1",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"gmsa_credential_spec":      toString,
-		"gmsa_credential_spec_name": toString,
-		"host_process":              toBool,
-		"run_as_username":           toString,
-	},
-	Blocks: map[string]ConverterSpec{},
-}
-
-var kubernetesPodV1_spec_securityContext_seLinuxOptions = ConverterSpec{
-	ResourceName: "kubernetes_pod_v1",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"level": toString,
-		"role":  toString,
-		"type":  toString,
-		"user":  toString,
-	},
-	Blocks: map[string]ConverterSpec{},
-}
-
-var kubernetesPodV1_spec_dnsConfig = ConverterSpec{
-	ResourceName: "kubernetes_pod_v1",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"nameservers": func(a any) (cty.Value, error) {
-			l, ok := a.([]any)
-			if !ok {
-				return cty.Value{}, fmt.Errorf("expected slice, got: %T", a)
-			}
-			vl := make([]cty.Value, 0, len(l))
-			for _, val := range l {
-				v, err := toString(val)
-				if err != nil {
-					return cty.Value{}, err
-				}
-				vl = append(vl, v)
-			}
-			return cty.ListVal(vl), nil
-		},
-		"searches": func(a any) (cty.Value, error) {
-			l, ok := a.([]any)
-			if !ok {
-				return cty.Value{}, fmt.Errorf("expected slice, got: %T", a)
-			}
-			vl := make([]cty.Value, 0, len(l))
-			for _, val := range l {
-				v, err := toString(val)
-				if err != nil {
-					return cty.Value{}, err
-				}
-				vl = append(vl, v)
-			}
-			return cty.ListVal(vl), nil
-		},
-	},
-	Blocks: map[string]ConverterSpec{
-		"option": kubernetesPodV1_spec_dnsConfig_option,
-	},
-}
-
-var kubernetesPodV1_spec_dnsConfig_option = ConverterSpec{
-	ResourceName: "kubernetes_pod_v1",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"name":  toString,
-		"value": toString,
-	},
-	Blocks: map[string]ConverterSpec{},
-}
-
-var kubernetesPodV1_spec_toleration = ConverterSpec{
-	ResourceName: "kubernetes_pod_v1",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"effect":             toString,
-		"key":                toString,
-		"operator":           toString,
-		"toleration_seconds": toString,
-		"value":              toString,
-	},
-	Blocks: map[string]ConverterSpec{},
-}
-
-var kubernetesPodV1_spec_readinessGate = ConverterSpec{
-	ResourceName: "kubernetes_pod_v1",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"condition_type": toString,
-	},
-	Blocks: map[string]ConverterSpec{},
-}
-
-var kubernetesPodV1_spec_os = ConverterSpec{
-	ResourceName: "kubernetes_pod_v1",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"name": toString,
-	},
-	Blocks: map[string]ConverterSpec{},
-}
-
-var kubernetesPodV1_metadata = ConverterSpec{
-	ResourceName: "kubernetes_pod_v1",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"annotations":   toStringMap,
-		"generate_name": toString,
-		"labels":        toStringMap,
-		"name":          toString,
-		"namespace":     toString,
+	Schema: map[string]FieldSchema{
+		"default_mode": {Optional: true, Description: "Optional: mode bits to use on created files by default. Must be a value between 0 and 0777. Defaults to 0644. Directories within the path are not affected by this setting. This might be in conflict with other options that affect the file mode, like fsGroup, and the result can be other mode bits set.", Default: cty.StringVal("0644")},
+		"items":        {Optional: true, Description: "If unspecified, each key-value pair in the Data field of the referenced Secret will be projected into the volume as a file whose name is the key and content is the value. If specified, the listed keys will be projected into the specified paths, and unlisted keys will not be present. If a key is specified which is not present in the Secret, the volume setup will error unless it is marked optional. Paths must be relative and may not contain the '..' path or start with '..'."},
+		"optional":     {Optional: true, Description: "Optional: Specify whether the Secret or its keys must be defined."},
+		"secret_name":  {Optional: true, Description: "Name of the secret in the pod's namespace to use. More info: https://kubernetes.io/docs/concepts/storage/volumes#secrets"},
 	},
-	Blocks: map[string]ConverterSpec{},
 }
@@ -5,15 +5,9 @@ package gen
 // DO NOT EDIT.
 
 import (
-	"fmt"
-
 	"github.com/zclconf/go-cty/cty"
 )
 
-// Make sure resources with no list attributes don't cause build errors because
-// of an unused import.
-var _ = fmt.Sprintf
-
 func init() {
 	register("kubernetes_priority_class", kubernetesPriorityClass)
 }
@@ -27,17 +21,13 @@ var kubernetesPriorityClass = ConverterSpec{
 		"value":             toInt,
 	},
 	Blocks: map[string]ConverterSpec{
-		"metadata": kubernetesPriorityClass_metadata,
+		"metadata": kubernetesPriorityClassV1_metadata,
 	},
-}
-
-var kubernetesPriorityClass_metadata = ConverterSpec{
-	ResourceName: "kubernetes_priority_class",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"annotations":   toStringMap,
-		"generate_name": toString,
-		"labels":        toStringMap,
-		"name":          toString,
+	Schema: map[string]FieldSchema{
+		"description":       {Optional: true, Description: "An arbitrary string that usually provides guidelines on when this priority class should be used.", Default: cty.StringVal("")},
+		"global_default":    {Optional: true, Description: "Specifies whether this PriorityClass should be considered as the default priority for pods that do not have any priority class. Only one PriorityClass can be marked as `globalDefault`. However, if more than one PriorityClasses exists with their `globalDefault` field set to true, the smallest value of such global default PriorityClasses will be used as the default priority.", Default: cty.BoolVal(false)},
+		"metadata":          {Required: true, MaxItems: 1, Description: "Standard priority class's metadata. More info: https://github.com/kubernetes/community/blob/master/contributors/devel/sig-architecture/api-conventions.md#metadata"},
+		"preemption_policy": {Optional: true, ForceNew: true, Description: "PreemptionPolicy is the Policy for preempting pods with lower priority. One of Never, PreemptLowerPriority. Defaults to PreemptLowerPriority if unset.", Default: cty.StringVal("PreemptLowerPriority")},
+		"value":             {Required: true, ForceNew: true, Description: "The value of this priority class. This is the actual priority that pods receive when they have the name of this class in their pod spec."},
 	},
-	Blocks: map[string]ConverterSpec{},
 }
@@ -5,15 +5,9 @@ package gen
 // DO NOT EDIT.
 
 import (
-	"fmt"
-
 	"github.com/zclconf/go-cty/cty"
 )
 
-// Make sure resources with no list attributes don't cause build errors because
-// of an unused import.
-var _ = fmt.Sprintf
-
 func init() {
 	register("kubernetes_priority_class_v1", kubernetesPriorityClassV1)
 }
@@ -29,15 +23,26 @@ var kubernetesPriorityClassV1 = ConverterSpec{
 	Blocks: map[string]ConverterSpec{
 		"metadata": kubernetesPriorityClassV1_metadata,
 	},
+	Schema: map[string]FieldSchema{
+		"description":       {Optional: true, Description: "An arbitrary string that usually provides guidelines on when this priority class should be used.", Default: cty.StringVal("")},
+		"global_default":    {Optional: true, Description: "Specifies whether this PriorityClass should be considered as the default priority for pods that do not have any priority class. Only one PriorityClass can be marked as `globalDefault`. However, if more than one PriorityClasses exists with their `globalDefault` field set to true, the smallest value of such global default PriorityClasses will be used as the default priority.", Default: cty.BoolVal(false)},
+		"metadata":          {Required: true, MaxItems: 1, Description: "Standard priority class's metadata. More info: https://github.com/kubernetes/community/blob/master/contributors/devel/sig-architecture/api-conventions.md#metadata"},
+		"preemption_policy": {Optional: true, ForceNew: true, Description: "PreemptionPolicy is the Policy for preempting pods with lower priority. One of Never, PreemptLowerPriority. Defaults to PreemptLowerPriority if unset.", Default: cty.StringVal("PreemptLowerPriority")},
+		"value":             {Required: true, ForceNew: true, Description: "The value of this priority class. This is the actual priority that pods receive when they have the name of this class in their pod spec."},
+	},
 }
 
 var kubernetesPriorityClassV1_metadata = ConverterSpec{
-	ResourceName: "kubernetes_priority_class_v1",
 	Attributes: map[string]func(any) (cty.Value, error){
 		"annotations":   toStringMap,
 		"generate_name": toString,
 		"labels":        toStringMap,
 		"name":          toString,
 	},
-	Blocks: map[string]ConverterSpec{},
+	Schema: map[string]FieldSchema{
+		"annotations":   {Optional: true, Description: "An unstructured key value map stored with the priority class that may be used to store arbitrary metadata. More info: https://kubernetes.io/docs/concepts/overview/working-with-objects/annotations/"},
+		"generate_name": {Optional: true, ForceNew: true, ConflictsWith: []string{"metadata.0.name"}, Description: "Prefix, used by the server, to generate a unique name ONLY IF the `name` field has not been provided. This value will also be combined with a unique suffix. More info: https://github.com/kubernetes/community/blob/master/contributors/devel/sig-architecture/api-conventions.md#idempotency"},
+		"labels":        {Optional: true, Description: "Map of string keys and values that can be used to organize and categorize (scope and select) the priority class. May match selectors of replication controllers and services. More info: https://kubernetes.io/docs/concepts/overview/working-with-objects/labels/"},
+		"name":          {Optional: true, ForceNew: true, ConflictsWith: []string{"metadata.0.generate_name"}, Description: "Name of the priority class, must be unique. Cannot be updated. More info: https://kubernetes.io/docs/concepts/overview/working-with-objects/names/#names"},
+	},
 }
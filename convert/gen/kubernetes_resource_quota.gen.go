@@ -5,15 +5,9 @@ package gen
 // DO NOT EDIT.
 
 import (
-	"fmt"
-
 	"github.com/zclconf/go-cty/cty"
 )
 
-// Make sure resources with no list attributes don't cause build errors because
-// of an unused import.
-var _ = fmt.Sprintf
-
 func init() {
 	register("kubernetes_resource_quota", kubernetesResourceQuota)
 }
@@ -22,76 +16,11 @@ var kubernetesResourceQuota = ConverterSpec{
 	ResourceName: "kubernetes_resource_quota",
 	Attributes:   map[string]func(any) (cty.Value, error){},
 	Blocks: map[string]ConverterSpec{
-		"metadata": kubernetesResourceQuota_metadata,
-		"spec":     kubernetesResourceQuota_spec,
-	},
-}
-
-var kubernetesResourceQuota_spec = ConverterSpec{
-	ResourceName: "kubernetes_resource_quota",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"hard": toStringMap,
-		"scopes": func(a any) (cty.Value, error) {
-			l, ok := a.([]any)
-			if !ok {
-				return cty.Value{}, fmt.Errorf("expected slice, got: %T", a)
-			}
-			vl := make([]cty.Value, 0, len(l))
-			for _, val := range l {
-				v, err := toString(val)
-				if err != nil {
-					return cty.Value{}, err
-				}
-				vl = append(vl, v)
-			}
-			return cty.ListVal(vl), nil
-		},
+		"metadata": kubernetesResourceQuotaV1_metadata,
+		"spec":     kubernetesResourceQuotaV1_spec,
 	},
-	Blocks: map[string]ConverterSpec{
-		"scope_selector": kubernetesResourceQuota_spec_scopeSelector,
-	},
-}
-
-var kubernetesResourceQuota_spec_scopeSelector = ConverterSpec{
-	ResourceName: "kubernetes_resource_quota",
-	Attributes:   map[string]func(any) (cty.Value, error){},
-	Blocks: map[string]ConverterSpec{
-		"match_expression": kubernetesResourceQuota_spec_scopeSelector_matchExpression,
-	},
-}
-
-var kubernetesResourceQuota_spec_scopeSelector_matchExpression = ConverterSpec{
-	ResourceName: "kubernetes_resource_quota",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"operator":   toString,
-		"scope_name": toString,
-		"values": func(a any) (cty.Value, error) {
-			l, ok := a.([]any)
-			if !ok {
-				return cty.Value{}, fmt.Errorf("expected slice, got: %T", a)
-			}
-			vl := make([]cty.Value, 0, len(l))
-			for _, val := range l {
-				v, err := toString(val)
-				if err != nil {
-					return cty.Value{}, err
-				}
-				vl = append(vl, v)
-			}
-			return cty.ListVal(vl), nil
-		},
-	},
-	Blocks: map[string]ConverterSpec{},
-}
-
-var kubernetesResourceQuota_metadata = ConverterSpec{
-	ResourceName: "kubernetes_resource_quota",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"annotations":   toStringMap,
-		"generate_name": toString,
-		"labels":        toStringMap,
-		"name":          toString,
-		"namespace":     toString,
+	Schema: map[string]FieldSchema{
+		"metadata": {Required: true, MaxItems: 1, Description: "Standard resource quota's metadata. More info: https://github.com/kubernetes/community/blob/master/contributors/devel/sig-architecture/api-conventions.md#metadata"},
+		"spec":     {Optional: true, MaxItems: 1, Description: "Spec defines the desired quota. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#spec-and-status"},
 	},
-	Blocks: map[string]ConverterSpec{},
 }
@@ -5,15 +5,9 @@ package gen
 // DO NOT EDIT.
 
 import (
-	"fmt"
-
 	"github.com/zclconf/go-cty/cty"
 )
 
-// Make sure resources with no list attributes don't cause build errors because
-// of an unused import.
-var _ = fmt.Sprintf
-
 func init() {
 	register("kubernetes_resource_quota_v1", kubernetesResourceQuotaV1)
 }
@@ -25,10 +19,13 @@ var kubernetesResourceQuotaV1 = ConverterSpec{
 		"metadata": kubernetesResourceQuotaV1_metadata,
 		"spec":     kubernetesResourceQuotaV1_spec,
 	},
+	Schema: map[string]FieldSchema{
+		"metadata": {Required: true, MaxItems: 1, Description: "Standard resource quota's metadata. More info: https://github.com/kubernetes/community/blob/master/contributors/devel/sig-architecture/api-conventions.md#metadata"},
+		"spec":     {Optional: true, MaxItems: 1, Description: "Spec defines the desired quota. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#spec-and-status"},
+	},
 }
 
 var kubernetesResourceQuotaV1_metadata = ConverterSpec{
-	ResourceName: "kubernetes_resource_quota_v1",
 	Attributes: map[string]func(any) (cty.Value, error){
 		"annotations":   toStringMap,
 		"generate_name": toString,
@@ -36,62 +33,52 @@ var kubernetesResourceQuotaV1_metadata = ConverterSpec{
 		"name":          toString,
 		"namespace":     toString,
 	},
-	Blocks: map[string]ConverterSpec{},
+	Schema: map[string]FieldSchema{
+		"annotations":   {Optional: true, Description: "An unstructured key value map stored with the resource quota that may be used to store arbitrary metadata. More info: https://kubernetes.io/docs/concepts/overview/working-with-objects/annotations/"},
+		"generate_name": {Optional: true, ForceNew: true, ConflictsWith: []string{"metadata.name"}, Description: "Prefix, used by the server, to generate a unique name ONLY IF the `name` field has not been provided. This value will also be combined with a unique suffix. More info: https://github.com/kubernetes/community/blob/master/contributors/devel/sig-architecture/api-conventions.md#idempotency"},
+		"labels":        {Optional: true, Description: "Map of string keys and values that can be used to organize and categorize (scope and select) the resource quota. May match selectors of replication controllers and services. More info: https://kubernetes.io/docs/concepts/overview/working-with-objects/labels/"},
+		"name":          {Optional: true, ForceNew: true, ConflictsWith: []string{"metadata.generate_name"}, Description: "Name of the resource quota, must be unique. Cannot be updated. More info: https://kubernetes.io/docs/concepts/overview/working-with-objects/names/#names"},
+		"namespace":     {Optional: true, ForceNew: true, Description: "Namespace defines the space within which name of the resource quota must be unique.", Default: cty.StringVal("default")},
+	},
 }
 
 var kubernetesResourceQuotaV1_spec = ConverterSpec{
-	ResourceName: "kubernetes_resource_quota_v1",
 	Attributes: map[string]func(any) (cty.Value, error){
-		"hard": toStringMap,
-		"scopes": func(a any) (cty.Value, error) {
-			l, ok := a.([]any)
-			if !ok {
-				return cty.Value{}, fmt.Errorf("expected slice, got: %T", a)
-			}
-			vl := make([]cty.Value, 0, len(l))
-			for _, val := range l {
-				v, err := toString(val)
-				if err != nil {
-					return cty.Value{}, err
-				}
-				vl = append(vl, v)
-			}
-			return cty.ListVal(vl), nil
-		},
+		"hard":   toQuantityMap,
+		"scopes": toList(toString),
 	},
 	Blocks: map[string]ConverterSpec{
 		"scope_selector": kubernetesResourceQuotaV1_spec_scopeSelector,
 	},
+	Quantities: map[string]bool{
+		"hard": true,
+	},
+	Schema: map[string]FieldSchema{
+		"hard":           {Optional: true, Description: "The set of desired hard limits for each named resource. More info: http://releases.k8s.io/HEAD/docs/design/admission_control_resource_quota.md#admissioncontrol-plugin-resourcequota"},
+		"scope_selector": {Optional: true, MaxItems: 1, Description: "A collection of filters like scopes that must match each object tracked by a quota but expressed using ScopeSelectorOperator in combination with possible values. For a resource to match, both scopes AND scopeSelector (if specified in spec), must be matched."},
+		"scopes":         {Optional: true, ForceNew: true, Description: "A collection of filters that must match each object tracked by a quota. If not specified, the quota matches all objects."},
+	},
 }
 
 var kubernetesResourceQuotaV1_spec_scopeSelector = ConverterSpec{
-	ResourceName: "kubernetes_resource_quota_v1",
-	Attributes:   map[string]func(any) (cty.Value, error){},
+	Attributes: map[string]func(any) (cty.Value, error){},
 	Blocks: map[string]ConverterSpec{
 		"match_expression": kubernetesResourceQuotaV1_spec_scopeSelector_matchExpression,
 	},
+	Schema: map[string]FieldSchema{
+		"match_expression": {Optional: true, Description: "A list of scope selector requirements by scope of the resources."},
+	},
 }
 
 var kubernetesResourceQuotaV1_spec_scopeSelector_matchExpression = ConverterSpec{
-	ResourceName: "kubernetes_resource_quota_v1",
 	Attributes: map[string]func(any) (cty.Value, error){
 		"operator":   toString,
 		"scope_name": toString,
-		"values": func(a any) (cty.Value, error) {
-			l, ok := a.([]any)
-			if !ok {
-				return cty.Value{}, fmt.Errorf("expected slice, got: %T", a)
-			}
-			vl := make([]cty.Value, 0, len(l))
-			for _, val := range l {
-				v, err := toString(val)
-				if err != nil {
-					return cty.Value{}, err
-				}
-				vl = append(vl, v)
-			}
-			return cty.ListVal(vl), nil
-		},
+		"values":     toList(toString),
+	},
+	Schema: map[string]FieldSchema{
+		"operator":   {Required: true, Description: "Represents a scope's relationship to a set of values."},
+		"scope_name": {Required: true, Description: "The name of the scope that the selector applies to."},
+		"values":     {Optional: true, Description: "A list of scope selector requirements by scope of the resources."},
 	},
-	Blocks: map[string]ConverterSpec{},
 }
@@ -5,15 +5,9 @@ package gen
 // DO NOT EDIT.
 
 import (
-	"fmt"
-
 	"github.com/zclconf/go-cty/cty"
 )
 
-// Make sure resources with no list attributes don't cause build errors because
-// of an unused import.
-var _ = fmt.Sprintf
-
 func init() {
 	register("kubernetes_role_binding", kubernetesRoleBinding)
 }
@@ -22,41 +16,13 @@ var kubernetesRoleBinding = ConverterSpec{
 	ResourceName: "kubernetes_role_binding",
 	Attributes:   map[string]func(any) (cty.Value, error){},
 	Blocks: map[string]ConverterSpec{
-		"metadata": kubernetesRoleBinding_metadata,
-		"role_ref": kubernetesRoleBinding_roleRef,
-		"subject":  kubernetesRoleBinding_subject,
+		"metadata": kubernetesRoleBindingV1_metadata,
+		"role_ref": kubernetesClusterRoleBindingV1_roleRef,
+		"subject":  kubernetesClusterRoleBindingV1_subject,
 	},
-}
-
-var kubernetesRoleBinding_metadata = ConverterSpec{
-	ResourceName: "kubernetes_role_binding",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"annotations":   toStringMap,
-		"generate_name": toString,
-		"labels":        toStringMap,
-		"name":          toString,
-		"namespace":     toString,
-	},
-	Blocks: map[string]ConverterSpec{},
-}
-
-var kubernetesRoleBinding_subject = ConverterSpec{
-	ResourceName: "kubernetes_role_binding",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"api_group": toString,
-		"kind":      toString,
-		"name":      toString,
-		"namespace": toString,
-	},
-	Blocks: map[string]ConverterSpec{},
-}
-
-var kubernetesRoleBinding_roleRef = ConverterSpec{
-	ResourceName: "kubernetes_role_binding",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"api_group": toString,
-		"kind":      toString,
-		"name":      toString,
+	Schema: map[string]FieldSchema{
+		"metadata": {Required: true, MaxItems: 1, Description: "Standard roleBinding's metadata. More info: https://github.com/kubernetes/community/blob/master/contributors/devel/sig-architecture/api-conventions.md#metadata"},
+		"role_ref": {Required: true, ForceNew: true, MaxItems: 1, Description: "RoleRef references the Role for this binding"},
+		"subject":  {Required: true, MinItems: 1, Description: "Subjects defines the entities to bind a Role to."},
 	},
-	Blocks: map[string]ConverterSpec{},
 }
@@ -5,15 +5,9 @@ package gen
 // DO NOT EDIT.
 
 import (
-	"fmt"
-
 	"github.com/zclconf/go-cty/cty"
 )
 
-// Make sure resources with no list attributes don't cause build errors because
-// of an unused import.
-var _ = fmt.Sprintf
-
 func init() {
 	register("kubernetes_role_binding_v1", kubernetesRoleBindingV1)
 }
@@ -23,34 +17,17 @@ var kubernetesRoleBindingV1 = ConverterSpec{
 	Attributes:   map[string]func(any) (cty.Value, error){},
 	Blocks: map[string]ConverterSpec{
 		"metadata": kubernetesRoleBindingV1_metadata,
-		"role_ref": kubernetesRoleBindingV1_roleRef,
-		"subject":  kubernetesRoleBindingV1_subject,
+		"role_ref": kubernetesClusterRoleBindingV1_roleRef,
+		"subject":  kubernetesClusterRoleBindingV1_subject,
 	},
-}
-
-var kubernetesRoleBindingV1_subject = ConverterSpec{
-	ResourceName: "kubernetes_role_binding_v1",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"api_group": toString,
-		"kind":      toString,
-		"name":      toString,
-		"namespace": toString,
+	Schema: map[string]FieldSchema{
+		"metadata": {Required: true, MaxItems: 1, Description: "Standard roleBinding's metadata. More info: https://github.com/kubernetes/community/blob/master/contributors/devel/sig-architecture/api-conventions.md#metadata"},
+		"role_ref": {Required: true, ForceNew: true, MaxItems: 1, Description: "RoleRef references the Role for this binding"},
+		"subject":  {Required: true, MinItems: 1, Description: "Subjects defines the entities to bind a Role to."},
 	},
-	Blocks: map[string]ConverterSpec{},
-}
-
-var kubernetesRoleBindingV1_roleRef = ConverterSpec{
-	ResourceName: "kubernetes_role_binding_v1",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"api_group": toString,
-		"kind":      toString,
-		"name":      toString,
-	},
-	Blocks: map[string]ConverterSpec{},
 }
 
 var kubernetesRoleBindingV1_metadata = ConverterSpec{
-	ResourceName: "kubernetes_role_binding_v1",
 	Attributes: map[string]func(any) (cty.Value, error){
 		"annotations":   toStringMap,
 		"generate_name": toString,
@@ -58,5 +35,11 @@ var kubernetesRoleBindingV1_metadata = ConverterSpec{
 		"name":          toString,
 		"namespace":     toString,
 	},
-	Blocks: map[string]ConverterSpec{},
+	Schema: map[string]FieldSchema{
+		"annotations":   {Optional: true, Description: "An unstructured key value map stored with the roleBinding that may be used to store arbitrary metadata. More info: https://kubernetes.io/docs/concepts/overview/working-with-objects/annotations/"},
+		"generate_name": {Optional: true, ForceNew: true, ConflictsWith: []string{"metadata.name"}, Description: "Prefix, used by the server, to generate a unique name ONLY IF the `name` field has not been provided. This value will also be combined with a unique suffix. More info: https://github.com/kubernetes/community/blob/master/contributors/devel/sig-architecture/api-conventions.md#idempotency"},
+		"labels":        {Optional: true, Description: "Map of string keys and values that can be used to organize and categorize (scope and select) the roleBinding. May match selectors of replication controllers and services. More info: https://kubernetes.io/docs/concepts/overview/working-with-objects/labels/"},
+		"name":          {Optional: true, ForceNew: true, ConflictsWith: []string{"metadata.generate_name"}, Description: "Name of the roleBinding, must be unique. Cannot be updated. More info: https://kubernetes.io/docs/concepts/overview/working-with-objects/names/#names"},
+		"namespace":     {Optional: true, ForceNew: true, Description: "Namespace defines the space within which name of the roleBinding must be unique.", Default: cty.StringVal("default")},
+	},
 }
@@ -5,15 +5,9 @@ package gen
 // DO NOT EDIT.
 
 import (
-	"fmt"
-
 	"github.com/zclconf/go-cty/cty"
 )
 
-// Make sure resources with no list attributes don't cause build errors because
-// of an unused import.
-var _ = fmt.Sprintf
-
 func init() {
 	register("kubernetes_secret", kubernetesSecret)
 }
@@ -32,18 +26,18 @@ var kubernetesSecret = ConverterSpec{
 		"wait_for_service_account_token": toBool,
 	},
 	Blocks: map[string]ConverterSpec{
-		"metadata": kubernetesSecret_metadata,
+		"metadata": kubernetesSecretV1_metadata,
 	},
-}
-
-var kubernetesSecret_metadata = ConverterSpec{
-	ResourceName: "kubernetes_secret",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"annotations":   toStringMap,
-		"generate_name": toString,
-		"labels":        toStringMap,
-		"name":          toString,
-		"namespace":     toString,
+	Schema: map[string]FieldSchema{
+		"binary_data":                    {Optional: true, Sensitive: true, Description: "A map of the secret data in base64 encoding. Use this for binary data."},
+		"binary_data_wo":                 {Optional: true, ConflictsWith: []string{"binary_data"}, Description: "A write-only map of the secret data in base64 encoding. Use this for binary data."},
+		"binary_data_wo_revision":        {Optional: true, Description: "The current revision of the write-only \"binary_data_wo\" attribute. Incrementing this integer value will cause Terraform to update the write-only value."},
+		"data":                           {Optional: true, Sensitive: true, Description: "A map of the secret data."},
+		"data_wo":                        {Optional: true, ConflictsWith: []string{"data"}, Description: "A map write-only of the secret data."},
+		"data_wo_revision":               {Optional: true, Description: "The current revision of the write-only \"data_wo\" attribute. Incrementing this integer value will cause Terraform to update the write-only value."},
+		"immutable":                      {Optional: true, Description: "Ensures that data stored in the Secret cannot be updated (only object metadata can be modified)."},
+		"metadata":                       {Required: true, MaxItems: 1, Description: "Standard secret's metadata. More info: https://github.com/kubernetes/community/blob/master/contributors/devel/sig-architecture/api-conventions.md#metadata"},
+		"type":                           {Optional: true, ForceNew: true, Description: "Type of secret", Default: cty.StringVal("Opaque")},
+		"wait_for_service_account_token": {Optional: true, Description: "Terraform will wait for the service account token to be created.", Default: cty.BoolVal(true)},
 	},
-	Blocks: map[string]ConverterSpec{},
 }
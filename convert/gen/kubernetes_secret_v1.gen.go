@@ -5,15 +5,9 @@ package gen
 // DO NOT EDIT.
 
 import (
-	"fmt"
-
 	"github.com/zclconf/go-cty/cty"
 )
 
-// Make sure resources with no list attributes don't cause build errors because
-// of an unused import.
-var _ = fmt.Sprintf
-
 func init() {
 	register("kubernetes_secret_v1", kubernetesSecretV1)
 }
@@ -34,10 +28,21 @@ var kubernetesSecretV1 = ConverterSpec{
 	Blocks: map[string]ConverterSpec{
 		"metadata": kubernetesSecretV1_metadata,
 	},
+	Schema: map[string]FieldSchema{
+		"binary_data":                    {Optional: true, Sensitive: true, Description: "A map of the secret data in base64 encoding. Use this for binary data."},
+		"binary_data_wo":                 {Optional: true, ConflictsWith: []string{"binary_data"}, Description: "A write-only map of the secret data in base64 encoding. Use this for binary data."},
+		"binary_data_wo_revision":        {Optional: true, Description: "The current revision of the write-only \"binary_data_wo\" attribute. Incrementing this integer value will cause Terraform to update the write-only value."},
+		"data":                           {Optional: true, Sensitive: true, Description: "A map of the secret data."},
+		"data_wo":                        {Optional: true, ConflictsWith: []string{"data"}, Description: "A map write-only of the secret data."},
+		"data_wo_revision":               {Optional: true, Description: "The current revision of the write-only \"data_wo\" attribute. Incrementing this integer value will cause Terraform to update the write-only value."},
+		"immutable":                      {Optional: true, Description: "Ensures that data stored in the Secret cannot be updated (only object metadata can be modified)."},
+		"metadata":                       {Required: true, MaxItems: 1, Description: "Standard secret's metadata. More info: https://github.com/kubernetes/community/blob/master/contributors/devel/sig-architecture/api-conventions.md#metadata"},
+		"type":                           {Optional: true, ForceNew: true, Description: "Type of secret", Default: cty.StringVal("Opaque")},
+		"wait_for_service_account_token": {Optional: true, Description: "Terraform will wait for the service account token to be created.", Default: cty.BoolVal(true)},
+	},
 }
 
 var kubernetesSecretV1_metadata = ConverterSpec{
-	ResourceName: "kubernetes_secret_v1",
 	Attributes: map[string]func(any) (cty.Value, error){
 		"annotations":   toStringMap,
 		"generate_name": toString,
@@ -45,5 +50,11 @@ var kubernetesSecretV1_metadata = ConverterSpec{
 		"name":          toString,
 		"namespace":     toString,
 	},
-	Blocks: map[string]ConverterSpec{},
+	Schema: map[string]FieldSchema{
+		"annotations":   {Optional: true, Description: "An unstructured key value map stored with the secret that may be used to store arbitrary metadata. More info: https://kubernetes.io/docs/concepts/overview/working-with-objects/annotations/"},
+		"generate_name": {Optional: true, ForceNew: true, ConflictsWith: []string{"metadata.name"}, Description: "Prefix, used by the server, to generate a unique name ONLY IF the `name` field has not been provided. This value will also be combined with a unique suffix. More info: https://github.com/kubernetes/community/blob/master/contributors/devel/sig-architecture/api-conventions.md#idempotency"},
+		"labels":        {Optional: true, Description: "Map of string keys and values that can be used to organize and categorize (scope and select) the secret. May match selectors of replication controllers and services. More info: https://kubernetes.io/docs/concepts/overview/working-with-objects/labels/"},
+		"name":          {Optional: true, ForceNew: true, ConflictsWith: []string{"metadata.generate_name"}, Description: "Name of the secret, must be unique. Cannot be updated. More info: https://kubernetes.io/docs/concepts/overview/working-with-objects/names/#names"},
+		"namespace":     {Optional: true, ForceNew: true, Description: "Namespace defines the space within which name of the secret must be unique.", Default: cty.StringVal("default")},
+	},
 }
@@ -5,15 +5,9 @@ package gen
 // DO NOT EDIT.
 
 import (
-	"fmt"
-
 	"github.com/zclconf/go-cty/cty"
 )
 
-// Make sure resources with no list attributes don't cause build errors because
-// of an unused import.
-var _ = fmt.Sprintf
-
 func init() {
 	register("kubernetes_secret_v1_data", kubernetesSecretV1Data)
 }
@@ -28,13 +22,21 @@ var kubernetesSecretV1Data = ConverterSpec{
 	Blocks: map[string]ConverterSpec{
 		"metadata": kubernetesSecretV1Data_metadata,
 	},
+	Schema: map[string]FieldSchema{
+		"data":          {Required: true, Description: "Data to be stored in the Kubernetes Secret."},
+		"field_manager": {Optional: true, Description: "Set the name of the field manager for the specified labels", Default: cty.StringVal("Terraform")},
+		"force":         {Optional: true, Description: "Flag to force updates to the Kubernetes Secret."},
+		"metadata":      {Required: true, MaxItems: 1, Description: "Metadata for the kubernetes Secret."},
+	},
 }
 
 var kubernetesSecretV1Data_metadata = ConverterSpec{
-	ResourceName: "kubernetes_secret_v1_data",
 	Attributes: map[string]func(any) (cty.Value, error){
 		"name":      toString,
 		"namespace": toString,
 	},
-	Blocks: map[string]ConverterSpec{},
+	Schema: map[string]FieldSchema{
+		"name":      {Required: true, ForceNew: true, Description: "The name of the Secret."},
+		"namespace": {Optional: true, ForceNew: true, Description: "The namespace of the Secret.", Default: cty.StringVal("default")},
+	},
 }
@@ -5,15 +5,9 @@ package gen
 // DO NOT EDIT.
 
 import (
-	"fmt"
-
 	"github.com/zclconf/go-cty/cty"
 )
 
-// Make sure resources with no list attributes don't cause build errors because
-// of an unused import.
-var _ = fmt.Sprintf
-
 func init() {
 	register("kubernetes_service", kubernetesService)
 }
@@ -24,131 +18,12 @@ var kubernetesService = ConverterSpec{
 		"wait_for_load_balancer": toBool,
 	},
 	Blocks: map[string]ConverterSpec{
-		"metadata": kubernetesService_metadata,
-		"spec":     kubernetesService_spec,
-	},
-}
-
-var kubernetesService_spec = ConverterSpec{
-	ResourceName: "kubernetes_service",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"allocate_load_balancer_node_ports": toBool,
-		"cluster_ip":                        toString,
-		"cluster_ips": func(a any) (cty.Value, error) {
-			l, ok := a.([]any)
-			if !ok {
-				return cty.Value{}, fmt.Errorf("expected slice, got: %T", a)
-			}
-			vl := make([]cty.Value, 0, len(l))
-			for _, val := range l {
-				v, err := toString(val)
-				if err != nil {
-					return cty.Value{}, err
-				}
-				vl = append(vl, v)
-			}
-			return cty.ListVal(vl), nil
-		},
-		"external_ips": func(a any) (cty.Value, error) {
-			l, ok := a.([]any)
-			if !ok {
-				return cty.Value{}, fmt.Errorf("expected slice, got: %T", a)
-			}
-			vl := make([]cty.Value, 0, len(l))
-			for _, val := range l {
-				v, err := toString(val)
-				if err != nil {
-					return cty.Value{}, err
-				}
-				vl = append(vl, v)
-			}
-			return cty.ListVal(vl), nil
-		},
-		"external_name":           toString,
-		"external_traffic_policy": toString,
-		"health_check_node_port":  toInt,
-		"internal_traffic_policy": toString,
-		"ip_families": func(a any) (cty.Value, error) {
-			l, ok := a.([]any)
-			if !ok {
-				return cty.Value{}, fmt.Errorf("expected slice, got: %T", a)
-			}
-			vl := make([]cty.Value, 0, len(l))
-			for _, val := range l {
-				v, err := toString(val)
-				if err != nil {
-					return cty.Value{}, err
-				}
-				vl = append(vl, v)
-			}
-			return cty.ListVal(vl), nil
-		},
-		"ip_family_policy":    toString,
-		"load_balancer_class": toString,
-		"load_balancer_ip":    toString,
-		"load_balancer_source_ranges": func(a any) (cty.Value, error) {
-			l, ok := a.([]any)
-			if !ok {
-				return cty.Value{}, fmt.Errorf("expected slice, got: %T", a)
-			}
-			vl := make([]cty.Value, 0, len(l))
-			for _, val := range l {
-				v, err := toString(val)
-				if err != nil {
-					return cty.Value{}, err
-				}
-				vl = append(vl, v)
-			}
-			return cty.ListVal(vl), nil
-		},
-		"publish_not_ready_addresses": toBool,
-		"selector":                    toStringMap,
-		"session_affinity":            toString,
-		"type":                        toString,
-	},
-	Blocks: map[string]ConverterSpec{
-		"port":                    kubernetesService_spec_port,
-		"session_affinity_config": kubernetesService_spec_sessionAffinityConfig,
-	},
-}
-
-var kubernetesService_spec_sessionAffinityConfig = ConverterSpec{
-	ResourceName: "kubernetes_service",
-	Attributes:   map[string]func(any) (cty.Value, error){},
-	Blocks: map[string]ConverterSpec{
-		"client_ip": kubernetesService_spec_sessionAffinityConfig_clientIp,
+		"metadata": kubernetesServiceV1_metadata,
+		"spec":     kubernetesServiceV1_spec,
 	},
-}
-
-var kubernetesService_spec_sessionAffinityConfig_clientIp = ConverterSpec{
-	ResourceName: "kubernetes_service",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"timeout_seconds": toInt,
-	},
-	Blocks: map[string]ConverterSpec{},
-}
-
-var kubernetesService_spec_port = ConverterSpec{
-	ResourceName: "kubernetes_service",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"app_protocol": toString,
-		"name":         toString,
-		"node_port":    toInt,
-		"port":         toInt,
-		"protocol":     toString,
-		"target_port":  toString,
-	},
-	Blocks: map[string]ConverterSpec{},
-}
-
-var kubernetesService_metadata = ConverterSpec{
-	ResourceName: "kubernetes_service",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"annotations":   toStringMap,
-		"generate_name": toString,
-		"labels":        toStringMap,
-		"name":          toString,
-		"namespace":     toString,
+	Schema: map[string]FieldSchema{
+		"metadata":               {Required: true, MaxItems: 1, Description: "Standard service's metadata. More info: https://github.com/kubernetes/community/blob/master/contributors/devel/sig-architecture/api-conventions.md#metadata"},
+		"spec":                   {Required: true, MaxItems: 1, Description: "Spec defines the behavior of a service. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#spec-and-status"},
+		"wait_for_load_balancer": {Optional: true, Description: "Terraform will wait for the load balancer to have at least 1 endpoint before considering the resource created.", Default: cty.BoolVal(true)},
 	},
-	Blocks: map[string]ConverterSpec{},
 }
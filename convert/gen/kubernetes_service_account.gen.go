@@ -5,15 +5,9 @@ package gen
 // DO NOT EDIT.
 
 import (
-	"fmt"
-
 	"github.com/zclconf/go-cty/cty"
 )
 
-// Make sure resources with no list attributes don't cause build errors because
-// of an unused import.
-var _ = fmt.Sprintf
-
 func init() {
 	register("kubernetes_service_account", kubernetesServiceAccount)
 }
@@ -24,36 +18,14 @@ var kubernetesServiceAccount = ConverterSpec{
 		"automount_service_account_token": toBool,
 	},
 	Blocks: map[string]ConverterSpec{
-		"image_pull_secret": kubernetesServiceAccount_imagePullSecret,
-		"metadata":          kubernetesServiceAccount_metadata,
-		"secret":            kubernetesServiceAccount_secret,
-	},
-}
-
-var kubernetesServiceAccount_secret = ConverterSpec{
-	ResourceName: "kubernetes_service_account",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"name": toString,
-	},
-	Blocks: map[string]ConverterSpec{},
-}
-
-var kubernetesServiceAccount_imagePullSecret = ConverterSpec{
-	ResourceName: "kubernetes_service_account",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"name": toString,
+		"image_pull_secret": kubernetesDefaultServiceAccountV1_imagePullSecret,
+		"metadata":          kubernetesServiceAccountV1_metadata,
+		"secret":            kubernetesDefaultServiceAccountV1_imagePullSecret,
 	},
-	Blocks: map[string]ConverterSpec{},
-}
-
-var kubernetesServiceAccount_metadata = ConverterSpec{
-	ResourceName: "kubernetes_service_account",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"annotations":   toStringMap,
-		"generate_name": toString,
-		"labels":        toStringMap,
-		"name":          toString,
-		"namespace":     toString,
+	Schema: map[string]FieldSchema{
+		"automount_service_account_token": {Optional: true, Description: "Enable automatic mounting of the service account token", Default: cty.BoolVal(true)},
+		"image_pull_secret":               {Optional: true, Description: "A list of references to secrets in the same namespace to use for pulling any images in pods that reference this Service Account. More info: https://kubernetes.io/docs/concepts/containers/images/#specifying-imagepullsecrets-on-a-pod"},
+		"metadata":                        {Required: true, MaxItems: 1, Description: "Standard service account's metadata. More info: https://github.com/kubernetes/community/blob/master/contributors/devel/sig-architecture/api-conventions.md#metadata"},
+		"secret":                          {Optional: true, Description: "A list of secrets allowed to be used by pods running using this Service Account. More info: https://kubernetes.io/docs/concepts/configuration/secret"},
 	},
-	Blocks: map[string]ConverterSpec{},
 }
@@ -5,15 +5,9 @@ package gen
 // DO NOT EDIT.
 
 import (
-	"fmt"
-
 	"github.com/zclconf/go-cty/cty"
 )
 
-// Make sure resources with no list attributes don't cause build errors because
-// of an unused import.
-var _ = fmt.Sprintf
-
 func init() {
 	register("kubernetes_service_account_v1", kubernetesServiceAccountV1)
 }
@@ -24,30 +18,19 @@ var kubernetesServiceAccountV1 = ConverterSpec{
 		"automount_service_account_token": toBool,
 	},
 	Blocks: map[string]ConverterSpec{
-		"image_pull_secret": kubernetesServiceAccountV1_imagePullSecret,
+		"image_pull_secret": kubernetesDefaultServiceAccountV1_imagePullSecret,
 		"metadata":          kubernetesServiceAccountV1_metadata,
-		"secret":            kubernetesServiceAccountV1_secret,
+		"secret":            kubernetesDefaultServiceAccountV1_imagePullSecret,
 	},
-}
-
-var kubernetesServiceAccountV1_secret = ConverterSpec{
-	ResourceName: "kubernetes_service_account_v1",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"name": toString,
+	Schema: map[string]FieldSchema{
+		"automount_service_account_token": {Optional: true, Description: "Enable automatic mounting of the service account token", Default: cty.BoolVal(true)},
+		"image_pull_secret":               {Optional: true, Description: "A list of references to secrets in the same namespace to use for pulling any images in pods that reference this Service Account. More info: https://kubernetes.io/docs/concepts/containers/images/#specifying-imagepullsecrets-on-a-pod"},
+		"metadata":                        {Required: true, MaxItems: 1, Description: "Standard service account's metadata. More info: https://github.com/kubernetes/community/blob/master/contributors/devel/sig-architecture/api-conventions.md#metadata"},
+		"secret":                          {Optional: true, Description: "A list of secrets allowed to be used by pods running using this Service Account. More info: https://kubernetes.io/docs/concepts/configuration/secret"},
 	},
-	Blocks: map[string]ConverterSpec{},
-}
-
-var kubernetesServiceAccountV1_imagePullSecret = ConverterSpec{
-	ResourceName: "kubernetes_service_account_v1",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"name": toString,
-	},
-	Blocks: map[string]ConverterSpec{},
 }
 
 var kubernetesServiceAccountV1_metadata = ConverterSpec{
-	ResourceName: "kubernetes_service_account_v1",
 	Attributes: map[string]func(any) (cty.Value, error){
 		"annotations":   toStringMap,
 		"generate_name": toString,
@@ -55,5 +38,11 @@ var kubernetesServiceAccountV1_metadata = ConverterSpec{
 		"name":          toString,
 		"namespace":     toString,
 	},
-	Blocks: map[string]ConverterSpec{},
+	Schema: map[string]FieldSchema{
+		"annotations":   {Optional: true, Description: "An unstructured key value map stored with the service account that may be used to store arbitrary metadata. More info: https://kubernetes.io/docs/concepts/overview/working-with-objects/annotations/"},
+		"generate_name": {Optional: true, ForceNew: true, ConflictsWith: []string{"metadata.name"}, Description: "Prefix, used by the server, to generate a unique name ONLY IF the `name` field has not been provided. This value will also be combined with a unique suffix. More info: https://github.com/kubernetes/community/blob/master/contributors/devel/sig-architecture/api-conventions.md#idempotency"},
+		"labels":        {Optional: true, Description: "Map of string keys and values that can be used to organize and categorize (scope and select) the service account. May match selectors of replication controllers and services. More info: https://kubernetes.io/docs/concepts/overview/working-with-objects/labels/"},
+		"name":          {Optional: true, ForceNew: true, ConflictsWith: []string{"metadata.generate_name"}, Description: "Name of the service account, must be unique. Cannot be updated. More info: https://kubernetes.io/docs/concepts/overview/working-with-objects/names/#names"},
+		"namespace":     {Optional: true, ForceNew: true, Description: "Namespace defines the space within which name of the service account must be unique.", Default: cty.StringVal("default")},
+	},
 }
@@ -5,15 +5,9 @@ package gen
 // DO NOT EDIT.
 
 import (
-	"fmt"
-
 	"github.com/zclconf/go-cty/cty"
 )
 
-// Make sure resources with no list attributes don't cause build errors because
-// of an unused import.
-var _ = fmt.Sprintf
-
 func init() {
 	register("kubernetes_service_v1", kubernetesServiceV1)
 }
@@ -27,109 +21,78 @@ var kubernetesServiceV1 = ConverterSpec{
 		"metadata": kubernetesServiceV1_metadata,
 		"spec":     kubernetesServiceV1_spec,
 	},
+	Schema: map[string]FieldSchema{
+		"metadata":               {Required: true, MaxItems: 1, Description: "Standard service's metadata. More info: https://github.com/kubernetes/community/blob/master/contributors/devel/sig-architecture/api-conventions.md#metadata"},
+		"spec":                   {Required: true, MaxItems: 1, Description: "Spec defines the behavior of a service. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#spec-and-status"},
+		"wait_for_load_balancer": {Optional: true, Description: "Terraform will wait for the load balancer to have at least 1 endpoint before considering the resource created.", Default: cty.BoolVal(true)},
+	},
+}
+
+var kubernetesServiceV1_metadata = ConverterSpec{
+	Attributes: map[string]func(any) (cty.Value, error){
+		"annotations":   toStringMap,
+		"generate_name": toString,
+		"labels":        toStringMap,
+		"name":          toString,
+		"namespace":     toString,
+	},
+	Schema: map[string]FieldSchema{
+		"annotations":   {Optional: true, Description: "An unstructured key value map stored with the service that may be used to store arbitrary metadata. More info: https://kubernetes.io/docs/concepts/overview/working-with-objects/annotations/"},
+		"generate_name": {Optional: true, ForceNew: true, ConflictsWith: []string{"metadata.name"}, Description: "Prefix, used by the server, to generate a unique name ONLY IF the `name` field has not been provided. This value will also be combined with a unique suffix. More info: https://github.com/kubernetes/community/blob/master/contributors/devel/sig-architecture/api-conventions.md#idempotency"},
+		"labels":        {Optional: true, Description: "Map of string keys and values that can be used to organize and categorize (scope and select) the service. May match selectors of replication controllers and services. More info: https://kubernetes.io/docs/concepts/overview/working-with-objects/labels/"},
+		"name":          {Optional: true, ForceNew: true, ConflictsWith: []string{"metadata.generate_name"}, Description: "Name of the service, must be unique. Cannot be updated. More info: https://kubernetes.io/docs/concepts/overview/working-with-objects/names/#names"},
+		"namespace":     {Optional: true, ForceNew: true, Description: "Namespace defines the space within which name of the service must be unique.", Default: cty.StringVal("default")},
+	},
 }
 
 var kubernetesServiceV1_spec = ConverterSpec{
-	ResourceName: "kubernetes_service_v1",
 	Attributes: map[string]func(any) (cty.Value, error){
 		"allocate_load_balancer_node_ports": toBool,
 		"cluster_ip":                        toString,
-		"cluster_ips": func(a any) (cty.Value, error) {
-			l, ok := a.([]any)
-			if !ok {
-				return cty.Value{}, fmt.Errorf("expected slice, got: %T", a)
-			}
-			vl := make([]cty.Value, 0, len(l))
-			for _, val := range l {
-				v, err := toString(val)
-				if err != nil {
-					return cty.Value{}, err
-				}
-				vl = append(vl, v)
-			}
-			return cty.ListVal(vl), nil
-		},
-		"external_ips": func(a any) (cty.Value, error) {
-			l, ok := a.([]any)
-			if !ok {
-				return cty.Value{}, fmt.Errorf("expected slice, got: %T", a)
-			}
-			vl := make([]cty.Value, 0, len(l))
-			for _, val := range l {
-				v, err := toString(val)
-				if err != nil {
-					return cty.Value{}, err
-				}
-				vl = append(vl, v)
-			}
-			return cty.ListVal(vl), nil
-		},
-		"external_name":           toString,
-		"external_traffic_policy": toString,
-		"health_check_node_port":  toInt,
-		"internal_traffic_policy": toString,
-		"ip_families": func(a any) (cty.Value, error) {
-			l, ok := a.([]any)
-			if !ok {
-				return cty.Value{}, fmt.Errorf("expected slice, got: %T", a)
-			}
-			vl := make([]cty.Value, 0, len(l))
-			for _, val := range l {
-				v, err := toString(val)
-				if err != nil {
-					return cty.Value{}, err
-				}
-				vl = append(vl, v)
-			}
-			return cty.ListVal(vl), nil
-		},
-		"ip_family_policy":    toString,
-		"load_balancer_class": toString,
-		"load_balancer_ip":    toString,
-		"load_balancer_source_ranges": func(a any) (cty.Value, error) {
-			l, ok := a.([]any)
-			if !ok {
-				return cty.Value{}, fmt.Errorf("expected slice, got: %T", a)
-			}
-			vl := make([]cty.Value, 0, len(l))
-			for _, val := range l {
-				v, err := toString(val)
-				if err != nil {
-					return cty.Value{}, err
-				}
-				vl = append(vl, v)
-			}
-			return cty.ListVal(vl), nil
-		},
-		"publish_not_ready_addresses": toBool,
-		"selector":                    toStringMap,
-		"session_affinity":            toString,
-		"type":                        toString,
+		"cluster_ips":                       toList(toString),
+		"external_ips":                      toList(toString),
+		"external_name":                     toString,
+		"external_traffic_policy":           toString,
+		"health_check_node_port":            toInt,
+		"internal_traffic_policy":           toString,
+		"ip_families":                       toList(toString),
+		"ip_family_policy":                  toString,
+		"load_balancer_class":               toString,
+		"load_balancer_ip":                  toString,
+		"load_balancer_source_ranges":       toList(toString),
+		"publish_not_ready_addresses":       toBool,
+		"selector":                          toStringMap,
+		"session_affinity":                  toString,
+		"type":                              toString,
 	},
 	Blocks: map[string]ConverterSpec{
 		"port":                    kubernetesServiceV1_spec_port,
 		"session_affinity_config": kubernetesServiceV1_spec_sessionAffinityConfig,
 	},
-}
-
-var kubernetesServiceV1_spec_sessionAffinityConfig = ConverterSpec{
-	ResourceName: "kubernetes_service_v1",
-	Attributes:   map[string]func(any) (cty.Value, error){},
-	Blocks: map[string]ConverterSpec{
-		"client_ip": kubernetesServiceV1_spec_sessionAffinityConfig_clientIp,
+	Schema: map[string]FieldSchema{
+		"allocate_load_balancer_node_ports": {Optional: true, Description: "Defines if `NodePorts` will be automatically allocated for services with type `LoadBalancer`. It may be set to `false` if the cluster load-balancer does not rely on `NodePorts`.  If the caller requests specific `NodePorts` (by specifying a value), those requests will be respected, regardless of this field. This field may only be set for services with type `LoadBalancer`. Default is `true`. More info: https://kubernetes.io/docs/concepts/services-networking/service/#load-balancer-nodeport-allocation", Default: cty.BoolVal(true)},
+		"cluster_ip":                        {Optional: true, ForceNew: true, Description: "The IP address of the service. It is usually assigned randomly by the master. If an address is specified manually and is not in use by others, it will be allocated to the service; otherwise, creation of the service will fail. `None` can be specified for headless services when proxying is not required. Ignored if type is `ExternalName`. More info: https://kubernetes.io/docs/concepts/services-networking/service/#virtual-ips-and-service-proxies"},
+		"cluster_ips":                       {Optional: true, ForceNew: true, MaxItems: 2, Description: "List of IP addresses assigned to this service, and are usually assigned randomly. If an address is specified manually and is not in use by others, it will be allocated to the service; otherwise creation of the service will fail. If this field is not specified, it will be initialized from the `clusterIP` field. If this field is specified, clients must ensure that `clusterIPs[0]` and `clusterIP` have the same value. More info: https://kubernetes.io/docs/concepts/services-networking/service/#virtual-ips-and-service-proxies"},
+		"external_ips":                      {Optional: true, Description: "A list of IP addresses for which nodes in the cluster will also accept traffic for this service. These IPs are not managed by Kubernetes. The user is responsible for ensuring that traffic arrives at a node with this IP.  A common example is external load-balancers that are not part of the Kubernetes system."},
+		"external_name":                     {Optional: true, Description: "The external reference that kubedns or equivalent will return as a CNAME record for this service. No proxying will be involved. Must be a valid DNS name and requires `type` to be `ExternalName`."},
+		"external_traffic_policy":           {Optional: true, Description: "Denotes if this Service desires to route external traffic to node-local or cluster-wide endpoints. `Local` preserves the client source IP and avoids a second hop for LoadBalancer and Nodeport type services, but risks potentially imbalanced traffic spreading. `Cluster` obscures the client source IP and may cause a second hop to another node, but should have good overall load-spreading. More info: https://kubernetes.io/docs/tutorials/services/source-ip/"},
+		"health_check_node_port":            {Optional: true, ForceNew: true, Description: "Specifies the Healthcheck NodePort for the service. Only effects when type is set to `LoadBalancer` and external_traffic_policy is set to `Local`."},
+		"internal_traffic_policy":           {Optional: true, Description: "Specifies if the cluster internal traffic should be routed to all endpoints or node-local endpoints only. `Cluster` routes internal traffic to a Service to all endpoints. `Local` routes traffic to node-local endpoints only, traffic is dropped if no node-local endpoints are ready. The default value is `Cluster`."},
+		"ip_families":                       {Optional: true, MaxItems: 2, Description: "IPFamilies is a list of IP families (e.g. IPv4, IPv6) assigned to this service. This field is usually assigned automatically based on cluster configuration and the ipFamilyPolicy field. If this field is specified manually, the requested family is available in the cluster, and ipFamilyPolicy allows it, it will be used; otherwise creation of the service will fail. This field is conditionally mutable: it allows for adding or removing a secondary IP family, but it does not allow changing the primary IP family of the Service."},
+		"ip_family_policy":                  {Optional: true, Description: "IPFamilyPolicy represents the dual-stack-ness requested or required by this Service. If there is no value provided, then this field will be set to SingleStack. Services can be 'SingleStack' (a single IP family), 'PreferDualStack' (two IP families on dual-stack configured clusters or a single IP family on single-stack clusters), or 'RequireDualStack' (two IP families on dual-stack configured clusters, otherwise fail). The ipFamilies and clusterIPs fields depend on the value of this field."},
+		"load_balancer_class":               {Optional: true, ForceNew: true, Description: "The class of the load balancer implementation this Service belongs to. If specified, the value of this field must be a label-style identifier, with an optional prefix. This field can only be set when the Service type is `LoadBalancer`. If not set, the default load balancer implementation is used. This field can only be set when creating or updating a Service to type `LoadBalancer`. More info: https://kubernetes.io/docs/concepts/services-networking/service/#load-balancer-class"},
+		"load_balancer_ip":                  {Optional: true, Description: "Only applies to `type = LoadBalancer`. LoadBalancer will get created with the IP specified in this field. This feature depends on whether the underlying cloud-provider supports specifying this field when a load balancer is created. This field will be ignored if the cloud-provider does not support the feature."},
+		"load_balancer_source_ranges":       {Optional: true, Description: "If specified and supported by the platform, this will restrict traffic through the cloud-provider load-balancer will be restricted to the specified client IPs. This field will be ignored if the cloud-provider does not support the feature. More info: http://kubernetes.io/docs/user-guide/services-firewalls"},
+		"port":                              {Optional: true, MinItems: 1, Description: "The list of ports that are exposed by this service. More info: https://kubernetes.io/docs/concepts/services-networking/service/#virtual-ips-and-service-proxies"},
+		"publish_not_ready_addresses":       {Optional: true, Description: "When set to true, indicates that DNS implementations must publish the `notReadyAddresses` of subsets for the Endpoints associated with the Service. The default value is `false`. The primary use case for setting this field is to use a StatefulSet's Headless Service to propagate `SRV` records for its Pods without respect to their readiness for purpose of peer discovery.", Default: cty.BoolVal(false)},
+		"selector":                          {Optional: true, Description: "Route service traffic to pods with label keys and values matching this selector. Only applies to types `ClusterIP`, `NodePort`, and `LoadBalancer`. More info: https://kubernetes.io/docs/concepts/services-networking/service/"},
+		"session_affinity":                  {Optional: true, Description: "Used to maintain session affinity. Supports `ClientIP` and `None`. Defaults to `None`. More info: https://kubernetes.io/docs/concepts/services-networking/service/#virtual-ips-and-service-proxies", Default: cty.StringVal("None")},
+		"session_affinity_config":           {Optional: true, MaxItems: 1, Description: "Contains the configurations of session affinity. More info: https://kubernetes.io/docs/concepts/services-networking/service/#proxy-mode-ipvs"},
+		"type":                              {Optional: true, Description: "Determines how the service is exposed. Defaults to `ClusterIP`. Valid options are `ExternalName`, `ClusterIP`, `NodePort`, and `LoadBalancer`. `ExternalName` maps to the specified `external_name`. More info: https://kubernetes.io/docs/concepts/services-networking/service/#publishing-services-service-types", Default: cty.StringVal("ClusterIP")},
 	},
 }
 
-var kubernetesServiceV1_spec_sessionAffinityConfig_clientIp = ConverterSpec{
-	ResourceName: "kubernetes_service_v1",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"timeout_seconds": toInt,
-	},
-	Blocks: map[string]ConverterSpec{},
-}
-
 var kubernetesServiceV1_spec_port = ConverterSpec{
-	ResourceName: "kubernetes_service_v1",
 	Attributes: map[string]func(any) (cty.Value, error){
 		"app_protocol": toString,
 		"name":         toString,
@@ -138,17 +101,31 @@ var kubernetesServiceV1_spec_port = ConverterSpec{
 		"protocol":     toString,
 		"target_port":  toString,
 	},
-	Blocks: map[string]ConverterSpec{},
+	Schema: map[string]FieldSchema{
+		"app_protocol": {Optional: true, Description: "The application protocol for this port. This field follows standard Kubernetes label syntax. Un-prefixed names are reserved for IANA standard service names (as per RFC-6335 and http://www.iana.org/assignments/service-names). Non-standard protocols should use prefixed names such as mycompany.com/my-custom-protocol."},
+		"name":         {Optional: true, Description: "The name of this port within the service. All ports within the service must have unique names. Optional if only one ServicePort is defined on this service."},
+		"node_port":    {Optional: true, Description: "The port on each node on which this service is exposed when `type` is `NodePort` or `LoadBalancer`. Usually assigned by the system. If specified, it will be allocated to the service if unused or else creation of the service will fail. Default is to auto-allocate a port if the `type` of this service requires one. More info: https://kubernetes.io/docs/concepts/services-networking/service/#type-nodeport"},
+		"port":         {Required: true, Description: "The port that will be exposed by this service."},
+		"protocol":     {Optional: true, Description: "The IP protocol for this port. Supports `TCP` and `UDP`. Default is `TCP`.", Default: cty.StringVal("TCP")},
+		"target_port":  {Optional: true, Description: "Number or name of the port to access on the pods targeted by the service. Number must be in the range 1 to 65535. This field is ignored for services with `cluster_ip = \"None\"`. More info: https://kubernetes.io/docs/concepts/services-networking/service/#defining-a-service"},
+	},
 }
 
-var kubernetesServiceV1_metadata = ConverterSpec{
-	ResourceName: "kubernetes_service_v1",
+var kubernetesServiceV1_spec_sessionAffinityConfig = ConverterSpec{
+	Attributes: map[string]func(any) (cty.Value, error){},
+	Blocks: map[string]ConverterSpec{
+		"client_ip": kubernetesServiceV1_spec_sessionAffinityConfig_clientIp,
+	},
+	Schema: map[string]FieldSchema{
+		"client_ip": {Optional: true, MaxItems: 1, Description: "Contains the configurations of Client IP based session affinity."},
+	},
+}
+
+var kubernetesServiceV1_spec_sessionAffinityConfig_clientIp = ConverterSpec{
 	Attributes: map[string]func(any) (cty.Value, error){
-		"annotations":   toStringMap,
-		"generate_name": toString,
-		"labels":        toStringMap,
-		"name":          toString,
-		"namespace":     toString,
+		"timeout_seconds": toInt,
+	},
+	Schema: map[string]FieldSchema{
+		"timeout_seconds": {Optional: true, Description: "Specifies the seconds of `ClientIP` type session sticky time. The value must be > 0 and <= 86400(for 1 day) if `ServiceAffinity` == `ClientIP`."},
 	},
-	Blocks: map[string]ConverterSpec{},
 }
@@ -5,15 +5,9 @@ package gen
 // DO NOT EDIT.
 
 import (
-	"fmt"
-
 	"github.com/zclconf/go-cty/cty"
 )
 
-// Make sure resources with no list attributes don't cause build errors because
-// of an unused import.
-var _ = fmt.Sprintf
-
 func init() {
 	register("kubernetes_storage_class", kubernetesStorageClass)
 }
@@ -22,70 +16,24 @@ var kubernetesStorageClass = ConverterSpec{
 	ResourceName: "kubernetes_storage_class",
 	Attributes: map[string]func(any) (cty.Value, error){
 		"allow_volume_expansion": toBool,
-		"mount_options": func(a any) (cty.Value, error) {
-			l, ok := a.([]any)
-			if !ok {
-				return cty.Value{}, fmt.Errorf("expected slice, got: %T", a)
-			}
-			vl := make([]cty.Value, 0, len(l))
-			for _, val := range l {
-				v, err := toString(val)
-				if err != nil {
-					return cty.Value{}, err
-				}
-				vl = append(vl, v)
-			}
-			return cty.ListVal(vl), nil
-		},
-		"parameters":          toStringMap,
-		"reclaim_policy":      toString,
-		"storage_provisioner": toString,
-		"volume_binding_mode": toString,
-	},
-	Blocks: map[string]ConverterSpec{
-		"allowed_topologies": kubernetesStorageClass_allowedTopologies,
-		"metadata":           kubernetesStorageClass_metadata,
-	},
-}
-
-var kubernetesStorageClass_metadata = ConverterSpec{
-	ResourceName: "kubernetes_storage_class",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"annotations":   toStringMap,
-		"generate_name": toString,
-		"labels":        toStringMap,
-		"name":          toString,
+		"mount_options":          toList(toString),
+		"parameters":             toStringMap,
+		"reclaim_policy":         toString,
+		"storage_provisioner":    toString,
+		"volume_binding_mode":    toString,
 	},
-	Blocks: map[string]ConverterSpec{},
-}
-
-var kubernetesStorageClass_allowedTopologies = ConverterSpec{
-	ResourceName: "kubernetes_storage_class",
-	Attributes:   map[string]func(any) (cty.Value, error){},
 	Blocks: map[string]ConverterSpec{
-		"match_label_expressions": kubernetesStorageClass_allowedTopologies_matchLabelExpressions,
+		"allowed_topologies": kubernetesStorageClassV1_allowedTopologies,
+		"metadata":           kubernetesStorageClassV1_metadata,
 	},
-}
-
-var kubernetesStorageClass_allowedTopologies_matchLabelExpressions = ConverterSpec{
-	ResourceName: "kubernetes_storage_class",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"key": toString,
-		"values": func(a any) (cty.Value, error) {
-			l, ok := a.([]any)
-			if !ok {
-				return cty.Value{}, fmt.Errorf("expected slice, got: %T", a)
-			}
-			vl := make([]cty.Value, 0, len(l))
-			for _, val := range l {
-				v, err := toString(val)
-				if err != nil {
-					return cty.Value{}, err
-				}
-				vl = append(vl, v)
-			}
-			return cty.ListVal(vl), nil
-		},
+	Schema: map[string]FieldSchema{
+		"allow_volume_expansion": {Optional: true, Description: "Indicates whether the storage class allow volume expand", Default: cty.BoolVal(true)},
+		"allowed_topologies":     {Optional: true, ForceNew: true, MaxItems: 1, Description: "Restrict the node topologies where volumes can be dynamically provisioned."},
+		"metadata":               {Required: true, MaxItems: 1, Description: "Standard storage class's metadata. More info: https://github.com/kubernetes/community/blob/master/contributors/devel/sig-architecture/api-conventions.md#metadata"},
+		"mount_options":          {Optional: true, ForceNew: true, Description: "Persistent Volumes that are dynamically created by a storage class will have the mount options specified"},
+		"parameters":             {Optional: true, ForceNew: true, Description: "The parameters for the provisioner that should create volumes of this storage class"},
+		"reclaim_policy":         {Optional: true, Description: "Indicates the type of the reclaim policy", Default: cty.StringVal("Delete")},
+		"storage_provisioner":    {Required: true, ForceNew: true, Description: "Indicates the type of the provisioner"},
+		"volume_binding_mode":    {Optional: true, ForceNew: true, Description: "Indicates when volume binding and dynamic provisioning should occur", Default: cty.StringVal("Immediate")},
 	},
-	Blocks: map[string]ConverterSpec{},
 }
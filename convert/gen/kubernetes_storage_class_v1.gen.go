@@ -5,15 +5,9 @@ package gen
 // DO NOT EDIT.
 
 import (
-	"fmt"
-
 	"github.com/zclconf/go-cty/cty"
 )
 
-// Make sure resources with no list attributes don't cause build errors because
-// of an unused import.
-var _ = fmt.Sprintf
-
 func init() {
 	register("kubernetes_storage_class_v1", kubernetesStorageClassV1)
 }
@@ -22,70 +16,60 @@ var kubernetesStorageClassV1 = ConverterSpec{
 	ResourceName: "kubernetes_storage_class_v1",
 	Attributes: map[string]func(any) (cty.Value, error){
 		"allow_volume_expansion": toBool,
-		"mount_options": func(a any) (cty.Value, error) {
-			l, ok := a.([]any)
-			if !ok {
-				return cty.Value{}, fmt.Errorf("expected slice, got: %T", a)
-			}
-			vl := make([]cty.Value, 0, len(l))
-			for _, val := range l {
-				v, err := toString(val)
-				if err != nil {
-					return cty.Value{}, err
-				}
-				vl = append(vl, v)
-			}
-			return cty.ListVal(vl), nil
-		},
-		"parameters":          toStringMap,
-		"reclaim_policy":      toString,
-		"storage_provisioner": toString,
-		"volume_binding_mode": toString,
+		"mount_options":          toList(toString),
+		"parameters":             toStringMap,
+		"reclaim_policy":         toString,
+		"storage_provisioner":    toString,
+		"volume_binding_mode":    toString,
 	},
 	Blocks: map[string]ConverterSpec{
 		"allowed_topologies": kubernetesStorageClassV1_allowedTopologies,
 		"metadata":           kubernetesStorageClassV1_metadata,
 	},
-}
-
-var kubernetesStorageClassV1_metadata = ConverterSpec{
-	ResourceName: "kubernetes_storage_class_v1",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"annotations":   toStringMap,
-		"generate_name": toString,
-		"labels":        toStringMap,
-		"name":          toString,
+	Schema: map[string]FieldSchema{
+		"allow_volume_expansion": {Optional: true, Description: "Indicates whether the storage class allow volume expand", Default: cty.BoolVal(true)},
+		"allowed_topologies":     {Optional: true, ForceNew: true, MaxItems: 1, Description: "Restrict the node topologies where volumes can be dynamically provisioned."},
+		"metadata":               {Required: true, MaxItems: 1, Description: "Standard storage class's metadata. More info: https://github.com/kubernetes/community/blob/master/contributors/devel/sig-architecture/api-conventions.md#metadata"},
+		"mount_options":          {Optional: true, ForceNew: true, Description: "Persistent Volumes that are dynamically created by a storage class will have the mount options specified"},
+		"parameters":             {Optional: true, ForceNew: true, Description: "The parameters for the provisioner that should create volumes of this storage class"},
+		"reclaim_policy":         {Optional: true, Description: "Indicates the type of the reclaim policy", Default: cty.StringVal("Delete")},
+		"storage_provisioner":    {Required: true, ForceNew: true, Description: "Indicates the type of the provisioner"},
+		"volume_binding_mode":    {Optional: true, ForceNew: true, Description: "Indicates when volume binding and dynamic provisioning should occur", Default: cty.StringVal("Immediate")},
 	},
-	Blocks: map[string]ConverterSpec{},
 }
 
 var kubernetesStorageClassV1_allowedTopologies = ConverterSpec{
-	ResourceName: "kubernetes_storage_class_v1",
-	Attributes:   map[string]func(any) (cty.Value, error){},
+	Attributes: map[string]func(any) (cty.Value, error){},
 	Blocks: map[string]ConverterSpec{
 		"match_label_expressions": kubernetesStorageClassV1_allowedTopologies_matchLabelExpressions,
 	},
+	Schema: map[string]FieldSchema{
+		"match_label_expressions": {Optional: true, Description: "A list of topology selector requirements by labels."},
+	},
 }
 
 var kubernetesStorageClassV1_allowedTopologies_matchLabelExpressions = ConverterSpec{
-	ResourceName: "kubernetes_storage_class_v1",
 	Attributes: map[string]func(any) (cty.Value, error){
-		"key": toString,
-		"values": func(a any) (cty.Value, error) {
-			l, ok := a.([]any)
-			if !ok {
-				return cty.Value{}, fmt.Errorf("expected slice, got: %T", a)
-			}
-			vl := make([]cty.Value, 0, len(l))
-			for _, val := range l {
-				v, err := toString(val)
-				if err != nil {
-					return cty.Value{}, err
-				}
-				vl = append(vl, v)
-			}
-			return cty.ListVal(vl), nil
-		},
+		"key":    toString,
+		"values": toList(toString),
+	},
+	Schema: map[string]FieldSchema{
+		"key":    {Optional: true, Description: "The label key that the selector applies to."},
+		"values": {Optional: true, Description: "An array of string values. One value must match the label to be selected."},
+	},
+}
+
+var kubernetesStorageClassV1_metadata = ConverterSpec{
+	Attributes: map[string]func(any) (cty.Value, error){
+		"annotations":   toStringMap,
+		"generate_name": toString,
+		"labels":        toStringMap,
+		"name":          toString,
+	},
+	Schema: map[string]FieldSchema{
+		"annotations":   {Optional: true, Description: "An unstructured key value map stored with the storage class that may be used to store arbitrary metadata. More info: https://kubernetes.io/docs/concepts/overview/working-with-objects/annotations/"},
+		"generate_name": {Optional: true, ForceNew: true, ConflictsWith: []string{"metadata.0.name"}, Description: "Prefix, used by the server, to generate a unique name ONLY IF the `name` field has not been provided. This value will also be combined with a unique suffix. More info: https://github.com/kubernetes/community/blob/master/contributors/devel/sig-architecture/api-conventions.md#idempotency"},
+		"labels":        {Optional: true, Description: "Map of string keys and values that can be used to organize and categorize (scope and select) the storage class. May match selectors of replication controllers and services. More info: https://kubernetes.io/docs/concepts/overview/working-with-objects/labels/"},
+		"name":          {Optional: true, ForceNew: true, ConflictsWith: []string{"metadata.0.generate_name"}, Description: "Name of the storage class, must be unique. Cannot be updated. More info: https://kubernetes.io/docs/concepts/overview/working-with-objects/names/#names"},
 	},
-	Blocks: map[string]ConverterSpec{},
 }
@@ -5,15 +5,9 @@ package gen
 // DO NOT EDIT.
 
 import (
-	"fmt"
-
 	"github.com/zclconf/go-cty/cty"
 )
 
-// Make sure resources with no list attributes don't cause build errors because
-// of an unused import.
-var _ = fmt.Sprintf
-
 func init() {
 	register("kubernetes_token_request_v1", kubernetesTokenRequestV1)
 }
@@ -25,52 +19,55 @@ var kubernetesTokenRequestV1 = ConverterSpec{
 		"metadata": kubernetesTokenRequestV1_metadata,
 		"spec":     kubernetesTokenRequestV1_spec,
 	},
+	Schema: map[string]FieldSchema{
+		"metadata": {Required: true, MaxItems: 1, Description: "Standard token request's metadata. More info: https://github.com/kubernetes/community/blob/master/contributors/devel/sig-architecture/api-conventions.md#metadata"},
+		"spec":     {Optional: true, ForceNew: true, MaxItems: 1},
+	},
+}
+
+var kubernetesTokenRequestV1_metadata = ConverterSpec{
+	Attributes: map[string]func(any) (cty.Value, error){
+		"annotations":   toStringMap,
+		"generate_name": toString,
+		"labels":        toStringMap,
+		"name":          toString,
+		"namespace":     toString,
+	},
+	Schema: map[string]FieldSchema{
+		"annotations":   {Optional: true, Description: "An unstructured key value map stored with the token request that may be used to store arbitrary metadata. More info: https://kubernetes.io/docs/concepts/overview/working-with-objects/annotations/"},
+		"generate_name": {Optional: true, ForceNew: true, ConflictsWith: []string{"metadata.name"}, Description: "Prefix, used by the server, to generate a unique name ONLY IF the `name` field has not been provided. This value will also be combined with a unique suffix. More info: https://github.com/kubernetes/community/blob/master/contributors/devel/sig-architecture/api-conventions.md#idempotency"},
+		"labels":        {Optional: true, Description: "Map of string keys and values that can be used to organize and categorize (scope and select) the token request. May match selectors of replication controllers and services. More info: https://kubernetes.io/docs/concepts/overview/working-with-objects/labels/"},
+		"name":          {Optional: true, ForceNew: true, ConflictsWith: []string{"metadata.generate_name"}, Description: "Name of the token request, must be unique. Cannot be updated. More info: https://kubernetes.io/docs/concepts/overview/working-with-objects/names/#names"},
+		"namespace":     {Optional: true, ForceNew: true, Description: "Namespace defines the space within which name of the token request must be unique.", Default: cty.StringVal("default")},
+	},
 }
 
 var kubernetesTokenRequestV1_spec = ConverterSpec{
-	ResourceName: "kubernetes_token_request_v1",
 	Attributes: map[string]func(any) (cty.Value, error){
-		"audiences": func(a any) (cty.Value, error) {
-			l, ok := a.([]any)
-			if !ok {
-				return cty.Value{}, fmt.Errorf("expected slice, got: %T", a)
-			}
-			vl := make([]cty.Value, 0, len(l))
-			for _, val := range l {
-				v, err := toString(val)
-				if err != nil {
-					return cty.Value{}, err
-				}
-				vl = append(vl, v)
-			}
-			return cty.ListVal(vl), nil
-		},
+		"audiences":          toList(toString),
 		"expiration_seconds": toInt,
 	},
 	Blocks: map[string]ConverterSpec{
 		"bound_object_ref": kubernetesTokenRequestV1_spec_boundObjectRef,
 	},
+	Schema: map[string]FieldSchema{
+		"audiences":          {Optional: true, ForceNew: true, Description: "Audiences are the intendend audiences of the token. A recipient of a token must identify themself with an identifier in the list of audiences of the token, and otherwise should reject the token. A token issued for multiple audiences may be used to authenticate against any of the audiences listed but implies a high degree of trust between the target audiences."},
+		"bound_object_ref":   {Optional: true, ForceNew: true, MaxItems: 1, Description: "BoundObjectRef is a reference to an object that the token will be bound to. The token will only be valid for as long as the bound object exists. NOTE: The API server's TokenReview endpoint will validate the BoundObjectRef, but other audiences may not. Keep ExpirationSeconds small if you want prompt revocation."},
+		"expiration_seconds": {Optional: true, ForceNew: true, Description: "expiration_seconds is the requested duration of validity of the request. The token issuer may return a token with a different validity duration so a client needs to check the 'expiration' field in a response. The expiration can't be less than 10 minutes."},
+	},
 }
 
 var kubernetesTokenRequestV1_spec_boundObjectRef = ConverterSpec{
-	ResourceName: "kubernetes_token_request_v1",
 	Attributes: map[string]func(any) (cty.Value, error){
 		"api_version": toString,
 		"kind":        toString,
 		"name":        toString,
 		"uid":         toString,
 	},
-	Blocks: map[string]ConverterSpec{},
-}
-
-var kubernetesTokenRequestV1_metadata = ConverterSpec{
-	ResourceName: "kubernetes_token_request_v1",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"annotations":   toStringMap,
-		"generate_name": toString,
-		"labels":        toStringMap,
-		"name":          toString,
-		"namespace":     toString,
+	Schema: map[string]FieldSchema{
+		"api_version": {Optional: true, ForceNew: true, Description: "API version of the referent."},
+		"kind":        {Optional: true, ForceNew: true, Description: "Kind of the referent. Valid kinds are 'Pod' and 'Secret'."},
+		"name":        {Optional: true, ForceNew: true, Description: "Name of the referent."},
+		"uid":         {Optional: true, ForceNew: true, Description: "UID of the referent."},
 	},
-	Blocks: map[string]ConverterSpec{},
 }
@@ -5,15 +5,9 @@ package gen
 // DO NOT EDIT.
 
 import (
-	"fmt"
-
 	"github.com/zclconf/go-cty/cty"
 )
 
-// Make sure resources with no list attributes don't cause build errors because
-// of an unused import.
-var _ = fmt.Sprintf
-
 func init() {
 	register("kubernetes_validating_webhook_configuration", kubernetesValidatingWebhookConfiguration)
 }
@@ -22,208 +16,11 @@ var kubernetesValidatingWebhookConfiguration = ConverterSpec{
 	ResourceName: "kubernetes_validating_webhook_configuration",
 	Attributes:   map[string]func(any) (cty.Value, error){},
 	Blocks: map[string]ConverterSpec{
-		"metadata": kubernetesValidatingWebhookConfiguration_metadata,
-		"webhook":  kubernetesValidatingWebhookConfiguration_webhook,
-	},
-}
-
-var kubernetesValidatingWebhookConfiguration_webhook = ConverterSpec{
-	ResourceName: "kubernetes_validating_webhook_configuration",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"admission_review_versions": func(a any) (cty.Value, error) {
-			l, ok := a.([]any)
-			if !ok {
-				return cty.Value{}, fmt.Errorf("expected slice, got: %T", a)
-			}
-			vl := make([]cty.Value, 0, len(l))
-			for _, val := range l {
-				v, err := toString(val)
-				if err != nil {
-					return cty.Value{}, err
-				}
-				vl = append(vl, v)
-			}
-			return cty.ListVal(vl), nil
-		},
-		"failure_policy":  toString,
-		"match_policy":    toString,
-		"name":            toString,
-		"side_effects":    toString,
-		"timeout_seconds": toInt,
-	},
-	Blocks: map[string]ConverterSpec{
-		"client_config":      kubernetesValidatingWebhookConfiguration_webhook_clientConfig,
-		"namespace_selector": kubernetesValidatingWebhookConfiguration_webhook_namespaceSelector,
-		"object_selector":    kubernetesValidatingWebhookConfiguration_webhook_objectSelector,
-		"rule":               kubernetesValidatingWebhookConfiguration_webhook_rule,
-	},
-}
-
-var kubernetesValidatingWebhookConfiguration_webhook_rule = ConverterSpec{
-	ResourceName: "kubernetes_validating_webhook_configuration",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"api_groups": func(a any) (cty.Value, error) {
-			l, ok := a.([]any)
-			if !ok {
-				return cty.Value{}, fmt.Errorf("expected slice, got: %T", a)
-			}
-			vl := make([]cty.Value, 0, len(l))
-			for _, val := range l {
-				v, err := toString(val)
-				if err != nil {
-					return cty.Value{}, err
-				}
-				vl = append(vl, v)
-			}
-			return cty.ListVal(vl), nil
-		},
-		"api_versions": func(a any) (cty.Value, error) {
-			l, ok := a.([]any)
-			if !ok {
-				return cty.Value{}, fmt.Errorf("expected slice, got: %T", a)
-			}
-			vl := make([]cty.Value, 0, len(l))
-			for _, val := range l {
-				v, err := toString(val)
-				if err != nil {
-					return cty.Value{}, err
-				}
-				vl = append(vl, v)
-			}
-			return cty.ListVal(vl), nil
-		},
-		"operations": func(a any) (cty.Value, error) {
-			l, ok := a.([]any)
-			if !ok {
-				return cty.Value{}, fmt.Errorf("expected slice, got: %T", a)
-			}
-			vl := make([]cty.Value, 0, len(l))
-			for _, val := range l {
-				v, err := toString(val)
-				if err != nil {
-					return cty.Value{}, err
-				}
-				vl = append(vl, v)
-			}
-			return cty.ListVal(vl), nil
-		},
-		"resources": func(a any) (cty.Value, error) {
-			l, ok := a.([]any)
-			if !ok {
-				return cty.Value{}, fmt.Errorf("expected slice, got: %T", a)
-			}
-			vl := make([]cty.Value, 0, len(l))
-			for _, val := range l {
-				v, err := toString(val)
-				if err != nil {
-					return cty.Value{}, err
-				}
-				vl = append(vl, v)
-			}
-			return cty.ListVal(vl), nil
-		},
-		"scope": toString,
+		"metadata": kubernetesValidatingWebhookConfigurationV1_metadata,
+		"webhook":  kubernetesValidatingWebhookConfigurationV1_webhook,
 	},
-	Blocks: map[string]ConverterSpec{},
-}
-
-var kubernetesValidatingWebhookConfiguration_webhook_objectSelector = ConverterSpec{
-	ResourceName: "kubernetes_validating_webhook_configuration",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"match_labels": toStringMap,
-	},
-	Blocks: map[string]ConverterSpec{
-		"match_expressions": kubernetesValidatingWebhookConfiguration_webhook_objectSelector_matchExpressions,
-	},
-}
-
-var kubernetesValidatingWebhookConfiguration_webhook_objectSelector_matchExpressions = ConverterSpec{
-	ResourceName: "kubernetes_validating_webhook_configuration",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"key":      toString,
-		"operator": toString,
-		"values": func(a any) (cty.Value, error) {
-			l, ok := a.([]any)
-			if !ok {
-				return cty.Value{}, fmt.Errorf("expected slice, got: %T", a)
-			}
-			vl := make([]cty.Value, 0, len(l))
-			for _, val := range l {
-				v, err := toString(val)
-				if err != nil {
-					return cty.Value{}, err
-				}
-				vl = append(vl, v)
-			}
-			return cty.ListVal(vl), nil
-		},
-	},
-	Blocks: map[string]ConverterSpec{},
-}
-
-var kubernetesValidatingWebhookConfiguration_webhook_namespaceSelector = ConverterSpec{
-	ResourceName: "kubernetes_validating_webhook_configuration",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"match_labels": toStringMap,
-	},
-	Blocks: map[string]ConverterSpec{
-		"match_expressions": kubernetesValidatingWebhookConfiguration_webhook_namespaceSelector_matchExpressions,
-	},
-}
-
-var kubernetesValidatingWebhookConfiguration_webhook_namespaceSelector_matchExpressions = ConverterSpec{
-	ResourceName: "kubernetes_validating_webhook_configuration",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"key":      toString,
-		"operator": toString,
-		"values": func(a any) (cty.Value, error) {
-			l, ok := a.([]any)
-			if !ok {
-				return cty.Value{}, fmt.Errorf("expected slice, got: %T", a)
-			}
-			vl := make([]cty.Value, 0, len(l))
-			for _, val := range l {
-				v, err := toString(val)
-				if err != nil {
-					return cty.Value{}, err
-				}
-				vl = append(vl, v)
-			}
-			return cty.ListVal(vl), nil
-		},
-	},
-	Blocks: map[string]ConverterSpec{},
-}
-
-var kubernetesValidatingWebhookConfiguration_webhook_clientConfig = ConverterSpec{
-	ResourceName: "kubernetes_validating_webhook_configuration",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"ca_bundle": toString,
-		"url":       toString,
-	},
-	Blocks: map[string]ConverterSpec{
-		"service": kubernetesValidatingWebhookConfiguration_webhook_clientConfig_service,
-	},
-}
-
-var kubernetesValidatingWebhookConfiguration_webhook_clientConfig_service = ConverterSpec{
-	ResourceName: "kubernetes_validating_webhook_configuration",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"name":      toString,
-		"namespace": toString,
-		"path":      toString,
-		"port":      toInt,
-	},
-	Blocks: map[string]ConverterSpec{},
-}
-
-var kubernetesValidatingWebhookConfiguration_metadata = ConverterSpec{
-	ResourceName: "kubernetes_validating_webhook_configuration",
-	Attributes: map[string]func(any) (cty.Value, error){
-		"annotations":   toStringMap,
-		"generate_name": toString,
-		"labels":        toStringMap,
-		"name":          toString,
+	Schema: map[string]FieldSchema{
+		"metadata": {Required: true, MaxItems: 1, Description: "Standard validating webhook configuration's metadata. More info: https://github.com/kubernetes/community/blob/master/contributors/devel/sig-architecture/api-conventions.md#metadata"},
+		"webhook":  {Required: true, MinItems: 1, Description: "Webhooks is a list of webhooks and the affected resources and operations."},
 	},
-	Blocks: map[string]ConverterSpec{},
 }
@@ -0,0 +1,8 @@
+package gen
+
+// Code generated by github.com/pfcm/ktf/cmd/gen from github.com/pfcm/terraform-provider-kubernetes/v2@v2.38.1.
+// DO NOT EDIT.
+
+// ProviderVersion is the version of the kubernetes provider the specs were
+// generated from.
+const ProviderVersion = "2.38.1"
@@ -0,0 +1,313 @@
+package gen
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"maps"
+	"slices"
+	"strings"
+
+	"github.com/zclconf/go-cty/cty"
+
+	"github.com/pfcm/ktf/resource"
+)
+
+// ProviderSchema is the schema of the kubernetes provider, as printed by
+// `terraform providers schema -json`.
+type ProviderSchema struct {
+	ResourceSchemas map[string]ResourceSchema `json:"resource_schemas"`
+}
+
+// ResourceSchema is the schema of a single resource type.
+type ResourceSchema struct {
+	Version int         `json:"version"`
+	Block   SchemaBlock `json:"block"`
+}
+
+// SchemaBlock is the schema of a resource or one of its nested blocks.
+type SchemaBlock struct {
+	Attributes  map[string]SchemaAttribute `json:"attributes"`
+	BlockTypes  map[string]NestedBlock     `json:"block_types"`
+	Description string                     `json:"description"`
+	Deprecated  bool                       `json:"deprecated"`
+}
+
+// SchemaAttribute is the schema of an attribute.
+type SchemaAttribute struct {
+	Type        cty.Type `json:"type"`
+	Optional    bool     `json:"optional"`
+	Required    bool     `json:"required"`
+	Computed    bool     `json:"computed"`
+	Sensitive   bool     `json:"sensitive"`
+	Deprecated  bool     `json:"deprecated"`
+	Description string   `json:"description"`
+}
+
+// NestedBlock is the schema of a nested block.
+type NestedBlock struct {
+	NestingMode string      `json:"nesting_mode"`
+	Block       SchemaBlock `json:"block"`
+	MinItems    int         `json:"min_items"`
+	MaxItems    int         `json:"max_items"`
+}
+
+// ReadProviderSchema reads the output of `terraform providers schema -json`
+// and returns the schema of the hashicorp/kubernetes provider in it.
+func ReadProviderSchema(r io.Reader) (*ProviderSchema, error) {
+	var out struct {
+		ProviderSchemas map[string]*ProviderSchema `json:"provider_schemas"`
+	}
+	if err := json.NewDecoder(r).Decode(&out); err != nil {
+		return nil, fmt.Errorf("reading provider schemas: %w", err)
+	}
+	for addr, ps := range out.ProviderSchemas {
+		// The address is usually registry.terraform.io/hashicorp/kubernetes,
+		// but mirrors can have their own hostname.
+		if strings.HasSuffix(addr, "/hashicorp/kubernetes") || addr == "kubernetes" {
+			return ps, nil
+		}
+	}
+	return nil, fmt.Errorf("no schema for hashicorp/kubernetes, only %v", slices.Sorted(maps.Keys(out.ProviderSchemas)))
+}
+
+// Specs is a set of ConverterSpecs, by resource name.
+type Specs map[string]ConverterSpec
+
+// Find tries to find the ConverterSpec for the given type key, see
+// resourceTypes. Older providers only have the unversioned aliases of the v1
+// resources, like kubernetes_deployment, which are used instead with a
+// deprecation warning. Kinds which aren't listed there, but are in one of the
+// kubernetes API groups, can still match a versioned resource by name, like
+// kubernetes_foo_v1 for v1 Foo, in case a newer provider has one.
+func (s Specs) Find(tk resource.TypeKey) (ConverterSpec, bool) {
+	if name, ok := resourceTypes[tk]; ok {
+		if spec, ok := s[name]; ok {
+			return spec, true
+		}
+		alias, ok := strings.CutSuffix(name, "_v1")
+		if !ok {
+			return ConverterSpec{}, false
+		}
+		spec, ok := s[alias]
+		if ok && spec.Deprecated == "" {
+			spec.Deprecated = fmt.Sprintf("the provider has no %s, which replaces it in newer versions", name)
+		}
+		return spec, ok
+	}
+	group, version := tk.GroupVersion()
+	if !builtIn(group) {
+		return ConverterSpec{}, false
+	}
+	spec, ok := s["kubernetes_"+resource.ToSnake(tk.Kind)+"_"+version]
+	return spec, ok
+}
+
+// Specs builds the ConverterSpecs for every resource in the schema, except
+// kubernetes_manifest.
+func (ps *ProviderSchema) Specs() (Specs, error) {
+	specs := make(Specs, len(ps.ResourceSchemas))
+	for name, rs := range ps.ResourceSchemas {
+		if name == "kubernetes_manifest" {
+			continue
+		}
+		spec, err := blockSpec(resource.ToCamel(name), ResourceBlock(rs.Block))
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", name, err)
+		}
+		spec.ResourceName = name
+		spec.Deprecated = rs.DeprecationMessage()
+		specs[name] = spec
+	}
+	return specs, nil
+}
+
+// ResourceBlock returns the top level block of a resource without the
+// attributes and blocks terraform adds to every resource, id and timeouts,
+// which don't come from the kubernetes object.
+func ResourceBlock(b SchemaBlock) SchemaBlock {
+	b.Attributes = maps.Clone(b.Attributes)
+	delete(b.Attributes, "id")
+	b.BlockTypes = maps.Clone(b.BlockTypes)
+	delete(b.BlockTypes, "timeouts")
+	return b
+}
+
+// blockSpec builds the spec for a block. path is the name of the block, as
+// used by IsQuantityMap.
+func blockSpec(path string, b SchemaBlock) (ConverterSpec, error) {
+	spec := ConverterSpec{
+		Attributes: make(map[string]func(any) (cty.Value, error)),
+		Blocks:     make(map[string]ConverterSpec),
+		Schema:     make(map[string]FieldSchema),
+	}
+	for name, nb := range b.BlockTypes {
+		child, err := blockSpec(path+"_"+resource.ToCamel(name), nb.Block)
+		if err != nil {
+			return ConverterSpec{}, err
+		}
+		spec.Blocks[name] = child
+		spec.Schema[name] = nb.FieldSchema()
+	}
+	for name, a := range b.Attributes {
+		if a.Computed && !a.Optional {
+			// Read-only.
+			continue
+		}
+		spec.Schema[name] = a.FieldSchema()
+		if obj, ok := ObjectBlock(a.Type); ok {
+			child, err := blockSpec(path+"_"+resource.ToCamel(name), obj)
+			if err != nil {
+				return ConverterSpec{}, err
+			}
+			spec.Blocks[name] = child
+			continue
+		}
+		quantities := IsQuantityMap(path, name)
+		fname, list, err := ValueFunc(a.Type, quantities)
+		if err != nil {
+			return ConverterSpec{}, fmt.Errorf("%s: %w", name, err)
+		}
+		f := valueFuncs[fname]
+		if list {
+			f = toList(f)
+		}
+		spec.Attributes[name] = f
+		if quantities && !list {
+			if spec.Quantities == nil {
+				spec.Quantities = make(map[string]bool)
+			}
+			spec.Quantities[name] = true
+		}
+	}
+	return spec, nil
+}
+
+// deprecated is the deprecation message for things the schema says are
+// deprecated, as it doesn't say why.
+const deprecated = "Deprecated in the provider's schema."
+
+// DeprecationMessage returns the message for ConverterSpec.Deprecated.
+func (rs ResourceSchema) DeprecationMessage() string {
+	if rs.Block.Deprecated {
+		return deprecated
+	}
+	return ""
+}
+
+// FieldSchema returns the metadata for the attribute.
+func (a SchemaAttribute) FieldSchema() FieldSchema {
+	fs := FieldSchema{
+		Required:    a.Required,
+		Optional:    a.Optional,
+		Sensitive:   a.Sensitive,
+		Description: a.Description,
+	}
+	if a.Deprecated {
+		fs.Deprecated = deprecated
+	}
+	return fs
+}
+
+// FieldSchema returns the metadata for the block.
+func (nb NestedBlock) FieldSchema() FieldSchema {
+	fs := FieldSchema{
+		Required:    nb.MinItems > 0,
+		Optional:    nb.MinItems == 0,
+		MinItems:    nb.MinItems,
+		MaxItems:    nb.MaxItems,
+		Description: nb.Block.Description,
+	}
+	if nb.Block.Deprecated {
+		fs.Deprecated = deprecated
+	}
+	return fs
+}
+
+// ObjectBlock returns the equivalent block for attributes holding a list or set
+// of objects, which the provider accepts written as blocks.
+func ObjectBlock(t cty.Type) (SchemaBlock, bool) {
+	if !t.IsListType() && !t.IsSetType() || !t.ElementType().IsObjectType() {
+		return SchemaBlock{}, false
+	}
+	obj := t.ElementType()
+	b := SchemaBlock{Attributes: make(map[string]SchemaAttribute)}
+	for name, at := range obj.AttributeTypes() {
+		b.Attributes[name] = SchemaAttribute{Type: at, Optional: obj.AttributeOptional(name)}
+	}
+	return b, true
+}
+
+// valueFuncs are the converters named by ValueFunc.
+var valueFuncs = map[string]func(any) (cty.Value, error){
+	"toBool":        toBool,
+	"toNumber":      toNumber,
+	"toString":      toString,
+	"toStringMap":   toStringMap,
+	"toQuantityMap": toQuantityMap,
+}
+
+// ValueFunc returns the name of the converter for values of type t, and
+// whether it has to be applied to each element of a list. quantities picks
+// toQuantityMap for maps of strings.
+func ValueFunc(t cty.Type, quantities bool) (name string, list bool, err error) {
+	if (t.IsListType() || t.IsSetType()) && t.ElementType().IsPrimitiveType() {
+		name, _, err := ValueFunc(t.ElementType(), false)
+		return name, true, err
+	}
+	switch {
+	case t == cty.Bool:
+		return "toBool", false, nil
+	case t == cty.Number:
+		return "toNumber", false, nil
+	case t == cty.String:
+		return "toString", false, nil
+	case t.Equals(cty.Map(cty.String)) && quantities:
+		return "toQuantityMap", false, nil
+	case t.Equals(cty.Map(cty.String)):
+		return "toStringMap", false, nil
+	}
+	return "", false, fmt.Errorf("unsupported type %s", t.FriendlyName())
+}
+
+func toList(f func(any) (cty.Value, error)) func(any) (cty.Value, error) {
+	return func(a any) (cty.Value, error) {
+		l, ok := a.([]any)
+		if !ok {
+			return cty.Value{}, fmt.Errorf("expected slice, got: %T", a)
+		}
+		vl := make([]cty.Value, 0, len(l))
+		for _, val := range l {
+			v, err := f(val)
+			if err != nil {
+				return cty.Value{}, err
+			}
+			vl = append(vl, v)
+		}
+		return cty.ListVal(vl), nil
+	}
+}
+
+// quantityMaps lists the map attributes that hold kubernetes resource
+// quantities (like "100m" or "2Gi"), along with the suffix of the name of the
+// block they have to be in to count.
+var quantityMaps = map[string]string{
+	"limits":                  "_resources",
+	"requests":                "_resources",
+	"capacity":                "_spec",
+	"hard":                    "_spec",
+	"default":                 "_spec_limit",
+	"default_request":         "_spec_limit",
+	"max":                     "_spec_limit",
+	"max_limit_request_ratio": "_spec_limit",
+	"min":                     "_spec_limit",
+}
+
+// IsQuantityMap reports whether the map attribute attrName holds resource
+// quantities. blockName is the camel cased resource name followed by the
+// camel cased names of the blocks leading to the attribute, separated by
+// underscores, like "kubernetesPodV1_spec_container_resources".
+func IsQuantityMap(blockName, attrName string) bool {
+	suffix, ok := quantityMaps[attrName]
+	return ok && strings.HasSuffix(blockName, suffix)
+}
@@ -0,0 +1,244 @@
+package gen
+
+import (
+	"encoding/json"
+	"maps"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/zclconf/go-cty/cty"
+
+	"github.com/pfcm/ktf/resource"
+)
+
+const testSchema = `{
+  "format_version": "1.0",
+  "provider_schemas": {
+    "registry.terraform.io/hashicorp/random": {"resource_schemas": {}},
+    "registry.terraform.io/hashicorp/kubernetes": {
+      "resource_schemas": {
+        "kubernetes_widget_v1": {
+          "version": 1,
+          "block": {
+            "attributes": {
+              "id": {"type": "string", "optional": true, "computed": true},
+              "size": {"type": "number", "optional": true},
+              "tags": {"type": ["list", "string"], "optional": true, "deprecated": true, "description": "Use labels."},
+              "status": {"type": "string", "computed": true},
+              "port": {"type": ["list", ["object", {"name": "string", "number": "number"}]], "optional": true}
+            },
+            "block_types": {
+              "timeouts": {"nesting_mode": "single", "block": {"attributes": {"create": {"type": "string", "optional": true}}}},
+              "spec": {
+                "nesting_mode": "list",
+                "max_items": 1,
+                "block": {
+                  "block_types": {
+                    "resources": {
+                      "nesting_mode": "list",
+                      "block": {
+                        "attributes": {
+                          "limits": {"type": ["map", "string"], "optional": true},
+                          "labels": {"type": ["map", "string"], "optional": true}
+                        }
+                      }
+                    }
+                  }
+                }
+              }
+            }
+          }
+        },
+        "kubernetes_manifest": {"version": 1, "block": {"attributes": {"manifest": {"type": "dynamic", "required": true}}}}
+      }
+    }
+  }
+}`
+
+func TestProviderSchema(t *testing.T) {
+	ps, err := ReadProviderSchema(strings.NewReader(testSchema))
+	if err != nil {
+		t.Fatal(err)
+	}
+	specs, err := ps.Specs()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := slices.Sorted(maps.Keys(specs)); !slices.Equal(got, []string{"kubernetes_widget_v1"}) {
+		t.Fatalf("got specs for %v", got)
+	}
+	spec, ok := specs.Find(resource.TypeKey{APIVersion: "v1", Kind: "Widget"})
+	if !ok {
+		t.Fatal("no spec for Widget")
+	}
+
+	var shape func(ConverterSpec) map[string]any
+	shape = func(cs ConverterSpec) map[string]any {
+		out := make(map[string]any)
+		for name := range cs.IterAttrs() {
+			out[name] = cs.Quantities[name]
+		}
+		for name, b := range cs.IterBlocks() {
+			out[name] = shape(b)
+		}
+		return out
+	}
+	want := map[string]any{
+		"size": false,
+		"tags": false,
+		"port": map[string]any{"name": false, "number": false},
+		"spec": map[string]any{
+			"resources": map[string]any{"limits": true, "labels": false},
+		},
+	}
+	if diff := cmp.Diff(want, shape(spec)); diff != "" {
+		t.Errorf("unexpected spec (-want +got):\n%s", diff)
+	}
+
+	if got, want := spec.Schema["tags"], (FieldSchema{Optional: true, Deprecated: deprecated, Description: "Use labels."}); !cmp.Equal(got, want, cmp.Comparer(cty.Value.RawEquals)) {
+		t.Errorf("tags schema: got %+v, want %+v", got, want)
+	}
+	if got := spec.Schema["spec"]; got.MaxItems != 1 || got.Required {
+		t.Errorf("spec schema: got %+v, want an optional block with at most one item", got)
+	}
+
+	for _, c := range []struct {
+		attr string
+		in   any
+		want cty.Value
+	}{
+		{"size", json.Number("3"), cty.NumberIntVal(3)},
+		{"size", 0.5, cty.NumberFloatVal(0.5)},
+		{"tags", []any{"a", "b"}, cty.ListVal([]cty.Value{cty.StringVal("a"), cty.StringVal("b")})},
+	} {
+		got, err := spec.Attributes[c.attr](c.in)
+		if err != nil {
+			t.Errorf("%s(%v): %v", c.attr, c.in, err)
+			continue
+		}
+		if !got.RawEquals(c.want) {
+			t.Errorf("%s(%v) = %#v, want %#v", c.attr, c.in, got, c.want)
+		}
+	}
+}
+
+func TestGeneratedSchema(t *testing.T) {
+	spec, ok := FindSpec(resource.TypeKey{APIVersion: "v1", Kind: "Service"})
+	if !ok {
+		t.Fatal("no spec for Service")
+	}
+	port := spec.Blocks["spec"].Blocks["port"]
+	if got := port.Schema["protocol"].Default; !got.RawEquals(cty.StringVal("TCP")) {
+		t.Errorf("default protocol: got %#v, want TCP", got)
+	}
+	if !port.Schema["port"].Required {
+		t.Errorf("port isn't required")
+	}
+	if got := spec.Schema["spec"].MaxItems; got != 1 {
+		t.Errorf("spec MaxItems: got %d, want 1", got)
+	}
+}
+
+func TestReadProviderSchemaErrors(t *testing.T) {
+	for _, in := range []string{
+		`{"provider_schemas": {"registry.terraform.io/hashicorp/random": {}}}`,
+		`{"provider_schemas": `,
+	} {
+		if _, err := ReadProviderSchema(strings.NewReader(in)); err == nil {
+			t.Errorf("ReadProviderSchema(%q): expected an error", in)
+		}
+	}
+	ps := &ProviderSchema{ResourceSchemas: map[string]ResourceSchema{
+		"kubernetes_widget_v1": {Block: SchemaBlock{Attributes: map[string]SchemaAttribute{
+			"anything": {Type: cty.DynamicPseudoType, Optional: true},
+		}}},
+	}}
+	if _, err := ps.Specs(); err == nil || !strings.Contains(err.Error(), "anything") {
+		t.Errorf("Specs() with a dynamic attribute: got error %v", err)
+	}
+}
+
+func TestSpecsFor(t *testing.T) {
+	major, minor, _ := strings.Cut(ProviderVersion, ".")
+	minor, _, _ = strings.Cut(minor, ".")
+	for _, v := range []string{ProviderVersion, "v" + ProviderVersion, major, major + "." + minor} {
+		if _, err := SpecsFor(v); err != nil {
+			t.Errorf("SpecsFor(%q): %v", v, err)
+		}
+	}
+	for _, v := range []string{"1.0.0", major + "." + minor + "0", ProviderVersion + "0"} {
+		if _, err := SpecsFor(v); err == nil {
+			t.Errorf("SpecsFor(%q): expected an error", v)
+		}
+	}
+}
+
+func TestFind(t *testing.T) {
+	for tk, name := range resourceTypes {
+		if _, ok := specs[name]; !ok {
+			t.Errorf("%s %s: no spec for %s", tk.APIVersion, tk.Kind, name)
+		}
+	}
+	for _, c := range []struct {
+		tk   resource.TypeKey
+		want string
+	}{
+		{resource.TypeKey{APIVersion: "apps/v1", Kind: "Deployment"}, "kubernetes_deployment_v1"},
+		{resource.TypeKey{APIVersion: "batch/v1beta1", Kind: "CronJob"}, "kubernetes_cron_job"},
+		{resource.TypeKey{APIVersion: "autoscaling/v2", Kind: "HorizontalPodAutoscaler"}, "kubernetes_horizontal_pod_autoscaler_v2"},
+		{resource.TypeKey{APIVersion: "policy/v1beta1", Kind: "PodDisruptionBudget"}, "kubernetes_pod_disruption_budget"},
+		// Same kind in a group the provider knows nothing about.
+		{resource.TypeKey{APIVersion: "example.com/v1", Kind: "Deployment"}, ""},
+		{resource.TypeKey{APIVersion: "apps/v1beta1", Kind: "Deployment"}, ""},
+	} {
+		spec, ok := FindSpec(c.tk)
+		if got := spec.ResourceName; got != c.want || ok != (c.want != "") {
+			t.Errorf("FindSpec(%v): got %q, %t, want %q", c.tk, got, ok, c.want)
+		}
+	}
+}
+
+// TestFindUnversioned checks that a schema from a provider without the
+// versioned resources still converts the kinds their aliases are for.
+func TestFindUnversioned(t *testing.T) {
+	const schema = `{
+  "provider_schemas": {
+    "registry.terraform.io/hashicorp/kubernetes": {
+      "resource_schemas": {
+        "kubernetes_deployment": {"version": 1, "block": {"attributes": {"id": {"type": "string", "computed": true}}}},
+        "kubernetes_config_map": {"version": 1, "block": {"attributes": {"id": {"type": "string", "computed": true}}}},
+        "kubernetes_horizontal_pod_autoscaler": {"version": 1, "block": {"attributes": {"id": {"type": "string", "computed": true}}}}
+      }
+    }
+  }
+}`
+	ps, err := ReadProviderSchema(strings.NewReader(schema))
+	if err != nil {
+		t.Fatal(err)
+	}
+	specs, err := ps.Specs()
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, c := range []struct {
+		tk   resource.TypeKey
+		want string
+	}{
+		{resource.TypeKey{APIVersion: "apps/v1", Kind: "Deployment"}, "kubernetes_deployment"},
+		{resource.TypeKey{APIVersion: "v1", Kind: "ConfigMap"}, "kubernetes_config_map"},
+		// The unversioned resource isn't the same as the v2 one.
+		{resource.TypeKey{APIVersion: "autoscaling/v2", Kind: "HorizontalPodAutoscaler"}, ""},
+		{resource.TypeKey{APIVersion: "v1", Kind: "Service"}, ""},
+	} {
+		spec, ok := specs.Find(c.tk)
+		if got := spec.ResourceName; got != c.want || ok != (c.want != "") {
+			t.Errorf("Find(%v): got %q, %t, want %q", c.tk, got, ok, c.want)
+			continue
+		}
+		if ok && !strings.Contains(spec.Deprecated, "kubernetes_") {
+			t.Errorf("Find(%v): got deprecation %q, want one naming the versioned resource", c.tk, spec.Deprecated)
+		}
+	}
+}
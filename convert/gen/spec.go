@@ -2,6 +2,7 @@ package gen
 
 import (
 	"cmp"
+	"fmt"
 	"iter"
 	"maps"
 	"slices"
@@ -16,26 +17,58 @@ import (
 // essentially the list of fields with types. Generally it should be generated
 // by cmd/gen.
 type ConverterSpec struct {
+	// ResourceName is only set on the top level spec, as the others can
+	// be shared between resources.
 	ResourceName string
 	Attributes   map[string]func(any) (cty.Value, error)
 	// TODO: is this a good idea, or should we just list the names and have
 	// a way to look them all up?
 	Blocks map[string]ConverterSpec
+	// Quantities holds the names of the attributes which are maps of
+	// kubernetes resource quantities.
+	Quantities map[string]bool
+	// Schema holds what the provider's schema says about each of the
+	// attributes and blocks.
+	Schema map[string]FieldSchema
+	// Deprecated is the provider's deprecation message for the resource,
+	// if it is deprecated. It's only set on the top level spec.
+	Deprecated string
 }
 
-// FindSpec tries to find the ConverterSpec for the given type key.
-func FindSpec(tk resource.TypeKey) (ConverterSpec, bool) {
-	group, version, ok := strings.Cut(tk.APIVersion, "/")
-	if !ok {
-		version = group
-	}
-	name := "kubernetes_" + resource.ToSnake(tk.Kind)
-	if spec, ok := specs[name+"_"+version]; ok {
-		return spec, ok
+// FieldSchema is the metadata from the provider's schema for an attribute or
+// block. Schemas read from `terraform providers schema -json` don't have
+// Default, ForceNew or ConflictsWith.
+type FieldSchema struct {
+	Required  bool
+	Optional  bool
+	Sensitive bool
+	ForceNew  bool
+	// MinItems and MaxItems are the number of blocks allowed, with 0
+	// meaning no limit.
+	MinItems int
+	MaxItems int
+	// Default is the value used when the attribute isn't set, or
+	// cty.NilVal if there isn't one.
+	Default       cty.Value
+	Deprecated    string
+	ConflictsWith []string
+	Description   string
+}
+
+// SpecsFor returns the generated specs if they were generated from the given
+// version of the provider. The version can leave off the patch or minor
+// version, eg. "2.38" or "2".
+func SpecsFor(version string) (Specs, error) {
+	v := strings.TrimPrefix(version, "v")
+	if v == ProviderVersion || strings.HasPrefix(ProviderVersion, v+".") {
+		return specs, nil
 	}
-	// Might be present unversioned?
-	spec, ok := specs[name]
-	return spec, ok
+	return nil, fmt.Errorf("the specs were generated from version %s of the kubernetes provider, not %s", ProviderVersion, version)
+}
+
+// FindSpec tries to find the generated ConverterSpec for the given type key.
+func FindSpec(tk resource.TypeKey) (ConverterSpec, bool) {
+	return specs.Find(tk)
 }
 
 func (cs ConverterSpec) IterAttrs() iter.Seq2[string, func(any) (cty.Value, error)] {
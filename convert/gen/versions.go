@@ -0,0 +1,75 @@
+package gen
+
+import (
+	"strings"
+
+	"github.com/pfcm/ktf/resource"
+)
+
+// resourceTypes maps the kinds and API versions the provider has typed
+// resources for to the resources. The versioned resources, like
+// kubernetes_deployment_v1, are used rather than their unversioned aliases
+// whenever the provider has them (see Specs.Find), so the aliases are only
+// here for the API versions they're the only resource for.
+var resourceTypes = map[resource.TypeKey]string{
+	{APIVersion: "v1", Kind: "ConfigMap"}:             "kubernetes_config_map_v1",
+	{APIVersion: "v1", Kind: "Endpoints"}:             "kubernetes_endpoints_v1",
+	{APIVersion: "v1", Kind: "LimitRange"}:            "kubernetes_limit_range_v1",
+	{APIVersion: "v1", Kind: "Namespace"}:             "kubernetes_namespace_v1",
+	{APIVersion: "v1", Kind: "PersistentVolume"}:      "kubernetes_persistent_volume_v1",
+	{APIVersion: "v1", Kind: "PersistentVolumeClaim"}: "kubernetes_persistent_volume_claim_v1",
+	{APIVersion: "v1", Kind: "Pod"}:                   "kubernetes_pod_v1",
+	{APIVersion: "v1", Kind: "ReplicationController"}: "kubernetes_replication_controller_v1",
+	{APIVersion: "v1", Kind: "ResourceQuota"}:         "kubernetes_resource_quota_v1",
+	{APIVersion: "v1", Kind: "Secret"}:                "kubernetes_secret_v1",
+	{APIVersion: "v1", Kind: "Service"}:               "kubernetes_service_v1",
+	{APIVersion: "v1", Kind: "ServiceAccount"}:        "kubernetes_service_account_v1",
+
+	{APIVersion: "apps/v1", Kind: "DaemonSet"}:   "kubernetes_daemon_set_v1",
+	{APIVersion: "apps/v1", Kind: "Deployment"}:  "kubernetes_deployment_v1",
+	{APIVersion: "apps/v1", Kind: "StatefulSet"}: "kubernetes_stateful_set_v1",
+
+	{APIVersion: "batch/v1", Kind: "CronJob"}:      "kubernetes_cron_job_v1",
+	{APIVersion: "batch/v1", Kind: "Job"}:          "kubernetes_job_v1",
+	{APIVersion: "batch/v1beta1", Kind: "CronJob"}: "kubernetes_cron_job",
+
+	{APIVersion: "autoscaling/v1", Kind: "HorizontalPodAutoscaler"}:      "kubernetes_horizontal_pod_autoscaler_v1",
+	{APIVersion: "autoscaling/v2", Kind: "HorizontalPodAutoscaler"}:      "kubernetes_horizontal_pod_autoscaler_v2",
+	{APIVersion: "autoscaling/v2beta2", Kind: "HorizontalPodAutoscaler"}: "kubernetes_horizontal_pod_autoscaler_v2beta2",
+
+	{APIVersion: "networking.k8s.io/v1", Kind: "Ingress"}:       "kubernetes_ingress_v1",
+	{APIVersion: "networking.k8s.io/v1", Kind: "IngressClass"}:  "kubernetes_ingress_class_v1",
+	{APIVersion: "networking.k8s.io/v1", Kind: "NetworkPolicy"}: "kubernetes_network_policy_v1",
+	{APIVersion: "networking.k8s.io/v1beta1", Kind: "Ingress"}:  "kubernetes_ingress",
+	{APIVersion: "extensions/v1beta1", Kind: "Ingress"}:         "kubernetes_ingress",
+
+	{APIVersion: "policy/v1", Kind: "PodDisruptionBudget"}:      "kubernetes_pod_disruption_budget_v1",
+	{APIVersion: "policy/v1beta1", Kind: "PodDisruptionBudget"}: "kubernetes_pod_disruption_budget",
+	{APIVersion: "policy/v1beta1", Kind: "PodSecurityPolicy"}:   "kubernetes_pod_security_policy_v1beta1",
+
+	{APIVersion: "rbac.authorization.k8s.io/v1", Kind: "ClusterRole"}:        "kubernetes_cluster_role_v1",
+	{APIVersion: "rbac.authorization.k8s.io/v1", Kind: "ClusterRoleBinding"}: "kubernetes_cluster_role_binding_v1",
+	{APIVersion: "rbac.authorization.k8s.io/v1", Kind: "Role"}:               "kubernetes_role_v1",
+	{APIVersion: "rbac.authorization.k8s.io/v1", Kind: "RoleBinding"}:        "kubernetes_role_binding_v1",
+
+	{APIVersion: "admissionregistration.k8s.io/v1", Kind: "MutatingWebhookConfiguration"}:        "kubernetes_mutating_webhook_configuration_v1",
+	{APIVersion: "admissionregistration.k8s.io/v1", Kind: "ValidatingWebhookConfiguration"}:      "kubernetes_validating_webhook_configuration_v1",
+	{APIVersion: "admissionregistration.k8s.io/v1beta1", Kind: "MutatingWebhookConfiguration"}:   "kubernetes_mutating_webhook_configuration",
+	{APIVersion: "admissionregistration.k8s.io/v1beta1", Kind: "ValidatingWebhookConfiguration"}: "kubernetes_validating_webhook_configuration",
+
+	{APIVersion: "apiregistration.k8s.io/v1", Kind: "APIService"}:                  "kubernetes_api_service_v1",
+	{APIVersion: "certificates.k8s.io/v1", Kind: "CertificateSigningRequest"}:      "kubernetes_certificate_signing_request_v1",
+	{APIVersion: "certificates.k8s.io/v1beta1", Kind: "CertificateSigningRequest"}: "kubernetes_certificate_signing_request",
+	{APIVersion: "discovery.k8s.io/v1", Kind: "EndpointSlice"}:                     "kubernetes_endpoint_slice_v1",
+	{APIVersion: "node.k8s.io/v1", Kind: "RuntimeClass"}:                           "kubernetes_runtime_class_v1",
+	{APIVersion: "scheduling.k8s.io/v1", Kind: "PriorityClass"}:                    "kubernetes_priority_class_v1",
+	{APIVersion: "storage.k8s.io/v1", Kind: "CSIDriver"}:                           "kubernetes_csi_driver_v1",
+	{APIVersion: "storage.k8s.io/v1", Kind: "StorageClass"}:                        "kubernetes_storage_class_v1",
+	{APIVersion: "storage.k8s.io/v1beta1", Kind: "CSIDriver"}:                      "kubernetes_csi_driver",
+}
+
+// builtIn reports whether group is one of kubernetes' own API groups, rather
+// than one added by a CustomResourceDefinition.
+func builtIn(group string) bool {
+	return !strings.Contains(group, ".") || strings.HasSuffix(group, ".k8s.io")
+}
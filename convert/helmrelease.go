@@ -0,0 +1,212 @@
+package convert
+
+import (
+	"fmt"
+	"io"
+	"maps"
+	"regexp"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclsyntax"
+	"github.com/hashicorp/hcl/v2/hclwrite"
+	"github.com/zclconf/go-cty/cty"
+
+	"github.com/pfcm/ktf/resource"
+)
+
+// HelmRelease is a chart to install with the helm provider's helm_release,
+// for charts that are better left as they are than converted.
+type HelmRelease struct {
+	// Name is the name of the release, and of the resource.
+	Name      string
+	Namespace string
+	// Chart is the name of the chart in Repository, or without a
+	// Repository a local path or URL.
+	Chart      string
+	Repository string
+	Version    string
+	// Values hold the contents of each values file, which are merged in
+	// order.
+	Values []map[string]any
+}
+
+// DefaultSensitiveValues match the keys of string values which are written as
+// set_sensitive instead of in values. Keys like existingSecret, which name a
+// Secret holding the value rather than being it, are left alone, as are
+// secretName and secretRef by not ending in one of the words.
+var DefaultSensitiveValues = []*regexp.Regexp{
+	regexp.MustCompile(`(?i)(password|passwd|secret|token|api_?key|private_?key|credentials?)$`),
+}
+
+// existingKey matches the keys DefaultSensitiveValues leaves alone, despite
+// matching.
+var existingKey = regexp.MustCompile(`(?i)^existing`)
+
+// ReadValues reads a helm values file.
+func ReadValues(r io.Reader) (map[string]any, error) {
+	values := make(map[string]any)
+	if err := readConfig(r, &values); err != nil {
+		return nil, err
+	}
+	return values, nil
+}
+
+// ConvertHelmRelease writes a helm_release resource for rel. The values go in
+// values as yamlencode of the equivalent HCL, apart from strings with a key
+// matched by opts.SensitiveValues or DefaultSensitiveValues, which are set from
+// sensitive variables in set_sensitive blocks, so they're neither in the
+// configuration nor shown by terraform. The variables are returned for the
+// caller to declare. Only values reached through maps are considered, as
+// set_sensitive can't set part of a list without replacing the rest of it.
+func ConvertHelmRelease(rel HelmRelease, opts Options) (*hclwrite.Block, []Variable, error) {
+	b := hclwrite.NewBlock("resource", []string{"helm_release", resource.ToSnake(rel.Name)})
+	body := b.Body()
+	body.SetAttributeValue("name", cty.StringVal(rel.Name))
+	if rel.Namespace != "" {
+		body.SetAttributeValue("namespace", cty.StringVal(rel.Namespace))
+	}
+	if rel.Repository != "" {
+		body.SetAttributeValue("repository", cty.StringVal(rel.Repository))
+	}
+	body.SetAttributeValue("chart", cty.StringVal(rel.Chart))
+	if rel.Version != "" {
+		body.SetAttributeValue("version", cty.StringVal(rel.Version))
+	}
+
+	matches := func(patterns []*regexp.Regexp, k string) bool {
+		return slices.ContainsFunc(patterns, func(re *regexp.Regexp) bool { return re.MatchString(k) })
+	}
+	isSensitive := func(k string) bool {
+		return matches(opts.SensitiveValues, k) || matches(DefaultSensitiveValues, k) && !existingKey.MatchString(k)
+	}
+	// What's sensitive depends on the values after merging, as a later
+	// file can replace or clear what an earlier one set.
+	var merged map[string]any
+	for _, v := range rel.Values {
+		merged = mergeValues(merged, v)
+	}
+	sensitive := make(map[string][]string)
+	splitSensitive(merged, isSensitive, nil, sensitive)
+
+	var values []hclwrite.Tokens
+	for i, v := range rel.Values {
+		v = splitSensitive(v, isSensitive, nil, make(map[string][]string))
+		if len(v) == 0 {
+			continue
+		}
+		w := tokenWriter{opts: opts}
+		if err := w.emitValue(v); err != nil {
+			return nil, nil, fmt.Errorf("values %d: %w", i, err)
+		}
+		values = append(values, hclwrite.TokensForFunctionCall("yamlencode", w.tokens))
+	}
+	switch len(values) {
+	case 0:
+	case 1:
+		body.SetAttributeRaw("values", hclwrite.TokensForTuple(values))
+	default:
+		// One file per line, rather than running them together.
+		toks := hclwrite.Tokens{
+			{Type: hclsyntax.TokenOBrack, Bytes: []byte("[")},
+			{Type: hclsyntax.TokenNewline, Bytes: []byte("\n")},
+		}
+		for _, v := range values {
+			toks = append(toks, v...)
+			toks = append(toks,
+				&hclwrite.Token{Type: hclsyntax.TokenComma, Bytes: []byte(",")},
+				&hclwrite.Token{Type: hclsyntax.TokenNewline, Bytes: []byte("\n")},
+			)
+		}
+		body.SetAttributeRaw("values", append(toks, &hclwrite.Token{Type: hclsyntax.TokenCBrack, Bytes: []byte("]")}))
+	}
+
+	var vars []Variable
+	for _, name := range slices.Sorted(maps.Keys(sensitive)) {
+		path := sensitive[name]
+		// Named after the whole path, as the same key is often in
+		// several places, like auth.password and metrics.password.
+		base := variableName(rel.Name, []any{strings.Join(path, "_")})
+		varName := base
+		for n := 2; slices.ContainsFunc(vars, func(v Variable) bool { return v.Name == varName }); n++ {
+			varName = fmt.Sprintf("%s_%d", base, n)
+		}
+		vars = append(vars, Variable{
+			Name:        varName,
+			Description: fmt.Sprintf("%s for the %s helm release.", name, rel.Name),
+			Type:        cty.String,
+			Sensitive:   true,
+		})
+
+		set := body.AppendNewBlock("set_sensitive", nil).Body()
+		set.SetAttributeValue("name", cty.StringVal(name))
+		set.SetAttributeTraversal("value", hcl.Traversal{
+			hcl.TraverseRoot{Name: "var"},
+			hcl.TraverseAttr{Name: varName},
+		})
+		// Otherwise helm would turn things like "true" or "1234" into
+		// other types.
+		set.SetAttributeValue("type", cty.StringVal("string"))
+	}
+	return b, vars, nil
+}
+
+// splitSensitive returns values without the non-empty strings whose keys are
+// sensitive, whose paths are added to sensitive by their --set style name instead.
+func splitSensitive(values map[string]any, isSensitive func(key string) bool, path []string, sensitive map[string][]string) map[string]any {
+	out := make(map[string]any, len(values))
+	for k, v := range values {
+		p := append(slices.Clip(path), k)
+		switch v := v.(type) {
+		case map[string]any:
+			if rest := splitSensitive(v, isSensitive, p, sensitive); len(rest) > 0 || len(v) == 0 {
+				out[k] = rest
+			}
+			continue
+		case string:
+			// Anything else, like createSecret: false, is a setting
+			// rather than a secret.
+			if v != "" && isSensitive(k) {
+				sensitive[setName(p)] = p
+				continue
+			}
+		}
+		out[k] = v
+	}
+	return out
+}
+
+// mergeValues merges src over dst like helm merges values files: maps are
+// merged, anything else in src replaces what's in dst, and null removes it.
+// Neither is modified.
+func mergeValues(dst, src map[string]any) map[string]any {
+	out := maps.Clone(dst)
+	if out == nil {
+		out = make(map[string]any, len(src))
+	}
+	for k, v := range src {
+		if v == nil {
+			delete(out, k)
+			continue
+		}
+		sm, ok := v.(map[string]any)
+		dm, dok := out[k].(map[string]any)
+		if ok && dok {
+			out[k] = mergeValues(dm, sm)
+			continue
+		}
+		out[k] = v
+	}
+	return out
+}
+
+// setName is the name of the value at path for helm's --set, with any dots in
+// the keys escaped.
+func setName(path []string) string {
+	escaped := make([]string, len(path))
+	for i, k := range path {
+		escaped[i] = strings.ReplaceAll(k, ".", `\.`)
+	}
+	return strings.Join(escaped, ".")
+}
@@ -0,0 +1,214 @@
+package convert
+
+import (
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/hcl/v2/hclwrite"
+)
+
+func TestConvertHelmRelease(t *testing.T) {
+	var values []map[string]any
+	for _, in := range []string{`
+replicas: 2
+auth:
+  password: hunter2
+  existingSecret: ""
+  pin: "1234"
+ingress:
+  annotations:
+    example.com/token: abc
+  hosts: [{host: a.example.com, token: xyz}]
+`, `
+auth:
+  apiKey: k-42
+`} {
+		v, err := ReadValues(strings.NewReader(in))
+		if err != nil {
+			t.Fatal(err)
+		}
+		values = append(values, v)
+	}
+	rel := HelmRelease{
+		Name:       "my-db",
+		Namespace:  "data",
+		Chart:      "postgresql",
+		Repository: "https://charts.example.com",
+		Version:    "1.2.3",
+		Values:     values,
+	}
+	b, vars, err := ConvertHelmRelease(rel, Options{SensitiveValues: []*regexp.Regexp{regexp.MustCompile(`^pin$`)}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := `variable "my_db_auth_api_key" {
+  type        = string
+  description = "auth.apiKey for the my-db helm release."
+  sensitive   = true
+}
+variable "my_db_auth_password" {
+  type        = string
+  description = "auth.password for the my-db helm release."
+  sensitive   = true
+}
+variable "my_db_auth_pin" {
+  type        = string
+  description = "auth.pin for the my-db helm release."
+  sensitive   = true
+}
+variable "my_db_ingress_annotations_example_com_token" {
+  type        = string
+  description = "ingress.annotations.example\\.com/token for the my-db helm release."
+  sensitive   = true
+}
+resource "helm_release" "my_db" {
+  name       = "my-db"
+  namespace  = "data"
+  repository = "https://charts.example.com"
+  chart      = "postgresql"
+  version    = "1.2.3"
+  values = [yamlencode({
+    "auth" = {
+      "existingSecret" = ""
+    }
+    "ingress" = {
+      "hosts" = [{
+        "host"  = "a.example.com"
+        "token" = "xyz"
+      }]
+    }
+    "replicas" = 2
+  })]
+  set_sensitive {
+    name  = "auth.apiKey"
+    value = var.my_db_auth_api_key
+    type  = "string"
+  }
+  set_sensitive {
+    name  = "auth.password"
+    value = var.my_db_auth_password
+    type  = "string"
+  }
+  set_sensitive {
+    name  = "auth.pin"
+    value = var.my_db_auth_pin
+    type  = "string"
+  }
+  set_sensitive {
+    name  = "ingress.annotations.example\\.com/token"
+    value = var.my_db_ingress_annotations_example_com_token
+    type  = "string"
+  }
+}
+`
+	f := hclwrite.NewEmptyFile()
+	for _, v := range vars {
+		f.Body().AppendBlock(v.Block())
+	}
+	f.Body().AppendBlock(b)
+	if diff := cmp.Diff(want, string(hclwrite.Format(f.Bytes()))); diff != "" {
+		t.Errorf("unexpected output (-want +got):\n%s", diff)
+	}
+}
+
+// TestConvertHelmReleaseOverridden checks that sensitive values are only taken
+// from a variable if they are still set once all the values files are merged.
+func TestConvertHelmReleaseOverridden(t *testing.T) {
+	var values []map[string]any
+	for _, in := range []string{`
+auth:
+  password: hunter2
+  token: abc
+`, `
+auth:
+  password: ""
+token: null
+`, `
+auth:
+  token: null
+apiKey: xyz
+`} {
+		v, err := ReadValues(strings.NewReader(in))
+		if err != nil {
+			t.Fatal(err)
+		}
+		values = append(values, v)
+	}
+	b, vars, err := ConvertHelmRelease(HelmRelease{Name: "app", Chart: "app", Values: values}, Options{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := `variable "app_api_key" {
+  type        = string
+  description = "apiKey for the app helm release."
+  sensitive   = true
+}
+resource "helm_release" "app" {
+  name  = "app"
+  chart = "app"
+  values = [
+    yamlencode({
+      "auth" = {
+        "password" = ""
+      }
+      "token" = null
+    }),
+    yamlencode({
+      "auth" = {
+        "token" = null
+      }
+    }),
+  ]
+  set_sensitive {
+    name  = "apiKey"
+    value = var.app_api_key
+    type  = "string"
+  }
+}
+`
+	f := hclwrite.NewEmptyFile()
+	for _, v := range vars {
+		f.Body().AppendBlock(v.Block())
+	}
+	f.Body().AppendBlock(b)
+	if diff := cmp.Diff(want, string(hclwrite.Format(f.Bytes()))); diff != "" {
+		t.Errorf("unexpected output (-want +got):\n%s", diff)
+	}
+}
+
+// TestSensitiveKeys checks which values are treated as secrets by default.
+func TestSensitiveKeys(t *testing.T) {
+	values, err := ReadValues(strings.NewReader(`
+password: hunter2
+adminPassword: hunter2
+clientSecret: abc
+auth:
+  token: abc
+  existingSecret: db-credentials
+  existingSecretPasswordKey: password
+  secretName: db-credentials
+  secretRef: db-credentials
+  createSecret: false
+serviceAccount:
+  automountServiceAccountToken: true
+apiKey: 42
+privateKey: ""
+`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, vars, err := ConvertHelmRelease(HelmRelease{Name: "app", Chart: "app", Values: []map[string]any{values}}, Options{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	var got []string
+	for _, v := range vars {
+		got = append(got, v.Name)
+	}
+	want := []string{"app_admin_password", "app_auth_token", "app_client_secret", "app_password"}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("unexpected sensitive values (-want +got):\n%s", diff)
+	}
+}
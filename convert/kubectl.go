@@ -0,0 +1,93 @@
+package convert
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/hcl/v2/hclsyntax"
+	"github.com/hashicorp/hcl/v2/hclwrite"
+	"github.com/zclconf/go-cty/cty"
+	"sigs.k8s.io/yaml"
+
+	"github.com/pfcm/ktf/resource"
+)
+
+// Fallback is the resource written for objects that the kubernetes provider
+// doesn't have a typed resource for.
+type Fallback string
+
+const (
+	// ManifestFallback is the kubernetes provider's kubernetes_manifest.
+	// It needs to be able to reach the cluster at plan time.
+	ManifestFallback Fallback = "kubernetes_manifest"
+	// KubectlFallback is kubectl_manifest, from the gavinbunney/kubectl
+	// provider, which doesn't.
+	KubectlFallback Fallback = "kubectl_manifest"
+)
+
+func (f Fallback) valid() bool {
+	return f == "" || f == ManifestFallback || f == KubectlFallback
+}
+
+// KubectlSettings are the settings for kubectl_manifest resources.
+type KubectlSettings struct {
+	// YAMLEncode writes the yaml_body as yamlencode of the equivalent HCL,
+	// instead of a heredoc holding the yaml. It is always used when some
+	// of the object is replaced with variables.
+	YAMLEncode      bool `json:"yamlencode,omitempty"`
+	ServerSideApply bool `json:"serverSideApply,omitempty"`
+	ForceConflicts  bool `json:"forceConflicts,omitempty"`
+	// Wait waits for the object to be deleted when it's destroyed.
+	Wait bool `json:"wait,omitempty"`
+	// WaitForRollout, if set, overrides the provider's default of waiting
+	// for Deployments, StatefulSets and DaemonSets to roll out.
+	WaitForRollout *bool `json:"waitForRollout,omitempty"`
+}
+
+// convertToKubectl writes a kubectl_manifest for the resource. The settings
+// come from the rule if it has any, otherwise opts, and the rule's
+// ComputedFields become ignore_fields. The rest of the rule only makes sense
+// for kubernetes_manifest.
+func convertToKubectl(r resource.Resource, rule ManifestRule, opts Options) (*hclwrite.Block, error) {
+	b := hclwrite.NewBlock("resource", []string{string(KubectlFallback), manifestName(r)})
+	body := b.Body()
+
+	settings := opts.Kubectl
+	if rule.Kubectl != nil {
+		settings = *rule.Kubectl
+	}
+	w := tokenWriter{opts: opts, params: parameterizer{params: opts.Parameters, r: r}}
+	if err := w.emitValue(r.Raw); err != nil {
+		return nil, err
+	}
+	if settings.YAMLEncode || w.parameterized {
+		body.SetAttributeRaw("yaml_body", hclwrite.TokensForFunctionCall("yamlencode", w.tokens))
+	} else {
+		raw, err := yaml.Marshal(r.Raw)
+		if err != nil {
+			return nil, fmt.Errorf("encoding yaml_body: %w", err)
+		}
+		toks := heredocTokens(string(raw))
+		// The attribute brings its own newline.
+		if last := toks[len(toks)-1]; last.Type == hclsyntax.TokenNewline {
+			toks = toks[:len(toks)-1]
+		}
+		body.SetAttributeRaw("yaml_body", toks)
+	}
+
+	if settings.ServerSideApply {
+		body.SetAttributeValue("server_side_apply", cty.True)
+	}
+	if settings.ForceConflicts {
+		body.SetAttributeValue("force_conflicts", cty.True)
+	}
+	if settings.Wait {
+		body.SetAttributeValue("wait", cty.True)
+	}
+	if settings.WaitForRollout != nil {
+		body.SetAttributeValue("wait_for_rollout", cty.BoolVal(*settings.WaitForRollout))
+	}
+	if len(rule.ComputedFields) > 0 {
+		body.SetAttributeValue("ignore_fields", stringList(rule.ComputedFields))
+	}
+	return b, nil
+}
@@ -0,0 +1,132 @@
+package convert
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/hcl/v2/hclwrite"
+)
+
+func TestKubectlFallback(t *testing.T) {
+	const widget = `
+apiVersion: example.com/v1
+kind: Widget
+metadata:
+  name: test
+spec:
+  size: 3
+  template: "${name}"
+`
+	rules, err := ReadManifestRules(strings.NewReader(`
+- group: example.com
+  kind: Widget
+  fallback: kubectl_manifest
+  computedFields: ["metadata.annotations"]
+  kubectl:
+    serverSideApply: true
+    forceConflicts: true
+    waitForRollout: false
+`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	params, err := ReadParameters(strings.NewReader("- kind: Widget\n  path: spec.size\n"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, c := range []struct {
+		name string
+		opts Options
+		want string
+	}{{
+		name: "default",
+		opts: Options{},
+		want: `resource "kubernetes_manifest" "widget__test"`,
+	}, {
+		name: "per run",
+		opts: Options{Fallback: KubectlFallback, Kubectl: KubectlSettings{Wait: true}},
+		want: `resource "kubectl_manifest" "widget__test" {
+  yaml_body = <<-EOT
+apiVersion: example.com/v1
+kind: Widget
+metadata:
+  name: test
+spec:
+  size: 3
+  template: $${name}
+EOT
+  wait      = true
+}
+`,
+	}, {
+		name: "yamlencode",
+		opts: Options{Fallback: KubectlFallback, Kubectl: KubectlSettings{YAMLEncode: true}},
+		want: `resource "kubectl_manifest" "widget__test" {
+  yaml_body = yamlencode({
+    "apiVersion" = "example.com/v1"
+    "kind"       = "Widget"
+    "metadata" = {
+      "name" = "test"
+    }
+    "spec" = {
+      "size"     = 3
+      "template" = "$${name}"
+    }
+  })
+}
+`,
+	}, {
+		name: "parameterized",
+		opts: Options{Fallback: KubectlFallback, Parameters: params},
+		want: `resource "kubectl_manifest" "widget__test" {
+  yaml_body = yamlencode({
+    "apiVersion" = "example.com/v1"
+    "kind"       = "Widget"
+    "metadata" = {
+      "name" = "test"
+    }
+    "spec" = {
+      "size"     = var.test_size
+      "template" = "$${name}"
+    }
+  })
+}
+`,
+	}, {
+		name: "per kind",
+		opts: Options{ManifestRules: rules},
+		want: `resource "kubectl_manifest" "widget__test" {
+  yaml_body         = <<-EOT
+apiVersion: example.com/v1
+kind: Widget
+metadata:
+  name: test
+spec:
+  size: 3
+  template: $${name}
+EOT
+  server_side_apply = true
+  force_conflicts   = true
+  wait_for_rollout  = false
+  ignore_fields     = ["metadata.annotations"]
+}
+`,
+	}} {
+		t.Run(c.name, func(t *testing.T) {
+			b, err := Convert(decode(t, widget), c.opts)
+			if err != nil {
+				t.Fatal(err)
+			}
+			f := hclwrite.NewEmptyFile()
+			f.Body().AppendBlock(b)
+			got := string(hclwrite.Format(f.Bytes()))
+			if b.Labels()[0] == "kubernetes_manifest" {
+				got, _, _ = strings.Cut(got, " {")
+			}
+			if diff := cmp.Diff(c.want, got); diff != "" {
+				t.Errorf("unexpected output (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
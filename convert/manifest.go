@@ -0,0 +1,178 @@
+package convert
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/hashicorp/hcl/v2/hclwrite"
+	"github.com/zclconf/go-cty/cty"
+	"k8s.io/apimachinery/pkg/util/yaml"
+
+	"github.com/pfcm/ktf/resource"
+)
+
+// ManifestRule holds the extra settings for the kubernetes_manifest (or
+// kubectl_manifest) resources generated for a particular group and kind.
+type ManifestRule struct {
+	// Group is the API group, eg. "cert-manager.io". It is empty for the
+	// core API.
+	Group string `json:"group"`
+	// Kind is the kind the rule applies to, or "*" for every kind in the
+	// group.
+	Kind string `json:"kind"`
+
+	// ComputedFields are the paths of fields which might be changed by
+	// the cluster (by webhooks etc.), eg. "metadata.annotations".
+	ComputedFields []string      `json:"computedFields,omitempty"`
+	Wait           *ManifestWait `json:"wait,omitempty"`
+	FieldManager   *FieldManager `json:"fieldManager,omitempty"`
+	Timeouts       *Timeouts     `json:"timeouts,omitempty"`
+
+	// Fallback, if set, overrides Options.Fallback for the kinds the rule
+	// matches. For KubectlFallback only ComputedFields and Kubectl apply.
+	Fallback Fallback         `json:"fallback,omitempty"`
+	Kubectl  *KubectlSettings `json:"kubectl,omitempty"`
+}
+
+// ManifestWait is the wait block of a kubernetes_manifest.
+type ManifestWait struct {
+	// Rollout waits for Deployments, StatefulSets and DaemonSets to finish
+	// rolling out.
+	Rollout bool `json:"rollout,omitempty"`
+	// Fields maps field paths to the value (or regexp) they should have.
+	Fields     map[string]string `json:"fields,omitempty"`
+	Conditions []WaitCondition   `json:"conditions,omitempty"`
+}
+
+// WaitCondition waits for one of the object's status conditions.
+type WaitCondition struct {
+	Type   string `json:"type"`
+	Status string `json:"status"`
+}
+
+// FieldManager configures server-side apply.
+type FieldManager struct {
+	Name           string `json:"name,omitempty"`
+	ForceConflicts bool   `json:"forceConflicts,omitempty"`
+}
+
+// Timeouts are the create, update and delete timeouts, as terraform durations
+// like "10m".
+type Timeouts struct {
+	Create string `json:"create,omitempty"`
+	Update string `json:"update,omitempty"`
+	Delete string `json:"delete,omitempty"`
+}
+
+// DefaultManifestRules are used after any rules in Options.ManifestRules. They
+// cover a few popular CRDs where the defaults are obviously not enough.
+var DefaultManifestRules = []ManifestRule{
+	readyRule("cert-manager.io", "Certificate"),
+	readyRule("cert-manager.io", "Issuer"),
+	readyRule("cert-manager.io", "ClusterIssuer"),
+}
+
+func readyRule(group, kind string) ManifestRule {
+	return ManifestRule{
+		Group: group,
+		Kind:  kind,
+		Wait: &ManifestWait{
+			Conditions: []WaitCondition{{Type: "Ready", Status: "True"}},
+		},
+	}
+}
+
+// ReadManifestRules reads a list of ManifestRules from yaml or json.
+func ReadManifestRules(r io.Reader) ([]ManifestRule, error) {
+	var rules []ManifestRule
+	if err := readConfig(r, &rules); err != nil {
+		return nil, err
+	}
+	for i, rule := range rules {
+		if rule.Kind == "" {
+			return nil, fmt.Errorf("rule %d (group %q): missing kind", i, rule.Group)
+		}
+		if !rule.Fallback.valid() {
+			return nil, fmt.Errorf("rule %d (%s/%s): unknown fallback %q", i, rule.Group, rule.Kind, rule.Fallback)
+		}
+	}
+	return rules, nil
+}
+
+// readConfig strictly decodes a yaml or json config file into v.
+func readConfig(r io.Reader, v any) error {
+	raw, err := io.ReadAll(r)
+	if err != nil {
+		return err
+	}
+	return yaml.UnmarshalStrict(raw, v)
+}
+
+// findManifestRule returns the first rule that matches the resource,
+// checking opts.ManifestRules before the defaults.
+func findManifestRule(r resource.Resource, opts Options) (ManifestRule, bool) {
+	group, _ := r.GroupVersion()
+	for _, rules := range [][]ManifestRule{opts.ManifestRules, DefaultManifestRules} {
+		for _, rule := range rules {
+			if rule.Group == group && (rule.Kind == r.Kind || rule.Kind == "*") {
+				return rule, true
+			}
+		}
+	}
+	return ManifestRule{}, false
+}
+
+// writeManifestRule adds the settings from rule to the body of a
+// kubernetes_manifest.
+func writeManifestRule(body *hclwrite.Body, rule ManifestRule) {
+	if len(rule.ComputedFields) > 0 {
+		body.SetAttributeValue("computed_fields", stringList(rule.ComputedFields))
+	}
+	if fm := rule.FieldManager; fm != nil {
+		b := body.AppendNewBlock("field_manager", nil).Body()
+		if fm.Name != "" {
+			b.SetAttributeValue("name", cty.StringVal(fm.Name))
+		}
+		if fm.ForceConflicts {
+			b.SetAttributeValue("force_conflicts", cty.True)
+		}
+	}
+	if w := rule.Wait; w != nil {
+		b := body.AppendNewBlock("wait", nil).Body()
+		if w.Rollout {
+			b.SetAttributeValue("rollout", cty.True)
+		}
+		if len(w.Fields) > 0 {
+			fields := make(map[string]cty.Value, len(w.Fields))
+			for k, v := range w.Fields {
+				fields[k] = cty.StringVal(v)
+			}
+			b.SetAttributeValue("fields", cty.MapVal(fields))
+		}
+		for _, c := range w.Conditions {
+			cb := b.AppendNewBlock("condition", nil).Body()
+			cb.SetAttributeValue("type", cty.StringVal(c.Type))
+			cb.SetAttributeValue("status", cty.StringVal(c.Status))
+		}
+	}
+	if t := rule.Timeouts; t != nil {
+		b := body.AppendNewBlock("timeouts", nil).Body()
+		for _, kv := range []struct{ name, value string }{
+			{"create", t.Create},
+			{"update", t.Update},
+			{"delete", t.Delete},
+		} {
+			if kv.value != "" {
+				b.SetAttributeValue(kv.name, cty.StringVal(kv.value))
+			}
+		}
+	}
+}
+
+func stringList(ss []string) cty.Value {
+	vals := make([]cty.Value, len(ss))
+	for i, s := range ss {
+		vals[i] = cty.StringVal(s)
+	}
+	return cty.ListVal(vals)
+}
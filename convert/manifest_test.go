@@ -0,0 +1,117 @@
+package convert
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/hcl/v2/hclwrite"
+)
+
+func TestManifestRules(t *testing.T) {
+	const rulesYAML = `
+- group: example.com
+  kind: Widget
+  computedFields: ["metadata.annotations", "spec.replicas"]
+  fieldManager:
+    name: ktf
+    forceConflicts: true
+  wait:
+    fields:
+      status.phase: Running
+  timeouts:
+    create: 10m
+- group: example.com
+  kind: "*"
+  wait:
+    rollout: true
+- group: cert-manager.io
+  kind: Certificate
+  timeouts:
+    create: 1m
+`
+	rules, err := ReadManifestRules(strings.NewReader(rulesYAML))
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, c := range []struct {
+		name, apiVersion, kind string
+		want                   string
+	}{{
+		name:       "everything",
+		apiVersion: "example.com/v1",
+		kind:       "Widget",
+		want: `computed_fields = ["metadata.annotations", "spec.replicas"]
+field_manager {
+  name            = "ktf"
+  force_conflicts = true
+}
+wait {
+  fields = {
+    "status.phase" = "Running"
+  }
+}
+timeouts {
+  create = "10m"
+}
+`,
+	}, {
+		name:       "wildcard",
+		apiVersion: "example.com/v1alpha1",
+		kind:       "Gadget",
+		want: `wait {
+  rollout = true
+}
+`,
+	}, {
+		name:       "default",
+		apiVersion: "cert-manager.io/v1",
+		kind:       "Issuer",
+		want: `wait {
+  condition {
+    type   = "Ready"
+    status = "True"
+  }
+}
+`,
+	}, {
+		name:       "override default",
+		apiVersion: "cert-manager.io/v1",
+		kind:       "Certificate",
+		want: `timeouts {
+  create = "1m"
+}
+`,
+	}, {
+		name:       "no match",
+		apiVersion: "other.example.com/v1",
+		kind:       "Widget",
+		want:       "",
+	}} {
+		t.Run(c.name, func(t *testing.T) {
+			r := decode(t, "apiVersion: "+c.apiVersion+"\nkind: "+c.kind+"\nmetadata:\n  name: test\n")
+			b, err := convertToManifest(r, Options{ManifestRules: rules})
+			if err != nil {
+				t.Fatal(err)
+			}
+			b.Body().RemoveAttribute("manifest")
+			got := string(hclwrite.Format(b.Body().BuildTokens(nil).Bytes()))
+			if diff := cmp.Diff(c.want, got); diff != "" {
+				t.Errorf("unexpected output (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestReadManifestRulesErrors(t *testing.T) {
+	for _, in := range []string{
+		"- group: example.com\n  kind: Widget\n  wiat: {rollout: true}\n",
+		"- group: example.com\n",
+		"- group: example.com\n  kind: Widget\n  fallback: kubectl\n",
+		"not: a list\n",
+	} {
+		if _, err := ReadManifestRules(strings.NewReader(in)); err == nil {
+			t.Errorf("ReadManifestRules(%q): expected an error", in)
+		}
+	}
+}
@@ -0,0 +1,204 @@
+package convert
+
+import (
+	"fmt"
+	"io"
+	"maps"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclsyntax"
+	"github.com/hashicorp/hcl/v2/hclwrite"
+	"github.com/zclconf/go-cty/cty"
+
+	"github.com/pfcm/ktf/resource"
+)
+
+// ModulePriority is the priority ModuleRules are registered at: after the
+// typed resources but before the kubernetes_manifest fallback.
+const ModulePriority = -50
+
+// ModuleRule turns instances of a custom resource into calls to a terraform
+// module. It is a Backend.
+type ModuleRule struct {
+	// Group, Version and Kind pick out the resources. Version can be
+	// left empty to match any version.
+	Group   string `json:"group"`
+	Version string `json:"version,omitempty"`
+	Kind    string `json:"kind"`
+
+	// Source and ModuleVersion are the module's source and version.
+	Source        string `json:"source"`
+	ModuleVersion string `json:"moduleVersion,omitempty"`
+	// Inputs map the module's variables to paths in the resource, like
+	// ParameterRule.Path, eg. "spec.dnsNames" or
+	// "spec.rules[*].host". Inputs which aren't set in a resource are
+	// left out.
+	Inputs map[string]string `json:"inputs"`
+	// Values are passed to the module as they are.
+	Values map[string]any `json:"values,omitempty"`
+
+	inputs map[string][]pathElem
+}
+
+// ReadModuleRules reads a list of ModuleRules from yaml or json.
+func ReadModuleRules(r io.Reader) ([]ModuleRule, error) {
+	var rules []ModuleRule
+	if err := readConfig(r, &rules); err != nil {
+		return nil, err
+	}
+	for i := range rules {
+		rule := &rules[i]
+		if rule.Kind == "" || rule.Source == "" {
+			return nil, fmt.Errorf("rule %d (group %q): kind and source are required", i, rule.Group)
+		}
+		rule.inputs = make(map[string][]pathElem, len(rule.Inputs))
+		for name, p := range rule.Inputs {
+			if !hclsyntax.ValidIdentifier(name) {
+				return nil, fmt.Errorf("rule %d (%s): invalid input name %q", i, rule.Kind, name)
+			}
+			path, err := parsePath(p)
+			if err != nil {
+				return nil, fmt.Errorf("rule %d (%s): input %s: %w", i, rule.Kind, name, err)
+			}
+			rule.inputs[name] = path
+		}
+		for name := range rule.Values {
+			if _, ok := rule.Inputs[name]; ok || !hclsyntax.ValidIdentifier(name) {
+				return nil, fmt.Errorf("rule %d (%s): invalid or duplicate value %q", i, rule.Kind, name)
+			}
+		}
+	}
+	return rules, nil
+}
+
+// RegisterModules registers each of the rules for its group, version and
+// kind at ModulePriority.
+func (reg *Registry) RegisterModules(rules []ModuleRule) {
+	for i := range rules {
+		reg.Register(rules[i].Group, rules[i].Version, rules[i].Kind, ModulePriority, &rules[i])
+	}
+}
+
+// Convert writes the module call for r. Anything in the resource's spec (or
+// elsewhere, besides its type, metadata and status) which doesn't go to one
+// of the inputs gets a warning, as it will be lost.
+func (rule *ModuleRule) Convert(r resource.Resource, ctx Context) ([]*hclwrite.Block, hcl.Diagnostics) {
+	b := hclwrite.NewBlock("module", []string{manifestName(r)})
+	body := b.Body()
+	body.SetAttributeValue("source", cty.StringVal(rule.Source))
+	if rule.ModuleVersion != "" {
+		body.SetAttributeValue("version", cty.StringVal(rule.ModuleVersion))
+	}
+
+	args := make(map[string]any, len(rule.inputs)+len(rule.Values))
+	for name, path := range rule.inputs {
+		if v, ok := lookupPath(r.Raw, path); ok {
+			args[name] = v
+		}
+	}
+	maps.Copy(args, rule.Values)
+	for _, name := range slices.Sorted(maps.Keys(args)) {
+		w := tokenWriter{opts: ctx.Options}
+		if err := w.emitValue(args[name]); err != nil {
+			return nil, errorDiags(fmt.Errorf("input %s: %w", name, err))
+		}
+		body.SetAttributeRaw(name, w.tokens)
+	}
+
+	var diags hcl.Diagnostics
+	if lost := rule.unmapped(r.Raw, nil); len(lost) > 0 {
+		diags = append(diags, &hcl.Diagnostic{
+			Severity: hcl.DiagWarning,
+			Summary:  "Fields not passed to module",
+			Detail:   fmt.Sprintf("%s isn't mapped to any of the inputs of %s.", strings.Join(lost, ", "), rule.Source),
+		})
+	}
+	return []*hclwrite.Block{b}, diags
+}
+
+// lookupPath finds the value at path. Paths through [*] collect the values
+// from each element of the list, skipping any without one.
+func lookupPath(v any, path []pathElem) (any, bool) {
+	if len(path) == 0 {
+		return v, true
+	}
+	e := path[0]
+	switch {
+	case e.isKey:
+		m, _ := v.(map[string]any)
+		next, ok := m[e.key]
+		if !ok {
+			return nil, false
+		}
+		return lookupPath(next, path[1:])
+	case e.any:
+		l, ok := v.([]any)
+		if !ok {
+			return nil, false
+		}
+		out := []any{}
+		for _, elem := range l {
+			if found, ok := lookupPath(elem, path[1:]); ok {
+				out = append(out, found)
+			}
+		}
+		return out, true
+	default:
+		l, _ := v.([]any)
+		if e.index >= len(l) {
+			return nil, false
+		}
+		return lookupPath(l[e.index], path[1:])
+	}
+}
+
+// unmapped returns the paths of the fields in v, found at path, which none of
+// the inputs cover. Lists count as covered if any input reaches into them.
+func (rule *ModuleRule) unmapped(v any, path []string) []string {
+	if len(path) == 1 {
+		switch path[0] {
+		case "apiVersion", "kind", "metadata", "status":
+			return nil
+		}
+	}
+	partial := len(path) == 0
+	for _, p := range rule.inputs {
+		switch common(p, path) {
+		case len(p):
+			return nil
+		case len(path):
+			partial = true
+		}
+	}
+	if !partial {
+		return []string{pathString(toAny(path))}
+	}
+	m, ok := v.(map[string]any)
+	if !ok {
+		return nil
+	}
+	var lost []string
+	for _, k := range slices.Sorted(maps.Keys(m)) {
+		lost = append(lost, rule.unmapped(m[k], append(slices.Clip(path), k))...)
+	}
+	return lost
+}
+
+// common returns the number of keys at the start of p that match path.
+func common(p []pathElem, path []string) int {
+	n := 0
+	for n < len(p) && n < len(path) && p[n].isKey && p[n].key == path[n] {
+		n++
+	}
+	return n
+}
+
+func toAny(path []string) []any {
+	out := make([]any, len(path))
+	for i, p := range path {
+		out[i] = p
+	}
+	return out
+}
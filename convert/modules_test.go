@@ -0,0 +1,92 @@
+package convert
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/hcl/v2/hclwrite"
+)
+
+func TestModuleRules(t *testing.T) {
+	rules, err := ReadModuleRules(strings.NewReader(`
+- group: cert-manager.io
+  kind: Certificate
+  source: git::https://git.example.com/modules/certificate
+  moduleVersion: 1.2.0
+  inputs:
+    name: metadata.name
+    domains: spec.dnsNames
+    issuer: spec.issuerRef.name
+    usages: spec.usages[*]
+    first_port: spec.ports[0].port
+  values:
+    team: platform
+`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	reg := NewRegistry()
+	reg.RegisterModules(rules)
+
+	r := decode(t, `
+apiVersion: cert-manager.io/v1
+kind: Certificate
+metadata:
+  name: example-com
+  namespace: web
+spec:
+  secretName: example-com-tls
+  dnsNames: [example.com, www.example.com]
+  issuerRef:
+    name: letsencrypt
+    kind: ClusterIssuer
+  usages: [server auth]
+status:
+  ready: true
+`)
+	blocks, diags := ConvertBlocks(r, Options{Registry: reg})
+	if err := DiagsError(diags); err != nil {
+		t.Fatal(err)
+	}
+	f := hclwrite.NewEmptyFile()
+	f.Body().AppendBlock(blocks[0])
+	want := `module "certificate__example_com" {
+  source  = "git::https://git.example.com/modules/certificate"
+  version = "1.2.0"
+  domains = ["example.com", "www.example.com"]
+  issuer  = "letsencrypt"
+  name    = "example-com"
+  team    = "platform"
+  usages  = ["server auth"]
+}
+`
+	if diff := cmp.Diff(want, string(hclwrite.Format(f.Bytes()))); diff != "" {
+		t.Errorf("unexpected output (-want +got):\n%s", diff)
+	}
+	if len(diags) != 1 || !strings.Contains(diags[0].Detail, "spec.issuerRef.kind, spec.secretName isn't mapped") {
+		t.Errorf("unexpected warnings: %v", diags)
+	}
+
+	// Other kinds aren't affected.
+	blocks, diags = ConvertBlocks(decode(t, "apiVersion: cert-manager.io/v1\nkind: Issuer\nmetadata:\n  name: test\n"), Options{Registry: reg})
+	if err := DiagsError(diags); err != nil {
+		t.Fatal(err)
+	}
+	if got := blocks[0].Labels()[0]; got != "kubernetes_manifest" {
+		t.Errorf("Issuer converted to %s, want kubernetes_manifest", got)
+	}
+}
+
+func TestReadModuleRulesErrors(t *testing.T) {
+	for _, in := range []string{
+		"- group: example.com\n  kind: Widget\n",
+		"- group: example.com\n  kind: Widget\n  source: ./widget\n  inputs:\n    1x: spec.x\n",
+		"- group: example.com\n  kind: Widget\n  source: ./widget\n  inputs:\n    x: spec..x\n",
+		"- group: example.com\n  kind: Widget\n  source: ./widget\n  inputs:\n    x: spec.x\n  values:\n    x: 1\n",
+	} {
+		if _, err := ReadModuleRules(strings.NewReader(in)); err == nil {
+			t.Errorf("ReadModuleRules(%q): expected an error", in)
+		}
+	}
+}
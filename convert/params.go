@@ -0,0 +1,357 @@
+package convert
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/ext/typeexpr"
+	"github.com/hashicorp/hcl/v2/hclsyntax"
+	"github.com/hashicorp/hcl/v2/hclwrite"
+	"github.com/zclconf/go-cty/cty"
+
+	"github.com/pfcm/ktf/resource"
+)
+
+// ParameterRule picks out fields to turn into terraform variables.
+type ParameterRule struct {
+	// Group and Kind restrict the rule to some resources. An empty Group
+	// matches every group, and an empty (or "*") Kind every kind.
+	Group string `json:"group,omitempty"`
+	Kind  string `json:"kind,omitempty"`
+	// Path is the path of the field in the kubernetes object, eg.
+	// "spec.template.spec.containers[*].image". Lists can be indexed with
+	// [*] or a number, and keys that aren't identifiers quoted like
+	// metadata.labels["app.kubernetes.io/name"].
+	Path string `json:"path"`
+	// Name, if set, is the name of a single variable shared by everything
+	// the rule matches (as long as the values are the same). Otherwise
+	// each field gets its own variable, named after the resource.
+	Name        string `json:"name,omitempty"`
+	Description string `json:"description,omitempty"`
+
+	path []pathElem
+}
+
+// pathElem is a step in a path: a key, a list index or (with neither) any
+// list index.
+type pathElem struct {
+	key   string
+	index int
+	isKey bool
+	any   bool
+}
+
+// Variable is a variable introduced by a ParameterRule.
+type Variable struct {
+	Name        string
+	Description string
+	// Default is the variable's default value, or cty.NilVal if it has to
+	// be set, in which case Type is its type.
+	Default   cty.Value
+	Type      cty.Type
+	Sensitive bool
+}
+
+// Parameters holds the rules for parameterising resources, and collects the
+// variables as they are used. The same Parameters should be used for all of
+// the resources going into one module, so the variable names are unique.
+type Parameters struct {
+	Rules     []ParameterRule
+	Variables []Variable
+}
+
+// ReadParameters reads a list of ParameterRules from yaml or json.
+func ReadParameters(r io.Reader) (*Parameters, error) {
+	var rules []ParameterRule
+	if err := readConfig(r, &rules); err != nil {
+		return nil, err
+	}
+	for i := range rules {
+		path, err := parsePath(rules[i].Path)
+		if err != nil {
+			return nil, fmt.Errorf("rule %d: %w", i, err)
+		}
+		rules[i].path = path
+		if n := rules[i].Name; n != "" && !hclsyntax.ValidIdentifier(n) {
+			return nil, fmt.Errorf("rule %d: invalid variable name %q", i, n)
+		}
+	}
+	return &Parameters{Rules: rules}, nil
+}
+
+// parsePath parses a path like a.b[*].c["d.e"].
+func parsePath(s string) ([]pathElem, error) {
+	var (
+		path []pathElem
+		rest = s
+	)
+	for rest != "" {
+		switch {
+		case rest[0] == '[':
+			end := strings.IndexByte(rest, ']')
+			if end < 0 {
+				return nil, fmt.Errorf("path %q: unterminated [", s)
+			}
+			inner := rest[1:end]
+			switch {
+			case inner == "*":
+				path = append(path, pathElem{any: true})
+			case strings.HasPrefix(inner, `"`):
+				k, err := strconv.Unquote(inner)
+				if err != nil {
+					return nil, fmt.Errorf("path %q: invalid key %s: %w", s, inner, err)
+				}
+				path = append(path, pathElem{key: k, isKey: true})
+			default:
+				i, err := strconv.Atoi(inner)
+				if err != nil || i < 0 {
+					return nil, fmt.Errorf("path %q: invalid index [%s]", s, inner)
+				}
+				path = append(path, pathElem{index: i})
+			}
+			rest = rest[end+1:]
+		case rest[0] == '.' && len(path) > 0:
+			rest = rest[1:]
+			fallthrough
+		default:
+			end := strings.IndexAny(rest, ".[")
+			if end < 0 {
+				end = len(rest)
+			}
+			if end == 0 {
+				return nil, fmt.Errorf("path %q: empty key", s)
+			}
+			path = append(path, pathElem{key: rest[:end], isKey: true})
+			rest = rest[end:]
+		}
+	}
+	if len(path) == 0 {
+		return nil, fmt.Errorf("empty path")
+	}
+	return path, nil
+}
+
+// pathString formats a path through an object, made up of strings and ints.
+func pathString(path []any) string {
+	var b strings.Builder
+	for _, p := range path {
+		switch p := p.(type) {
+		case int:
+			fmt.Fprintf(&b, "[%d]", p)
+		case string:
+			if hclsyntax.ValidIdentifier(p) {
+				if b.Len() > 0 {
+					b.WriteByte('.')
+				}
+				b.WriteString(p)
+			} else {
+				fmt.Fprintf(&b, "[%q]", p)
+			}
+		}
+	}
+	return b.String()
+}
+
+func (rule *ParameterRule) matches(r resource.Resource, path []any, prefix bool) bool {
+	if group, _ := r.GroupVersion(); rule.Group != "" && rule.Group != group {
+		return false
+	}
+	if rule.Kind != "" && rule.Kind != "*" && rule.Kind != r.Kind {
+		return false
+	}
+	if len(path) > len(rule.path) || !prefix && len(path) != len(rule.path) {
+		return false
+	}
+	for i, p := range path {
+		e := rule.path[i]
+		switch p := p.(type) {
+		case string:
+			if !e.isKey || e.key != p {
+				return false
+			}
+		case int:
+			if e.isKey || !e.any && e.index != p {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// parameterizer replaces the fields of a resource matched by the rules with
+// references to variables.
+type parameterizer struct {
+	params *Parameters
+	r      resource.Resource
+}
+
+// lookup returns the rule matching the field at path, if any.
+func (p parameterizer) lookup(path []any) (*ParameterRule, bool) {
+	if p.params == nil {
+		return nil, false
+	}
+	for i := range p.params.Rules {
+		if rule := &p.params.Rules[i]; rule.matches(p.r, path, false) {
+			return rule, true
+		}
+	}
+	return nil, false
+}
+
+// within reports whether any rule might match something inside the field at
+// path.
+func (p parameterizer) within(path []any) bool {
+	if p.params == nil {
+		return false
+	}
+	for i := range p.params.Rules {
+		if p.params.Rules[i].matches(p.r, path, true) {
+			return true
+		}
+	}
+	return false
+}
+
+// variable finds or adds the variable for the field at path, returning the
+// tokens referring to it.
+func (p parameterizer) variable(rule *ParameterRule, path []any, def cty.Value) hclwrite.Tokens {
+	name, desc := rule.Name, rule.Description
+	if name == "" {
+		name = variableName(p.r.Metadata.Name, path)
+		if desc == "" {
+			desc = fmt.Sprintf("%s of %s %q.", pathString(path), p.r.Kind, p.r.Metadata.Name)
+		}
+	} else if desc == "" {
+		desc = fmt.Sprintf("%s of every matching resource.", rule.Path)
+	}
+
+	base := name
+	for n := 2; ; n++ {
+		v, ok := p.params.find(name)
+		if !ok {
+			p.params.Variables = append(p.params.Variables, Variable{Name: name, Description: desc, Default: def})
+			break
+		}
+		if rule.Name != "" && v.Default.Equals(def).True() {
+			// Shared with another resource.
+			break
+		}
+		name = fmt.Sprintf("%s_%d", base, n)
+	}
+	return hclwrite.TokensForTraversal(hcl.Traversal{
+		hcl.TraverseRoot{Name: "var"},
+		hcl.TraverseAttr{Name: name},
+	})
+}
+
+func (p *Parameters) find(name string) (Variable, bool) {
+	for _, v := range p.Variables {
+		if v.Name == name {
+			return v, true
+		}
+	}
+	return Variable{}, false
+}
+
+// variableName names a variable after the resource and the last key of the
+// path, eg. web_image.
+func variableName(objectName string, path []any) string {
+	field := ""
+	for _, p := range path {
+		if k, ok := p.(string); ok {
+			field = k
+		}
+	}
+	name := strings.Map(func(r rune) rune {
+		if r >= 'a' && r <= 'z' || r >= '0' && r <= '9' || r == '_' {
+			return r
+		}
+		return '_'
+	}, resource.ToSnake(objectName+"_"+field))
+	if !hclsyntax.ValidIdentifier(name) {
+		name = "v_" + name
+	}
+	return name
+}
+
+// Block returns the variable block declaring v.
+func (v Variable) Block() *hclwrite.Block {
+	b := hclwrite.NewBlock("variable", []string{v.Name})
+	body := b.Body()
+	hasDefault := v.Default.Type() != cty.NilType
+	t := v.Type
+	if hasDefault {
+		t = v.Default.Type()
+	}
+	if t != cty.NilType && !t.IsObjectType() && !t.IsTupleType() && t != cty.DynamicPseudoType {
+		body.SetAttributeRaw("type", typeTokens(t))
+	}
+	body.SetAttributeValue("description", cty.StringVal(v.Description))
+	if v.Sensitive {
+		body.SetAttributeValue("sensitive", cty.True)
+	}
+	if hasDefault {
+		w := tokenWriter{}
+		w.emitCty(v.Default)
+		body.SetAttributeRaw("default", w.tokens)
+	}
+	return b
+}
+
+// typeTokens writes a type constraint, eg. list(string).
+func typeTokens(t cty.Type) hclwrite.Tokens {
+	src := typeexpr.TypeString(t)
+	f, diags := hclwrite.ParseConfig([]byte("type = "+src), "", hcl.InitialPos)
+	if diags.HasErrors() {
+		panic(fmt.Sprintf("invalid type string %q: %v", src, diags))
+	}
+	return f.Body().GetAttribute("type").Expr().BuildTokens(nil)
+}
+
+// ctyValue converts a plain go value, as decoded from yaml or json, to cty.
+func ctyValue(a any) (cty.Value, error) {
+	switch v := a.(type) {
+	case string:
+		return cty.StringVal(v), nil
+	case json.Number:
+		return cty.ParseNumberVal(v.String())
+	case float64:
+		return cty.NumberFloatVal(v), nil
+	case int:
+		return cty.NumberIntVal(int64(v)), nil
+	case int64:
+		return cty.NumberIntVal(v), nil
+	case uint64:
+		return cty.NumberUIntVal(v), nil
+	case bool:
+		return cty.BoolVal(v), nil
+	case nil:
+		return cty.NullVal(cty.DynamicPseudoType), nil
+	case map[string]any:
+		attrs := make(map[string]cty.Value, len(v))
+		for k, e := range v {
+			ev, err := ctyValue(e)
+			if err != nil {
+				return cty.NilVal, err
+			}
+			attrs[k] = ev
+		}
+		return cty.ObjectVal(attrs), nil
+	case []any:
+		elems := make([]cty.Value, len(v))
+		for i, e := range v {
+			ev, err := ctyValue(e)
+			if err != nil {
+				return cty.NilVal, err
+			}
+			elems[i] = ev
+		}
+		return cty.TupleVal(elems), nil
+	default:
+		return cty.NilVal, fmt.Errorf("unhandled type: %T (value: %v)", v, v)
+	}
+}
@@ -0,0 +1,203 @@
+package convert
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/hcl/v2/hclwrite"
+	"github.com/zclconf/go-cty/cty"
+)
+
+func TestParsePath(t *testing.T) {
+	for _, c := range []struct {
+		in   string
+		want []pathElem
+	}{
+		{"spec.replicas", []pathElem{{key: "spec", isKey: true}, {key: "replicas", isKey: true}}},
+		{"spec.containers[*].image", []pathElem{
+			{key: "spec", isKey: true}, {key: "containers", isKey: true}, {any: true}, {key: "image", isKey: true},
+		}},
+		{`metadata.labels["app.kubernetes.io/name"]`, []pathElem{
+			{key: "metadata", isKey: true}, {key: "labels", isKey: true}, {key: "app.kubernetes.io/name", isKey: true},
+		}},
+		{"args[2]", []pathElem{{key: "args", isKey: true}, {index: 2}}},
+	} {
+		got, err := parsePath(c.in)
+		if err != nil {
+			t.Errorf("parsePath(%q): %v", c.in, err)
+			continue
+		}
+		if diff := cmp.Diff(c.want, got, cmp.AllowUnexported(pathElem{})); diff != "" {
+			t.Errorf("parsePath(%q): unexpected result (-want +got):\n%s", c.in, diff)
+		}
+	}
+	for _, in := range []string{"", "spec..replicas", "args[x]", "args[-1]", "args[*", `a["b]`} {
+		if _, err := parsePath(in); err == nil {
+			t.Errorf("parsePath(%q): expected an error", in)
+		}
+	}
+}
+
+func TestParameters(t *testing.T) {
+	const rules = `
+- kind: Deployment
+  path: spec.template.spec.containers[*].image
+- kind: Deployment
+  path: spec.template.spec.containers[0].resources.limits.memory
+- path: metadata.namespace
+  name: namespace
+  description: The namespace.
+- group: example.com
+  path: spec.replicas
+`
+	params, err := ReadParameters(strings.NewReader(rules))
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, c := range []struct {
+		in, want string
+	}{{
+		in: `
+apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: web
+  namespace: default
+spec:
+  selector:
+    matchLabels: {app: web}
+  template:
+    metadata:
+      labels: {app: web}
+    spec:
+      containers:
+      - name: web
+        image: nginx
+        resources:
+          limits: {memory: 1Gi}
+      - name: sidecar
+        image: envoy
+`,
+		want: `resource "kubernetes_deployment_v1" "web" {
+  metadata {
+    name      = "web"
+    namespace = var.namespace
+  }
+  spec {
+    selector {
+      match_labels = {
+        app = "web"
+      }
+    }
+    template {
+      metadata {
+        labels = {
+          app = "web"
+        }
+      }
+      spec {
+        container {
+          image = var.web_image
+          name  = "web"
+          resources {
+            limits = {
+              memory = var.web_memory
+            }
+          }
+        }
+        container {
+          image = var.web_image_2
+          name  = "sidecar"
+        }
+      }
+    }
+  }
+}
+`,
+	}, {
+		in: `
+apiVersion: example.com/v1
+kind: Widget
+metadata:
+  name: widget
+  namespace: default
+spec:
+  replicas: 2
+`,
+		want: `resource "kubernetes_manifest" "widget__widget" {
+  manifest = {
+    "apiVersion" = "example.com/v1"
+    "kind"       = "Widget"
+    "metadata" = {
+      "name"      = "widget"
+      "namespace" = var.namespace
+    }
+    "spec" = {
+      "replicas" = var.widget_replicas
+    }
+  }
+}
+`,
+	}, {
+		in: `
+apiVersion: v1
+kind: ServiceAccount
+metadata:
+  name: other
+  namespace: other
+`,
+		want: `resource "kubernetes_service_account_v1" "other" {
+  metadata {
+    name      = "other"
+    namespace = var.namespace_2
+  }
+}
+`,
+	}} {
+		b, err := Convert(decode(t, c.in), Options{Parameters: params})
+		if err != nil {
+			t.Fatal(err)
+		}
+		f := hclwrite.NewEmptyFile()
+		f.Body().AppendBlock(b)
+		if diff := cmp.Diff(c.want, string(hclwrite.Format(f.Bytes()))); diff != "" {
+			t.Errorf("unexpected output (-want +got):\n%s", diff)
+		}
+	}
+
+	want := []Variable{
+		{Name: "namespace", Description: "The namespace.", Default: cty.StringVal("default")},
+		{Name: "web_image", Description: `spec.template.spec.containers[0].image of Deployment "web".`, Default: cty.StringVal("nginx")},
+		{Name: "web_memory", Description: `spec.template.spec.containers[0].resources.limits.memory of Deployment "web".`, Default: cty.StringVal("1Gi")},
+		{Name: "web_image_2", Description: `spec.template.spec.containers[1].image of Deployment "web".`, Default: cty.StringVal("envoy")},
+		{Name: "widget_replicas", Description: `spec.replicas of Widget "widget".`, Default: cty.NumberIntVal(2)},
+		{Name: "namespace_2", Description: "The namespace.", Default: cty.StringVal("other")},
+	}
+	if diff := cmp.Diff(want, params.Variables, cmp.Comparer(cty.Value.RawEquals), cmp.Comparer(cty.Type.Equals)); diff != "" {
+		t.Errorf("unexpected variables (-want +got):\n%s", diff)
+	}
+
+	got := string(hclwrite.Format(params.Variables[4].Block().BuildTokens(nil).Bytes()))
+	wantBlock := `variable "widget_replicas" {
+  type        = number
+  description = "spec.replicas of Widget \"widget\"."
+  default     = 2
+}
+`
+	if diff := cmp.Diff(wantBlock, got); diff != "" {
+		t.Errorf("unexpected variable block (-want +got):\n%s", diff)
+	}
+}
+
+func TestReadParametersErrors(t *testing.T) {
+	for _, in := range []string{
+		"- kind: Deployment\n  pth: spec.replicas\n",
+		"- kind: Deployment\n",
+		"- path: spec.replicas\n  name: not a name\n",
+	} {
+		if _, err := ReadParameters(strings.NewReader(in)); err == nil {
+			t.Errorf("ReadParameters(%q): expected an error", in)
+		}
+	}
+}
@@ -0,0 +1,486 @@
+package convert
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"maps"
+	"math/big"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/hashicorp/hcl/v2/hclsyntax"
+	"github.com/hashicorp/hcl/v2/hclwrite"
+	"github.com/zclconf/go-cty/cty"
+	"gopkg.in/yaml.v3"
+
+	"github.com/pfcm/ktf/resource"
+)
+
+// tokenWriter accumulates the tokens for an expression. It can write both cty
+// values, as produced by the generated specs, and the plain go values that
+// come out of decoding yaml or json.
+type tokenWriter struct {
+	opts   Options
+	tokens hclwrite.Tokens
+
+	// params replaces values with variables, keeping track of where we
+	// are in the resource with path.
+	params parameterizer
+	path   []any
+	// parameterized is set once anything has been replaced.
+	parameterized bool
+}
+
+func (w *tokenWriter) emitSingle(b byte, spacesBefore int) error {
+	var t hclsyntax.TokenType
+	switch b {
+	case '{':
+		t = hclsyntax.TokenOBrace
+	case '}':
+		t = hclsyntax.TokenCBrace
+	case '[':
+		t = hclsyntax.TokenOBrack
+	case ']':
+		t = hclsyntax.TokenCBrack
+	case '(':
+		t = hclsyntax.TokenOParen
+	case ')':
+		t = hclsyntax.TokenCParen
+	case '=':
+		t = hclsyntax.TokenEqual
+	case ',':
+		t = hclsyntax.TokenComma
+	case '\n':
+		t = hclsyntax.TokenNewline
+	default:
+		return fmt.Errorf("unknown single char token: %q", b)
+	}
+	w.tokens = append(w.tokens, &hclwrite.Token{
+		Type:         t,
+		Bytes:        []byte{b},
+		SpacesBefore: spacesBefore,
+	})
+	return nil
+}
+
+// emitNewline ends a line, unless the last thing written already did (as
+// heredocs do).
+func (w *tokenWriter) emitNewline() {
+	if n := len(w.tokens); n > 0 && w.tokens[n-1].Type == hclsyntax.TokenNewline {
+		return
+	}
+	w.emitSingle('\n', 0)
+}
+
+// emitString writes a string, as a heredoc if it can sensibly be written as
+// one, or as a call to jsonencode or yamlencode if it holds structured data
+// and that was asked for.
+func (w *tokenWriter) emitString(s string) {
+	if w.opts.EncodeStructured && w.emitStructured(s) {
+		return
+	}
+	if heredocable(s) {
+		w.tokens = append(w.tokens, heredocTokens(s)...)
+		return
+	}
+	w.tokens = append(w.tokens, quotedTokens(s)...)
+}
+
+// emitKey writes the key of an object. They are always quoted, even when they
+// are valid identifiers, to match the usual style for manifests.
+func (w *tokenWriter) emitKey(s string) {
+	w.tokens = append(w.tokens, quotedTokens(s)...)
+}
+
+func (w *tokenWriter) emitFloat64(f float64) {
+	w.tokens = append(w.tokens, &hclwrite.Token{
+		Type:  hclsyntax.TokenNumberLit,
+		Bytes: strconv.AppendFloat(nil, f, 'f', -1, 64),
+	})
+}
+
+// emitNumber writes a number exactly as it was written, unless it used an
+// exponent, in which case it's expanded so that integers look like integers.
+func (w *tokenWriter) emitNumber(n json.Number) error {
+	lit := []byte(n.String())
+	if bytes.ContainsAny(lit, "eE") {
+		f, _, err := big.ParseFloat(n.String(), 10, 512, big.ToNearestEven)
+		if err != nil {
+			return fmt.Errorf("invalid number %q: %w", n, err)
+		}
+		lit = []byte(f.Text('f', -1))
+	}
+	w.tokens = append(w.tokens, &hclwrite.Token{
+		Type:  hclsyntax.TokenNumberLit,
+		Bytes: lit,
+	})
+	return nil
+}
+
+func (w *tokenWriter) emitBool(b bool) {
+	w.tokens = append(w.tokens, &hclwrite.Token{
+		Type:  hclsyntax.TokenIdent,
+		Bytes: strconv.AppendBool(nil, b),
+	})
+}
+
+func (w *tokenWriter) emitNull() {
+	w.tokens = append(w.tokens, &hclwrite.Token{
+		Type:  hclsyntax.TokenIdent,
+		Bytes: []byte("null"),
+	})
+}
+
+// emitValue writes a plain go value, as decoded from yaml or json.
+func (w *tokenWriter) emitValue(a any) error {
+	if rule, ok := w.params.lookup(w.path); ok {
+		def, err := ctyValue(a)
+		if err != nil {
+			return err
+		}
+		w.tokens = append(w.tokens, w.params.variable(rule, w.path, def)...)
+		w.parameterized = true
+		return nil
+	}
+	switch v := a.(type) {
+	case string:
+		w.emitString(v)
+	case float64:
+		w.emitFloat64(v)
+	case json.Number:
+		return w.emitNumber(v)
+	case int, int64, uint64:
+		return w.emitNumber(json.Number(fmt.Sprint(v)))
+	case bool:
+		w.emitBool(v)
+	case nil:
+		w.emitNull()
+	case map[string]any:
+		return w.emitMap(v)
+	case []any:
+		return w.emitList(v)
+	default:
+		return fmt.Errorf("unhandled type in manifest: %T (value: %v)", v, v)
+	}
+	return nil
+}
+
+func (w *tokenWriter) emitMap(m map[string]any) error {
+	if err := w.emitSingle('{', 1); err != nil {
+		return err
+	}
+	if err := w.emitSingle('\n', 0); err != nil {
+		return err
+	}
+
+	names := slices.Collect(maps.Keys(m))
+	slices.Sort(names)
+	for _, name := range names {
+		w.path = append(w.path, name)
+		err := w.emitAttr(name, m[name])
+		w.path = w.path[:len(w.path)-1]
+		if err != nil {
+			return err
+		}
+	}
+
+	return w.emitSingle('}', 1)
+}
+
+func (w *tokenWriter) emitList(l []any) error {
+	if err := w.emitSingle('[', 1); err != nil {
+		return err
+	}
+
+	for i, v := range l {
+		w.path = append(w.path, i)
+		err := w.emitValue(v)
+		w.path = w.path[:len(w.path)-1]
+		if err != nil {
+			return err
+		}
+		if i != len(l)-1 {
+			w.emitSingle(',', 0)
+		}
+	}
+
+	return w.emitSingle(']', 1)
+}
+
+func (w *tokenWriter) emitAttr(name string, value any) error {
+	// "name" = <value>
+	w.emitKey(name)
+	w.emitSingle('=', 1)
+
+	if err := w.emitValue(value); err != nil {
+		return err
+	}
+	w.emitNewline()
+	return nil
+}
+
+// emitCty writes a cty value. For the most part this is just
+// hclwrite.TokensForValue, but strings might need special treatment (see
+// emitString), in which case we have to walk the value ourselves.
+func (w *tokenWriter) emitCty(v cty.Value) {
+	if rule, ok := w.params.lookup(w.path); ok {
+		w.tokens = append(w.tokens, w.params.variable(rule, w.path, v)...)
+		w.parameterized = true
+		return
+	}
+	if !hasString(v, w.special) && !w.params.within(w.path) || v.IsNull() {
+		w.tokens = append(w.tokens, hclwrite.TokensForValue(v)...)
+		return
+	}
+	t := v.Type()
+	switch {
+	case t == cty.String:
+		w.emitString(v.AsString())
+	case t.IsListType() || t.IsSetType() || t.IsTupleType():
+		w.emitSingle('[', 0)
+		w.emitSingle('\n', 0)
+		i := 0
+		for it := v.ElementIterator(); it.Next(); i++ {
+			_, ev := it.Element()
+			w.path = append(w.path, i)
+			w.emitCty(ev)
+			w.path = w.path[:len(w.path)-1]
+			w.emitNewline()
+			w.emitSingle(',', 0)
+			w.emitSingle('\n', 0)
+		}
+		w.emitSingle(']', 0)
+	case t.IsMapType() || t.IsObjectType():
+		w.emitSingle('{', 0)
+		w.emitSingle('\n', 0)
+		for it := v.ElementIterator(); it.Next(); {
+			k, ev := it.Element()
+			w.tokens = append(w.tokens, keyTokens(k.AsString())...)
+			w.emitSingle('=', 0)
+			// Object attributes come from the schema, so are
+			// snake_case, but map keys are as they were.
+			key := k.AsString()
+			if t.IsObjectType() {
+				key = resource.ToCamel(key)
+			}
+			w.path = append(w.path, key)
+			w.emitCty(ev)
+			w.path = w.path[:len(w.path)-1]
+			w.emitNewline()
+		}
+		w.emitSingle('}', 0)
+	default:
+		w.tokens = append(w.tokens, hclwrite.TokensForValue(v)...)
+	}
+}
+
+// special reports whether a string needs more than just quoting.
+func (w *tokenWriter) special(s string) bool {
+	if heredocable(s) {
+		return true
+	}
+	if w.opts.EncodeStructured {
+		_, _, ok := parseStructured(s)
+		return ok
+	}
+	return false
+}
+
+// emitStructured tries to write s as a call to jsonencode or yamlencode,
+// reporting whether it succeeded.
+func (w *tokenWriter) emitStructured(s string) bool {
+	fn, v, ok := parseStructured(s)
+	if !ok {
+		return false
+	}
+	inner := tokenWriter{opts: w.opts}
+	if err := inner.emitValue(v); err != nil {
+		// Something we can't write, leave it as a string.
+		return false
+	}
+	w.tokens = append(w.tokens, &hclwrite.Token{Type: hclsyntax.TokenIdent, Bytes: []byte(fn)})
+	w.emitSingle('(', 0)
+	w.tokens = append(w.tokens, inner.tokens...)
+	w.emitSingle(')', 0)
+	return true
+}
+
+// yamlDocumentMarker matches the markers that start or end a yaml document.
+var yamlDocumentMarker = regexp.MustCompile(`(?m)^(---|\.\.\.)(\s|$)`)
+
+// parseStructured tries to parse s as a JSON object or array, or failing that
+// a multi-line YAML mapping or sequence, returning the name of the function
+// that would turn it back into a string. Scalars, single line YAML (which is
+// hard to tell apart from an ordinary string) and multiple YAML documents are
+// not considered structured.
+func parseStructured(s string) (string, any, bool) {
+	trimmed := strings.TrimSpace(s)
+	if trimmed == "" {
+		return "", nil, false
+	}
+	if trimmed[0] == '{' || trimmed[0] == '[' {
+		d := json.NewDecoder(strings.NewReader(trimmed))
+		d.UseNumber()
+		var v any
+		if err := d.Decode(&v); err == nil {
+			if _, err := d.Token(); errors.Is(err, io.EOF) {
+				return "jsonencode", v, true
+			}
+		}
+	}
+	if !strings.Contains(trimmed, "\n") || yamlDocumentMarker.MatchString(s) {
+		return "", nil, false
+	}
+	// yaml.v3, unlike the yaml->json conversion used for manifests, follows
+	// YAML 1.2, so things like `on:` and `no` stay as strings.
+	var v any
+	if err := yaml.Unmarshal([]byte(s), &v); err != nil {
+		return "", nil, false
+	}
+	switch v.(type) {
+	case map[string]any, []any:
+		return "yamlencode", v, true
+	}
+	return "", nil, false
+}
+
+// keyTokens returns the tokens for the key of an object, which is a bare
+// identifier if possible.
+func keyTokens(k string) hclwrite.Tokens {
+	if hclsyntax.ValidIdentifier(k) {
+		return hclwrite.Tokens{{Type: hclsyntax.TokenIdent, Bytes: []byte(k)}}
+	}
+	return quotedTokens(k)
+}
+
+// quotedTokens returns the tokens for s as a quoted string. This is the only
+// way quoted strings should be written: as well as the usual escapes it takes
+// care of doubling up the $ and % of anything that looks like a template
+// sequence, and only uses escapes HCL understands (unlike Go's %q, which
+// can produce \x00, \a and friends).
+func quotedTokens(s string) hclwrite.Tokens {
+	return hclwrite.TokensForValue(cty.StringVal(s))
+}
+
+// heredocable reports whether s should be written as a heredoc: it must span
+// multiple lines and not contain anything that can't be represented without
+// escapes.
+func heredocable(s string) bool {
+	if !strings.Contains(s, "\n") || !utf8.ValidString(s) {
+		return false
+	}
+	for _, r := range s {
+		if r != '\n' && r != '\t' && unicode.IsControl(r) {
+			return false
+		}
+	}
+	return true
+}
+
+// heredocTokens writes s as a heredoc. Heredocs always end in a newline, so
+// if s doesn't it is wrapped in chomp(). Template sequences are escaped, so
+// terraform won't try to interpolate anything.
+func heredocTokens(s string) hclwrite.Tokens {
+	lines := strings.SplitAfter(s, "\n")
+	if lines[len(lines)-1] == "" {
+		lines = lines[:len(lines)-1]
+	}
+	chomp := !strings.HasSuffix(s, "\n")
+	if chomp {
+		lines[len(lines)-1] += "\n"
+	}
+
+	marker := "EOT"
+	for containsLine(lines, marker) {
+		marker = "_" + marker + "_"
+	}
+	// The flush form (<<-) strips the shortest common indent, which would
+	// change the value if every line was indented.
+	open := "<<-"
+	if commonIndent(lines) {
+		open = "<<"
+	}
+
+	var tokens hclwrite.Tokens
+	if chomp {
+		tokens = append(tokens,
+			&hclwrite.Token{Type: hclsyntax.TokenIdent, Bytes: []byte("chomp")},
+			&hclwrite.Token{Type: hclsyntax.TokenOParen, Bytes: []byte{'('}},
+		)
+	}
+	tokens = append(tokens, &hclwrite.Token{
+		Type:  hclsyntax.TokenOHeredoc,
+		Bytes: []byte(open + marker + "\n"),
+	})
+	for _, l := range lines {
+		tokens = append(tokens, &hclwrite.Token{
+			Type:  hclsyntax.TokenStringLit,
+			Bytes: []byte(escapeTemplate(l)),
+		})
+	}
+	tokens = append(tokens,
+		&hclwrite.Token{Type: hclsyntax.TokenCHeredoc, Bytes: []byte(marker)},
+		&hclwrite.Token{Type: hclsyntax.TokenNewline, Bytes: []byte{'\n'}},
+	)
+	if chomp {
+		tokens = append(tokens, &hclwrite.Token{Type: hclsyntax.TokenCParen, Bytes: []byte{')'}})
+	}
+	return tokens
+}
+
+// escapeTemplate escapes the sequences that would otherwise start a template
+// interpolation or directive.
+func escapeTemplate(s string) string {
+	s = strings.ReplaceAll(s, "${", "$${")
+	return strings.ReplaceAll(s, "%{", "%%{")
+}
+
+func containsLine(lines []string, marker string) bool {
+	for _, l := range lines {
+		if strings.TrimSpace(l) == marker {
+			return true
+		}
+	}
+	return false
+}
+
+// commonIndent reports whether every non-blank line starts with whitespace.
+func commonIndent(lines []string) bool {
+	indented := false
+	for _, l := range lines {
+		if strings.TrimSpace(l) == "" {
+			continue
+		}
+		if r, _ := utf8.DecodeRuneInString(l); !unicode.IsSpace(r) {
+			return false
+		}
+		indented = true
+	}
+	return indented
+}
+
+// hasString reports whether v contains any string for which f returns true.
+func hasString(v cty.Value, f func(string) bool) bool {
+	if v.IsNull() || !v.IsKnown() {
+		return false
+	}
+	t := v.Type()
+	switch {
+	case t == cty.String:
+		return f(v.AsString())
+	case t.IsCollectionType() || t.IsObjectType() || t.IsTupleType():
+		for it := v.ElementIterator(); it.Next(); {
+			if _, ev := it.Element(); hasString(ev, f) {
+				return true
+			}
+		}
+	}
+	return false
+}
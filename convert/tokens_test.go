@@ -0,0 +1,237 @@
+package convert
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclsyntax"
+	"github.com/hashicorp/hcl/v2/hclwrite"
+	"github.com/zclconf/go-cty/cty"
+	ctyconvert "github.com/zclconf/go-cty/cty/convert"
+	"github.com/zclconf/go-cty/cty/function"
+	"github.com/zclconf/go-cty/cty/function/stdlib"
+	"gopkg.in/yaml.v3"
+
+	"github.com/pfcm/ktf/resource"
+)
+
+// evalAttr formats and parses a file containing the block, then evaluates the
+// named attribute. Only the functions that the converters emit are
+// available.
+func evalAttr(t *testing.T, b *hclwrite.Block, name string) cty.Value {
+	t.Helper()
+	f := hclwrite.NewEmptyFile()
+	f.Body().AppendBlock(b)
+	src := hclwrite.Format(f.Bytes())
+
+	parsed, diags := hclsyntax.ParseConfig(src, "test.tf", hcl.InitialPos)
+	if diags.HasErrors() {
+		t.Fatalf("parsing:\n%s\n%v", src, diags)
+	}
+	block := parsed.Body.(*hclsyntax.Body).Blocks[0]
+	attr, ok := block.Body.Attributes[name]
+	if !ok {
+		t.Fatalf("no attribute %q in:\n%s", name, src)
+	}
+	ctx := &hcl.EvalContext{
+		Functions: map[string]function.Function{
+			"chomp":      stdlib.ChompFunc,
+			"jsonencode": stdlib.JSONEncodeFunc,
+			// JSON is YAML, so this is enough to check the value
+			// going in is right.
+			"yamlencode": stdlib.JSONEncodeFunc,
+		},
+	}
+	v, diags := attr.Expr.Value(ctx)
+	if diags.HasErrors() {
+		t.Fatalf("evaluating:\n%s\n%v", src, diags)
+	}
+	return v
+}
+
+var multilineStrings = []string{
+	"one line",
+	"trailing newline\n",
+	"two\nlines\n",
+	"no trailing\nnewline",
+	"several trailing\nnewlines\n\n\n",
+	"\n\nleading newlines\n",
+	"  indented\n  all the way\n",
+	"  partially\nindented\n",
+	"\ttabs\n\tmore tabs\n",
+	"EOT\nhas the marker\nEOT\n",
+	"server {\n  return 200 \"${host}%{if x}y%{endif}\";\n}\n",
+	"already escaped $${x} and %%{y}\n",
+	"backslashes \\n \\\" stay put\n",
+	"windows\r\nline endings\r\n",
+	"unicode ✓\nlines\n",
+}
+
+func TestValueTokensRoundTrip(t *testing.T) {
+	for _, s := range multilineStrings {
+		t.Run(s, func(t *testing.T) {
+			for name, v := range map[string]cty.Value{
+				"string": cty.StringVal(s),
+				"map":    cty.MapVal(map[string]cty.Value{"key.conf": cty.StringVal(s), "other": cty.StringVal("x")}),
+				"list":   cty.ListVal([]cty.Value{cty.StringVal(s), cty.StringVal("x")}),
+			} {
+				b := hclwrite.NewBlock("test", nil)
+				w := tokenWriter{}
+				w.emitCty(v)
+				b.Body().SetAttributeRaw("value", w.tokens)
+				// Literals always come back as objects or tuples.
+				got, err := ctyconvert.Convert(evalAttr(t, b, "value"), v.Type())
+				if err != nil {
+					t.Fatalf("%s: %v", name, err)
+				}
+				if !got.RawEquals(v) {
+					t.Errorf("%s: got %#v, want %#v", name, got, v)
+				}
+			}
+		})
+	}
+}
+
+func TestManifestRoundTrip(t *testing.T) {
+	for _, s := range multilineStrings {
+		t.Run(s, func(t *testing.T) {
+			r := resource.New()
+			r.Raw["data"] = map[string]any{"value": s}
+			r.Raw["list"] = []any{s, "x"}
+			tokens, err := manifestDataTokens(r, Options{})
+			if err != nil {
+				t.Fatal(err)
+			}
+			b := hclwrite.NewBlock("test", nil)
+			b.Body().SetAttributeRaw("manifest", tokens)
+
+			got := evalAttr(t, b, "manifest")
+			if v := got.GetAttr("data").GetAttr("value"); !v.RawEquals(cty.StringVal(s)) {
+				t.Errorf("data: got %#v, want %q", v, s)
+			}
+			if v := got.GetAttr("list").Index(cty.NumberIntVal(0)); !v.RawEquals(cty.StringVal(s)) {
+				t.Errorf("list: got %#v, want %q", v, s)
+			}
+		})
+	}
+}
+
+// adversarialStrings are all single line, so they go through the quoted string
+// path rather than the heredoc one.
+var adversarialStrings = []string{
+	"${var.secret}",
+	"%{ if true }x%{ endif }",
+	"$${already} %%{escaped}",
+	"$$${mixed}",
+	"lonely $ and % and { }",
+	"${",
+	"%{",
+	"trailing $",
+	`"quotes" and \backslashes\`,
+	`\n is not a newline`,
+	"\x00\a\b\f\v control characters",
+	"\u2028 line separator",
+	"emoji 🙃 and \U0001F600",
+}
+
+func TestAdversarialStrings(t *testing.T) {
+	for _, s := range adversarialStrings {
+		t.Run(s, func(t *testing.T) {
+			want := cty.MapVal(map[string]cty.Value{
+				s:       cty.StringVal(s),
+				"other": cty.StringVal("x"),
+			})
+			b := hclwrite.NewBlock("test", nil)
+			w := tokenWriter{}
+			w.emitCty(want)
+			b.Body().SetAttributeRaw("value", w.tokens)
+			got, err := ctyconvert.Convert(evalAttr(t, b, "value"), want.Type())
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !got.RawEquals(want) {
+				t.Errorf("spec: got %#v, want %#v", got, want)
+			}
+
+			r := resource.New()
+			r.Raw[s] = s
+			tokens, err := manifestDataTokens(r, Options{})
+			if err != nil {
+				t.Fatal(err)
+			}
+			b = hclwrite.NewBlock("test", nil)
+			b.Body().SetAttributeRaw("manifest", tokens)
+			if v := evalAttr(t, b, "manifest").GetAttr(s); !v.RawEquals(cty.StringVal(s)) {
+				t.Errorf("manifest: got %#v, want %q", v, s)
+			}
+		})
+	}
+}
+
+func TestEncodeStructured(t *testing.T) {
+	for _, c := range []struct {
+		name, in string
+		fn       string // empty if it should stay a string
+	}{
+		{"json object", `{"a": 1, "b": [true, "x", 1.5], "c": {"d": "${e}"}}`, "jsonencode"},
+		{"json array", "[1, 2, 3]\n", "jsonencode"},
+		{"json big int", `{"big": 12345678901234567890}`, "jsonencode"},
+		{"empty json object", `{}`, "jsonencode"},
+		{"yaml mapping", "global:\n  scrape_interval: 15s # comment\nrule_files:\n- a.yml\n", "yamlencode"},
+		{"yaml sequence", "- name: a\n  value: 1\n- name: b\n  on: yes\n", "yamlencode"},
+		{"not json", `{"a": 1`, ""},
+		{"trailing garbage", `{"a": 1} {"b": 2}`, ""},
+		{"json scalar", `"just a string"`, ""},
+		{"single line yaml", "key: value", ""},
+		{"yaml scalar", "just\nsome text\n", ""},
+		{"script", "#!/bin/sh\necho hi\n", ""},
+		{"multiple documents", "a: 1\n---\nb: 2\n", ""},
+		{"yaml null", "a: 1\nb:\n", "yamlencode"},
+	} {
+		t.Run(c.name, func(t *testing.T) {
+			w := tokenWriter{opts: Options{EncodeStructured: true}}
+			w.emitCty(cty.MapVal(map[string]cty.Value{"value": cty.StringVal(c.in)}))
+			b := hclwrite.NewBlock("test", nil)
+			b.Body().SetAttributeRaw("data", w.tokens)
+
+			src := string(w.tokens.Bytes())
+			if c.fn == "" {
+				if strings.Contains(src, "encode(") {
+					t.Fatalf("expected a plain string, got: %s", src)
+				}
+			} else if !strings.Contains(src, c.fn+"(") {
+				t.Fatalf("expected a call to %s, got: %s", c.fn, src)
+			}
+
+			got := evalAttr(t, b, "data").GetAttr("value").AsString()
+			if c.fn == "" {
+				if got != c.in {
+					t.Errorf("got %q, want %q", got, c.in)
+				}
+				return
+			}
+			// Compare the parsed values, the strings will be different.
+			var gotV, wantV any
+			if err := json.Unmarshal([]byte(got), &gotV); err != nil {
+				t.Fatalf("parsing result %q: %v", got, err)
+			}
+			if err := yaml.Unmarshal([]byte(c.in), &wantV); err != nil {
+				t.Fatal(err)
+			}
+			// Round trip through json so the types line up.
+			raw, err := json.Marshal(wantV)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if err := json.Unmarshal(raw, &wantV); err != nil {
+				t.Fatal(err)
+			}
+			if diff := cmp.Diff(wantV, gotV); diff != "" {
+				t.Errorf("value changed (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,271 @@
+package convert
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// The upgrades of removedAPIs whose fields changed. They're given a copy of
+// the object, so can change it in place, and only have to handle what's in
+// manifests: status is left alone.
+
+// upgradeWorkload upgrades Deployments, DaemonSets, ReplicaSets and
+// StatefulSets from extensions/v1beta1 and apps/v1beta1, writing out the old
+// defaults for anything apps/v1 defaults differently: the selector, the
+// update strategy of DaemonSets and StatefulSets, and the rollout and history
+// limits of Deployments.
+func upgradeWorkload(raw map[string]any) error {
+	spec := object(raw, "spec")
+	if spec == nil {
+		return errors.New("no spec")
+	}
+	if _, ok := spec["selector"]; !ok {
+		// The selector used to default to the template's labels, but is
+		// required in apps/v1.
+		labels := object(object(object(spec, "template"), "metadata"), "labels")
+		if len(labels) == 0 {
+			return errors.New("no selector, and no template labels to take it from")
+		}
+		spec["selector"] = map[string]any{"matchLabels": labels}
+	}
+	delete(spec, "rollbackTo")
+	delete(spec, "templateGeneration")
+	switch raw["kind"] {
+	case "DaemonSet", "StatefulSet":
+		// OnDelete was the default, now it's RollingUpdate.
+		if _, ok := spec["updateStrategy"]; !ok {
+			spec["updateStrategy"] = map[string]any{"type": "OnDelete"}
+		}
+	case "Deployment":
+		upgradeDeploymentDefaults(spec, raw["apiVersion"])
+	}
+	return nil
+}
+
+// upgradeDeploymentDefaults sets whatever a Deployment's spec leaves out to
+// what it defaulted to in apiVersion, where apps/v1 has something else.
+func upgradeDeploymentDefaults(spec map[string]any, apiVersion any) {
+	setDefault := func(m map[string]any, key string, v any) {
+		if _, ok := m[key]; !ok {
+			m[key] = v
+		}
+	}
+	// MaxInt32, which the API server took to mean no limit.
+	const unlimited = json.Number("2147483647")
+	switch apiVersion {
+	case "extensions/v1beta1":
+		// Rolling updates went one pod at a time rather than 25%, all the
+		// old ReplicaSets were kept and there was no progress deadline.
+		setDefault(spec, "strategy", map[string]any{})
+		strategy := object(spec, "strategy")
+		if strategy != nil {
+			setDefault(strategy, "type", "RollingUpdate")
+			if strategy["type"] == "RollingUpdate" {
+				setDefault(strategy, "rollingUpdate", map[string]any{})
+				if ru := object(strategy, "rollingUpdate"); ru != nil {
+					setDefault(ru, "maxSurge", json.Number("1"))
+					setDefault(ru, "maxUnavailable", json.Number("1"))
+				}
+			}
+		}
+		setDefault(spec, "revisionHistoryLimit", unlimited)
+		setDefault(spec, "progressDeadlineSeconds", unlimited)
+	case "apps/v1beta1":
+		// Only two old ReplicaSets were kept, rather than ten.
+		setDefault(spec, "revisionHistoryLimit", json.Number("2"))
+	}
+}
+
+// upgradeIngress upgrades Ingresses from extensions/v1beta1 and
+// networking.k8s.io/v1beta1, where backends named the service's port
+// directly and the path type could be left out.
+func upgradeIngress(raw map[string]any) error {
+	spec := object(raw, "spec")
+	if spec == nil {
+		return errors.New("no spec")
+	}
+	if b, ok := spec["backend"]; ok {
+		spec["defaultBackend"] = b
+		delete(spec, "backend")
+		if err := upgradeBackend(object(spec, "defaultBackend")); err != nil {
+			return fmt.Errorf("spec.backend: %w", err)
+		}
+	}
+	for _, rule := range objects(spec, "rules") {
+		for _, path := range objects(object(rule, "http"), "paths") {
+			if _, ok := path["pathType"]; !ok {
+				path["pathType"] = "ImplementationSpecific"
+			}
+			if err := upgradeBackend(object(path, "backend")); err != nil {
+				return fmt.Errorf("path %v: %w", path["path"], err)
+			}
+		}
+	}
+	return nil
+}
+
+// upgradeBackend moves serviceName and servicePort into a service.
+func upgradeBackend(b map[string]any) error {
+	name, ok := b["serviceName"]
+	if !ok {
+		// A resource backend, which hasn't changed.
+		return nil
+	}
+	port := map[string]any{}
+	switch p := b["servicePort"].(type) {
+	case string:
+		port["name"] = p
+	case json.Number:
+		port["number"] = p
+	default:
+		return fmt.Errorf("unexpected servicePort %v", p)
+	}
+	delete(b, "serviceName")
+	delete(b, "servicePort")
+	b["service"] = map[string]any{"name": name, "port": port}
+	return nil
+}
+
+// upgradePodDisruptionBudget upgrades PodDisruptionBudgets from
+// policy/v1beta1, as long as their meaning doesn't change.
+func upgradePodDisruptionBudget(raw map[string]any) error {
+	if sel, ok := object(raw, "spec")["selector"].(map[string]any); ok && len(sel) == 0 {
+		return errors.New("its empty selector matches no pods in policy/v1beta1, but every pod in the namespace in policy/v1")
+	}
+	return nil
+}
+
+// upgradeHorizontalPodAutoscaler upgrades HorizontalPodAutoscalers from
+// autoscaling/v2beta1, where each metric had its own fields for the target.
+func upgradeHorizontalPodAutoscaler(raw map[string]any) error {
+	for _, m := range objects(object(raw, "spec"), "metrics") {
+		typ, _ := m["type"].(string)
+		if typ == "" {
+			return errors.New("metric without a type")
+		}
+		source := object(m, lowerFirst(typ))
+		if source == nil {
+			return fmt.Errorf("%s metric without a source", typ)
+		}
+		if typ == "Object" {
+			// The object used to be called target.
+			if obj, ok := source["target"]; ok {
+				source["describedObject"] = obj
+				delete(source, "target")
+			}
+		}
+		switch typ {
+		case "Pods", "Object", "External":
+			metric := map[string]any{"name": source["metricName"]}
+			delete(source, "metricName")
+			for _, sel := range []string{"selector", "metricSelector"} {
+				if s, ok := source[sel]; ok {
+					metric["selector"] = s
+					delete(source, sel)
+				}
+			}
+			source["metric"] = metric
+		}
+		target := map[string]any{}
+		for old, field := range map[string]string{
+			"targetAverageUtilization": "averageUtilization",
+			"targetAverageValue":       "averageValue",
+			"averageValue":             "averageValue",
+			"targetValue":              "value",
+		} {
+			if v, ok := source[old]; ok {
+				target[field] = v
+				delete(source, old)
+			}
+		}
+		switch {
+		case target["averageUtilization"] != nil:
+			target["type"] = "Utilization"
+		case target["averageValue"] != nil:
+			target["type"] = "AverageValue"
+		case target["value"] != nil:
+			target["type"] = "Value"
+		default:
+			return fmt.Errorf("%s metric without a target", typ)
+		}
+		source["target"] = target
+	}
+	return nil
+}
+
+// upgradeEndpointSlice upgrades EndpointSlices from discovery.k8s.io/v1beta1,
+// which kept an endpoint's node and zone in its topology.
+func upgradeEndpointSlice(raw map[string]any) error {
+	for _, e := range objects(raw, "endpoints") {
+		topology := object(e, "topology")
+		delete(e, "topology")
+		if len(topology) == 0 {
+			continue
+		}
+		for key, field := range map[string]string{
+			"kubernetes.io/hostname":      "nodeName",
+			"topology.kubernetes.io/zone": "zone",
+		} {
+			if v, ok := topology[key]; ok {
+				if _, ok := e[field]; !ok {
+					e[field] = v
+				}
+				delete(topology, key)
+			}
+		}
+		if len(topology) > 0 {
+			e["deprecatedTopology"] = topology
+		}
+	}
+	return nil
+}
+
+// upgradeWebhooks upgrades Mutating and ValidatingWebhookConfigurations from
+// admissionregistration.k8s.io/v1beta1, keeping the defaults which v1 changed
+// or doesn't have.
+func upgradeWebhooks(raw map[string]any) error {
+	for _, w := range objects(raw, "webhooks") {
+		switch w["sideEffects"] {
+		case nil, "Unknown", "Some":
+			return fmt.Errorf("webhook %v: v1 webhooks need sideEffects None or NoneOnDryRun", w["name"])
+		}
+		for field, def := range map[string]any{
+			"failurePolicy":           "Ignore",
+			"matchPolicy":             "Exact",
+			"timeoutSeconds":          json.Number("30"),
+			"admissionReviewVersions": []any{"v1beta1"},
+		} {
+			if _, ok := w[field]; !ok {
+				w[field] = def
+			}
+		}
+	}
+	return nil
+}
+
+// object returns m[key] if it's a map, or nil.
+func object(m map[string]any, key string) map[string]any {
+	o, _ := m[key].(map[string]any)
+	return o
+}
+
+// objects returns the maps in the list m[key].
+func objects(m map[string]any, key string) []map[string]any {
+	l, _ := m[key].([]any)
+	var out []map[string]any
+	for _, e := range l {
+		if o, ok := e.(map[string]any); ok {
+			out = append(out, o)
+		}
+	}
+	return out
+}
+
+func lowerFirst(s string) string {
+	if s == "" {
+		return s
+	}
+	return strings.ToLower(s[:1]) + s[1:]
+}
@@ -0,0 +1,322 @@
+package convert
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestUpgrades(t *testing.T) {
+	for _, c := range []struct {
+		name, in, want string
+	}{{
+		name: "deployment",
+		in: `
+apiVersion: extensions/v1beta1
+kind: Deployment
+metadata: {name: web}
+spec:
+  rollbackTo: {revision: 2}
+  template:
+    metadata:
+      labels: {app: web}
+`,
+		want: `
+apiVersion: apps/v1
+kind: Deployment
+metadata: {name: web}
+spec:
+  selector:
+    matchLabels: {app: web}
+  template:
+    metadata:
+      labels: {app: web}
+  strategy:
+    type: RollingUpdate
+    rollingUpdate: {maxSurge: 1, maxUnavailable: 1}
+  revisionHistoryLimit: 2147483647
+  progressDeadlineSeconds: 2147483647
+`,
+	}, {
+		name: "deployment with some defaults",
+		in: `
+apiVersion: extensions/v1beta1
+kind: Deployment
+metadata: {name: web}
+spec:
+  selector:
+    matchLabels: {app: web}
+  strategy:
+    rollingUpdate: {maxSurge: 25%}
+  revisionHistoryLimit: 5
+`,
+		want: `
+apiVersion: apps/v1
+kind: Deployment
+metadata: {name: web}
+spec:
+  selector:
+    matchLabels: {app: web}
+  strategy:
+    type: RollingUpdate
+    rollingUpdate: {maxSurge: 25%, maxUnavailable: 1}
+  revisionHistoryLimit: 5
+  progressDeadlineSeconds: 2147483647
+`,
+	}, {
+		name: "deployment recreated",
+		in: `
+apiVersion: extensions/v1beta1
+kind: Deployment
+metadata: {name: web}
+spec:
+  selector:
+    matchLabels: {app: web}
+  strategy: {type: Recreate}
+  progressDeadlineSeconds: 60
+`,
+		want: `
+apiVersion: apps/v1
+kind: Deployment
+metadata: {name: web}
+spec:
+  selector:
+    matchLabels: {app: web}
+  strategy: {type: Recreate}
+  revisionHistoryLimit: 2147483647
+  progressDeadlineSeconds: 60
+`,
+	}, {
+		name: "apps deployment",
+		in: `
+apiVersion: apps/v1beta1
+kind: Deployment
+metadata: {name: web}
+spec:
+  template:
+    metadata:
+      labels: {app: web}
+`,
+		want: `
+apiVersion: apps/v1
+kind: Deployment
+metadata: {name: web}
+spec:
+  selector:
+    matchLabels: {app: web}
+  template:
+    metadata:
+      labels: {app: web}
+  revisionHistoryLimit: 2
+`,
+	}, {
+		name: "apps deployment with history",
+		in: `
+apiVersion: apps/v1beta1
+kind: Deployment
+metadata: {name: web}
+spec:
+  selector:
+    matchLabels: {app: web}
+  revisionHistoryLimit: 10
+`,
+		want: `
+apiVersion: apps/v1
+kind: Deployment
+metadata: {name: web}
+spec:
+  selector:
+    matchLabels: {app: web}
+  revisionHistoryLimit: 10
+`,
+	}, {
+		name: "daemon set",
+		in: `
+apiVersion: extensions/v1beta1
+kind: DaemonSet
+metadata: {name: agent}
+spec:
+  templateGeneration: 3
+  selector:
+    matchLabels: {app: agent}
+`,
+		want: `
+apiVersion: apps/v1
+kind: DaemonSet
+metadata: {name: agent}
+spec:
+  selector:
+    matchLabels: {app: agent}
+  updateStrategy: {type: OnDelete}
+`,
+	}, {
+		name: "ingress",
+		in: `
+apiVersion: extensions/v1beta1
+kind: Ingress
+metadata: {name: web}
+spec:
+  backend: {serviceName: default, servicePort: http}
+  rules:
+  - host: example.com
+    http:
+      paths:
+      - path: /
+        backend: {serviceName: web, servicePort: 80}
+      - path: /static
+        pathType: Prefix
+        backend:
+          resource: {apiGroup: example.com, kind: Bucket, name: static}
+`,
+		want: `
+apiVersion: networking.k8s.io/v1
+kind: Ingress
+metadata: {name: web}
+spec:
+  defaultBackend:
+    service: {name: default, port: {name: http}}
+  rules:
+  - host: example.com
+    http:
+      paths:
+      - path: /
+        pathType: ImplementationSpecific
+        backend:
+          service: {name: web, port: {number: 80}}
+      - path: /static
+        pathType: Prefix
+        backend:
+          resource: {apiGroup: example.com, kind: Bucket, name: static}
+`,
+	}, {
+		name: "horizontal pod autoscaler",
+		in: `
+apiVersion: autoscaling/v2beta1
+kind: HorizontalPodAutoscaler
+metadata: {name: web}
+spec:
+  maxReplicas: 10
+  metrics:
+  - type: Resource
+    resource: {name: cpu, targetAverageUtilization: 80}
+  - type: Pods
+    pods: {metricName: requests, targetAverageValue: 100}
+  - type: Object
+    object:
+      target: {apiVersion: networking.k8s.io/v1, kind: Ingress, name: web}
+      metricName: hits
+      targetValue: 2k
+  - type: External
+    external:
+      metricName: queue_length
+      metricSelector:
+        matchLabels: {queue: jobs}
+      targetAverageValue: 30
+`,
+		want: `
+apiVersion: autoscaling/v2
+kind: HorizontalPodAutoscaler
+metadata: {name: web}
+spec:
+  maxReplicas: 10
+  metrics:
+  - type: Resource
+    resource:
+      name: cpu
+      target: {type: Utilization, averageUtilization: 80}
+  - type: Pods
+    pods:
+      metric: {name: requests}
+      target: {type: AverageValue, averageValue: 100}
+  - type: Object
+    object:
+      describedObject: {apiVersion: networking.k8s.io/v1, kind: Ingress, name: web}
+      metric: {name: hits}
+      target: {type: Value, value: 2k}
+  - type: External
+    external:
+      metric:
+        name: queue_length
+        selector:
+          matchLabels: {queue: jobs}
+      target: {type: AverageValue, averageValue: 30}
+`,
+	}, {
+		name: "endpoint slice",
+		in: `
+apiVersion: discovery.k8s.io/v1beta1
+kind: EndpointSlice
+metadata: {name: web}
+addressType: IPv4
+endpoints:
+- addresses: [10.0.0.1]
+  topology:
+    kubernetes.io/hostname: node-1
+    topology.kubernetes.io/zone: zone-a
+    example.com/rack: r1
+`,
+		want: `
+apiVersion: discovery.k8s.io/v1
+kind: EndpointSlice
+metadata: {name: web}
+addressType: IPv4
+endpoints:
+- addresses: [10.0.0.1]
+  nodeName: node-1
+  zone: zone-a
+  deprecatedTopology:
+    example.com/rack: r1
+`,
+	}, {
+		name: "webhooks",
+		in: `
+apiVersion: admissionregistration.k8s.io/v1beta1
+kind: ValidatingWebhookConfiguration
+metadata: {name: policy}
+webhooks:
+- name: policy.example.com
+  sideEffects: None
+  failurePolicy: Fail
+`,
+		want: `
+apiVersion: admissionregistration.k8s.io/v1
+kind: ValidatingWebhookConfiguration
+metadata: {name: policy}
+webhooks:
+- name: policy.example.com
+  sideEffects: None
+  failurePolicy: Fail
+  matchPolicy: Exact
+  timeoutSeconds: 30
+  admissionReviewVersions: [v1beta1]
+`,
+	}} {
+		t.Run(c.name, func(t *testing.T) {
+			got, diags := UpgradeAPIVersion(decode(t, c.in), Options{})
+			if len(diags) != 1 || diags[0].Summary != "Upgraded API version" {
+				t.Fatalf("got diagnostics %v", diags)
+			}
+			want := decode(t, c.want)
+			if got.TypeKey != want.TypeKey {
+				t.Errorf("got %v, want %v", got.TypeKey, want.TypeKey)
+			}
+			if diff := cmp.Diff(want.Raw, got.Raw); diff != "" {
+				t.Errorf("(-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpgradeFailures(t *testing.T) {
+	for _, in := range []string{
+		"apiVersion: apps/v1beta1\nkind: Deployment\nmetadata: {name: web}\nspec:\n  replicas: 1\n",
+		"apiVersion: admissionregistration.k8s.io/v1beta1\nkind: MutatingWebhookConfiguration\nmetadata: {name: inject}\nwebhooks:\n- name: inject.example.com\n",
+		"apiVersion: autoscaling/v2beta1\nkind: HorizontalPodAutoscaler\nmetadata: {name: web}\nspec:\n  metrics:\n  - type: Pods\n    pods: {metricName: requests}\n",
+	} {
+		r := decode(t, in)
+		got, diags := UpgradeAPIVersion(r, Options{})
+		if got.APIVersion != r.APIVersion || len(diags) != 1 || diags[0].Summary != "Removed API version" {
+			t.Errorf("UpgradeAPIVersion(%q): got %s, %v", in, got.APIVersion, diags)
+		}
+	}
+}
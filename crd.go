@@ -0,0 +1,62 @@
+package ktf
+
+import "slices"
+
+// crdType is a type of custom resource defined by a CustomResourceDefinition.
+type crdType struct {
+	group, kind string
+	versions    []string
+}
+
+func isCRD(o *object) bool {
+	group, _ := o.GroupVersion()
+	return group == "apiextensions.k8s.io" && o.Kind == "CustomResourceDefinition"
+}
+
+// definedType pulls the type a CRD defines out of its spec. It handles both
+// apiextensions.k8s.io/v1 (spec.versions) and the older v1beta1
+// (spec.version).
+func definedType(o *object) (crdType, bool) {
+	spec, _ := o.Raw["spec"].(map[string]any)
+	names, _ := spec["names"].(map[string]any)
+	t := crdType{}
+	t.group, _ = spec["group"].(string)
+	t.kind, _ = names["kind"].(string)
+	if v, ok := spec["version"].(string); ok {
+		t.versions = append(t.versions, v)
+	}
+	versions, _ := spec["versions"].([]any)
+	for _, v := range versions {
+		version, _ := v.(map[string]any)
+		if name, ok := version["name"].(string); ok {
+			t.versions = append(t.versions, name)
+		}
+	}
+	return t, t.group != "" && t.kind != ""
+}
+
+// addCRDDependencies makes every instance of a custom resource depend on the
+// CustomResourceDefinition for it, if it's in objs.
+func addCRDDependencies(objs []*object) {
+	var (
+		crds  []*object
+		types []crdType
+	)
+	for _, o := range objs {
+		if !isCRD(o) {
+			continue
+		}
+		if t, ok := definedType(o); ok {
+			crds = append(crds, o)
+			types = append(types, t)
+		}
+	}
+	for _, o := range objs {
+		group, version := o.GroupVersion()
+		for i, t := range types {
+			if t.group == group && t.kind == o.Kind && slices.Contains(t.versions, version) {
+				o.addDependency(crds[i])
+			}
+		}
+	}
+}
@@ -0,0 +1,231 @@
+package ktf
+
+import (
+	"container/heap"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclsyntax"
+	"github.com/hashicorp/hcl/v2/hclwrite"
+)
+
+// objectKey identifies an object by the things other objects refer to it by.
+type objectKey struct {
+	group, kind, namespace, name string
+}
+
+func keyOf(o *object) objectKey {
+	group, _ := o.GroupVersion()
+	return objectKey{group: group, kind: o.Kind, namespace: o.Metadata.Namespace, name: o.Metadata.Name}
+}
+
+// addDependencies adds the well-known relationships between objects:
+// namespaced objects depend on their Namespace, RoleBindings on their Roles
+// and ServiceAccounts, webhook configurations on their Services and anything
+// running pods or claiming volumes on its PriorityClass and StorageClass, and
+// helm hooks run before or after everything else. Only objects in objs are
+// considered, and edges which are already implied by a reference in the
+// object's attributes are skipped.
+func addDependencies(objs []*object) {
+	byKey := make(map[objectKey]*object, len(objs))
+	for _, o := range objs {
+		// The first block of each resource is the main one.
+		if _, ok := byKey[keyOf(o)]; !ok {
+			byKey[keyOf(o)] = o
+		}
+	}
+	for _, o := range objs {
+		for _, k := range related(o) {
+			dep, ok := byKey[k]
+			if !ok || dep == o || references(o.block, dep) {
+				continue
+			}
+			o.addDependency(dep)
+		}
+	}
+	addHookDependencies(objs)
+}
+
+// related returns the keys of the objects that o needs to exist first.
+func related(o *object) []objectKey {
+	var keys []objectKey
+	ns := o.Metadata.Namespace
+	if ns != "" {
+		keys = append(keys, objectKey{kind: "Namespace", name: ns})
+	}
+	group, _ := o.GroupVersion()
+	spec, _ := o.Raw["spec"].(map[string]any)
+	switch {
+	case group == "rbac.authorization.k8s.io" && (o.Kind == "RoleBinding" || o.Kind == "ClusterRoleBinding"):
+		if ref, ok := o.Raw["roleRef"].(map[string]any); ok {
+			kind, _ := ref["kind"].(string)
+			name, _ := ref["name"].(string)
+			k := objectKey{group: group, kind: kind, name: name}
+			if kind == "Role" {
+				k.namespace = ns
+			}
+			keys = append(keys, k)
+		}
+		for _, s := range objects(o.Raw["subjects"]) {
+			if kind, _ := s["kind"].(string); kind != "ServiceAccount" {
+				continue
+			}
+			name, _ := s["name"].(string)
+			namespace, _ := s["namespace"].(string)
+			if namespace == "" {
+				namespace = ns
+			}
+			keys = append(keys, objectKey{kind: "ServiceAccount", namespace: namespace, name: name})
+		}
+	case group == "admissionregistration.k8s.io" && (o.Kind == "MutatingWebhookConfiguration" || o.Kind == "ValidatingWebhookConfiguration"):
+		for _, w := range objects(o.Raw["webhooks"]) {
+			cc, _ := w["clientConfig"].(map[string]any)
+			svc, _ := cc["service"].(map[string]any)
+			name, _ := svc["name"].(string)
+			namespace, _ := svc["namespace"].(string)
+			if name != "" {
+				keys = append(keys, objectKey{kind: "Service", namespace: namespace, name: name})
+			}
+		}
+	case group == "" && o.Kind == "PersistentVolumeClaim":
+		keys = append(keys, storageClass(spec)...)
+	}
+	if podSpec := podSpec(o); podSpec != nil {
+		if pc, _ := podSpec["priorityClassName"].(string); pc != "" {
+			keys = append(keys, objectKey{group: "scheduling.k8s.io", kind: "PriorityClass", name: pc})
+		}
+	}
+	for _, t := range objects(spec["volumeClaimTemplates"]) {
+		s, _ := t["spec"].(map[string]any)
+		keys = append(keys, storageClass(s)...)
+	}
+	return keys
+}
+
+// podSpec finds the pod spec of a Pod or of anything with a pod template.
+func podSpec(o *object) map[string]any {
+	spec, _ := o.Raw["spec"].(map[string]any)
+	if o.Kind == "Pod" {
+		return spec
+	}
+	if jt, ok := spec["jobTemplate"].(map[string]any); ok {
+		spec, _ = jt["spec"].(map[string]any)
+	}
+	template, _ := spec["template"].(map[string]any)
+	s, _ := template["spec"].(map[string]any)
+	return s
+}
+
+func storageClass(pvcSpec map[string]any) []objectKey {
+	if sc, _ := pvcSpec["storageClassName"].(string); sc != "" {
+		return []objectKey{{group: "storage.k8s.io", kind: "StorageClass", name: sc}}
+	}
+	return nil
+}
+
+// objects returns the elements of a list which are objects.
+func objects(v any) []map[string]any {
+	l, _ := v.([]any)
+	var out []map[string]any
+	for _, e := range l {
+		if m, ok := e.(map[string]any); ok {
+			out = append(out, m)
+		}
+	}
+	return out
+}
+
+// references reports whether anything in the block refers to dep's address,
+// in which case terraform already knows about the dependency.
+func references(block *hclwrite.Block, dep *object) bool {
+	var names []string
+	for _, step := range dep.traversal() {
+		switch step := step.(type) {
+		case hcl.TraverseRoot:
+			names = append(names, step.Name)
+		case hcl.TraverseAttr:
+			names = append(names, step.Name)
+		}
+	}
+	toks := block.Body().BuildTokens(nil)
+outer:
+	for i := 0; i+2*len(names)-1 <= len(toks); i++ {
+		// Make sure it's not the tail of some other traversal.
+		if i > 0 && toks[i-1].Type == hclsyntax.TokenDot {
+			continue
+		}
+		for j, name := range names {
+			t := toks[i+2*j]
+			if t.Type != hclsyntax.TokenIdent || string(t.Bytes) != name {
+				continue outer
+			}
+			if j > 0 && toks[i+2*j-1].Type != hclsyntax.TokenDot {
+				continue outer
+			}
+		}
+		return true
+	}
+	return false
+}
+
+// sortObjects orders objs so that everything comes after its dependencies,
+// otherwise keeping the original order: whenever several objects are ready,
+// the first of them in objs goes next. Anything left in a cycle stays in its
+// original order at the end.
+func sortObjects(objs []*object) []*object {
+	var (
+		sorted = make([]*object, 0, len(objs))
+		index  = make(map[*object]int, len(objs))
+		// waiting counts the dependencies of each object which haven't
+		// been placed yet.
+		waiting    = make([]int, len(objs))
+		dependents = make([][]int, len(objs))
+		ready      indexHeap
+	)
+	for i, o := range objs {
+		index[o] = i
+	}
+	for i, o := range objs {
+		for _, d := range o.dependsOn {
+			if j, ok := index[d]; ok {
+				waiting[i]++
+				dependents[j] = append(dependents[j], i)
+			}
+		}
+		if waiting[i] == 0 {
+			ready = append(ready, i)
+		}
+	}
+	heap.Init(&ready)
+	for ready.Len() > 0 {
+		i := heap.Pop(&ready).(int)
+		sorted = append(sorted, objs[i])
+		for _, j := range dependents[i] {
+			if waiting[j]--; waiting[j] == 0 {
+				heap.Push(&ready, j)
+			}
+		}
+	}
+	if len(sorted) < len(objs) {
+		for i, o := range objs {
+			if waiting[i] > 0 {
+				sorted = append(sorted, o)
+			}
+		}
+	}
+	return sorted
+}
+
+// indexHeap is a min-heap of indexes into the objects being sorted.
+type indexHeap []int
+
+func (h indexHeap) Len() int           { return len(h) }
+func (h indexHeap) Less(i, j int) bool { return h[i] < h[j] }
+func (h indexHeap) Swap(i, j int)      { h[i], h[j] = h[j], h[i] }
+func (h *indexHeap) Push(x any)        { *h = append(*h, x.(int)) }
+
+func (h *indexHeap) Pop() any {
+	old := *h
+	x := old[len(old)-1]
+	*h = old[:len(old)-1]
+	return x
+}
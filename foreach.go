@@ -0,0 +1,389 @@
+package ktf
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclsyntax"
+	"github.com/hashicorp/hcl/v2/hclwrite"
+	"github.com/zclconf/go-cty/cty"
+
+	"github.com/pfcm/ktf/resource"
+)
+
+// local is a named value for the locals block.
+type local struct {
+	name   string
+	tokens hclwrite.Tokens
+}
+
+// unit is a piece of a block's body which is compared as a whole: a single
+// literal value (a quoted string, heredoc, number or keyword) or any other
+// token.
+type unit struct {
+	tokens hclwrite.Tokens
+	// literal is set for values, but not keys, that could be replaced with
+	// a reference.
+	literal bool
+	// name is the key or block the value belongs to, for naming it.
+	name string
+	path []string
+}
+
+func (u unit) String() string {
+	return text(u.tokens)
+}
+
+// units splits tokens into units, working out the names of the literals as
+// it goes.
+func units(tokens hclwrite.Tokens) []unit {
+	var (
+		out   []unit
+		stack []string // the enclosing blocks, objects and lists.
+		key   string   // the most recent key or block name.
+	)
+	for i := 0; i < len(tokens); i++ {
+		t := tokens[i]
+		u := unit{tokens: tokens[i : i+1]}
+		switch t.Type {
+		case hclsyntax.TokenOQuote, hclsyntax.TokenOHeredoc:
+			end := hclsyntax.TokenCQuote
+			if t.Type == hclsyntax.TokenOHeredoc {
+				end = hclsyntax.TokenCHeredoc
+			}
+			j := i
+			for j < len(tokens) && tokens[j].Type != end {
+				j++
+			}
+			u.tokens = tokens[i : j+1]
+			i = j
+			u.literal = true
+		case hclsyntax.TokenNumberLit:
+			u.literal = true
+		case hclsyntax.TokenIdent:
+			switch string(t.Bytes) {
+			case "true", "false", "null":
+				u.literal = true
+			}
+		case hclsyntax.TokenOBrace, hclsyntax.TokenOBrack:
+			stack = append(stack, key)
+		case hclsyntax.TokenCBrace, hclsyntax.TokenCBrack:
+			if len(stack) > 0 {
+				key = stack[len(stack)-1]
+				stack = stack[:len(stack)-1]
+			}
+		}
+		next := hclsyntax.TokenNil
+		if i+1 < len(tokens) {
+			next = tokens[i+1].Type
+		}
+		if next == hclsyntax.TokenEqual || next == hclsyntax.TokenOBrace && t.Type == hclsyntax.TokenIdent {
+			// It's a key or a block name rather than a value.
+			key = strings.Trim(u.String(), `"`)
+			u.literal = false
+		}
+		if u.literal {
+			u.name = key
+			u.path = append([]string(nil), stack...)
+		}
+		out = append(out, u)
+	}
+	return out
+}
+
+// shape is the structure of a block with the literal values left out, so
+// that blocks with the same shape differ only in their literals.
+func shape(block *hclwrite.Block, us []unit) string {
+	var b strings.Builder
+	b.WriteString(block.Labels()[0])
+	for _, u := range us {
+		b.WriteByte(0)
+		if u.literal {
+			continue
+		}
+		b.WriteString(u.String())
+	}
+	return b.String()
+}
+
+// maxForEachFields is the most literal values, including the name, that
+// resources can differ in and still be collapsed by collapseForEach. Past
+// that, the local holding the differences would be harder to follow than the
+// resources themselves.
+const maxForEachFields = 4
+
+// collapseForEach replaces groups of resources of the same type which only
+// differ in a few literal values (at most maxForEachFields) with a single
+// resource using for_each over a local map, holding the differences. It
+// returns the new objects and the locals they need.
+func collapseForEach(objs []*object) ([]*object, []local) {
+	var (
+		shapes  = make(map[string][]*object)
+		order   []string
+		unitsOf = make(map[*object][]unit)
+	)
+	for _, o := range objs {
+		if o.block.Type() != "resource" {
+			// Only resources, with their two labels, are collapsed.
+			continue
+		}
+		us := units(o.block.Body().BuildTokens(nil))
+		unitsOf[o] = us
+		s := shape(o.block, us)
+		if _, ok := shapes[s]; !ok {
+			order = append(order, s)
+		}
+		shapes[s] = append(shapes[s], o)
+	}
+
+	var (
+		locals   []local
+		replaced = make(map[*object]*object)
+		names    = make(map[string]bool)
+	)
+	for _, o := range objs {
+		names[o.traversal().RootName()+"."+o.name()] = true
+	}
+	for _, s := range order {
+		group := shapes[s]
+		if len(group) < 2 || !independent(group) {
+			continue
+		}
+		fields := differences(group, unitsOf)
+		if len(fields) > maxForEachFields {
+			continue
+		}
+		g, l := collapse(group, unitsOf, fields, names)
+		locals = append(locals, l)
+		for _, o := range group {
+			replaced[o] = g
+		}
+	}
+	if len(replaced) == 0 {
+		return objs, nil
+	}
+
+	var out []*object
+	for _, o := range objs {
+		if g, ok := replaced[o]; ok {
+			if !slices.Contains(out, g) {
+				out = append(out, g)
+			}
+			continue
+		}
+		out = append(out, o)
+	}
+	// Point all of the dependencies at the new resources.
+	for _, o := range out {
+		var deps []*object
+		for _, d := range o.dependsOn {
+			if g, ok := replaced[d]; ok {
+				d = g
+			}
+			if d != o && !slices.Contains(deps, d) {
+				deps = append(deps, d)
+			}
+		}
+		o.dependsOn = deps
+	}
+	return sortObjects(out), locals
+}
+
+// independent reports whether none of the objects in the group depend on
+// each other, even indirectly, which would be a cycle once they are
+// collapsed.
+func independent(group []*object) bool {
+	in := make(map[*object]bool, len(group))
+	for _, o := range group {
+		in[o] = true
+	}
+	seen := make(map[*object]bool)
+	var visit func(o *object) bool
+	visit = func(o *object) bool {
+		for _, d := range o.dependsOn {
+			if in[d] {
+				return false
+			}
+			if seen[d] {
+				continue
+			}
+			seen[d] = true
+			if !visit(d) {
+				return false
+			}
+		}
+		return true
+	}
+	for _, o := range group {
+		clear(seen)
+		if !visit(o) {
+			return false
+		}
+	}
+	return true
+}
+
+// differences names the literal values which aren't the same throughout a
+// group of objects with the same shape, by their index in its units.
+func differences(group []*object, unitsOf map[*object][]unit) map[int]string {
+	var (
+		fields = make(map[int]string)
+		used   = make(map[string]bool)
+	)
+	for i, u := range unitsOf[group[0]] {
+		if !u.literal {
+			continue
+		}
+		for _, o := range group[1:] {
+			if unitsOf[o][i].String() != u.String() {
+				fields[i] = fieldName(u, used)
+				break
+			}
+		}
+	}
+	return fields
+}
+
+// collapse turns a group of objects with the same shape into a single object
+// using for_each over the fields from differences, and the local it iterates
+// over.
+func collapse(group []*object, unitsOf map[*object][]unit, fields map[int]string, names map[string]bool) (*object, local) {
+	first := unitsOf[group[0]]
+
+	typ := group[0].block.Labels()[0]
+	name := groupName(group)
+	for n := 2; names[typ+"."+name]; n++ {
+		name = fmt.Sprintf("%s_%d", groupName(group), n)
+	}
+	names[typ+"."+name] = true
+	localName := strings.TrimPrefix(typ, "kubernetes_") + "_" + name
+	for n := 2; names["local."+localName]; n++ {
+		localName = fmt.Sprintf("%s_%s_%d", strings.TrimPrefix(typ, "kubernetes_"), name, n)
+	}
+	names["local."+localName] = true
+
+	// The local is a map from each object's name to its fields.
+	elems := make([]hclwrite.ObjectAttrTokens, len(group))
+	for i, o := range group {
+		var attrs []hclwrite.ObjectAttrTokens
+		for j := range first {
+			if field, ok := fields[j]; ok {
+				attrs = append(attrs, hclwrite.ObjectAttrTokens{
+					Name:  hclwrite.TokensForIdentifier(field),
+					Value: unitsOf[o][j].tokens,
+				})
+			}
+		}
+		elems[i] = hclwrite.ObjectAttrTokens{
+			Name:  keyTokens(o.block.Labels()[1]),
+			Value: hclwrite.TokensForObject(attrs),
+		}
+	}
+
+	var body hclwrite.Tokens
+	for i, u := range first {
+		if field, ok := fields[i]; ok {
+			body = append(body, hclwrite.TokensForTraversal(hcl.Traversal{
+				hcl.TraverseRoot{Name: "each"},
+				hcl.TraverseAttr{Name: "value"},
+				hcl.TraverseAttr{Name: field},
+			})...)
+			continue
+		}
+		body = append(body, u.tokens...)
+	}
+	b := hclwrite.NewBlock("resource", []string{typ, name})
+	b.Body().SetAttributeTraversal("for_each", hcl.Traversal{
+		hcl.TraverseRoot{Name: "local"},
+		hcl.TraverseAttr{Name: localName},
+	})
+	b.Body().AppendNewline()
+	b.Body().AppendUnstructuredTokens(body)
+
+	g := &object{Resource: group[0].Resource, block: b, forEach: true}
+	for _, o := range group {
+		for _, d := range o.dependsOn {
+			g.addDependency(d)
+		}
+	}
+	return g, local{name: localName, tokens: hclwrite.TokensForObject(elems)}
+}
+
+// fieldName picks a name for the field of each.value holding u, which isn't
+// already used.
+func fieldName(u unit, used map[string]bool) string {
+	candidates := []string{
+		identifier(u.name),
+		identifier(strings.Join(append(u.path, u.name), "_")),
+	}
+	for _, c := range candidates {
+		if c != "" && !used[c] {
+			used[c] = true
+			return c
+		}
+	}
+	base := candidates[1]
+	if base == "" {
+		base = "value"
+	}
+	for n := 2; ; n++ {
+		if c := fmt.Sprintf("%s_%d", base, n); !used[c] {
+			used[c] = true
+			return c
+		}
+	}
+}
+
+// identifier turns s into a valid identifier, or returns "".
+func identifier(s string) string {
+	s = strings.Map(func(r rune) rune {
+		if r >= 'a' && r <= 'z' || r >= 'A' && r <= 'Z' || r >= '0' && r <= '9' || r == '_' {
+			return r
+		}
+		return '_'
+	}, resource.ToSnake(s))
+	s = strings.Trim(s, "_")
+	if !hclsyntax.ValidIdentifier(s) {
+		return ""
+	}
+	return s
+}
+
+// groupName names the for_each resource after whatever its members' names
+// have in common, or "this".
+func groupName(group []*object) string {
+	prefix := group[0].block.Labels()[1]
+	for _, o := range group[1:] {
+		name := o.block.Labels()[1]
+		for !strings.HasPrefix(name, prefix) {
+			prefix = prefix[:len(prefix)-1]
+		}
+	}
+	if name := identifier(prefix); name != "" {
+		return name
+	}
+	return "this"
+}
+
+// keyTokens are the tokens for an object key, quoted unless it's a valid
+// identifier.
+func keyTokens(k string) hclwrite.Tokens {
+	if hclsyntax.ValidIdentifier(k) {
+		return hclwrite.TokensForIdentifier(k)
+	}
+	return hclwrite.TokensForValue(cty.StringVal(k))
+}
+
+// writeLocals adds a locals block to body, if there are any.
+func writeLocals(body *hclwrite.Body, locals []local) {
+	if len(locals) == 0 {
+		return
+	}
+	separate(body)
+	b := body.AppendNewBlock("locals", nil).Body()
+	for _, l := range locals {
+		b.SetAttributeRaw(l.name, l.tokens)
+	}
+}
@@ -0,0 +1,357 @@
+package ktf
+
+import (
+	"fmt"
+	"path"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclsyntax"
+	"github.com/hashicorp/hcl/v2/hclwrite"
+)
+
+// hoistedMaps are the attributes holding maps that tend to be repeated all
+// over the place, like the app.kubernetes.io labels on every object in a helm
+// chart, and the name of the local to put them in. Selectors go with the
+// labels, as they are usually a subset of them.
+var hoistedMaps = map[string]string{
+	"labels":       "common_labels",
+	"match_labels": "common_labels",
+	"matchLabels":  "common_labels",
+	"annotations":  "common_annotations",
+}
+
+// hoistedStrings are the attributes holding strings that are worth pulling
+// out when repeated.
+var hoistedStrings = []string{"image"}
+
+// entry is one key and (literal) value of a map.
+type entry struct {
+	key, value hclwrite.Tokens
+}
+
+// id is what the entry is compared by. Keys are the same whether or not they
+// are quoted.
+func (e entry) id() string {
+	return strings.Trim(text(e.key), `"`) + "\x00" + text(e.value)
+}
+
+// hoist finds maps and strings that are repeated across the objects, defines
+// them in locals and replaces the copies with references. Maps which share
+// some entries become merge(local.common_labels, {...}).
+func hoist(objs []*object, names map[string]bool) []local {
+	var (
+		maps    = make(map[string][][]entry) // by local
+		strs    = make(map[string]int)
+		strToks = make(map[string]hclwrite.Tokens)
+		strList []string
+	)
+	collect := func(key string, value hclwrite.Tokens) hclwrite.Tokens {
+		switch {
+		case hoistedMaps[key] != "":
+			if entries, ok := mapEntries(value); ok && len(entries) > 0 {
+				maps[hoistedMaps[key]] = append(maps[hoistedMaps[key]], entries)
+			}
+		case slices.Contains(hoistedStrings, key) && isQuoted(value):
+			s := text(value)
+			if strs[s] == 0 {
+				strList = append(strList, s)
+				strToks[s] = value
+			}
+			strs[s]++
+		}
+		return nil
+	}
+	for _, o := range objs {
+		rewriteBody(o.block.Body(), collect)
+	}
+
+	var (
+		locals  []local
+		commons = make(map[string][][]entry) // the common entries by local
+		refs    = make(map[string][]string)  // and the locals holding them
+		strRefs = make(map[string]string)
+	)
+	for _, base := range []string{"common_labels", "common_annotations"} {
+		remaining := maps[base]
+		for {
+			common, covered := commonEntries(remaining)
+			if common == nil {
+				break
+			}
+			name := uniqueName(base, names)
+			attrs := make([]hclwrite.ObjectAttrTokens, len(common))
+			for i, e := range common {
+				attrs[i] = hclwrite.ObjectAttrTokens{Name: e.key, Value: e.value}
+			}
+			locals = append(locals, local{name: name, tokens: hclwrite.TokensForObject(attrs)})
+			commons[base] = append(commons[base], common)
+			refs[base] = append(refs[base], name)
+			var rest [][]entry
+			for i, m := range remaining {
+				if !covered[i] {
+					rest = append(rest, m)
+				}
+			}
+			remaining = rest
+		}
+	}
+	for _, s := range strList {
+		if strs[s] < 2 {
+			continue
+		}
+		name := uniqueName(imageName(s), names)
+		locals = append(locals, local{name: name, tokens: strToks[s]})
+		strRefs[s] = name
+	}
+	if len(locals) == 0 {
+		return nil
+	}
+
+	replace := func(key string, value hclwrite.Tokens) hclwrite.Tokens {
+		switch {
+		case hoistedMaps[key] != "":
+			entries, ok := mapEntries(value)
+			if !ok {
+				return nil
+			}
+			base := hoistedMaps[key]
+			for i, common := range commons[base] {
+				if rest, ok := without(entries, common); ok {
+					return mergeTokens(refs[base][i], rest)
+				}
+			}
+		case slices.Contains(hoistedStrings, key) && isQuoted(value):
+			if name, ok := strRefs[text(value)]; ok {
+				return localTokens(name)
+			}
+		}
+		return nil
+	}
+	for _, o := range objs {
+		rewriteBody(o.block.Body(), replace)
+	}
+	return locals
+}
+
+// commonEntries finds the set of entries, shared by at least two of the maps,
+// that saves the most repetition, along with the indices of the maps
+// containing it. It returns nil if there's nothing worth sharing.
+func commonEntries(maps [][]entry) ([]entry, map[int]bool) {
+	counts := make(map[string]int)
+	for _, m := range maps {
+		for _, e := range m {
+			counts[e.id()]++
+		}
+	}
+	var (
+		best       []entry
+		bestCover  map[int]bool
+		bestSaving int
+	)
+	for _, m := range maps {
+		// Each candidate is one of the maps, without anything that
+		// isn't repeated.
+		candidate := slices.DeleteFunc(slices.Clone(m), func(e entry) bool { return counts[e.id()] < 2 })
+		if len(candidate) == 0 {
+			continue
+		}
+		cover := make(map[int]bool)
+		for i, other := range maps {
+			if _, ok := without(other, candidate); ok {
+				cover[i] = true
+			}
+		}
+		if saving := (len(cover) - 1) * len(candidate); len(cover) > 1 && saving > bestSaving {
+			best, bestCover, bestSaving = candidate, cover, saving
+		}
+	}
+	return best, bestCover
+}
+
+// without returns the entries of m which aren't in common, if m contains all
+// of common.
+func without(m, common []entry) ([]entry, bool) {
+	ids := make(map[string]bool, len(m))
+	for _, e := range m {
+		ids[e.id()] = true
+	}
+	for _, e := range common {
+		if !ids[e.id()] {
+			return nil, false
+		}
+	}
+	return slices.DeleteFunc(slices.Clone(m), func(e entry) bool {
+		return slices.ContainsFunc(common, func(c entry) bool { return c.id() == e.id() })
+	}), true
+}
+
+func mergeTokens(name string, rest []entry) hclwrite.Tokens {
+	if len(rest) == 0 {
+		return localTokens(name)
+	}
+	attrs := make([]hclwrite.ObjectAttrTokens, len(rest))
+	for i, e := range rest {
+		attrs[i] = hclwrite.ObjectAttrTokens{Name: e.key, Value: e.value}
+	}
+	return hclwrite.TokensForFunctionCall("merge", localTokens(name), hclwrite.TokensForObject(attrs))
+}
+
+func localTokens(name string) hclwrite.Tokens {
+	return hclwrite.TokensForTraversal(hcl.Traversal{
+		hcl.TraverseRoot{Name: "local"},
+		hcl.TraverseAttr{Name: name},
+	})
+}
+
+// imageName names the local for an image after its repository, eg.
+// "docker.io/library/nginx:1.25" is nginx_image.
+func imageName(quoted string) string {
+	s := strings.Trim(quoted, `"`)
+	s, _, _ = strings.Cut(s, "@")
+	s = path.Base(s)
+	if i := strings.LastIndex(s, ":"); i > 0 {
+		s = s[:i]
+	}
+	if id := identifier(s); id != "" {
+		return id + "_image"
+	}
+	return "image"
+}
+
+// uniqueName returns name, or name with a number on the end if it's already
+// taken, and marks it as used.
+func uniqueName(name string, names map[string]bool) string {
+	n := name
+	for i := 2; names["local."+n]; i++ {
+		n = fmt.Sprintf("%s_%d", name, i)
+	}
+	names["local."+n] = true
+	return n
+}
+
+// text is the source of the tokens, without any spacing.
+func text(toks hclwrite.Tokens) string {
+	var b strings.Builder
+	for _, t := range toks {
+		b.Write(t.Bytes)
+	}
+	return b.String()
+}
+
+func isQuoted(toks hclwrite.Tokens) bool {
+	return len(toks) >= 2 && toks[0].Type == hclsyntax.TokenOQuote && toks[len(toks)-1].Type == hclsyntax.TokenCQuote
+}
+
+// mapEntries parses an object constructor where every value is a quoted
+// string.
+func mapEntries(toks hclwrite.Tokens) ([]entry, bool) {
+	if len(toks) < 2 || toks[0].Type != hclsyntax.TokenOBrace || toks[len(toks)-1].Type != hclsyntax.TokenCBrace {
+		return nil, false
+	}
+	var (
+		entries []entry
+		inner   = toks[1 : len(toks)-1]
+	)
+	for i := 0; i < len(inner); i++ {
+		switch inner[i].Type {
+		case hclsyntax.TokenNewline, hclsyntax.TokenComma:
+			continue
+		}
+		k, ok := keyAt(inner, i)
+		if !ok {
+			return nil, false
+		}
+		end := valueEnd(inner, k+1)
+		if value := inner[k+1 : end]; isQuoted(value) {
+			entries = append(entries, entry{key: inner[i:k], value: value})
+		} else {
+			return nil, false
+		}
+		i = end - 1
+	}
+	return entries, true
+}
+
+// rewriteBody calls f with every attribute in body, its nested blocks and
+// any objects in their values, replacing the value with whatever f returns
+// unless it's nil.
+func rewriteBody(body *hclwrite.Body, f func(key string, value hclwrite.Tokens) hclwrite.Tokens) {
+	for name, attr := range body.Attributes() {
+		toks := attr.Expr().BuildTokens(nil)
+		if repl := f(name, toks); repl != nil {
+			body.SetAttributeRaw(name, repl)
+		} else if repl, changed := rewriteTokens(toks, f); changed {
+			body.SetAttributeRaw(name, repl)
+		}
+	}
+	for _, b := range body.Blocks() {
+		rewriteBody(b.Body(), f)
+	}
+}
+
+// rewriteTokens is rewriteBody for the keys and values within an expression.
+func rewriteTokens(toks hclwrite.Tokens, f func(key string, value hclwrite.Tokens) hclwrite.Tokens) (hclwrite.Tokens, bool) {
+	var (
+		out     hclwrite.Tokens
+		changed bool
+	)
+	for i := 0; i < len(toks); i++ {
+		if k, ok := keyAt(toks, i); ok {
+			end := valueEnd(toks, k+1)
+			key := strings.Trim(text(toks[i:k]), `"`)
+			if repl := f(key, toks[k+1:end]); repl != nil {
+				out = append(out, toks[i:k+1]...)
+				out = append(out, repl...)
+				i = end - 1
+				changed = true
+				continue
+			}
+		}
+		out = append(out, toks[i])
+	}
+	return out, changed
+}
+
+// keyAt checks whether the tokens at i are an object key, either an
+// identifier or a quoted string, returning the index of the following =.
+func keyAt(toks hclwrite.Tokens, i int) (int, bool) {
+	j := i
+	switch toks[i].Type {
+	case hclsyntax.TokenIdent:
+	case hclsyntax.TokenOQuote:
+		for j < len(toks) && toks[j].Type != hclsyntax.TokenCQuote {
+			j++
+		}
+	default:
+		return 0, false
+	}
+	if j+1 < len(toks) && toks[j+1].Type == hclsyntax.TokenEqual {
+		return j + 1, true
+	}
+	return 0, false
+}
+
+// valueEnd returns the index just after the expression starting at i.
+func valueEnd(toks hclwrite.Tokens, i int) int {
+	depth := 0
+	for j := i; j < len(toks); j++ {
+		switch toks[j].Type {
+		case hclsyntax.TokenOBrace, hclsyntax.TokenOBrack, hclsyntax.TokenOParen,
+			hclsyntax.TokenOQuote, hclsyntax.TokenOHeredoc:
+			depth++
+		case hclsyntax.TokenCBrace, hclsyntax.TokenCBrack, hclsyntax.TokenCParen,
+			hclsyntax.TokenCQuote, hclsyntax.TokenCHeredoc:
+			if depth == 0 {
+				return j
+			}
+			depth--
+		case hclsyntax.TokenNewline, hclsyntax.TokenComma:
+			if depth == 0 {
+				return j
+			}
+		}
+	}
+	return len(toks)
+}
@@ -0,0 +1,104 @@
+package ktf
+
+import (
+	"slices"
+	"strconv"
+	"strings"
+)
+
+// hookPhase is when a helm hook runs relative to the rest of a release.
+type hookPhase int
+
+const (
+	notHook hookPhase = iota
+	preHook
+	postHook
+)
+
+// hook works out whether o is a helm hook, from its helm.sh/hook annotation,
+// and its weight. Hooks for things other than install and upgrade aren't
+// hooks as far as terraform is concerned.
+func hook(o *object) (hookPhase, int) {
+	annotations, _ := o.Metadata.Meta["annotations"].(map[string]any)
+	events, _ := annotations["helm.sh/hook"].(string)
+	phase := notHook
+	for _, e := range strings.Split(events, ",") {
+		switch strings.TrimSpace(e) {
+		case "pre-install", "pre-upgrade":
+			phase = preHook
+		case "post-install", "post-upgrade":
+			if phase == notHook {
+				phase = postHook
+			}
+		}
+	}
+	s, _ := annotations["helm.sh/hook-weight"].(string)
+	weight, _ := strconv.Atoi(strings.TrimSpace(s))
+	return phase, weight
+}
+
+// addHookDependencies orders helm hooks like helm would: the hooks of each
+// phase run one after the other in order of weight, everything else depends
+// on the last pre-install hook and the first post-install hook depends on
+// everything else. Chaining them like that keeps the number of depends_on
+// entries linear in the number of objects. Edges which would make a cycle,
+// like a pre-install hook's Namespace depending on it, are left out.
+func addHookDependencies(objs []*object) {
+	var (
+		weights   = make(map[*object]int, len(objs))
+		pre, post []*object
+		others    []*object
+	)
+	for _, o := range objs {
+		p, w := hook(o)
+		weights[o] = w
+		switch p {
+		case preHook:
+			pre = append(pre, o)
+		case postHook:
+			post = append(post, o)
+		default:
+			others = append(others, o)
+		}
+	}
+	if len(pre) == 0 && len(post) == 0 {
+		return
+	}
+	add := func(o, dep *object) {
+		if !reaches(dep, o) {
+			o.addDependency(dep)
+		}
+	}
+	byWeight := func(a, b *object) int { return weights[a] - weights[b] }
+	for _, hooks := range [][]*object{pre, post} {
+		slices.SortStableFunc(hooks, byWeight)
+		for i := 1; i < len(hooks); i++ {
+			add(hooks[i], hooks[i-1])
+		}
+	}
+	for _, o := range others {
+		if len(pre) > 0 {
+			add(o, pre[len(pre)-1])
+		}
+		if len(post) > 0 {
+			add(post[0], o)
+		}
+	}
+}
+
+// reaches reports whether from depends on to, directly or indirectly.
+func reaches(from, to *object) bool {
+	seen := make(map[*object]bool)
+	var visit func(o *object) bool
+	visit = func(o *object) bool {
+		if o == to {
+			return true
+		}
+		if seen[o] {
+			return false
+		}
+		seen[o] = true
+		return slices.ContainsFunc(o.dependsOn, visit)
+	}
+	return visit(from)
+}
@@ -0,0 +1,100 @@
+package input
+
+import (
+	"bytes"
+	"fmt"
+	"slices"
+	"strings"
+
+	"helm.sh/helm/v3/pkg/action"
+	"helm.sh/helm/v3/pkg/chart/loader"
+	"helm.sh/helm/v3/pkg/cli/values"
+	"helm.sh/helm/v3/pkg/getter"
+	"helm.sh/helm/v3/pkg/release"
+)
+
+// HelmOptions configure Helm. The zero value is the default.
+type HelmOptions struct {
+	// ReleaseName and Namespace are what the chart sees as .Release.Name
+	// and .Release.Namespace. They default to "release" and "default".
+	ReleaseName string
+	Namespace   string
+	// ValuesFiles are merged in order over the chart's own values, like
+	// helm's -f.
+	ValuesFiles []string
+}
+
+// HelmOutput is a rendered chart.
+type HelmOutput struct {
+	// Manifests holds the ordinary resources, including any CRDs, as yaml.
+	Manifests []byte
+	// Hooks holds the resources with helm.sh/hook annotations that run on
+	// install or upgrade, in the order helm would run them. They keep their
+	// annotations, so they can be ordered around everything else.
+	Hooks []byte
+	// Skipped lists the hooks that only run at other times (on delete,
+	// rollback or for helm test), which have no equivalent in terraform.
+	Skipped []string
+}
+
+// installEvents are the hook events that have something to do with creating
+// or updating a release.
+var installEvents = []release.HookEvent{
+	release.HookPreInstall,
+	release.HookPostInstall,
+	release.HookPreUpgrade,
+	release.HookPostUpgrade,
+}
+
+// Helm renders the chart at path, either a directory or a packaged .tgz, like
+// `helm template`. Nothing is fetched: any dependencies must already be in
+// the chart's charts directory, and values files must be local.
+func Helm(path string, opts HelmOptions) (*HelmOutput, error) {
+	chart, err := loader.Load(path)
+	if err != nil {
+		return nil, err
+	}
+	if err := action.CheckDependencies(chart, chart.Metadata.Dependencies); err != nil {
+		return nil, err
+	}
+	vals, err := (&values.Options{ValueFiles: opts.ValuesFiles}).MergeValues(getter.Providers{})
+	if err != nil {
+		return nil, err
+	}
+
+	install := action.NewInstall(&action.Configuration{})
+	install.DryRun = true
+	install.ClientOnly = true
+	install.Replace = true
+	install.IncludeCRDs = true
+	install.ReleaseName = opts.ReleaseName
+	if install.ReleaseName == "" {
+		install.ReleaseName = "release"
+	}
+	install.Namespace = opts.Namespace
+	if install.Namespace == "" {
+		install.Namespace = "default"
+	}
+	rel, err := install.Run(chart, vals)
+	if err != nil {
+		return nil, err
+	}
+
+	out := &HelmOutput{Manifests: []byte(rel.Manifest)}
+	hooks := slices.Clone(rel.Hooks)
+	slices.SortStableFunc(hooks, func(a, b *release.Hook) int { return a.Weight - b.Weight })
+	var buf bytes.Buffer
+	for _, h := range hooks {
+		if !slices.ContainsFunc(h.Events, func(e release.HookEvent) bool { return slices.Contains(installEvents, e) }) {
+			events := make([]string, len(h.Events))
+			for i, e := range h.Events {
+				events[i] = e.String()
+			}
+			out.Skipped = append(out.Skipped, fmt.Sprintf("%s/%s (%s)", h.Kind, h.Name, strings.Join(events, ",")))
+			continue
+		}
+		fmt.Fprintf(&buf, "---\n# Source: %s\n%s\n", h.Path, h.Manifest)
+	}
+	out.Hooks = buf.Bytes()
+	return out, nil
+}
@@ -0,0 +1,89 @@
+package input
+
+import (
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestHelm(t *testing.T) {
+	dir := t.TempDir()
+	writeFiles(t, dir, map[string]string{
+		"chart/Chart.yaml": `
+apiVersion: v2
+name: web
+version: 0.1.0
+`,
+		"chart/values.yaml": `
+port: 80
+`,
+		"chart/templates/service.yaml": `
+apiVersion: v1
+kind: Service
+metadata:
+  name: {{ .Release.Name }}-web
+  namespace: {{ .Release.Namespace }}
+spec:
+  ports:
+  - port: {{ .Values.port }}
+`,
+		"chart/templates/hooks.yaml": `
+apiVersion: v1
+kind: ServiceAccount
+metadata:
+  name: second
+  annotations:
+    helm.sh/hook: pre-install
+    helm.sh/hook-weight: "2"
+---
+apiVersion: v1
+kind: ServiceAccount
+metadata:
+  name: first
+  annotations:
+    helm.sh/hook: pre-install,pre-upgrade
+    helm.sh/hook-weight: "1"
+---
+apiVersion: v1
+kind: Pod
+metadata:
+  name: test
+  annotations:
+    helm.sh/hook: test
+spec:
+  containers:
+  - name: test
+    image: busybox
+`,
+		"values.yaml": `
+port: 8080
+`,
+	})
+
+	out, err := Helm(filepath.Join(dir, "chart"), HelmOptions{
+		ReleaseName: "prod",
+		Namespace:   "web",
+		ValuesFiles: []string{filepath.Join(dir, "values.yaml")},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	manifests := string(out.Manifests)
+	for _, want := range []string{"name: prod-web", "namespace: web", "port: 8080"} {
+		if !strings.Contains(manifests, want) {
+			t.Errorf("manifests don't contain %q:\n%s", want, manifests)
+		}
+	}
+	if strings.Contains(manifests, "helm.sh/hook") {
+		t.Errorf("manifests contain hooks:\n%s", manifests)
+	}
+	hooks := string(out.Hooks)
+	if first, second := strings.Index(hooks, "name: first"), strings.Index(hooks, "name: second"); first < 0 || second < first {
+		t.Errorf("hooks aren't in order of weight:\n%s", hooks)
+	}
+	if diff := cmp.Diff([]string{"Pod/test (test)"}, out.Skipped); diff != "" {
+		t.Errorf("unexpected skipped hooks (-want +got):\n%s", diff)
+	}
+}
@@ -0,0 +1,219 @@
+// package input reads kubernetes resources from things other than plain yaml
+// files, so they can be passed on to ktf.Convert.
+package input
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+	"sigs.k8s.io/kustomize/api/krusty"
+	"sigs.k8s.io/kustomize/api/resource"
+	"sigs.k8s.io/kustomize/api/types"
+	"sigs.k8s.io/kustomize/kyaml/filesys"
+)
+
+// KustomizeOptions configure Kustomize. The zero value is the default.
+type KustomizeOptions struct {
+	// Origins, if set, gets a line for every resource saying which file in
+	// the overlay it came from, or which generator made it. That's
+	// recorded with config.kubernetes.io/origin annotations, as if the
+	// kustomization had buildMetadata: [originAnnotations], which are
+	// removed afterwards so they don't end up in the cluster.
+	Origins io.Writer
+}
+
+// kustomizationFiles are the names kustomize looks for in a directory.
+var kustomizationFiles = []string{"kustomization.yaml", "kustomization.yml", "Kustomization"}
+
+// Kustomize builds the kustomization in dir, like `kustomize build`, and
+// returns the resulting resources as yaml. Everything has to be local: remote
+// bases, resources, files and charts are an error rather than something to
+// download.
+func Kustomize(dir string, opts KustomizeOptions) ([]byte, error) {
+	root, err := filepath.Abs(dir)
+	if err != nil {
+		return nil, err
+	}
+	if err := checkLocal(root, make(map[string]bool)); err != nil {
+		return nil, err
+	}
+	var fs filesys.FileSystem = filesys.MakeFsOnDisk()
+	if opts.Origins != nil {
+		fs = originsFS{FileSystem: fs, root: root}
+	}
+	k := krusty.MakeKustomizer(krusty.MakeDefaultOptions())
+	m, err := k.Run(fs, root)
+	if err != nil {
+		return nil, err
+	}
+	if opts.Origins != nil {
+		for _, r := range m.Resources() {
+			origin, err := r.GetOrigin()
+			if err != nil {
+				return nil, err
+			}
+			name := r.GetName()
+			if ns := r.GetNamespace(); ns != "" {
+				name = ns + "/" + name
+			}
+			fmt.Fprintf(opts.Origins, "%s %s: %s\n", r.GetKind(), name, describeOrigin(origin))
+		}
+		if err := m.RemoveOriginAnnotations(); err != nil {
+			return nil, err
+		}
+	}
+	return m.AsYaml()
+}
+
+// describeOrigin says where a resource came from, for KustomizeOptions.Origins.
+func describeOrigin(o *resource.Origin) string {
+	switch {
+	case o == nil:
+		return "unknown origin"
+	case o.ConfiguredIn != "":
+		return fmt.Sprintf("generated by %s %s in %s", o.ConfiguredBy.Kind, o.ConfiguredBy.Name, o.ConfiguredIn)
+	}
+	return o.Path
+}
+
+// findKustomization returns the path of the kustomization file in dir.
+func findKustomization(dir string) (string, error) {
+	for _, name := range kustomizationFiles {
+		path := filepath.Join(dir, name)
+		if _, err := os.Stat(path); err == nil {
+			return path, nil
+		}
+	}
+	return "", fmt.Errorf("%s: no kustomization file", dir)
+}
+
+// checkLocal makes sure that everything the kustomization in dir refers to,
+// recursively, exists locally, so the build won't go looking for it anywhere
+// else.
+func checkLocal(dir string, seen map[string]bool) error {
+	if seen[dir] {
+		return nil
+	}
+	seen[dir] = true
+	path, err := findKustomization(dir)
+	if err != nil {
+		return err
+	}
+	raw, err := os.ReadFile(path)
+	if err != nil {
+		return err
+	}
+	var k types.Kustomization
+	if err := yaml.Unmarshal(raw, &k); err != nil {
+		return fmt.Errorf("%s: %w", path, err)
+	}
+	for _, c := range k.HelmCharts {
+		if c.Repo != "" {
+			return fmt.Errorf("%s: helm chart %q comes from %s (remote charts aren't supported)", path, c.Name, c.Repo)
+		}
+	}
+	for _, c := range k.HelmChartInflationGenerator {
+		if c.ChartRepoURL != "" {
+			return fmt.Errorf("%s: helm chart %q comes from %s (remote charts aren't supported)", path, c.ChartName, c.ChartRepoURL)
+		}
+	}
+	for _, ref := range localRefs(&k) {
+		if strings.Contains(ref, "\n") {
+			// An inline patch or plugin config, rather than a path.
+			continue
+		}
+		target := ref
+		if !filepath.IsAbs(ref) {
+			target = filepath.Join(dir, ref)
+		}
+		info, err := os.Stat(target)
+		if err != nil {
+			return fmt.Errorf("%s: %q is not a local file or directory (remote resources aren't supported)", path, ref)
+		}
+		if info.IsDir() {
+			if err := checkLocal(target, seen); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// localRefs returns the paths of every file and directory k refers to.
+func localRefs(k *types.Kustomization) []string {
+	refs := slices.Concat(k.Resources, k.Bases, k.Components, k.Generators, k.Transformers, k.Validators, k.Crds, k.Configurations)
+	for _, p := range k.PatchesStrategicMerge {
+		refs = append(refs, string(p))
+	}
+	for _, p := range slices.Concat(k.Patches, k.PatchesJson6902) {
+		if p.Path != "" {
+			refs = append(refs, p.Path)
+		}
+	}
+	for _, r := range k.Replacements {
+		if r.Path != "" {
+			refs = append(refs, r.Path)
+		}
+	}
+	if p := k.OpenAPI["path"]; p != "" {
+		refs = append(refs, p)
+	}
+	var sources []types.KvPairSources
+	for _, g := range k.ConfigMapGenerator {
+		sources = append(sources, g.KvPairSources)
+	}
+	for _, g := range k.SecretGenerator {
+		sources = append(sources, g.KvPairSources)
+	}
+	for _, s := range sources {
+		for _, f := range s.FileSources {
+			// Either a path, or key=path.
+			if _, p, ok := strings.Cut(f, "="); ok {
+				f = p
+			}
+			refs = append(refs, f)
+		}
+		refs = append(refs, s.EnvSources...)
+		if s.EnvSource != "" {
+			refs = append(refs, s.EnvSource)
+		}
+	}
+	for _, c := range k.HelmCharts {
+		if c.ValuesFile != "" {
+			refs = append(refs, c.ValuesFile)
+		}
+		refs = append(refs, c.AdditionalValuesFiles...)
+	}
+	return refs
+}
+
+// originsFS turns on origin annotations for the kustomization in root.
+type originsFS struct {
+	filesys.FileSystem
+	root string
+}
+
+func (fs originsFS) ReadFile(path string) ([]byte, error) {
+	raw, err := fs.FileSystem.ReadFile(path)
+	if err != nil || !slices.Contains(kustomizationFiles, filepath.Base(path)) || filepath.Dir(path) != fs.root {
+		return raw, err
+	}
+	var k map[string]any
+	if err := yaml.Unmarshal(raw, &k); err != nil {
+		// Leave it for kustomize to complain about.
+		return raw, nil
+	}
+	if k == nil {
+		k = make(map[string]any)
+	}
+	metadata, _ := k["buildMetadata"].([]any)
+	if !slices.Contains(metadata, any(types.OriginAnnotations)) {
+		k["buildMetadata"] = append(metadata, types.OriginAnnotations)
+	}
+	return yaml.Marshal(k)
+}
@@ -0,0 +1,119 @@
+package input
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/pfcm/ktf"
+)
+
+// writeFiles writes files, by path relative to dir.
+func writeFiles(t *testing.T, dir string, files map[string]string) {
+	t.Helper()
+	for name, content := range files {
+		path := filepath.Join(dir, name)
+		if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(path, []byte(content), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+}
+
+func TestKustomize(t *testing.T) {
+	dir := t.TempDir()
+	writeFiles(t, dir, map[string]string{
+		"base/kustomization.yaml": `
+resources:
+- service.yaml
+`,
+		"base/service.yaml": `
+apiVersion: v1
+kind: Service
+metadata:
+  name: web
+spec:
+  ports:
+  - port: 80
+`,
+		"overlay/kustomization.yaml": `
+namePrefix: prod-
+namespace: prod
+resources:
+- ../base
+`,
+	})
+
+	for _, origins := range []bool{false, true} {
+		var opts KustomizeOptions
+		var report strings.Builder
+		if origins {
+			opts.Origins = &report
+		}
+		out, err := Kustomize(filepath.Join(dir, "overlay"), opts)
+		if err != nil {
+			t.Fatalf("Kustomize(%+v): %v", opts, err)
+		}
+		var tf strings.Builder
+		if err := ktf.Convert(strings.NewReader(string(out)), &tf); err != nil {
+			t.Fatalf("Convert(%q): %v", out, err)
+		}
+		for _, want := range []string{`resource "kubernetes_service_v1" "prod_web"`, `namespace = "prod"`} {
+			if !strings.Contains(tf.String(), want) {
+				t.Errorf("Kustomize(%+v): output doesn't contain %q:\n%s", opts, want, tf.String())
+			}
+		}
+		// Only reported, never applied.
+		if strings.Contains(tf.String(), "config.kubernetes.io/origin") {
+			t.Errorf("Kustomize(%+v): origin annotation present:\n%s", opts, tf.String())
+		}
+		if want := "Service prod/prod-web: ../base/service.yaml\n"; origins && report.String() != want {
+			t.Errorf("Kustomize(%+v): got origins %q, want %q", opts, report.String(), want)
+		}
+	}
+}
+
+// TestKustomizeAbsolute checks that absolute references are looked for where
+// they are, not under the kustomization.
+func TestKustomizeAbsolute(t *testing.T) {
+	dir := t.TempDir()
+	writeFiles(t, dir, map[string]string{
+		"service.yaml":       "apiVersion: v1\nkind: Service\nmetadata:\n  name: web\n",
+		"kustomization.yaml": "resources:\n- " + filepath.Join(dir, "service.yaml") + "\n",
+	})
+	out, err := Kustomize(dir, KustomizeOptions{})
+	if err != nil {
+		t.Fatalf("Kustomize: %v", err)
+	}
+	if !strings.Contains(string(out), "name: web") {
+		t.Errorf("Kustomize: output doesn't contain the Service:\n%s", out)
+	}
+}
+
+func TestKustomizeRemote(t *testing.T) {
+	for name, kustomization := range map[string]string{
+		"resource":     "resources:\n- https://github.com/kubernetes-sigs/kustomize//examples/multibases?ref=v1.0.6\n",
+		"generator":    "generators:\n- https://example.com/generator.yaml\n",
+		"transformer":  "transformers:\n- https://example.com/transformer.yaml\n",
+		"patch":        "patches:\n- path: https://example.com/patch.yaml\n",
+		"merge patch":  "patchesStrategicMerge:\n- https://example.com/patch.yaml\n",
+		"config map":   "configMapGenerator:\n- name: settings\n  files:\n  - settings=https://example.com/settings.json\n",
+		"secret env":   "secretGenerator:\n- name: creds\n  envs:\n  - https://example.com/creds.env\n",
+		"helm chart":   "helmCharts:\n- name: redis\n  repo: https://charts.example.com\n",
+		"helm values":  "helmCharts:\n- name: redis\n  valuesFile: https://example.com/values.yaml\n",
+		"replacements": "replacements:\n- path: https://example.com/replacements.yaml\n",
+	} {
+		dir := t.TempDir()
+		writeFiles(t, dir, map[string]string{
+			"base/kustomization.yaml":    kustomization,
+			"overlay/kustomization.yaml": "resources:\n- ../base\n",
+		})
+		_, err := Kustomize(filepath.Join(dir, "overlay"), KustomizeOptions{})
+		if err == nil || !strings.Contains(err.Error(), "aren't supported") {
+			t.Errorf("%s: got error %v, want one about remote files", name, err)
+		}
+	}
+}
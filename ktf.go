@@ -6,7 +6,10 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	"slices"
 
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclsyntax"
 	"github.com/hashicorp/hcl/v2/hclwrite"
 	"k8s.io/apimachinery/pkg/util/yaml"
 
@@ -14,36 +17,277 @@ import (
 	"github.com/pfcm/ktf/resource"
 )
 
+// Options configure Convert. The zero value is the default.
+type Options struct {
+	// Convert holds the options for converting each individual resource.
+	Convert convert.Options
+
+	// CRDOut, if set, receives the resources for any
+	// CustomResourceDefinitions instead of the main output. kubernetes_manifest
+	// needs a CRD to exist at plan time, so instances of a CRD can't be
+	// planned in the same run that creates it: CRDOut lets them be applied in
+	// a separate root module (or stage) first.
+	CRDOut io.Writer
+
+	// ForEach collapses resources of the same type which only differ in
+	// a few literal values, at most four including the name, into a single
+	// resource with a for_each over a map in locals.
+	ForEach bool
+	// Hoist moves labels, annotations and images that are repeated across
+	// resources into locals.
+	Hoist bool
+
+	// Warnings, if set, receives any warnings about the conversion.
+	Warnings io.Writer
+}
+
 // Convert attempts to read yaml from in and convert it to HCL terraform
 // resources, which will be written to out.
 func Convert(in io.Reader, out io.Writer) error {
+	return ConvertWithOptions(in, out, Options{})
+}
+
+// ConvertWithOptions is like Convert, but with the behaviour customised by
+// opts.
+func ConvertWithOptions(in io.Reader, out io.Writer, opts Options) error {
+	objs, err := readObjects(in, opts)
+	if err != nil {
+		return err
+	}
+	if opts.CRDOut != nil {
+		var crds []*object
+		crds, objs = partition(objs, isCRD)
+		addDependencies(crds)
+		f := hclwrite.NewEmptyFile()
+		writeObjects(f.Body(), crds, opts)
+		if err := writeFile(opts.CRDOut, withVariables(f, opts)); err != nil {
+			return err
+		}
+	} else {
+		addCRDDependencies(objs)
+	}
+	addDependencies(objs)
+
+	f := hclwrite.NewEmptyFile()
+	writeObjects(f.Body(), objs, opts)
+	return writeFile(out, withVariables(f, opts))
+}
+
+// withVariables returns f with blocks for the variables it refers to in
+// front, so each file written declares its own.
+func withVariables(f *hclwrite.File, opts Options) *hclwrite.File {
+	tokens := f.Body().BuildTokens(nil)
+	out := hclwrite.NewEmptyFile()
+	writeVariables(out.Body(), opts, usedVariables(tokens))
+	if len(tokens) > 0 {
+		separate(out.Body())
+		out.Body().AppendUnstructuredTokens(tokens)
+	}
+	return out
+}
+
+// usedVariables returns the names of the variables referred to in tokens.
+func usedVariables(tokens hclwrite.Tokens) map[string]bool {
+	used := make(map[string]bool)
+	for i := 0; i+2 < len(tokens); i++ {
+		if tokens[i].Type == hclsyntax.TokenIdent && string(tokens[i].Bytes) == "var" &&
+			tokens[i+1].Type == hclsyntax.TokenDot && tokens[i+2].Type == hclsyntax.TokenIdent {
+			used[string(tokens[i+2].Bytes)] = true
+		}
+	}
+	return used
+}
+
+// ConvertHelmRelease writes a helm_release resource for rel to out, for
+// installing a chart as it is instead of converting what it renders, along
+// with the variables for its sensitive values.
+func ConvertHelmRelease(rel convert.HelmRelease, out io.Writer, opts Options) error {
+	block, vars, err := convert.ConvertHelmRelease(rel, opts.Convert)
+	if err != nil {
+		return fmt.Errorf("converting helm release %s: %w", rel.Name, err)
+	}
+	f := hclwrite.NewEmptyFile()
+	for _, v := range vars {
+		separate(f.Body())
+		f.Body().AppendBlock(v.Block())
+	}
+	separate(f.Body())
+	f.Body().AppendBlock(block)
+	return writeFile(out, f)
+}
+
+// readObjects reads and converts every resource in in.
+func readObjects(in io.Reader, opts Options) ([]*object, error) {
 	var (
-		d = yaml.NewYAMLOrJSONDecoder(in, 4*1024)
-		f = hclwrite.NewEmptyFile()
-		b = f.Body()
+		d    = yaml.NewYAMLOrJSONDecoder(in, 4*1024)
+		objs []*object
 	)
 	for {
 		r := resource.New()
 		if err := d.Decode(&r); errors.Is(err, io.EOF) {
 			break
 		} else if err != nil {
-			return err
+			return nil, err
 		}
 		if r.IsEmpty() {
 			continue
 		}
-		block, err := convert.Convert(r)
-		if err != nil {
-			return fmt.Errorf("converting resource %+v/%v: %w", r.TypeKey, r.Metadata.Name, err)
+		var diags hcl.Diagnostics
+		if opts.Convert.UpgradeAPIVersions {
+			// Before converting, so the dependencies and outputs see the
+			// new version too. If it can't be upgraded, ConvertBlocks
+			// checks it again and has the same warning.
+			if up, ds := convert.UpgradeAPIVersion(r, opts.Convert); up.APIVersion != r.APIVersion {
+				r, diags = up, ds
+			}
+		}
+		blocks, ds := convert.ConvertBlocks(r, opts.Convert)
+		diags = append(diags, ds...)
+		if err := convert.DiagsError(diags); err != nil {
+			return nil, fmt.Errorf("converting resource %+v/%v: %w", r.TypeKey, r.Metadata.Name, err)
+		}
+		warn(opts, r, diags)
+		for _, block := range blocks {
+			objs = append(objs, &object{Resource: r, block: block})
+		}
+	}
+	return objs, nil
+}
+
+// warn writes any warnings about r to opts.Warnings.
+func warn(opts Options, r resource.Resource, diags hcl.Diagnostics) {
+	if opts.Warnings == nil {
+		return
+	}
+	for _, d := range diags {
+		if d.Severity == hcl.DiagWarning {
+			fmt.Fprintf(opts.Warnings, "warning: %s %s: %s: %s\n", r.Kind, r.Metadata.Name, d.Summary, d.Detail)
+		}
+	}
+}
+
+// object is a resource that has been converted, along with everything we've
+// worked out about how it relates to the others.
+type object struct {
+	resource.Resource
+	block *hclwrite.Block
+	// forEach is set if the block is several objects collapsed into one.
+	forEach bool
+
+	dependsOn []*object
+}
+
+// traversal returns the reference to the terraform resource, eg.
+// kubernetes_manifest.thing, or the data source or module call.
+func (o *object) traversal() hcl.Traversal {
+	labels := o.block.Labels()
+	switch o.block.Type() {
+	case "module":
+		return hcl.Traversal{
+			hcl.TraverseRoot{Name: "module"},
+			hcl.TraverseAttr{Name: labels[0]},
+		}
+	case "data":
+		return hcl.Traversal{
+			hcl.TraverseRoot{Name: "data"},
+			hcl.TraverseAttr{Name: labels[0]},
+			hcl.TraverseAttr{Name: labels[1]},
+		}
+	}
+	return hcl.Traversal{
+		hcl.TraverseRoot{Name: labels[0]},
+		hcl.TraverseAttr{Name: labels[1]},
+	}
+}
+
+// name is the last label of the object's block, the name it has in
+// terraform.
+func (o *object) name() string {
+	labels := o.block.Labels()
+	return labels[len(labels)-1]
+}
+
+func (o *object) addDependency(dep *object) {
+	if slices.Contains(o.dependsOn, dep) {
+		return
+	}
+	o.dependsOn = append(o.dependsOn, dep)
+}
+
+// writeObjects adds the objects' blocks, with their depends_on, to body in
+// dependency order, after any locals they need. It returns the objects that
+// were actually written, which might have been collapsed with for_each.
+func writeObjects(body *hclwrite.Body, objs []*object, opts Options) []*object {
+	var locals []local
+	objs = sortObjects(objs)
+	if opts.ForEach {
+		objs, locals = collapseForEach(objs)
+	}
+	if opts.Hoist {
+		names := make(map[string]bool)
+		for _, l := range locals {
+			names["local."+l.name] = true
+		}
+		locals = append(hoist(objs, names), locals...)
+	}
+	writeLocals(body, locals)
+	if len(objs) > 0 {
+		separate(body)
+	}
+	for _, o := range objs {
+		if len(o.dependsOn) > 0 {
+			deps := make([]hclwrite.Tokens, len(o.dependsOn))
+			for i, d := range o.dependsOn {
+				deps[i] = hclwrite.TokensForTraversal(d.traversal())
+			}
+			o.block.Body().SetAttributeRaw("depends_on", hclwrite.TokensForTuple(deps))
 		}
-		b.AppendBlock(block)
+		body.AppendBlock(o.block)
+	}
+	return objs
+}
+
+// writeVariables adds blocks for the variables the parameter rules
+// introduced, or only those in used if it isn't nil.
+func writeVariables(body *hclwrite.Body, opts Options, used map[string]bool) {
+	if opts.Convert.Parameters == nil {
+		return
+	}
+	for _, v := range opts.Convert.Parameters.Variables {
+		if used != nil && !used[v.Name] {
+			continue
+		}
+		separate(body)
+		body.AppendBlock(v.Block())
+	}
+}
+
+// separate adds a blank line before the next block, unless it's the first
+// thing in body.
+func separate(body *hclwrite.Body) {
+	if len(body.BuildTokens(nil)) > 0 {
+		body.AppendNewline()
 	}
+}
+
+// writeFile formats f and writes it to out.
+func writeFile(out io.Writer, f *hclwrite.File) error {
 	var buf bytes.Buffer
 	if _, err := f.WriteTo(&buf); err != nil {
 		return err
 	}
-	formatted := hclwrite.Format(buf.Bytes())
-
-	_, err := out.Write(formatted)
+	_, err := out.Write(hclwrite.Format(buf.Bytes()))
 	return err
 }
+
+// partition splits objs into those for which f returns true, and the rest.
+func partition(objs []*object, f func(*object) bool) (yes, no []*object) {
+	for _, o := range objs {
+		if f(o) {
+			yes = append(yes, o)
+		} else {
+			no = append(no, o)
+		}
+	}
+	return yes, no
+}
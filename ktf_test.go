@@ -2,12 +2,24 @@ package ktf
 
 import (
 	"bytes"
+	"fmt"
 	"io"
 	"os"
 	"path/filepath"
+	"slices"
+	"strings"
 	"testing"
 
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/hcl/v2"
 	"github.com/hashicorp/hcl/v2/hclparse"
+	"github.com/hashicorp/hcl/v2/hclsyntax"
+	"github.com/hashicorp/hcl/v2/hclwrite"
+	"github.com/zclconf/go-cty/cty"
+
+	"github.com/pfcm/ktf/convert"
+	"github.com/pfcm/ktf/convert/gen"
+	"github.com/pfcm/ktf/resource"
 )
 
 // TestConvert is a high-level test that just checks all of the testdata
@@ -57,3 +69,935 @@ func TestConvert(t *testing.T) {
 		testFile(t, "all-files", bytes.NewReader(b.Bytes()))
 	})
 }
+
+// dependencies parses the output of Convert and returns the depends_on of
+// each resource, keyed by its address.
+func dependencies(t *testing.T, src []byte) map[string][]string {
+	t.Helper()
+	f, diags := hclsyntax.ParseConfig(src, "test.tf", hcl.InitialPos)
+	if diags.HasErrors() {
+		t.Fatalf("parsing:\n%s\n%v", src, diags)
+	}
+	deps := make(map[string][]string)
+	for _, b := range f.Body.(*hclsyntax.Body).Blocks {
+		addr := strings.Join(b.Labels, ".")
+		deps[addr] = nil
+		attr, ok := b.Body.Attributes["depends_on"]
+		if !ok {
+			continue
+		}
+		for _, tr := range attr.Expr.Variables() {
+			deps[addr] = append(deps[addr], tr.RootName()+"."+tr[1].(hcl.TraverseAttr).Name)
+		}
+	}
+	return deps
+}
+
+func TestCRDDependencies(t *testing.T) {
+	raw, err := os.ReadFile("convert/testdata/crd.yaml")
+	if err != nil {
+		t.Fatal(err)
+	}
+	const (
+		crd    = "kubernetes_manifest.custom_resource_definition__widgets_example_com"
+		widget = "kubernetes_manifest.widget__test_widget"
+	)
+	// Another version of the same kind, which the CRD doesn't define.
+	other := "\n---\napiVersion: example.com/v2\nkind: Widget\nmetadata:\n  name: other\n"
+
+	t.Run("depends_on", func(t *testing.T) {
+		var out bytes.Buffer
+		if err := Convert(bytes.NewReader(append(raw, other...)), &out); err != nil {
+			t.Fatal(err)
+		}
+		want := map[string][]string{
+			crd:                                 nil,
+			widget:                              {crd},
+			"kubernetes_manifest.widget__other": nil,
+		}
+		if diff := cmp.Diff(want, dependencies(t, out.Bytes())); diff != "" {
+			t.Errorf("unexpected dependencies (-want +got):\n%s", diff)
+		}
+	})
+	t.Run("split", func(t *testing.T) {
+		var out, crds bytes.Buffer
+		if err := ConvertWithOptions(bytes.NewReader(raw), &out, Options{CRDOut: &crds}); err != nil {
+			t.Fatal(err)
+		}
+		if diff := cmp.Diff(map[string][]string{widget: nil}, dependencies(t, out.Bytes())); diff != "" {
+			t.Errorf("unexpected main output (-want +got):\n%s", diff)
+		}
+		if diff := cmp.Diff(map[string][]string{crd: nil}, dependencies(t, crds.Bytes())); diff != "" {
+			t.Errorf("unexpected CRD output (-want +got):\n%s", diff)
+		}
+	})
+	t.Run("split parameters", func(t *testing.T) {
+		params, err := convert.ReadParameters(strings.NewReader(`
+- kind: CustomResourceDefinition
+  path: spec.scope
+- kind: Widget
+  path: spec.colour
+`))
+		if err != nil {
+			t.Fatal(err)
+		}
+		var out, crds bytes.Buffer
+		opts := Options{CRDOut: &crds, Convert: convert.Options{Parameters: params}}
+		if err := ConvertWithOptions(bytes.NewReader(raw), &out, opts); err != nil {
+			t.Fatal(err)
+		}
+		// Each file declares the variables it uses, and only those.
+		for _, c := range []struct {
+			name string
+			src  []byte
+			want string
+		}{
+			{"main", out.Bytes(), "test_widget_colour"},
+			{"CRD", crds.Bytes(), "widgets_example_com_scope"},
+		} {
+			if diff := cmp.Diff([]string{c.want}, variables(t, c.src)); diff != "" {
+				t.Errorf("unexpected variables in %s output (-want +got):\n%s", c.name, diff)
+			}
+			if !strings.Contains(string(c.src), "var."+c.want) {
+				t.Errorf("%s output doesn't use var.%s:\n%s", c.name, c.want, c.src)
+			}
+		}
+	})
+}
+
+// variables returns the names of the variables declared in src.
+func variables(t *testing.T, src []byte) []string {
+	t.Helper()
+	f, diags := hclsyntax.ParseConfig(src, "test.tf", hcl.InitialPos)
+	if diags.HasErrors() {
+		t.Fatalf("parsing:\n%s\n%v", src, diags)
+	}
+	var names []string
+	for _, b := range f.Body.(*hclsyntax.Body).Blocks {
+		if b.Type == "variable" {
+			names = append(names, b.Labels[0])
+		}
+	}
+	return names
+}
+
+// addresses returns the addresses of the resources in src, in order.
+func addresses(t *testing.T, src []byte) []string {
+	t.Helper()
+	f, diags := hclsyntax.ParseConfig(src, "test.tf", hcl.InitialPos)
+	if diags.HasErrors() {
+		t.Fatalf("parsing:\n%s\n%v", src, diags)
+	}
+	var addrs []string
+	for _, b := range f.Body.(*hclsyntax.Body).Blocks {
+		if b.Type == "resource" {
+			addrs = append(addrs, strings.Join(b.Labels, "."))
+		}
+	}
+	return addrs
+}
+
+func TestDependencies(t *testing.T) {
+	const in = `
+apiVersion: rbac.authorization.k8s.io/v1
+kind: RoleBinding
+metadata:
+  name: reader
+  namespace: app
+roleRef:
+  apiGroup: rbac.authorization.k8s.io
+  kind: Role
+  name: reader
+subjects:
+- kind: ServiceAccount
+  name: app
+- kind: ServiceAccount
+  name: elsewhere
+  namespace: other
+---
+apiVersion: admissionregistration.k8s.io/v1
+kind: ValidatingWebhookConfiguration
+metadata:
+  name: check
+webhooks:
+- name: check.example.com
+  admissionReviewVersions: [v1]
+  sideEffects: None
+  clientConfig:
+    service:
+      name: webhook
+      namespace: app
+---
+apiVersion: apps/v1
+kind: StatefulSet
+metadata:
+  name: db
+  namespace: app
+spec:
+  serviceName: db
+  selector:
+    matchLabels: {app: db}
+  template:
+    metadata:
+      labels: {app: db}
+    spec:
+      priorityClassName: high
+      containers:
+      - name: db
+        image: postgres
+  volumeClaimTemplates:
+  - metadata:
+      name: data
+    spec:
+      storageClassName: fast
+      accessModes: [ReadWriteOnce]
+      resources:
+        requests:
+          storage: 1Gi
+---
+apiVersion: v1
+kind: Namespace
+metadata:
+  name: app
+---
+apiVersion: rbac.authorization.k8s.io/v1
+kind: Role
+metadata:
+  name: reader
+  namespace: app
+rules:
+- apiGroups: [""]
+  resources: [pods]
+  verbs: [get]
+---
+apiVersion: v1
+kind: ServiceAccount
+metadata:
+  name: app
+  namespace: app
+---
+apiVersion: v1
+kind: Service
+metadata:
+  name: webhook
+  namespace: app
+spec:
+  ports:
+  - port: 443
+---
+apiVersion: scheduling.k8s.io/v1
+kind: PriorityClass
+metadata:
+  name: high
+value: 1000
+---
+apiVersion: storage.k8s.io/v1
+kind: StorageClass
+metadata:
+  name: fast
+`
+	var out bytes.Buffer
+	if err := Convert(strings.NewReader(in), &out); err != nil {
+		t.Fatal(err)
+	}
+	const (
+		ns       = "kubernetes_namespace_v1.app"
+		role     = "kubernetes_role_v1.reader"
+		sa       = "kubernetes_service_account_v1.app"
+		svc      = "kubernetes_service_v1.webhook"
+		pc       = "kubernetes_priority_class_v1.high"
+		sc       = "kubernetes_storage_class_v1.fast"
+		binding  = "kubernetes_role_binding_v1.reader"
+		webhook  = "kubernetes_validating_webhook_configuration_v1.check"
+		stateful = "kubernetes_stateful_set_v1.db"
+	)
+	want := map[string][]string{
+		ns:       nil,
+		role:     {ns},
+		sa:       {ns},
+		svc:      {ns},
+		pc:       nil,
+		sc:       nil,
+		binding:  {ns, role, sa},
+		webhook:  {svc},
+		stateful: {ns, pc, sc},
+	}
+	if diff := cmp.Diff(want, dependencies(t, out.Bytes())); diff != "" {
+		t.Errorf("unexpected dependencies (-want +got):\n%s", diff)
+	}
+	// Everything comes after its dependencies but otherwise stays in the
+	// original order.
+	wantOrder := []string{ns, role, sa, binding, svc, webhook, pc, sc, stateful}
+	if diff := cmp.Diff(wantOrder, addresses(t, out.Bytes())); diff != "" {
+		t.Errorf("unexpected order (-want +got):\n%s", diff)
+	}
+}
+
+func TestSortObjects(t *testing.T) {
+	objs := make([]*object, 6)
+	for i := range objs {
+		objs[i] = &object{Resource: resource.New()}
+		objs[i].Metadata.Name = fmt.Sprint(i)
+	}
+	// 0 waits for 3, and 4 and 5 depend on each other.
+	objs[0].addDependency(objs[3])
+	objs[4].addDependency(objs[5])
+	objs[5].addDependency(objs[4])
+	var got []string
+	for _, o := range sortObjects(objs) {
+		got = append(got, o.Metadata.Name)
+	}
+	if want := []string{"1", "2", "3", "0", "4", "5"}; !slices.Equal(got, want) {
+		t.Errorf("got order %v, want %v", got, want)
+	}
+
+	// A long chain, backwards, as big charts can have.
+	chain := make([]*object, 10000)
+	for i := range chain {
+		chain[i] = &object{}
+		if i > 0 {
+			chain[i-1].addDependency(chain[i])
+		}
+	}
+	sorted := sortObjects(chain)
+	for i, o := range sorted {
+		if o != chain[len(chain)-1-i] {
+			t.Fatalf("object %d of the chain is out of order", i)
+		}
+	}
+}
+
+func TestReferences(t *testing.T) {
+	src := []byte(`
+resource "kubernetes_namespace_v1" "app" {}
+resource "kubernetes_service_account_v1" "app" {
+  metadata {
+    namespace = kubernetes_namespace_v1.app.metadata[0].name
+  }
+}
+resource "kubernetes_service_account_v1" "other" {
+  metadata {
+    namespace = var.kubernetes_namespace_v1.app
+  }
+}
+`)
+	f, diags := hclwrite.ParseConfig(src, "test.tf", hcl.InitialPos)
+	if diags.HasErrors() {
+		t.Fatal(diags)
+	}
+	blocks := f.Body().Blocks()
+	ns := &object{block: blocks[0]}
+	for i, want := range []bool{true, false} {
+		if got := references(blocks[i+1], ns); got != want {
+			t.Errorf("references(%v, %v) = %v, want %v", blocks[i+1].Labels(), ns.block.Labels(), got, want)
+		}
+	}
+}
+
+func TestHookDependencies(t *testing.T) {
+	const in = `
+apiVersion: v1
+kind: Namespace
+metadata:
+  name: app
+---
+apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: settings
+  namespace: app
+data:
+  a: b
+---
+apiVersion: v1
+kind: ServiceAccount
+metadata:
+  name: migrate
+  namespace: app
+  annotations:
+    helm.sh/hook: pre-install,pre-upgrade
+    helm.sh/hook-weight: "-5"
+---
+apiVersion: v1
+kind: Pod
+metadata:
+  name: migrate
+  namespace: app
+  annotations:
+    helm.sh/hook: pre-install
+spec:
+  containers:
+  - name: migrate
+    image: migrate
+---
+apiVersion: v1
+kind: Pod
+metadata:
+  name: smoke
+  namespace: app
+  annotations:
+    helm.sh/hook: post-install
+spec:
+  containers:
+  - name: smoke
+    image: smoke
+`
+	var out bytes.Buffer
+	if err := Convert(strings.NewReader(in), &out); err != nil {
+		t.Fatal(err)
+	}
+	const (
+		ns       = "kubernetes_namespace_v1.app"
+		settings = "kubernetes_config_map_v1.settings"
+		sa       = "kubernetes_service_account_v1.migrate"
+		migrate  = "kubernetes_pod_v1.migrate"
+		smoke    = "kubernetes_pod_v1.smoke"
+	)
+	want := map[string][]string{
+		// Not on the hooks, as they need the namespace first.
+		ns: nil,
+		// The service account through migrate, which runs after it.
+		settings: {ns, migrate},
+		sa:       {ns},
+		migrate:  {ns, sa},
+		smoke:    {ns, settings},
+	}
+	if diff := cmp.Diff(want, dependencies(t, out.Bytes())); diff != "" {
+		t.Errorf("unexpected dependencies (-want +got):\n%s", diff)
+	}
+	if diff := cmp.Diff([]string{ns, sa, migrate, settings, smoke}, addresses(t, out.Bytes())); diff != "" {
+		t.Errorf("unexpected order (-want +got):\n%s\n%s", diff, out.String())
+	}
+}
+
+func TestHookDependenciesChained(t *testing.T) {
+	var in strings.Builder
+	for i, w := range []string{"3", "1", "2"} {
+		fmt.Fprintf(&in, "---\napiVersion: v1\nkind: ConfigMap\nmetadata:\n  name: pre-%d\n  annotations: {helm.sh/hook: pre-install, helm.sh/hook-weight: %q}\n", i, w)
+		fmt.Fprintf(&in, "---\napiVersion: v1\nkind: ConfigMap\nmetadata:\n  name: post-%d\n  annotations: {helm.sh/hook: post-install, helm.sh/hook-weight: %q}\n", i, w)
+		fmt.Fprintf(&in, "---\napiVersion: v1\nkind: ConfigMap\nmetadata:\n  name: app-%d\n", i)
+	}
+	var out bytes.Buffer
+	if err := Convert(strings.NewReader(in.String()), &out); err != nil {
+		t.Fatal(err)
+	}
+	const cm = "kubernetes_config_map_v1."
+	// Each phase runs in order of weight, with the others between them.
+	want := map[string][]string{
+		cm + "pre_1":  nil,
+		cm + "pre_2":  {cm + "pre_1"},
+		cm + "pre_0":  {cm + "pre_2"},
+		cm + "app_0":  {cm + "pre_0"},
+		cm + "app_1":  {cm + "pre_0"},
+		cm + "app_2":  {cm + "pre_0"},
+		cm + "post_1": {cm + "app_0", cm + "app_1", cm + "app_2"},
+		cm + "post_2": {cm + "post_1"},
+		cm + "post_0": {cm + "post_2"},
+	}
+	if diff := cmp.Diff(want, dependencies(t, out.Bytes())); diff != "" {
+		t.Errorf("unexpected dependencies (-want +got):\n%s", diff)
+	}
+}
+
+func TestBackend(t *testing.T) {
+	reg := convert.NewRegistry()
+	reg.Register("example.com", "", "Database", 10, convert.BackendFunc(func(r resource.Resource, ctx convert.Context) ([]*hclwrite.Block, hcl.Diagnostics) {
+		b := hclwrite.NewBlock("module", []string{resource.ToSnake(r.Metadata.Name)})
+		b.Body().SetAttributeValue("source", cty.StringVal("./modules/database"))
+		b.Body().SetAttributeValue("namespace", cty.StringVal(r.Metadata.Namespace))
+		return []*hclwrite.Block{b}, hcl.Diagnostics{{Severity: hcl.DiagWarning, Summary: "Unchecked", Detail: "Check the size."}}
+	}))
+	const in = `
+apiVersion: example.com/v1
+kind: Database
+metadata:
+  name: orders
+  namespace: shop
+---
+apiVersion: v1
+kind: Namespace
+metadata:
+  name: shop
+`
+	var out, warnings bytes.Buffer
+	if err := ConvertWithOptions(strings.NewReader(in), &out, Options{
+		Convert:  convert.Options{Registry: reg},
+		Warnings: &warnings,
+	}); err != nil {
+		t.Fatal(err)
+	}
+	want := `resource "kubernetes_namespace_v1" "shop" {
+  metadata {
+    name = "shop"
+  }
+}
+module "orders" {
+  source     = "./modules/database"
+  namespace  = "shop"
+  depends_on = [kubernetes_namespace_v1.shop]
+}
+`
+	if diff := cmp.Diff(want, out.String()); diff != "" {
+		t.Errorf("unexpected output (-want +got):\n%s", diff)
+	}
+	if got, want := warnings.String(), "warning: Database orders: Unchecked: Check the size.\n"; got != want {
+		t.Errorf("got warnings %q, want %q", got, want)
+	}
+}
+
+// TestUpgradeWarnings checks that -upgrade-api-versions warns about each
+// removed API version once, whether or not it can be upgraded.
+func TestUpgradeWarnings(t *testing.T) {
+	const in = `
+apiVersion: policy/v1beta1
+kind: PodSecurityPolicy
+metadata:
+  name: restricted
+spec:
+  privileged: false
+---
+apiVersion: policy/v1beta1
+kind: PodDisruptionBudget
+metadata:
+  name: everything
+spec:
+  selector: {}
+  minAvailable: 1
+---
+apiVersion: policy/v1beta1
+kind: PodDisruptionBudget
+metadata:
+  name: web
+spec:
+  selector:
+    matchLabels:
+      app: web
+  minAvailable: 1
+`
+	var out, warnings bytes.Buffer
+	if err := ConvertWithOptions(strings.NewReader(in), &out, Options{
+		Convert:  convert.Options{UpgradeAPIVersions: true},
+		Warnings: &warnings,
+	}); err != nil {
+		t.Fatal(err)
+	}
+	counts := make(map[string]int)
+	for _, line := range strings.Split(strings.TrimSpace(warnings.String()), "\n") {
+		// The object and the summary, without the detail.
+		fields := strings.SplitN(line, ": ", 4)
+		if len(fields) < 3 {
+			t.Fatalf("unexpected warning %q", line)
+		}
+		counts[fields[1]+": "+fields[2]]++
+	}
+	want := map[string]int{
+		"PodSecurityPolicy restricted: Removed API version":   1,
+		"PodSecurityPolicy restricted: Deprecated resource":   1,
+		"PodDisruptionBudget everything: Removed API version": 1,
+		"PodDisruptionBudget web: Upgraded API version":       1,
+	}
+	if diff := cmp.Diff(want, counts); diff != "" {
+		t.Errorf("unexpected warnings (-want +got):\n%s\nwarnings:\n%s", diff, warnings.String())
+	}
+}
+
+func TestForEach(t *testing.T) {
+	const in = `
+apiVersion: v1
+kind: Namespace
+metadata:
+  name: teams
+---
+apiVersion: v1
+kind: ServiceAccount
+metadata:
+  name: team-a
+  namespace: teams
+  labels:
+    team: a
+---
+apiVersion: v1
+kind: ServiceAccount
+metadata:
+  name: team-b
+  namespace: teams
+  labels:
+    team: b
+---
+apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: team-a
+  namespace: teams
+data:
+  owner: "alice"
+  size: "3"
+---
+apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: team-b
+  namespace: teams
+data:
+  owner: "bob"
+  size: "3"
+---
+apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: other
+  namespace: teams
+data:
+  x: "y"
+`
+	const want = `locals {
+  service_account_v1_team = {
+    team_a = {
+      team = "a"
+      name = "team-a"
+    }
+    team_b = {
+      team = "b"
+      name = "team-b"
+    }
+  }
+  config_map_v1_team = {
+    team_a = {
+      owner = "alice"
+      name  = "team-a"
+    }
+    team_b = {
+      owner = "bob"
+      name  = "team-b"
+    }
+  }
+}
+
+resource "kubernetes_namespace_v1" "teams" {
+  metadata {
+    name = "teams"
+  }
+}
+resource "kubernetes_service_account_v1" "team" {
+  for_each = local.service_account_v1_team
+
+  metadata {
+    labels = {
+      team = each.value.team
+    }
+    name      = each.value.name
+    namespace = "teams"
+  }
+  depends_on = [kubernetes_namespace_v1.teams]
+}
+resource "kubernetes_config_map_v1" "team" {
+  for_each = local.config_map_v1_team
+
+  data = {
+    owner = each.value.owner
+    size  = "3"
+  }
+  metadata {
+    name      = each.value.name
+    namespace = "teams"
+  }
+  depends_on = [kubernetes_namespace_v1.teams]
+}
+resource "kubernetes_config_map_v1" "other" {
+  data = {
+    x = "y"
+  }
+  metadata {
+    name      = "other"
+    namespace = "teams"
+  }
+  depends_on = [kubernetes_namespace_v1.teams]
+}
+`
+	var out bytes.Buffer
+	if err := ConvertWithOptions(strings.NewReader(in), &out, Options{ForEach: true}); err != nil {
+		t.Fatal(err)
+	}
+	if diff := cmp.Diff(want, out.String()); diff != "" {
+		t.Errorf("unexpected output (-want +got):\n%s", diff)
+	}
+
+	// Without the option, nothing changes.
+	out.Reset()
+	if err := Convert(strings.NewReader(in), &out); err != nil {
+		t.Fatal(err)
+	}
+	if got := len(addresses(t, out.Bytes())); got != 6 {
+		t.Errorf("got %d resources without ForEach, want 6", got)
+	}
+}
+
+func TestForEachManyDifferences(t *testing.T) {
+	// Same shape, but the name and four data values differ.
+	const in = `
+apiVersion: v1
+kind: ConfigMap
+metadata: {name: a}
+data: {w: "1", x: "1", y: "1", z: "1"}
+---
+apiVersion: v1
+kind: ConfigMap
+metadata: {name: b}
+data: {w: "2", x: "2", y: "2", z: "2"}
+`
+	var out bytes.Buffer
+	if err := ConvertWithOptions(strings.NewReader(in), &out, Options{ForEach: true}); err != nil {
+		t.Fatal(err)
+	}
+	if got := len(addresses(t, out.Bytes())); got != 2 || strings.Contains(out.String(), "for_each") {
+		t.Errorf("got %d resources, want the 2 left as they were:\n%s", got, out.String())
+	}
+}
+
+func TestHoist(t *testing.T) {
+	const in = `
+apiVersion: v1
+kind: ServiceAccount
+metadata:
+  name: web
+  namespace: default
+  labels:
+    app.kubernetes.io/name: web
+    app.kubernetes.io/instance: prod
+    app.kubernetes.io/managed-by: Helm
+    helm.sh/chart: web-1.0.0
+---
+apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: web
+  namespace: default
+  labels:
+    app.kubernetes.io/name: web
+    app.kubernetes.io/instance: prod
+    app.kubernetes.io/managed-by: Helm
+    helm.sh/chart: web-1.0.0
+    tier: frontend
+spec:
+  selector:
+    matchLabels:
+      app.kubernetes.io/name: web
+      app.kubernetes.io/instance: prod
+  template:
+    metadata:
+      labels:
+        app.kubernetes.io/name: web
+        app.kubernetes.io/instance: prod
+    spec:
+      serviceAccountName: web
+      initContainers:
+      - name: migrate
+        image: example.com/web:1.2.3
+      containers:
+      - name: web
+        image: example.com/web:1.2.3
+---
+apiVersion: example.com/v1
+kind: Widget
+metadata:
+  name: web
+  namespace: default
+  labels:
+    app.kubernetes.io/name: web
+    app.kubernetes.io/instance: prod
+    app.kubernetes.io/managed-by: Helm
+    helm.sh/chart: web-1.0.0
+spec:
+  image: example.com/web:1.2.3
+`
+	const want = `locals {
+  common_labels = {
+    "app.kubernetes.io/instance"   = "prod"
+    "app.kubernetes.io/managed-by" = "Helm"
+    "app.kubernetes.io/name"       = "web"
+    "helm.sh/chart"                = "web-1.0.0"
+  }
+  common_labels_2 = {
+    "app.kubernetes.io/instance" = "prod"
+    "app.kubernetes.io/name"     = "web"
+  }
+  web_image = "example.com/web:1.2.3"
+}
+
+resource "kubernetes_service_account_v1" "web" {
+  metadata {
+    labels    = local.common_labels
+    name      = "web"
+    namespace = "default"
+  }
+}
+resource "kubernetes_deployment_v1" "web" {
+  metadata {
+    labels = merge(local.common_labels, {
+      tier = "frontend"
+    })
+    name      = "web"
+    namespace = "default"
+  }
+  spec {
+    selector {
+      match_labels = local.common_labels_2
+    }
+    template {
+      metadata {
+        labels = local.common_labels_2
+      }
+      spec {
+        service_account_name = "web"
+        container {
+          image = local.web_image
+          name  = "web"
+        }
+        init_container {
+          image = local.web_image
+          name  = "migrate"
+        }
+      }
+    }
+  }
+}
+resource "kubernetes_manifest" "widget__web" {
+  manifest = {
+    "apiVersion" = "example.com/v1"
+    "kind"       = "Widget"
+    "metadata" = {
+      "labels"    = local.common_labels
+      "name"      = "web"
+      "namespace" = "default"
+    }
+    "spec" = {
+      "image" = local.web_image
+    }
+  }
+}
+`
+	var out bytes.Buffer
+	if err := ConvertWithOptions(strings.NewReader(in), &out, Options{Hoist: true}); err != nil {
+		t.Fatal(err)
+	}
+	if diff := cmp.Diff(want, out.String()); diff != "" {
+		t.Errorf("unexpected output (-want +got):\n%s", diff)
+	}
+}
+
+func TestConvertModule(t *testing.T) {
+	const in = `
+apiVersion: v1
+kind: Namespace
+metadata:
+  name: teams
+---
+apiVersion: v1
+kind: Service
+metadata:
+  name: team-a
+  namespace: teams
+spec:
+  ports:
+  - port: 80
+---
+apiVersion: v1
+kind: Service
+metadata:
+  name: team-b
+  namespace: teams
+spec:
+  ports:
+  - port: 80
+---
+apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: settings
+  namespace: teams
+data:
+  a: b
+`
+	dir := t.TempDir()
+	if err := ConvertModule(strings.NewReader(in), dir, Options{ForEach: true}); err != nil {
+		t.Fatal(err)
+	}
+	for _, c := range []struct {
+		file, want string
+	}{{
+		file: "outputs.tf",
+		want: `output "teams_namespace" {
+  description = "The name of Namespace \"teams\"."
+  value = {
+    name = kubernetes_namespace_v1.teams.metadata[0].name
+  }
+}
+
+output "team_service" {
+  description = "The name and namespace of each Service, by key."
+  value = { for k, v in kubernetes_service_v1.team : k => {
+    name      = v.metadata[0].name
+    namespace = v.metadata[0].namespace
+  } }
+}
+`,
+	}, {
+		file: "versions.tf",
+		want: `terraform {
+  required_providers {
+    kubernetes = {
+      source  = "hashicorp/kubernetes"
+      version = "~> ` + gen.ProviderVersion + `"
+    }
+  }
+}
+`,
+	}, {
+		file: "variables.tf",
+		want: "",
+	}} {
+		got, err := os.ReadFile(filepath.Join(dir, c.file))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if diff := cmp.Diff(c.want, string(got)); diff != "" {
+			t.Errorf("unexpected %s (-want +got):\n%s", c.file, diff)
+		}
+	}
+	main, err := os.ReadFile(filepath.Join(dir, "main.tf"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []string{"kubernetes_namespace_v1.teams", "kubernetes_service_v1.team", "kubernetes_config_map_v1.settings"}
+	if diff := cmp.Diff(want, addresses(t, main)); diff != "" {
+		t.Errorf("unexpected resources in main.tf (-want +got):\n%s", diff)
+	}
+}
+
+// TestConvertModuleSpecs checks that a module converted with specs from some
+// other version of the provider doesn't pin the built in one.
+func TestConvertModuleSpecs(t *testing.T) {
+	const in = `
+apiVersion: v1
+kind: Namespace
+metadata:
+  name: teams
+`
+	opts := Options{Convert: convert.Options{Specs: gen.Specs{}}}
+	if err := ConvertModule(strings.NewReader(in), t.TempDir(), opts); err == nil {
+		t.Error("ConvertModule with Specs but no ProviderVersion: expected an error")
+	}
+
+	dir := t.TempDir()
+	opts.Convert.ProviderVersion = "2.30.0"
+	if err := ConvertModule(strings.NewReader(in), dir, opts); err != nil {
+		t.Fatal(err)
+	}
+	versions, err := os.ReadFile(filepath.Join(dir, "versions.tf"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(string(versions), `version = "~> 2.30.0"`) {
+		t.Errorf("versions.tf doesn't pin 2.30.0:\n%s", versions)
+	}
+}
@@ -0,0 +1,186 @@
+package ktf
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclsyntax"
+	"github.com/hashicorp/hcl/v2/hclwrite"
+	"github.com/zclconf/go-cty/cty"
+
+	"github.com/pfcm/ktf/convert"
+	"github.com/pfcm/ktf/convert/gen"
+	"github.com/pfcm/ktf/resource"
+)
+
+// kubectlProviderVersion is the version of gavinbunney/kubectl that
+// kubectl_manifest resources are written for.
+const kubectlProviderVersion = "1.14"
+
+// outputKinds are the kinds of resource, and their groups, whose names (and
+// namespaces) are exposed as outputs of a module, as they're the ones other
+// things are likely to need to refer to.
+var outputKinds = map[string]string{
+	"Namespace":      "",
+	"Service":        "",
+	"ServiceAccount": "",
+	"Ingress":        "networking.k8s.io",
+}
+
+// ConvertModule converts the yaml from in into a terraform module in dir,
+// made up of main.tf with the resources, variables.tf with any variables
+// introduced by opts.Convert.Parameters, outputs.tf with the names of the
+// more useful resources and versions.tf pinning the provider. opts.CRDOut is
+// ignored: CRDs go in main.tf. If opts.Convert.Specs are set, so must be
+// opts.Convert.ProviderVersion, as that's the version to pin.
+func ConvertModule(in io.Reader, dir string, opts Options) error {
+	version := opts.Convert.ProviderVersion
+	if version == "" {
+		if opts.Convert.Specs != nil {
+			return errors.New("no provider version to pin for the given specs")
+		}
+		version = gen.ProviderVersion
+	}
+	objs, err := readObjects(in, opts)
+	if err != nil {
+		return err
+	}
+	addCRDDependencies(objs)
+	addDependencies(objs)
+
+	main := hclwrite.NewEmptyFile()
+	objs = writeObjects(main.Body(), objs, opts)
+
+	variables := hclwrite.NewEmptyFile()
+	writeVariables(variables.Body(), opts, nil)
+
+	outputs := hclwrite.NewEmptyFile()
+	writeOutputs(outputs.Body(), objs)
+
+	versions := hclwrite.NewEmptyFile()
+	writeVersions(versions.Body(), version, objs)
+
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return err
+	}
+	for name, f := range map[string]*hclwrite.File{
+		"main.tf":      main,
+		"variables.tf": variables,
+		"outputs.tf":   outputs,
+		"versions.tf":  versions,
+	} {
+		var buf bytes.Buffer
+		if err := writeFile(&buf, f); err != nil {
+			return err
+		}
+		if err := os.WriteFile(filepath.Join(dir, name), buf.Bytes(), 0644); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// writeVersions pins the kubernetes provider to the version the specs are
+// for, and the kubectl provider if any of objs need it.
+func writeVersions(body *hclwrite.Body, version string, objs []*object) {
+	providers := body.AppendNewBlock("terraform", nil).Body().AppendNewBlock("required_providers", nil).Body()
+	providers.SetAttributeValue("kubernetes", cty.ObjectVal(map[string]cty.Value{
+		"source":  cty.StringVal("hashicorp/kubernetes"),
+		"version": cty.StringVal("~> " + version),
+	}))
+	if slices.ContainsFunc(objs, func(o *object) bool { return o.block.Labels()[0] == string(convert.KubectlFallback) }) {
+		providers.SetAttributeValue("kubectl", cty.ObjectVal(map[string]cty.Value{
+			"source":  cty.StringVal("gavinbunney/kubectl"),
+			"version": cty.StringVal("~> " + kubectlProviderVersion),
+		}))
+	}
+}
+
+// writeOutputs adds an output with the name, and namespace if it has one, of
+// each object of one of the outputKinds.
+func writeOutputs(body *hclwrite.Body, objs []*object) {
+	names := make(map[string]bool)
+	for _, o := range objs {
+		want, ok := outputKinds[o.Kind]
+		if group, _ := o.GroupVersion(); !ok || group != want || o.block.Type() != "resource" {
+			continue
+		}
+		base := identifier(o.name() + "_" + resource.ToSnake(o.Kind))
+		name := base
+		for n := 2; names[name]; n++ {
+			name = fmt.Sprintf("%s_%d", base, n)
+		}
+		names[name] = true
+
+		fields := []string{"name"}
+		if o.Kind != "Namespace" {
+			fields = append(fields, "namespace")
+		}
+		separate(body)
+		b := body.AppendNewBlock("output", []string{name}).Body()
+		if o.forEach {
+			b.SetAttributeValue("description", cty.StringVal(fmt.Sprintf("The %s of each %s, by key.", strings.Join(fields, " and "), o.Kind)))
+			b.SetAttributeRaw("value", forTokens(o.traversal(), metadataTokens(o, hcl.Traversal{hcl.TraverseRoot{Name: "v"}}, fields)))
+		} else {
+			b.SetAttributeValue("description", cty.StringVal(fmt.Sprintf("The %s of %s %q.", strings.Join(fields, " and "), o.Kind, o.Metadata.Name)))
+			b.SetAttributeRaw("value", metadataTokens(o, o.traversal(), fields))
+		}
+	}
+}
+
+// metadataTokens is an object holding the given metadata fields of the
+// resource at root.
+func metadataTokens(o *object, root hcl.Traversal, fields []string) hclwrite.Tokens {
+	attrs := make([]hclwrite.ObjectAttrTokens, len(fields))
+	for i, f := range fields {
+		var tr hcl.Traversal
+		switch o.block.Labels()[0] {
+		case "kubectl_manifest":
+			tr = append(slices.Clone(root), hcl.TraverseAttr{Name: f})
+		case "kubernetes_manifest":
+			tr = append(slices.Clone(root),
+				hcl.TraverseAttr{Name: "object"},
+				hcl.TraverseAttr{Name: "metadata"},
+				hcl.TraverseAttr{Name: f},
+			)
+		default:
+			tr = append(slices.Clone(root),
+				hcl.TraverseAttr{Name: "metadata"},
+				hcl.TraverseIndex{Key: cty.NumberIntVal(0)},
+				hcl.TraverseAttr{Name: f},
+			)
+		}
+		attrs[i] = hclwrite.ObjectAttrTokens{
+			Name:  hclwrite.TokensForIdentifier(f),
+			Value: hclwrite.TokensForTraversal(tr),
+		}
+	}
+	return hclwrite.TokensForObject(attrs)
+}
+
+// forTokens writes { for k, v in collection : k => value }.
+func forTokens(collection hcl.Traversal, value hclwrite.Tokens) hclwrite.Tokens {
+	toks := hclwrite.Tokens{
+		{Type: hclsyntax.TokenOBrace, Bytes: []byte("{")},
+		{Type: hclsyntax.TokenIdent, Bytes: []byte("for")},
+		{Type: hclsyntax.TokenIdent, Bytes: []byte("k")},
+		{Type: hclsyntax.TokenComma, Bytes: []byte(",")},
+		{Type: hclsyntax.TokenIdent, Bytes: []byte("v")},
+		{Type: hclsyntax.TokenIdent, Bytes: []byte("in")},
+	}
+	toks = append(toks, hclwrite.TokensForTraversal(collection)...)
+	toks = append(toks,
+		&hclwrite.Token{Type: hclsyntax.TokenColon, Bytes: []byte(":")},
+		&hclwrite.Token{Type: hclsyntax.TokenIdent, Bytes: []byte("k")},
+		&hclwrite.Token{Type: hclsyntax.TokenFatArrow, Bytes: []byte("=>")},
+	)
+	toks = append(toks, value...)
+	return append(toks, &hclwrite.Token{Type: hclsyntax.TokenCBrace, Bytes: []byte("}")})
+}
@@ -3,6 +3,7 @@
 package resource
 
 import (
+	"bytes"
 	"encoding/json"
 	"fmt"
 	"strings"
@@ -44,8 +45,12 @@ func (r *Resource) UnmarshalJSON(raw []byte) error {
 	if err := json.Unmarshal(raw, &typeMeta); err != nil {
 		return err
 	}
+	// Numbers are kept as json.Numbers so that nothing is lost, they can be
+	// anything from a port to a very large integer.
 	all := make(map[string]any)
-	if err := json.Unmarshal(raw, &all); err != nil {
+	d := json.NewDecoder(bytes.NewReader(raw))
+	d.UseNumber()
+	if err := d.Decode(&all); err != nil {
 		return err
 	}
 	r.TypeKey = typeMeta.TypeKey
@@ -61,6 +66,16 @@ type TypeKey struct {
 	Kind       string // the kind from  the manifest.
 }
 
+// GroupVersion splits the APIVersion into the API group and version. The group
+// is empty for the core API ("v1").
+func (tk TypeKey) GroupVersion() (group, version string) {
+	group, version, ok := strings.Cut(tk.APIVersion, "/")
+	if !ok {
+		return "", group
+	}
+	return group, version
+}
+
 // PartialMetadata is a partially kubernetes ObjectMeta block, to make it easy
 // to access fields that are usually need while generating resources, such as
 // metadata and name.